@@ -11,7 +11,7 @@ func NewAccountCmd(f *factory.Factory) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "account",
 		Short:   "Manage Basecamp accounts",
-		Long:    `Work with Basecamp accounts - list, select, and manage accounts.`,
+		Long:    `Work with Basecamp accounts - list, select, add, and manage accounts.`,
 		Aliases: []string{"a"},
 	}
 
@@ -23,6 +23,7 @@ func NewAccountCmd(f *factory.Factory) *cobra.Command {
 	cmd.AddCommand(newSelectCmd(f))
 	cmd.AddCommand(newSetCmd(f))
 	cmd.AddCommand(newCurrentCmd(f))
+	cmd.AddCommand(newAddCmd(f))
 
 	return cmd
 }
@@ -0,0 +1,68 @@
+package account
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/needmore/bc4/internal/errors"
+	"github.com/needmore/bc4/internal/factory"
+)
+
+func newAddCmd(f *factory.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add",
+		Short: "Authenticate an additional account",
+		Long: `Log in to Basecamp again to add accounts you don't have access to yet,
+such as another Basecamp organization.
+
+Uses the OAuth credentials already in your config. Newly discovered accounts are
+added alongside your existing ones; existing accounts and the default account
+are left unchanged. Use 'account set' to switch to a new account afterwards.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Get config from factory
+			cfg, err := f.Config()
+			if err != nil {
+				return fmt.Errorf("failed to load config: %w", err)
+			}
+
+			// Adding accounts needs the OAuth app from the first-run setup
+			if cfg.ClientID == "" || cfg.ClientSecret == "" {
+				return errors.NewConfigurationError("OAuth credentials not configured", nil)
+			}
+
+			// Get auth client from factory
+			authClient, err := f.AuthClient()
+			if err != nil {
+				return err
+			}
+
+			previousDefault := authClient.GetDefaultAccount()
+
+			fmt.Println("Starting authentication flow...")
+			added, err := authClient.AddAccounts(context.Background())
+			if err != nil {
+				return fmt.Errorf("failed to add account: %w", err)
+			}
+
+			if len(added) == 0 {
+				fmt.Println("No new accounts found. All accounts for this login are already authenticated.")
+				return nil
+			}
+
+			for _, account := range added {
+				fmt.Printf("Added account: %s (ID: %s)\n", account.AccountName, account.AccountID)
+			}
+			if previousDefault != "" {
+				fmt.Printf("Default account is still %s. Use 'bc4 account set <id>' to change it.\n", previousDefault)
+			} else {
+				fmt.Printf("Default account set to %s.\n", authClient.GetDefaultAccount())
+			}
+			return nil
+		},
+	}
+
+	return cmd
+}
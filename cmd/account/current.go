@@ -2,24 +2,67 @@ package account
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"os"
+	"strconv"
+	"time"
 
 	"github.com/spf13/cobra"
 
+	"github.com/needmore/bc4/internal/api"
 	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/identity"
 	"github.com/needmore/bc4/internal/ui"
+	"github.com/needmore/bc4/internal/ui/tableprinter"
 )
 
 func newCurrentCmd(f *factory.Factory) *cobra.Command {
 	var jsonOutput bool
+	var showProjects bool
+	var showToken bool
+	var formatStr string
+	var refresh bool
 
 	cmd := &cobra.Command{
-		Use:     "current",
-		Short:   "Show current account",
-		Long:    `Display information about the current default account.`,
+		Use:   "current",
+		Short: "Show current account",
+		Long: `Display information about the current default account and who you are
+logged in as.
+
+Your name and email are cached for 24 hours so commands that need to know
+who you are don't look you up every time. Use --refresh to fetch them again,
+for example after changing your profile. Logging in or out clears the cache.
+
+Use --projects to also list the projects you're a member of in this account,
+with your title and role on each.
+
+Use --token to debug authentication: it shows the token type, a redacted
+token, when it was obtained and expires, and any granted scopes. The full
+token is never printed.`,
+		Example: `  # Show the current account
+  bc4 account current
+
+  # Look up your profile again instead of using the cached one
+  bc4 account whoami --refresh
+
+  # List the projects you belong to
+  bc4 account whoami --projects
+
+  # Inspect the stored token (redacted)
+  bc4 account whoami --token`,
 		Aliases: []string{"whoami"},
 		RunE: func(cmd *cobra.Command, args []string) error {
+			// Parse output format
+			format, err := ui.ResolveTableOrJSON(formatStr)
+			if err != nil {
+				return err
+			}
+			if format == ui.OutputFormatJSON {
+				jsonOutput = true
+			}
+
 			// Get config from factory
 			cfg, err := f.Config()
 			if err != nil {
@@ -48,9 +91,12 @@ func newCurrentCmd(f *factory.Factory) *cobra.Command {
 
 			// Prepare output data
 			type currentAccount struct {
-				ID      string `json:"id"`
-				Name    string `json:"name"`
-				Default bool   `json:"default"`
+				ID       string              `json:"id"`
+				Name     string              `json:"name"`
+				Default  bool                `json:"default"`
+				User     *currentUser        `json:"user,omitempty"`
+				Projects []projectMembership `json:"projects,omitempty"`
+				Token    *tokenInfo          `json:"token,omitempty"`
 			}
 
 			current := currentAccount{
@@ -59,6 +105,55 @@ func newCurrentCmd(f *factory.Factory) *cobra.Command {
 				Default: true,
 			}
 
+			// Who you are is a bonus; the account is still shown, from the
+			// config alone, if there is no API client or the profile can't
+			// be fetched
+			client, clientErr := f.ApiClient()
+			var self *identity.Identity
+			if clientErr != nil {
+				fmt.Fprintf(os.Stderr, "Warning: failed to get your profile: %v\n", clientErr)
+			} else if self, err = identity.Me(f.Context(), client.Client, defaultAccountID, refresh); err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: failed to get your profile: %v\n", err)
+			} else {
+				current.User = &currentUser{ID: self.PersonID, Name: self.Name, Email: self.Email}
+			}
+
+			if showProjects {
+				if clientErr != nil {
+					return clientErr
+				}
+				var memberships []api.ProjectMembership
+				if self != nil {
+					memberships, err = client.GetProjectMemberships(f.Context(), self.PersonID)
+				} else {
+					memberships, err = client.GetMyProjectMemberships(f.Context())
+				}
+				var partialErr *api.PartialError
+				if errors.As(err, &partialErr) {
+					// Show the projects that did load rather than failing outright
+					fmt.Fprintf(os.Stderr, "Warning: %v\n", partialErr)
+				} else if err != nil {
+					return fmt.Errorf("failed to fetch project memberships: %w", err)
+				}
+
+				current.Projects = make([]projectMembership, 0, len(memberships))
+				for _, m := range memberships {
+					current.Projects = append(current.Projects, newProjectMembership(m))
+				}
+			}
+
+			if showToken {
+				// Identity details are a bonus; the stored token is enough to
+				// report on if Launchpad can't be reached
+				authorization, err := authClient.GetAuthorization(f.Context(), defaultAccountID)
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+				}
+				// Re-read the token, which GetAuthorization may have refreshed
+				info := newTokenInfo(authClient.GetAccounts()[defaultAccountID], authorization, time.Now())
+				current.Token = &info
+			}
+
 			// Output JSON if requested
 			if jsonOutput {
 				encoder := json.NewEncoder(os.Stdout)
@@ -72,6 +167,13 @@ func newCurrentCmd(f *factory.Factory) *cobra.Command {
 			fmt.Println()
 			fmt.Printf("%s %s\n", ui.LabelStyle.Render("Name:"), ui.ValueStyle.Render(account.AccountName))
 			fmt.Printf("%s %s\n", ui.LabelStyle.Render("ID:"), ui.ValueStyle.Render(defaultAccountID))
+			if current.User != nil {
+				user := current.User.Name
+				if current.User.Email != "" {
+					user += " <" + current.User.Email + ">"
+				}
+				fmt.Printf("%s %s\n", ui.LabelStyle.Render("User:"), ui.ValueStyle.Render(user))
+			}
 
 			// Show default project if set
 			if cfg.DefaultProject != "" {
@@ -84,11 +186,75 @@ func newCurrentCmd(f *factory.Factory) *cobra.Command {
 
 			fmt.Println()
 
+			if current.Token != nil {
+				renderTokenInfo(os.Stdout, *current.Token)
+			}
+
+			if showProjects {
+				if len(current.Projects) == 0 {
+					fmt.Println("You're not a member of any projects in this account.")
+					return nil
+				}
+				fmt.Println(ui.TitleStyle.Render("Projects"))
+				fmt.Println()
+				return renderProjectMemberships(os.Stdout, current.Projects)
+			}
+
 			return nil
 		},
 	}
 
 	cmd.Flags().BoolVar(&jsonOutput, "json", false, "Output as JSON")
+	cmd.Flags().BoolVar(&showProjects, "projects", false, "List the projects you're a member of")
+	cmd.Flags().BoolVar(&showToken, "token", false, "Show redacted details of the stored OAuth token")
+	cmd.Flags().StringVarP(&formatStr, "format", "f", "table", "Output format: table or json")
+	cmd.Flags().BoolVar(&refresh, "refresh", false, "Fetch your profile again instead of using the cached one")
 
 	return cmd
 }
+
+// currentUser is who you are logged in as in the current account
+type currentUser struct {
+	ID    int64  `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email,omitempty"`
+}
+
+// projectMembership is a project the current user belongs to, as shown by
+// --projects
+type projectMembership struct {
+	ProjectID   int64  `json:"project_id"`
+	ProjectName string `json:"project_name"`
+	Title       string `json:"title,omitempty"`
+	Role        string `json:"role"`
+}
+
+func newProjectMembership(m api.ProjectMembership) projectMembership {
+	role := "Member"
+	if m.Person.Owner {
+		role = "Owner"
+	} else if m.Person.Admin {
+		role = "Admin"
+	}
+	return projectMembership{
+		ProjectID:   m.Project.ID,
+		ProjectName: m.Project.Name,
+		Title:       m.Person.Title,
+		Role:        role,
+	}
+}
+
+func renderProjectMemberships(w io.Writer, projects []projectMembership) error {
+	table := tableprinter.New(w)
+	table.AddHeader("PROJECT", "ID", "TITLE", "ROLE")
+
+	cs := table.GetColorScheme()
+	for _, p := range projects {
+		table.AddProjectField(p.ProjectName, "active")
+		table.AddIDField(strconv.FormatInt(p.ProjectID, 10), "")
+		table.AddField(p.Title, cs.Muted)
+		table.AddField(p.Role)
+		table.EndRow()
+	}
+	return table.Render()
+}
@@ -62,7 +62,7 @@ func newListCmd(f *factory.Factory) *cobra.Command {
 			})
 
 			// Parse output format
-			format, err := ui.ParseOutputFormat(formatStr)
+			format, err := ui.ResolveTableOrJSON(formatStr)
 			if err != nil {
 				return err
 			}
@@ -128,7 +128,7 @@ func newListCmd(f *factory.Factory) *cobra.Command {
 	}
 
 	cmd.Flags().BoolVar(&jsonOutput, "json", false, "Output as JSON (deprecated, use --format=json)")
-	cmd.Flags().StringVarP(&formatStr, "format", "f", "table", "Output format: table, json, or csv")
+	cmd.Flags().StringVarP(&formatStr, "format", "f", "table", "Output format: table or json")
 
 	return cmd
 }
@@ -22,6 +22,11 @@ type accountsLoadedMsg struct {
 	accounts []accountItem
 }
 
+// defaultSavedMsg reports the result of saving the chosen default
+type defaultSavedMsg struct {
+	err error
+}
+
 type accountItem struct {
 	id      string
 	name    string
@@ -34,6 +39,7 @@ type selectModel struct {
 	spinner  spinner.Model
 	loading  bool
 	err      error
+	saveErr  error
 	width    int
 	height   int
 	factory  *factory.Factory
@@ -105,6 +111,10 @@ func (m selectModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.list.Styles.TitleBar = lipgloss.NewStyle()
 		return m, nil
 
+	case defaultSavedMsg:
+		m.saveErr = msg.err
+		return m, nil
+
 	case spinner.TickMsg:
 		var cmd tea.Cmd
 		m.spinner, cmd = m.spinner.Update(msg)
@@ -198,25 +208,24 @@ func (m *selectModel) setDefaultAccount(accountID, accountName string) tea.Cmd {
 		}
 
 		// Update config
-		cfg.DefaultAccount = accountID
-
-		// Clear default project if changing accounts
-		if changingAccounts {
-			cfg.DefaultProject = ""
-			// Also clear the account-specific default project
-			if cfg.Accounts != nil {
-				for accID, accConfig := range cfg.Accounts {
-					if accID == accountID {
-						accConfig.DefaultProject = ""
-						cfg.Accounts[accID] = accConfig
-					}
+		err = config.Update(func(cfg *config.Config) error {
+			cfg.DefaultAccount = accountID
+
+			// Clear default project if changing accounts
+			if changingAccounts {
+				cfg.DefaultProject = ""
+				// Also clear the account-specific default project
+				if accConfig, ok := cfg.Accounts[accountID]; ok {
+					accConfig.DefaultProject = ""
+					cfg.Accounts[accountID] = accConfig
 				}
 			}
+			return nil
+		})
+		if err != nil {
+			return defaultSavedMsg{err: fmt.Errorf("failed to save config: %w", err)}
 		}
 
-		// Save config
-		_ = config.Save(cfg)
-
 		fmt.Printf("\nDefault account set to: %s (ID: %s)\n", accountName, accountID)
 		if changingAccounts {
 			fmt.Println("Note: Default project has been cleared since you changed accounts.")
@@ -310,9 +319,13 @@ func newSelectCmd(f *factory.Factory) *cobra.Command {
 			}
 
 			// Run the interactive selector
-			if _, err := tea.NewProgram(m, tea.WithAltScreen()).Run(); err != nil {
+			final, err := tea.NewProgram(m, tea.WithAltScreen()).Run()
+			if err != nil {
 				return fmt.Errorf("error running selector: %w", err)
 			}
+			if sm, ok := final.(selectModel); ok && sm.saveErr != nil {
+				return sm.saveErr
+			}
 
 			return nil
 		},
@@ -18,12 +18,6 @@ func newSetCmd(f *factory.Factory) *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			accountID := args[0]
 
-			// Get config from factory
-			cfg, err := f.Config()
-			if err != nil {
-				return fmt.Errorf("failed to load config: %w", err)
-			}
-
 			// Get auth client from factory
 			authClient, err := f.AuthClient()
 			if err != nil {
@@ -47,23 +41,25 @@ func newSetCmd(f *factory.Factory) *cobra.Command {
 			}
 
 			// Update config
-			cfg.DefaultAccount = accountID
+			err = config.Update(func(cfg *config.Config) error {
+				cfg.DefaultAccount = accountID
 
-			// Clear default project if changing accounts
-			if changingAccounts {
-				cfg.DefaultProject = ""
-				// Also clear the account-specific default project
-				if cfg.Accounts != nil {
-					for accID, accConfig := range cfg.Accounts {
-						if accID == accountID {
-							accConfig.DefaultProject = ""
-							cfg.Accounts[accID] = accConfig
+				// Clear default project if changing accounts
+				if changingAccounts {
+					cfg.DefaultProject = ""
+					// Also clear the account-specific default project
+					if cfg.Accounts != nil {
+						for accID, accConfig := range cfg.Accounts {
+							if accID == accountID {
+								accConfig.DefaultProject = ""
+								cfg.Accounts[accID] = accConfig
+							}
 						}
 					}
 				}
-			}
-
-			if err := config.Save(cfg); err != nil {
+				return nil
+			})
+			if err != nil {
 				return fmt.Errorf("failed to save config: %w", err)
 			}
 
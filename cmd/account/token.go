@@ -0,0 +1,79 @@
+package account
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/needmore/bc4/internal/auth"
+	"github.com/needmore/bc4/internal/ui"
+)
+
+// tokenInfo describes the stored OAuth token without exposing it
+type tokenInfo struct {
+	Type       string    `json:"type"`
+	Token      string    `json:"token"`
+	ObtainedAt time.Time `json:"obtained_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+	Expired    bool      `json:"expired"`
+	Scopes     []string  `json:"scopes,omitempty"`
+	Identity   string    `json:"identity,omitempty"`
+}
+
+// newTokenInfo builds the redacted token details. authorization is optional
+// and fills in the identity, scopes, and server-side expiry when available.
+func newTokenInfo(token auth.AccountToken, authorization *auth.Authorization, now time.Time) tokenInfo {
+	info := tokenInfo{
+		Type:       token.TokenType,
+		Token:      auth.RedactToken(token.AccessToken),
+		ObtainedAt: token.ObtainedAt,
+		ExpiresAt:  token.ExpiresAt(),
+	}
+	if info.Type == "" {
+		info.Type = "Bearer"
+	}
+
+	if authorization != nil {
+		if !authorization.ExpiresAt.IsZero() {
+			info.ExpiresAt = authorization.ExpiresAt
+		}
+		info.Scopes = authorization.Scopes
+		identity := authorization.Identity
+		name := strings.TrimSpace(identity.FirstName + " " + identity.LastName)
+		switch {
+		case name != "" && identity.EmailAddress != "":
+			info.Identity = fmt.Sprintf("%s <%s>", name, identity.EmailAddress)
+		case identity.EmailAddress != "":
+			info.Identity = identity.EmailAddress
+		default:
+			info.Identity = name
+		}
+	}
+
+	info.Expired = now.After(info.ExpiresAt)
+	return info
+}
+
+func renderTokenInfo(w io.Writer, info tokenInfo) {
+	const layout = "January 2, 2006 at 3:04 PM"
+
+	expires := info.ExpiresAt.Local().Format(layout)
+	if info.Expired {
+		expires += " (expired)"
+	}
+
+	_, _ = fmt.Fprintln(w, ui.TitleStyle.Render("Token"))
+	_, _ = fmt.Fprintln(w)
+	_, _ = fmt.Fprintf(w, "%s %s\n", ui.LabelStyle.Render("Type:"), ui.ValueStyle.Render(info.Type))
+	_, _ = fmt.Fprintf(w, "%s %s\n", ui.LabelStyle.Render("Token:"), ui.ValueStyle.Render(info.Token))
+	_, _ = fmt.Fprintf(w, "%s %s\n", ui.LabelStyle.Render("Obtained:"), ui.ValueStyle.Render(info.ObtainedAt.Local().Format(layout)))
+	_, _ = fmt.Fprintf(w, "%s %s\n", ui.LabelStyle.Render("Expires:"), ui.ValueStyle.Render(expires))
+	if info.Identity != "" {
+		_, _ = fmt.Fprintf(w, "%s %s\n", ui.LabelStyle.Render("Identity:"), ui.ValueStyle.Render(info.Identity))
+	}
+	if len(info.Scopes) > 0 {
+		_, _ = fmt.Fprintf(w, "%s %s\n", ui.LabelStyle.Render("Scopes:"), ui.ValueStyle.Render(strings.Join(info.Scopes, ", ")))
+	}
+	_, _ = fmt.Fprintln(w)
+}
@@ -23,6 +23,7 @@ your team and stay up to date on project progress.`,
   bc4 activity list --type todo       # Only todo activity
   bc4 activity list --person "john"   # Activity by person
   bc4 activity list --format json     # Output as JSON
+  bc4 activity show 12345678          # One recording and its history
   bc4 activity watch                  # Watch for real-time activity
   bc4 activity watch --interval 10    # Poll every 10 seconds`,
 	}
@@ -32,6 +33,7 @@ your team and stay up to date on project progress.`,
 
 	// Add subcommands
 	cmd.AddCommand(newListCmd(f))
+	cmd.AddCommand(newShowCmd(f))
 	cmd.AddCommand(newWatchCmd(f))
 
 	return cmd
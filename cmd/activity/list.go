@@ -3,18 +3,26 @@ package activity
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"os"
+	"os/signal"
+	"slices"
 	"strconv"
 	"strings"
+	"text/template"
 	"time"
 
 	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/cmdutil"
 	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/identity"
 	"github.com/needmore/bc4/internal/parser"
 	coretableprinter "github.com/needmore/bc4/internal/tableprinter"
 	"github.com/needmore/bc4/internal/ui"
 	"github.com/needmore/bc4/internal/ui/tableprinter"
+	"github.com/needmore/bc4/internal/utils"
 	"github.com/spf13/cobra"
 )
 
@@ -24,18 +32,116 @@ func newListCmd(f *factory.Factory) *cobra.Command {
 		projectID     string
 		sinceStr      string
 		recordingType string
-		personStr     string
+		people        []string
+		me            bool
 		formatStr     string
+		fieldsStr     string
 		limit         int
+		all           bool
+		maxPages      int
+		templateStr   string
+		recording     string
+		watch         bool
+		interval      time.Duration
 	)
 
 	cmd := &cobra.Command{
-		Use:     "list [project]",
-		Short:   "List recent project activity",
-		Long:    `List recent activity and changes across a Basecamp project.`,
+		Use:   "list [project]",
+		Short: "List recent project activity",
+		Long: `List recent activity and changes across a Basecamp project.
+
+Use --format jsonl to write one activity item per line as newline-delimited
+JSON, without the enclosing project wrapper.
+
+Use --fields with --format json or jsonl to limit each activity item to the given
+fields. Valid fields are the JSON keys of an activity item: ` + strings.Join(ui.JSONFieldNames(ActivityRecord{}), ", ") + `.
+
+Use --all (or --limit 0) to page through all activity instead of showing only
+the most recent items. Combine it with --since to fetch a complete window.
+Each activity type stops after --max-pages pages to avoid unbounded fetches;
+a warning is printed when older activity was left out.
+
+Use --template to format each activity item as a line of your own, like
+git log --format. Fields are written as {name} (or Go template syntax,
+{{.name}}): ` + strings.Join(templateFields, ", ") + `.
+
+Use --person to show activity by particular people, given by ID, name, or
+email. Several people can be given comma-separated or by repeating the flag;
+activity by any of them is shown. --me adds your own activity to the set.
+
+Use --recording <id|URL> to show one recording's details and event history
+instead of the feed (the same as 'bc4 activity show').
+
+Use --watch to keep polling after the listing and print new activity as it
+appears, until Ctrl+C. --interval sets how often to poll (at least 5s);
+polling slows down while the API is returning errors. --type and --person
+apply to the watch too.
+
+Use --type event for recently added or changed schedule entries. Events show
+when they start alongside where they were posted.`,
+		Example: `  bc4 activity list
+  bc4 activity list --since 7d --type todo
+  bc4 activity list --person alice,bob --me --since 7d
+  bc4 activity list --type event --since 14d
+  bc4 activity list --all --since 30d
+  bc4 activity list --format json --fields id,type,title,created_at
+  bc4 activity list --format jsonl --fields id,type,title
+  bc4 activity list --template '{type} {title} by {creator} ({updated})'
+  bc4 activity list --watch --interval 15s --type todo`,
 		Aliases: []string{"ls"},
 		Args:    cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if watch {
+				if recording != "" {
+					return fmt.Errorf("--watch cannot be used with --recording")
+				}
+				if templateStr != "" {
+					return fmt.Errorf("--watch cannot be used with --template")
+				}
+				if err := checkWatchInterval(interval); err != nil {
+					return err
+				}
+			}
+
+			// A single recording is shown in detail rather than as a feed
+			if recording != "" {
+				if len(args) > 0 {
+					f = f.WithProject(args[0])
+				}
+				if accountID != "" {
+					f = f.WithAccount(accountID)
+				}
+				if projectID != "" {
+					f = f.WithProject(projectID)
+				}
+				return showRecording(cmd.Context(), f, recording, formatStr, true, os.Stdout)
+			}
+
+			// Check output format and field selection before hitting the API
+			format, err := ui.ParseOutputFormat(formatStr)
+			if err != nil {
+				return err
+			}
+			fields, err := ui.ParseJSONFields(fieldsStr, ui.JSONFieldNames(ActivityRecord{}))
+			if err != nil {
+				return err
+			}
+			if fields != nil && format != ui.OutputFormatJSON && format != ui.OutputFormatJSONL {
+				return fmt.Errorf("--fields requires --format json or jsonl")
+			}
+			if watch && format != ui.OutputFormatTable {
+				return fmt.Errorf("--watch cannot be used with --format %s", format)
+			}
+			var tmpl *template.Template
+			if templateStr != "" {
+				if format != ui.OutputFormatTable {
+					return fmt.Errorf("--template cannot be used with --format %s", format)
+				}
+				if tmpl, err = parseActivityTemplate(templateStr); err != nil {
+					return err
+				}
+			}
+
 			// Parse project argument if provided (could be URL or ID)
 			if len(args) > 0 {
 				if parser.IsBasecampURL(args[0]) {
@@ -103,33 +209,49 @@ func newListCmd(f *factory.Factory) *cobra.Command {
 			}
 
 			// Parse person filter
-			if personStr != "" {
-				personID, err := parsePersonIdentifier(client, cmd.Context(), personStr)
+			if len(people) > 0 || me {
+				self, err := resolveMe(cmd.Context(), f, client.Client, resolvedProjectID, me)
 				if err != nil {
-					return fmt.Errorf("invalid --person value: %w", err)
+					return err
+				}
+				if opts.PersonIDs, err = resolveActivityPeople(cmd.Context(), client.Client, people, self); err != nil {
+					return err
 				}
-				opts.PersonID = personID
 			}
 
-			// Set limit
-			if limit > 0 {
+			// Set limit; --all and --limit 0 show everything
+			if limit > 0 && !all {
 				opts.Limit = limit
 			}
+			opts.MaxPages = maxPages
 
-			// Get recordings (activity)
-			recordings, err := client.ListRecordings(cmd.Context(), resolvedProjectID, opts)
-			if err != nil {
-				return err
+			if watch {
+				ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt)
+				defer stop()
+				return watchActivity(ctx, client, resolvedProjectID, project.Name, opts, interval)
 			}
 
-			// Check output format
-			format, err := ui.ParseOutputFormat(formatStr)
+			// Get recordings (activity)
+			recordings, err := client.ListRecordings(cmd.Context(), resolvedProjectID, opts)
 			if err != nil {
-				return err
+				var partialErr *api.PartialError
+				if !errors.As(err, &partialErr) {
+					return err
+				}
+				// Only worth mentioning when the cut-off could change what's shown
+				if opts.Limit == 0 || len(recordings) < opts.Limit {
+					fmt.Fprintf(os.Stderr, "Warning: %v; narrow the window with --since or raise --max-pages\n", err)
+				}
 			}
 
 			if format == ui.OutputFormatJSON {
-				return outputActivityJSON(recordings, project.Name)
+				return outputActivityJSON(os.Stdout, recordings, project.Name, fields)
+			}
+			if format == ui.OutputFormatJSONL {
+				return ui.WriteJSONLines(os.Stdout, activityRecords(recordings), fields)
+			}
+			if tmpl != nil {
+				return renderActivityTemplate(os.Stdout, tmpl, recordings)
 			}
 
 			// Display activity
@@ -145,10 +267,18 @@ func newListCmd(f *factory.Factory) *cobra.Command {
 	cmd.Flags().StringVarP(&accountID, "account", "a", "", "Specify account ID")
 	cmd.Flags().StringVarP(&projectID, "project", "p", "", "Specify project ID")
 	cmd.Flags().StringVar(&sinceStr, "since", "", "Show activity since time (e.g., '24h', '7d', '2024-01-01')")
-	cmd.Flags().StringVarP(&recordingType, "type", "t", "", "Filter by type: todo, message, document, comment, upload")
-	cmd.Flags().StringVar(&personStr, "person", "", "Filter by person (ID, name, or email)")
-	cmd.Flags().StringVarP(&formatStr, "format", "f", "table", "Output format: table or json")
-	cmd.Flags().IntVarP(&limit, "limit", "l", 25, "Limit number of items shown")
+	cmd.Flags().StringVarP(&recordingType, "type", "t", "", "Filter by type: todo, message, document, comment, upload, event")
+	cmd.Flags().StringSliceVar(&people, "person", nil, "Filter by people (ID, name, or email; comma-separated or repeated)")
+	cmd.Flags().BoolVar(&me, "me", false, "Include your own activity in the --person filter")
+	cmd.Flags().StringVarP(&formatStr, "format", "f", "table", "Output format: table, json, or jsonl")
+	cmd.Flags().StringVar(&templateStr, "template", "", "Format each activity item with a template, e.g. '{type} {title} by {creator}'")
+	cmd.Flags().StringVar(&fieldsStr, "fields", "", "Comma-separated fields to include in JSON output (e.g., id,type,title,created_at)")
+	cmd.Flags().IntVarP(&limit, "limit", "l", 25, "Limit number of items shown (0 for all)")
+	cmd.Flags().BoolVar(&all, "all", false, "Page through all activity (same as --limit 0)")
+	cmd.Flags().StringVar(&recording, "recording", "", "Show one recording (ID or URL) and its event history instead of the feed")
+	cmd.Flags().BoolVarP(&watch, "watch", "w", false, "Keep polling and print new activity as it appears")
+	cmdutil.IntervalVarP(cmd.Flags(), &interval, "interval", "", 30*time.Second, "Polling interval for --watch (e.g., 15s, 1m, or 30 for seconds)")
+	cmd.Flags().IntVar(&maxPages, "max-pages", api.DefaultActivityMaxPages, "Maximum pages to fetch per activity type (0 for no limit)")
 
 	return cmd
 }
@@ -238,6 +368,8 @@ func parseTypes(s string) []string {
 		"uploads":  "Upload",
 		"file":     "Upload",
 		"files":    "Upload",
+		"event":    "Schedule::Entry",
+		"events":   "Schedule::Entry",
 	}
 
 	for _, t := range types {
@@ -272,14 +404,37 @@ type ActivityRecord struct {
 	URL          string    `json:"url"`
 	ParentTitle  string    `json:"parent_title,omitempty"`
 	ParentType   string    `json:"parent_type,omitempty"`
+	StartsAt     string    `json:"starts_at,omitempty"`
 }
 
-func outputActivityJSON(recordings []api.Recording, projectName string) error {
+// outputActivityJSON writes recordings as JSON. When fields is non-nil, each
+// activity item only contains the requested fields.
+func outputActivityJSON(w io.Writer, recordings []api.Recording, projectName string, fields []string) error {
 	output := ActivityOutput{
 		Project:  projectName,
-		Activity: make([]ActivityRecord, 0, len(recordings)),
+		Activity: activityRecords(recordings),
 	}
 
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	if fields == nil {
+		return encoder.Encode(output)
+	}
+
+	activity, err := ui.FilterJSONFieldsSlice(output.Activity, fields)
+	if err != nil {
+		return err
+	}
+	return encoder.Encode(map[string]interface{}{
+		"project":  output.Project,
+		"activity": activity,
+	})
+}
+
+// activityRecords converts recordings to their JSON output form
+func activityRecords(recordings []api.Recording) []ActivityRecord {
+	records := make([]ActivityRecord, 0, len(recordings))
 	for _, r := range recordings {
 		record := ActivityRecord{
 			ID:        r.ID,
@@ -298,12 +453,12 @@ func outputActivityJSON(recordings []api.Recording, projectName string) error {
 			record.ParentTitle = r.Parent.Title
 			record.ParentType = r.Parent.Type
 		}
-		output.Activity = append(output.Activity, record)
+		if r.Type == eventRecordingType {
+			record.StartsAt = r.StartsAt
+		}
+		records = append(records, record)
 	}
-
-	encoder := json.NewEncoder(os.Stdout)
-	encoder.SetIndent("", "  ")
-	return encoder.Encode(output)
+	return records
 }
 
 func renderActivityTable(recordings []api.Recording, projectName string) error {
@@ -352,16 +507,19 @@ func renderActivityTable(recordings []api.Recording, projectName string) error {
 				table.AddField("", cs.Muted)
 			}
 		} else {
-			// Context column for TTY (shows parent if exists)
+			// Context column for TTY (when an event starts, and its parent)
+			var contextParts []string
+			if start := eventStart(r); start != "" {
+				contextParts = append(contextParts, "starts "+start)
+			}
 			if r.Parent != nil {
-				contextLabel := fmt.Sprintf("in %s", r.Parent.Title)
-				if len(contextLabel) > 40 {
-					contextLabel = contextLabel[:37] + "..."
-				}
-				table.AddField(contextLabel, cs.Muted)
-			} else {
-				table.AddField("", cs.Muted)
+				contextParts = append(contextParts, fmt.Sprintf("in %s", r.Parent.Title))
 			}
+			contextLabel := strings.Join(contextParts, " · ")
+			if len(contextLabel) > 40 {
+				contextLabel = contextLabel[:37] + "..."
+			}
+			table.AddField(contextLabel, cs.Muted)
 		}
 
 		// Creator
@@ -380,6 +538,26 @@ func renderActivityTable(recordings []api.Recording, projectName string) error {
 	return table.Render()
 }
 
+// eventRecordingType is the recording type of schedule entries
+const eventRecordingType = "Schedule::Entry"
+
+// eventStart returns when an event recording starts, as "Mon Jan 2" for
+// all-day events and "Mon Jan 2 15:04" otherwise, or "" for other recordings
+// and events without a start time
+func eventStart(r api.Recording) string {
+	if r.Type != eventRecordingType || r.StartsAt == "" {
+		return ""
+	}
+	startsAt, err := time.Parse(time.RFC3339, r.StartsAt)
+	if err != nil {
+		return ""
+	}
+	if r.AllDay {
+		return startsAt.Format("Mon Jan 2")
+	}
+	return startsAt.Local().Format("Mon Jan 2 15:04")
+}
+
 // formatRecordingType formats the recording type for display
 func formatRecordingType(t string) string {
 	typeLabels := map[string]string{
@@ -436,37 +614,35 @@ func formatRecordingTypeWithStyle(t string, cs *coretableprinter.ColorScheme) (s
 	return strings.ToLower(t), cs.Muted
 }
 
-// parsePersonIdentifier parses a person identifier (ID, name, or email) into a person ID
-func parsePersonIdentifier(client *api.ModularClient, ctx context.Context, identifier string) (int64, error) {
-	// Try parsing as ID first
-	if id, err := strconv.ParseInt(identifier, 10, 64); err == nil {
-		return id, nil
-	}
-
-	// Otherwise, fetch all people and search by name or email
-	people, err := client.GetAllPeople(ctx)
+// resolveActivityPeople resolves --person identifiers (IDs, names, or
+// emails) to the IDs of the people whose activity is shown. self is the
+// logged-in user's person ID when --me is given, and 0 otherwise.
+func resolveActivityPeople(ctx context.Context, client api.APIClient, identifiers []string, self int64) ([]int64, error) {
+	ids, err := utils.ResolvePersonIDs(ctx, utils.NewAccountUserResolver(client), identifiers)
 	if err != nil {
-		return 0, fmt.Errorf("failed to list people: %w", err)
+		return nil, fmt.Errorf("invalid --person value: %w", err)
 	}
 
-	// Search for matching person by name or email
-	identifier = strings.ToLower(strings.TrimSpace(identifier))
-	var matches []api.Person
-	for _, person := range people {
-		nameMatch := strings.Contains(strings.ToLower(person.Name), identifier)
-		emailMatch := strings.Contains(strings.ToLower(person.EmailAddress), identifier)
-		if nameMatch || emailMatch {
-			matches = append(matches, person)
-		}
+	if self != 0 && !slices.Contains(ids, self) {
+		ids = append(ids, self)
 	}
+	return ids, nil
+}
 
-	if len(matches) == 0 {
-		return 0, fmt.Errorf("no person found matching '%s'", identifier)
+// resolveMe returns the logged-in user's person ID on the project for --me,
+// or 0 without it. The ID is cached between runs, so --me doesn't fetch the
+// profile and the project's people every time.
+func resolveMe(ctx context.Context, f *factory.Factory, client api.APIClient, projectID string, me bool) (int64, error) {
+	if !me {
+		return 0, nil
 	}
-
-	if len(matches) > 1 {
-		return 0, fmt.Errorf("multiple people found matching '%s' - please be more specific or use person ID", identifier)
+	accountID, err := f.AccountID()
+	if err != nil {
+		return 0, err
 	}
-
-	return matches[0].ID, nil
+	self, err := identity.ProjectPersonID(ctx, client, accountID, projectID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to resolve --me: %w", err)
+	}
+	return self, nil
 }
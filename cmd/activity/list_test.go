@@ -1,8 +1,13 @@
 package activity
 
 import (
+	"bytes"
+	"encoding/json"
+	"reflect"
 	"testing"
 	"time"
+
+	"github.com/needmore/bc4/internal/api"
 )
 
 func TestParseSince(t *testing.T) {
@@ -124,6 +129,11 @@ func TestParseTypes(t *testing.T) {
 			input:    "file,files",
 			expected: []string{"Upload", "Upload"},
 		},
+		{
+			name:     "event alias",
+			input:    "event,Events",
+			expected: []string{"Schedule::Entry", "Schedule::Entry"},
+		},
 	}
 
 	for _, tt := range tests {
@@ -206,6 +216,39 @@ func TestFormatRecordingType(t *testing.T) {
 	}
 }
 
+func TestEventStart(t *testing.T) {
+	timed := api.Recording{Type: "Schedule::Entry", StartsAt: "2026-03-12T10:30:00Z"}
+	allDay := api.Recording{Type: "Schedule::Entry", StartsAt: "2026-03-12T00:00:00.000Z", AllDay: true}
+	todo := api.Recording{Type: "Todo", StartsAt: "2026-03-12T10:30:00Z"}
+
+	tests := []struct {
+		name     string
+		input    api.Recording
+		expected string
+	}{
+		{name: "timed event", input: timed, expected: time.Date(2026, 3, 12, 10, 30, 0, 0, time.UTC).Local().Format("Mon Jan 2 15:04")},
+		{name: "all-day event", input: allDay, expected: "Thu Mar 12"},
+		{name: "not an event", input: todo, expected: ""},
+		{name: "event without start", input: api.Recording{Type: "Schedule::Entry"}, expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := eventStart(tt.input); got != tt.expected {
+				t.Errorf("eventStart() = %q, expected %q", got, tt.expected)
+			}
+		})
+	}
+
+	records := activityRecords([]api.Recording{timed, todo})
+	if records[0].StartsAt != "2026-03-12T10:30:00Z" {
+		t.Errorf("event record StartsAt = %q", records[0].StartsAt)
+	}
+	if records[1].StartsAt != "" {
+		t.Errorf("only events should carry a start, got %q", records[1].StartsAt)
+	}
+}
+
 func TestParseDurationValue(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -254,3 +297,33 @@ func TestParseDurationValue(t *testing.T) {
 		})
 	}
 }
+
+func TestOutputActivityJSON_Fields(t *testing.T) {
+	recordings := []api.Recording{
+		{ID: 1, Type: "Todo", Title: "Ship it", CreatedAt: time.Date(2025, 1, 15, 9, 0, 0, 0, time.UTC)},
+	}
+
+	var buf bytes.Buffer
+	if err := outputActivityJSON(&buf, recordings, "Launch", []string{"id", "title"}); err != nil {
+		t.Fatalf("outputActivityJSON() error = %v", err)
+	}
+
+	var got struct {
+		Project  string                   `json:"project"`
+		Activity []map[string]interface{} `json:"activity"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("invalid JSON output: %v", err)
+	}
+
+	if got.Project != "Launch" {
+		t.Errorf("project = %q, want %q", got.Project, "Launch")
+	}
+	if len(got.Activity) != 1 {
+		t.Fatalf("got %d activity items, want 1", len(got.Activity))
+	}
+	want := map[string]interface{}{"id": float64(1), "title": "Ship it"}
+	if !reflect.DeepEqual(got.Activity[0], want) {
+		t.Errorf("activity[0] = %v, want %v", got.Activity[0], want)
+	}
+}
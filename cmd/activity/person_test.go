@@ -0,0 +1,35 @@
+package activity
+
+import (
+	"context"
+	"testing"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/api/mock"
+)
+
+func TestResolveActivityPeople(t *testing.T) {
+	client := mock.NewMockClient()
+	client.People = []api.Person{
+		{ID: 1, Name: "Alice Jones", EmailAddress: "alice@example.com"},
+		{ID: 2, Name: "Bob Smith", EmailAddress: "bob@example.com"},
+	}
+
+	ids, err := resolveActivityPeople(context.Background(), client, []string{"alice", "bob@example.com", "42"}, 3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []int64{42, 1, 2, 3}
+	if len(ids) != len(want) {
+		t.Fatalf("ids = %v, want %v", ids, want)
+	}
+	for i := range want {
+		if ids[i] != want[i] {
+			t.Fatalf("ids = %v, want %v", ids, want)
+		}
+	}
+
+	if _, err := resolveActivityPeople(context.Background(), client, []string{"carol"}, 0); err == nil {
+		t.Error("expected an error for an unknown person")
+	}
+}
@@ -0,0 +1,194 @@
+package activity
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/parser"
+	"github.com/needmore/bc4/internal/ui"
+	"github.com/needmore/bc4/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+func newShowCmd(f *factory.Factory) *cobra.Command {
+	var (
+		accountID string
+		projectID string
+		formatStr string
+		noEvents  bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "show <id|URL>",
+		Short: "Show a single recording and its event history",
+		Long: `Show one recording from the activity feed - a todo, message, document,
+comment, card, or any other item - with its details and the events that
+touched it, oldest first.
+
+The recording can be given as a numeric ID or a Basecamp URL. Use
+--format json to print the recording exactly as returned by the API.`,
+		Example: `  bc4 activity show 12345678
+  bc4 activity show https://3.basecamp.com/1234567/buckets/89012345/todos/12345678
+  bc4 activity show 12345678 --format json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if accountID != "" {
+				f = f.WithAccount(accountID)
+			}
+			if projectID != "" {
+				f = f.WithProject(projectID)
+			}
+			return showRecording(cmd.Context(), f, args[0], formatStr, !noEvents, os.Stdout)
+		},
+	}
+
+	cmd.Flags().StringVarP(&accountID, "account", "a", "", "Specify account ID")
+	cmd.Flags().StringVarP(&projectID, "project", "p", "", "Specify project ID")
+	cmd.Flags().StringVarP(&formatStr, "format", "f", "table", "Output format: table or json")
+	cmd.Flags().BoolVar(&noEvents, "no-events", false, "Don't fetch the recording's event history")
+
+	return cmd
+}
+
+// showRecording resolves arg to a recording and writes its details and,
+// when withEvents is set, its event history to w
+func showRecording(ctx context.Context, f *factory.Factory, arg, formatStr string, withEvents bool, w io.Writer) error {
+	format, err := ui.ResolveTableOrJSON(formatStr)
+	if err != nil {
+		return err
+	}
+
+	recordingID, parsedURL, err := parser.ParseArgument(arg)
+	if err != nil {
+		return fmt.Errorf("invalid recording ID or URL: %s", arg)
+	}
+	if parsedURL != nil {
+		if parsedURL.AccountID > 0 {
+			f = f.WithAccount(strconv.FormatInt(parsedURL.AccountID, 10))
+		}
+		if parsedURL.ProjectID > 0 {
+			f = f.WithProject(strconv.FormatInt(parsedURL.ProjectID, 10))
+		}
+	}
+
+	resolvedProjectID, err := f.ProjectID()
+	if err != nil {
+		return err
+	}
+	client, err := f.ApiClient()
+	if err != nil {
+		return err
+	}
+	activityOps := client.Activity()
+
+	if format == ui.OutputFormatJSON {
+		body, err := activityOps.GetRecordingRaw(ctx, resolvedProjectID, recordingID)
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(body); err != nil {
+			return err
+		}
+		if len(body) > 0 && body[len(body)-1] != '\n' {
+			_, err = fmt.Fprintln(w)
+		}
+		return err
+	}
+
+	recording, err := activityOps.GetRecording(ctx, resolvedProjectID, recordingID)
+	if err != nil {
+		return err
+	}
+
+	var events []api.Event
+	if withEvents {
+		events, err = activityOps.ListEventsChronological(ctx, resolvedProjectID, recordingID)
+		if err != nil {
+			// The details are still worth showing without the history
+			fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+			withEvents = false
+		}
+	}
+
+	relative := ui.IsTerminal(w)
+	renderRecordingDetails(w, recording, time.Now(), relative)
+	if withEvents {
+		_, _ = fmt.Fprintln(w)
+		return renderRecordingEvents(w, events, time.Now(), relative)
+	}
+	return nil
+}
+
+// renderRecordingDetails writes the fields every recording type shares
+func renderRecordingDetails(w io.Writer, r *api.Recording, now time.Time, relative bool) {
+	title := r.Title
+	if title == "" {
+		title = "(untitled)"
+	}
+	fmt.Fprintf(w, "%s #%d: %s\n", recordingTypeName(r.Type), r.ID, title)
+	if r.Status != "" {
+		fmt.Fprintf(w, "Status: %s\n", r.Status)
+	}
+	if r.Bucket.Name != "" {
+		fmt.Fprintf(w, "Project: %s\n", r.Bucket.Name)
+	}
+	if r.Parent != nil {
+		fmt.Fprintf(w, "In: %s %q\n", recordingTypeName(r.Parent.Type), r.Parent.Title)
+	}
+	if r.Creator.Name != "" {
+		fmt.Fprintf(w, "Created by: %s\n", r.Creator.Name)
+	}
+	fmt.Fprintf(w, "Created: %s\n", formatShowTime(now, r.CreatedAt, relative))
+	fmt.Fprintf(w, "Updated: %s\n", formatShowTime(now, r.UpdatedAt, relative))
+	if r.AppURL != "" {
+		fmt.Fprintf(w, "URL: %s\n", r.AppURL)
+	}
+}
+
+// renderRecordingEvents writes a recording's events as a table
+func renderRecordingEvents(w io.Writer, events []api.Event, now time.Time, relative bool) error {
+	if len(events) == 0 {
+		_, err := fmt.Fprintln(w, "No events found")
+		return err
+	}
+
+	_, _ = fmt.Fprintf(w, "History (%d):\n", len(events))
+	return utils.WriteEventTable(w, events, func(t time.Time) string {
+		return formatShowTime(now, t, relative)
+	})
+}
+
+// recordingTypeName returns a display name for an API recording type
+func recordingTypeName(recordingType string) string {
+	switch recordingType {
+	case "":
+		return "Recording"
+	case "Kanban::Card":
+		return "Card"
+	case "Kanban::Step":
+		return "Step"
+	case "Kanban::Column":
+		return "Column"
+	case "Todolist":
+		return "Todo list"
+	case "Message::Board":
+		return "Message board"
+	}
+	return recordingType
+}
+
+func formatShowTime(now, t time.Time, relative bool) string {
+	if t.IsZero() {
+		return "-"
+	}
+	if relative {
+		return ui.HumanTime(now, t)
+	}
+	return t.Format("2006-01-02 15:04")
+}
@@ -0,0 +1,66 @@
+package activity
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/needmore/bc4/internal/api"
+)
+
+func TestRenderRecordingDetails(t *testing.T) {
+	created := time.Date(2025, 3, 1, 9, 30, 0, 0, time.UTC)
+	r := &api.Recording{
+		ID:        42,
+		Title:     "Ship it",
+		Type:      "Kanban::Card",
+		Status:    "active",
+		CreatedAt: created,
+		UpdatedAt: created.Add(time.Hour),
+		AppURL:    "https://3.basecamp.com/1/buckets/2/card_tables/cards/42",
+		Creator:   api.Person{Name: "Ann"},
+		Bucket:    api.Bucket{Name: "Launch"},
+		Parent:    &api.Parent{Type: "Kanban::Column", Title: "Doing"},
+	}
+
+	var buf bytes.Buffer
+	renderRecordingDetails(&buf, r, created, false)
+	out := buf.String()
+
+	for _, want := range []string{
+		"Card #42: Ship it",
+		"Status: active",
+		"Project: Launch",
+		`In: Column "Doing"`,
+		"Created by: Ann",
+		"Created: 2025-03-01 09:30",
+		"Updated: 2025-03-01 10:30",
+		"URL: https://3.basecamp.com/1/buckets/2/card_tables/cards/42",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestRenderRecordingEvents(t *testing.T) {
+	var buf bytes.Buffer
+	if err := renderRecordingEvents(&buf, nil, time.Now(), false); err != nil {
+		t.Fatal(err)
+	}
+	if got := buf.String(); got != "No events found\n" {
+		t.Errorf("got %q", got)
+	}
+
+	buf.Reset()
+	events := []api.Event{{Action: "assignment_changed", Creator: api.Person{Name: "Bob"}, CreatedAt: time.Date(2025, 3, 2, 8, 0, 0, 0, time.UTC)}}
+	if err := renderRecordingEvents(&buf, events, time.Now(), false); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"History (1):", "Bob", "assignment changed", "2025-03-02 08:00"} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("output missing %q:\n%s", want, buf.String())
+		}
+	}
+}
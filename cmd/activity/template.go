@@ -0,0 +1,87 @@
+package activity
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/needmore/bc4/internal/api"
+)
+
+// templateFields are the names available to --template, as {name} or
+// {{.name}}
+var templateFields = []string{"id", "type", "title", "status", "creator", "created", "updated", "url", "parent_title", "starts"}
+
+// placeholderRe matches {name} shorthand, and {{...}} actions so they can be
+// left alone
+var placeholderRe = regexp.MustCompile(`\{\{.*?\}\}|\{([a-z_]+)\}`)
+
+// parseActivityTemplate compiles a --template value. {name} shorthand is
+// expanded to {{.name}}; full text/template syntax also works. Unknown fields
+// are reported here, before anything is fetched.
+func parseActivityTemplate(text string) (*template.Template, error) {
+	expanded := placeholderRe.ReplaceAllStringFunc(text, func(match string) string {
+		if strings.HasPrefix(match, "{{") {
+			return match
+		}
+		return "{{." + strings.Trim(match, "{}") + "}}"
+	})
+
+	tmpl, err := template.New("activity").Option("missingkey=error").Parse(expanded)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --template: %w", err)
+	}
+
+	// Render against an empty record so misspelled fields fail early
+	if err := tmpl.Execute(io.Discard, templateData(ActivityRecord{})); err != nil {
+		return nil, fmt.Errorf("invalid --template: %w (available fields: %s)", err, strings.Join(templateFields, ", "))
+	}
+
+	return tmpl, nil
+}
+
+// templateData maps an activity record to the --template field set
+func templateData(record ActivityRecord) map[string]string {
+	formatTime := func(t time.Time) string {
+		if t.IsZero() {
+			return ""
+		}
+		return t.Local().Format(time.RFC3339)
+	}
+
+	return map[string]string{
+		"id":           strconv.FormatInt(record.ID, 10),
+		"type":         record.Type,
+		"title":        record.Title,
+		"status":       record.Status,
+		"creator":      record.Creator,
+		"created":      formatTime(record.CreatedAt),
+		"updated":      formatTime(record.UpdatedAt),
+		"url":          record.URL,
+		"parent_title": record.ParentTitle,
+		"starts":       record.StartsAt,
+	}
+}
+
+// renderActivityTemplate writes one line per recording using tmpl
+func renderActivityTemplate(w io.Writer, tmpl *template.Template, recordings []api.Recording) error {
+	var line bytes.Buffer
+	for _, record := range activityRecords(recordings) {
+		line.Reset()
+		if err := tmpl.Execute(&line, templateData(record)); err != nil {
+			return fmt.Errorf("failed to render activity #%d: %w", record.ID, err)
+		}
+		if !bytes.HasSuffix(line.Bytes(), []byte("\n")) {
+			line.WriteByte('\n')
+		}
+		if _, err := w.Write(line.Bytes()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
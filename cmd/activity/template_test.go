@@ -0,0 +1,60 @@
+package activity
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/needmore/bc4/internal/api"
+)
+
+func TestParseActivityTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		wantErr  bool
+	}{
+		{name: "shorthand", template: "{type} {title} by {creator}"},
+		{name: "go template", template: "{{.id}}\t{{.title | printf \"%q\"}}"},
+		{name: "unknown field", template: "{type} {author}", wantErr: true},
+		{name: "bad syntax", template: "{{.title", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseActivityTemplate(tt.template)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parseActivityTemplate(%q) error = %v, wantErr %v", tt.template, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRenderActivityTemplate(t *testing.T) {
+	tmpl, err := parseActivityTemplate("{id} {type} {title} by {creator} [{parent_title}]")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	recordings := []api.Recording{
+		{
+			ID:        1,
+			Type:      "Todo",
+			Title:     "Ship it",
+			Creator:   api.Person{Name: "Jane"},
+			UpdatedAt: time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC),
+			Parent:    &api.Parent{Title: "Launch"},
+		},
+		{ID: 2, Type: "Message", Title: "Kickoff", Creator: api.Person{Name: "John"}},
+	}
+
+	var buf bytes.Buffer
+	if err := renderActivityTemplate(&buf, tmpl, recordings); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := "1 Todo Ship it by Jane [Launch]\n2 Message Kickoff by John []\n"
+	if got := buf.String(); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
@@ -2,14 +2,20 @@ package activity
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"os"
+	"os/signal"
+	"sort"
 	"strconv"
 	"time"
 
 	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/cmdutil"
 	"github.com/needmore/bc4/internal/factory"
 	"github.com/needmore/bc4/internal/parser"
+	coretableprinter "github.com/needmore/bc4/internal/tableprinter"
 	"github.com/spf13/cobra"
 )
 
@@ -18,8 +24,9 @@ func newWatchCmd(f *factory.Factory) *cobra.Command {
 		accountID     string
 		projectID     string
 		recordingType string
-		personStr     string
-		interval      int
+		people        []string
+		me            bool
+		interval      time.Duration
 	)
 
 	cmd := &cobra.Command{
@@ -28,10 +35,17 @@ func newWatchCmd(f *factory.Factory) *cobra.Command {
 		Long: `Watch for real-time activity and changes across a Basecamp project.
 
 This command polls the activity feed at regular intervals and displays new items
-as they appear. Press Ctrl+C to stop watching.`,
+as they appear. Press Ctrl+C to stop watching. The interval is at least 5
+seconds, and polling slows down while the API is returning errors.
+
+This is the same as 'bc4 activity list --watch'.`,
 		Aliases: []string{"w"},
 		Args:    cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := checkWatchInterval(interval); err != nil {
+				return err
+			}
+
 			// Parse project argument if provided (could be URL or ID)
 			if len(args) > 0 {
 				if parser.IsBasecampURL(args[0]) {
@@ -92,92 +106,171 @@ as they appear. Press Ctrl+C to stop watching.`,
 			}
 
 			// Parse person filter
-			if personStr != "" {
-				personID, err := parsePersonIdentifier(client, cmd.Context(), personStr)
+			if len(people) > 0 || me {
+				self, err := resolveMe(cmd.Context(), f, client.Client, resolvedProjectID, me)
 				if err != nil {
-					return fmt.Errorf("invalid --person value: %w", err)
+					return err
+				}
+				if opts.PersonIDs, err = resolveActivityPeople(cmd.Context(), client.Client, people, self); err != nil {
+					return err
 				}
-				opts.PersonID = personID
 			}
 
-			// Start watching
-			return watchActivity(cmd.Context(), client, resolvedProjectID, project.Name, opts, time.Duration(interval)*time.Second)
+			// Start watching until interrupted
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt)
+			defer stop()
+			return watchActivity(ctx, client, resolvedProjectID, project.Name, opts, interval)
 		},
 	}
 
 	cmd.Flags().StringVarP(&accountID, "account", "a", "", "Specify account ID")
 	cmd.Flags().StringVarP(&projectID, "project", "p", "", "Specify project ID")
 	cmd.Flags().StringVarP(&recordingType, "type", "t", "", "Filter by type: todo, message, document, comment, upload")
-	cmd.Flags().StringVar(&personStr, "person", "", "Filter by person (ID, name, or email)")
-	cmd.Flags().IntVarP(&interval, "interval", "i", 30, "Polling interval in seconds")
+	cmd.Flags().StringSliceVar(&people, "person", nil, "Filter by people (ID, name, or email; comma-separated or repeated)")
+	cmd.Flags().BoolVar(&me, "me", false, "Include your own activity in the --person filter")
+	cmdutil.IntervalVarP(cmd.Flags(), &interval, "interval", "i", 30*time.Second, "Polling interval (e.g., 15s, 1m, or 30 for seconds)")
 
 	return cmd
 }
 
-// watchActivity continuously polls for new activity and displays it
+// minWatchInterval keeps watches from polling the API too often
+const minWatchInterval = 5 * time.Second
+
+// checkWatchInterval enforces minWatchInterval
+func checkWatchInterval(interval time.Duration) error {
+	if interval < minWatchInterval {
+		return fmt.Errorf("--interval must be at least %v", minWatchInterval)
+	}
+	return nil
+}
+
+// activityTail remembers what a watch has already printed. Recordings are
+// new when they were updated after the newest one seen, or at the same
+// moment under an ID not seen yet.
+type activityTail struct {
+	lastSeen time.Time
+	seenIDs  map[int64]bool // recordings shown with UpdatedAt == lastSeen
+}
+
+// fresh returns the recordings not shown yet, oldest first, and marks them
+// as shown
+func (t *activityTail) fresh(recordings []api.Recording) []api.Recording {
+	sorted := make([]api.Recording, len(recordings))
+	copy(sorted, recordings)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if !sorted[i].UpdatedAt.Equal(sorted[j].UpdatedAt) {
+			return sorted[i].UpdatedAt.Before(sorted[j].UpdatedAt)
+		}
+		return sorted[i].ID < sorted[j].ID
+	})
+
+	var fresh []api.Recording
+	for _, r := range sorted {
+		switch {
+		case r.UpdatedAt.After(t.lastSeen):
+			t.lastSeen = r.UpdatedAt
+			t.seenIDs = map[int64]bool{}
+		case r.UpdatedAt.Equal(t.lastSeen) && !t.seenIDs[r.ID]:
+		default:
+			continue
+		}
+		t.seenIDs[r.ID] = true
+		fresh = append(fresh, r)
+	}
+	return fresh
+}
+
+// pollDelay is the wait before the next poll: the interval, doubled for
+// each consecutive failed poll up to the retry transport's maximum backoff
+func pollDelay(interval time.Duration, failures int) time.Duration {
+	maxBackoff := api.DefaultRetryConfig().MaxBackoff
+	if interval >= maxBackoff {
+		return interval
+	}
+	delay := interval
+	for i := 0; i < failures && delay < maxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > maxBackoff {
+		delay = maxBackoff
+	}
+	return delay
+}
+
+// watchActivity shows recent activity, then polls for new items and prints
+// them as they appear until ctx is cancelled
 func watchActivity(ctx context.Context, client *api.ModularClient, projectID string, projectName string, opts *api.ActivityListOptions, interval time.Duration) error {
 	fmt.Printf("Watching activity in project: %s\n", projectName)
 	fmt.Printf("Polling every %v (Press Ctrl+C to stop)\n\n", interval)
 
-	// Track the last seen timestamp to avoid showing duplicates
-	var lastSeen time.Time
+	cs := coretableprinter.NewColorScheme()
+	tail := &activityTail{}
 
 	// Initial fetch to establish baseline
 	recordings, err := client.ListRecordings(ctx, projectID, opts)
-	if err != nil {
+	if err != nil && !isPartial(err) {
 		return err
 	}
-
-	if len(recordings) > 0 {
-		// Show initial state
+	if initial := tail.fresh(recordings); len(initial) > 0 {
 		fmt.Println("Recent activity:")
-		for i := len(recordings) - 1; i >= 0; i-- {
-			displayActivityItem(recordings[i])
+		for _, r := range initial {
+			displayActivityItem(os.Stdout, r, cs)
 		}
-		lastSeen = recordings[0].UpdatedAt
 		fmt.Println()
 	}
 
-	// Poll for updates
-	ticker := time.NewTicker(interval)
-	defer ticker.Stop()
+	// Only the first fetch is limited; later polls get everything new
+	opts.Limit = 0
+	failures := 0
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
 
 	for {
 		select {
 		case <-ctx.Done():
 			fmt.Println("\nStopping watch...")
 			return nil
-		case <-ticker.C:
-			// Update the since time to only get new items
-			since := lastSeen
+		case <-timer.C:
+		}
+
+		if !tail.lastSeen.IsZero() {
+			since := tail.lastSeen
 			opts.Since = &since
+		}
 
-			recordings, err := client.ListRecordings(ctx, projectID, opts)
-			if err != nil {
-				fmt.Fprintf(os.Stderr, "Error fetching activity: %v\n", err)
+		recordings, err := client.ListRecordings(ctx, projectID, opts)
+		if err != nil && !isPartial(err) {
+			if ctx.Err() != nil {
 				continue
 			}
+			failures++
+			delay := pollDelay(interval, failures)
+			fmt.Fprintf(os.Stderr, "Error fetching activity: %v (retrying in %v)\n", err, delay)
+			timer.Reset(delay)
+			continue
+		}
+		failures = 0
 
-			// Display new items (in reverse chronological order, oldest first)
-			if len(recordings) > 0 {
-				for i := len(recordings) - 1; i >= 0; i-- {
-					if recordings[i].UpdatedAt.After(lastSeen) {
-						displayActivityItem(recordings[i])
-						lastSeen = recordings[i].UpdatedAt
-					}
-				}
-			}
+		for _, r := range tail.fresh(recordings) {
+			displayActivityItem(os.Stdout, r, cs)
 		}
+		timer.Reset(interval)
 	}
 }
 
+// isPartial reports whether err only means some activity was left out
+func isPartial(err error) bool {
+	var partialErr *api.PartialError
+	return errors.As(err, &partialErr)
+}
+
 // displayActivityItem displays a single activity item in a compact format
-func displayActivityItem(r api.Recording) {
-	timestamp := r.UpdatedAt.Format("15:04:05")
-	typeLabel := formatRecordingType(r.Type)
+func displayActivityItem(w io.Writer, r api.Recording, cs *coretableprinter.ColorScheme) {
+	timestamp := r.UpdatedAt.Local().Format("15:04:05")
+	typeLabel, typeColor := formatRecordingTypeWithStyle(r.Type, cs)
 
 	// Build the display line
-	line := fmt.Sprintf("[%s] %s", timestamp, typeLabel)
+	line := fmt.Sprintf("[%s] %s", cs.Muted(timestamp), typeColor(typeLabel))
 
 	// Add creator
 	line = fmt.Sprintf("%s by %s:", line, r.Creator.Name)
@@ -189,14 +282,19 @@ func displayActivityItem(r api.Recording) {
 	}
 	line = fmt.Sprintf("%s %s", line, title)
 
+	// Add when an event starts
+	if start := eventStart(r); start != "" {
+		line = fmt.Sprintf("%s %s", line, cs.Muted("(starts "+start+")"))
+	}
+
 	// Add parent context if available
 	if r.Parent != nil {
 		parentTitle := r.Parent.Title
 		if len(parentTitle) > 40 {
 			parentTitle = parentTitle[:37] + "..."
 		}
-		line = fmt.Sprintf("%s (in %s)", line, parentTitle)
+		line = fmt.Sprintf("%s %s", line, cs.Muted("(in "+parentTitle+")"))
 	}
 
-	fmt.Println(line)
+	_, _ = fmt.Fprintln(w, line)
 }
@@ -0,0 +1,93 @@
+package activity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/needmore/bc4/internal/api"
+)
+
+func TestActivityTailFresh(t *testing.T) {
+	base := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	rec := func(id int64, offset time.Duration) api.Recording {
+		return api.Recording{ID: id, UpdatedAt: base.Add(offset)}
+	}
+	ids := func(recordings []api.Recording) []int64 {
+		var out []int64
+		for _, r := range recordings {
+			out = append(out, r.ID)
+		}
+		return out
+	}
+	equal := func(got, want []int64) bool {
+		if len(got) != len(want) {
+			return false
+		}
+		for i := range got {
+			if got[i] != want[i] {
+				return false
+			}
+		}
+		return true
+	}
+
+	tail := &activityTail{}
+
+	// Newest-first input comes back oldest first
+	got := ids(tail.fresh([]api.Recording{rec(3, 2*time.Minute), rec(2, time.Minute), rec(1, 0)}))
+	if !equal(got, []int64{1, 2, 3}) {
+		t.Errorf("first poll = %v, want [1 2 3]", got)
+	}
+
+	// A since-filtered poll repeats the newest item; only new ones are returned,
+	// including one updated at the same moment as the last one seen
+	got = ids(tail.fresh([]api.Recording{rec(3, 2*time.Minute), rec(4, 2*time.Minute), rec(5, 3*time.Minute)}))
+	if !equal(got, []int64{4, 5}) {
+		t.Errorf("second poll = %v, want [4 5]", got)
+	}
+
+	// Nothing new
+	if got := tail.fresh([]api.Recording{rec(5, 3*time.Minute)}); len(got) != 0 {
+		t.Errorf("third poll = %v, want none", ids(got))
+	}
+
+	// An edited recording shows up again once its update time moves on
+	got = ids(tail.fresh([]api.Recording{rec(1, 4*time.Minute)}))
+	if !equal(got, []int64{1}) {
+		t.Errorf("fourth poll = %v, want [1]", got)
+	}
+}
+
+func TestPollDelay(t *testing.T) {
+	maxBackoff := api.DefaultRetryConfig().MaxBackoff
+
+	tests := []struct {
+		name     string
+		interval time.Duration
+		failures int
+		want     time.Duration
+	}{
+		{"no failures", 10 * time.Second, 0, 10 * time.Second},
+		{"one failure", 10 * time.Second, 1, 20 * time.Second},
+		{"two failures", 10 * time.Second, 2, 40 * time.Second},
+		{"capped", 10 * time.Second, 10, maxBackoff},
+		{"interval above cap", 2 * maxBackoff, 3, 2 * maxBackoff},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := pollDelay(tt.interval, tt.failures); got != tt.want {
+				t.Errorf("pollDelay(%v, %d) = %v, want %v", tt.interval, tt.failures, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckWatchInterval(t *testing.T) {
+	if err := checkWatchInterval(minWatchInterval); err != nil {
+		t.Errorf("minimum interval rejected: %v", err)
+	}
+	if err := checkWatchInterval(time.Second); err == nil {
+		t.Error("expected an error for an interval below the minimum")
+	}
+}
@@ -0,0 +1,225 @@
+// Package alias implements user-defined command shortcuts, stored in the
+// config file and expanded before command dispatch.
+package alias
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/needmore/bc4/internal/cmdutil"
+	"github.com/needmore/bc4/internal/config"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/ui/tableprinter"
+)
+
+// reservedNames are commands cobra adds at execution time, so they aren't
+// in the command tree when aliases are checked
+var reservedNames = []string{"help", "completion"}
+
+// NewAliasCmd creates the alias command
+func NewAliasCmd(f *factory.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "alias",
+		Short: "Create shortcuts for bc4 commands",
+		Long: `Aliases are shortcuts for bc4 commands you run often. They are stored in
+the config file and expanded before the command runs, so 'bc4 todos' can
+stand for 'bc4 todo list --all --has-due'.
+
+Arguments after the alias are appended to the expansion. Use $1, $2, ... in
+the expansion to place individual arguments, or $@ for all of them.
+Expansions are split like a shell command line, so quote arguments that
+contain spaces.
+
+An alias is only recognised as the first word after bc4, so put global flags
+such as --config after it: 'bc4 todos --config work.json'.
+
+Aliases can't replace built-in commands, and an alias can't expand to
+another alias.`,
+		Example: `  bc4 alias set todos 'todo list --all --has-due'
+  bc4 alias set done 'todo check $1'
+  bc4 alias set standup 'campfire post "Standup: $1"'
+  bc4 alias list
+  bc4 alias delete todos`,
+	}
+
+	// Enable suggestions for subcommand typos
+	cmdutil.EnableSuggestions(cmd)
+
+	cmd.AddCommand(newSetCmd(f))
+	cmd.AddCommand(newListCmd(f))
+	cmd.AddCommand(newDeleteCmd(f))
+
+	return cmd
+}
+
+func newSetCmd(f *factory.Factory) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set <name> <expansion>",
+		Short: "Create or change an alias",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name, expansion := args[0], args[1]
+
+			var existed bool
+			var validationErr error
+			err := config.Update(func(cfg *config.Config) error {
+				if validationErr = validateAlias(cmd.Root(), cfg.Aliases, name, expansion); validationErr != nil {
+					return validationErr
+				}
+
+				_, existed = cfg.Aliases[name]
+				if cfg.Aliases == nil {
+					cfg.Aliases = make(map[string]string)
+				}
+				cfg.Aliases[name] = expansion
+				return nil
+			})
+			if validationErr != nil {
+				return validationErr
+			}
+			if err != nil {
+				return fmt.Errorf("failed to save config: %w", err)
+			}
+
+			if existed {
+				fmt.Printf("Changed alias %s to: %s\n", name, expansion)
+			} else {
+				fmt.Printf("Added alias %s: %s\n", name, expansion)
+			}
+			return nil
+		},
+	}
+}
+
+func newListCmd(f *factory.Factory) *cobra.Command {
+	return &cobra.Command{
+		Use:     "list",
+		Short:   "List aliases",
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := f.Config()
+			if err != nil {
+				return fmt.Errorf("failed to load config: %w", err)
+			}
+			if len(cfg.Aliases) == 0 {
+				fmt.Println("No aliases defined. Add one with 'bc4 alias set <name> <expansion>'.")
+				return nil
+			}
+
+			names := make([]string, 0, len(cfg.Aliases))
+			for name := range cfg.Aliases {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+
+			table := tableprinter.New(os.Stdout)
+			table.AddHeader("NAME", "EXPANSION")
+			for _, name := range names {
+				table.AddField(name)
+				table.AddField(cfg.Aliases[name])
+				table.EndRow()
+			}
+			return table.Render()
+		},
+	}
+}
+
+func newDeleteCmd(f *factory.Factory) *cobra.Command {
+	return &cobra.Command{
+		Use:     "delete <name>",
+		Short:   "Delete an alias",
+		Aliases: []string{"rm"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+
+			var expansion string
+			var notFound error
+			err := config.Update(func(cfg *config.Config) error {
+				var ok bool
+				if expansion, ok = cfg.Aliases[name]; !ok {
+					notFound = fmt.Errorf("no alias named %q", name)
+					return notFound
+				}
+				delete(cfg.Aliases, name)
+				return nil
+			})
+			if notFound != nil {
+				return notFound
+			}
+			if err != nil {
+				return fmt.Errorf("failed to save config: %w", err)
+			}
+			fmt.Printf("Deleted alias %s (was: %s)\n", name, expansion)
+			return nil
+		},
+	}
+}
+
+// validateAlias checks that name can be defined as an alias for expansion
+func validateAlias(root *cobra.Command, aliases map[string]string, name, expansion string) error {
+	if name == "" || strings.ContainsAny(name, " \t\n") || strings.HasPrefix(name, "-") {
+		return fmt.Errorf("invalid alias name %q: use a single word that doesn't start with '-'", name)
+	}
+	if isBuiltin(root, name) {
+		return fmt.Errorf("%q is a bc4 command and can't be used as an alias", name)
+	}
+
+	words, err := SplitArgs(expansion)
+	if err != nil {
+		return fmt.Errorf("invalid expansion: %w", err)
+	}
+	if len(words) == 0 {
+		return fmt.Errorf("invalid expansion: it can't be empty")
+	}
+	if words[0] == name {
+		return fmt.Errorf("alias %q can't expand to itself", name)
+	}
+	if _, ok := aliases[words[0]]; ok {
+		return fmt.Errorf("alias %q can't expand to another alias (%s)", name, words[0])
+	}
+	if !isBuiltin(root, words[0]) {
+		return fmt.Errorf("invalid expansion: %q is not a bc4 command", words[0])
+	}
+	return nil
+}
+
+// isBuiltin reports whether name is a top-level command or one of its aliases
+func isBuiltin(root *cobra.Command, name string) bool {
+	for _, reserved := range reservedNames {
+		if name == reserved {
+			return true
+		}
+	}
+	for _, c := range root.Commands() {
+		if c.Name() == name || c.HasAlias(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// ExpandCommandLine expands args when its first word is an alias. Only the
+// first word is checked, so global flags must follow the alias to reach its
+// expansion. Built-in commands always take precedence. It reports whether an
+// alias was expanded.
+func ExpandCommandLine(root *cobra.Command, aliases map[string]string, args []string) ([]string, bool, error) {
+	if len(args) == 0 || len(aliases) == 0 || isBuiltin(root, args[0]) {
+		return args, false, nil
+	}
+	expansion, ok := aliases[args[0]]
+	if !ok {
+		return args, false, nil
+	}
+
+	expanded, err := Expand(expansion, args[1:])
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to expand alias %q: %w", args[0], err)
+	}
+	return expanded, true, nil
+}
@@ -0,0 +1,103 @@
+package alias
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitArgs(t *testing.T) {
+	tests := []struct {
+		input string
+		want  []string
+	}{
+		{"todo list --all", []string{"todo", "list", "--all"}},
+		{`campfire post "Standup: $1"`, []string{"campfire", "post", "Standup: $1"}},
+		{`todo add 'it''s' x`, []string{"todo", "add", "its", "x"}},
+		{`a "say \"hi\"" b\ c`, []string{"a", `say "hi"`, "b c"}},
+		{`a ''`, []string{"a", ""}},
+		{"  ", nil},
+	}
+	for _, tt := range tests {
+		got, err := SplitArgs(tt.input)
+		require.NoError(t, err, tt.input)
+		assert.Equal(t, tt.want, got, tt.input)
+	}
+
+	_, err := SplitArgs(`todo add "oops`)
+	assert.ErrorContains(t, err, "unterminated")
+}
+
+func TestExpand(t *testing.T) {
+	got, err := Expand("todo list --all", []string{"Inbox"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"todo", "list", "--all", "Inbox"}, got)
+
+	got, err = Expand("todo check $1 --project=$2", []string{"12", "34", "extra"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"todo", "check", "12", "--project=34", "extra"}, got)
+
+	got, err = Expand("todo list $@ --all", []string{"a", "b"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"todo", "list", "a", "b", "--all"}, got)
+
+	_, err = Expand("todo check $2", []string{"12"})
+	assert.ErrorContains(t, err, "at least 2 argument(s)")
+
+	_, err = Expand("todo check $0", nil)
+	assert.ErrorContains(t, err, "invalid placeholder $0")
+}
+
+func newRoot() *cobra.Command {
+	root := &cobra.Command{Use: "bc4"}
+	root.AddCommand(&cobra.Command{Use: "todo", Aliases: []string{"t"}})
+	root.AddCommand(&cobra.Command{Use: "card"})
+	return root
+}
+
+func TestValidateAlias(t *testing.T) {
+	root := newRoot()
+	aliases := map[string]string{"todos": "todo list"}
+
+	assert.NoError(t, validateAlias(root, aliases, "cards", "card list"))
+	assert.ErrorContains(t, validateAlias(root, aliases, "todo", "card list"), "is a bc4 command")
+	assert.ErrorContains(t, validateAlias(root, aliases, "t", "card list"), "is a bc4 command")
+	assert.ErrorContains(t, validateAlias(root, aliases, "help", "card list"), "is a bc4 command")
+	assert.ErrorContains(t, validateAlias(root, aliases, "loop", "loop"), "itself")
+	assert.ErrorContains(t, validateAlias(root, aliases, "mine", "todos --all"), "another alias")
+	assert.ErrorContains(t, validateAlias(root, aliases, "x", "nope"), "not a bc4 command")
+	assert.ErrorContains(t, validateAlias(root, aliases, "-x", "card"), "invalid alias name")
+	assert.ErrorContains(t, validateAlias(root, aliases, "x", "  "), "can't be empty")
+}
+
+func TestExpandCommandLine(t *testing.T) {
+	root := newRoot()
+	aliases := map[string]string{"todos": "todo list --all", "card": "todo list"}
+
+	got, expanded, err := ExpandCommandLine(root, aliases, []string{"todos", "Inbox"})
+	require.NoError(t, err)
+	assert.True(t, expanded)
+	assert.Equal(t, []string{"todo", "list", "--all", "Inbox"}, got)
+
+	// Built-in commands win over aliases of the same name
+	got, expanded, err = ExpandCommandLine(root, aliases, []string{"card", "list"})
+	require.NoError(t, err)
+	assert.False(t, expanded)
+	assert.Equal(t, []string{"card", "list"}, got)
+
+	_, expanded, err = ExpandCommandLine(root, aliases, []string{"unknown"})
+	require.NoError(t, err)
+	assert.False(t, expanded)
+
+	// Only the first word is an alias; flags go after it
+	_, expanded, err = ExpandCommandLine(root, aliases, []string{"--config", "other.json", "todos"})
+	require.NoError(t, err)
+	assert.False(t, expanded)
+
+	got, expanded, err = ExpandCommandLine(root, aliases, []string{"todos", "--config", "other.json"})
+	require.NoError(t, err)
+	assert.True(t, expanded)
+	assert.Equal(t, []string{"todo", "list", "--all", "--config", "other.json"}, got)
+}
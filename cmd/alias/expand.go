@@ -0,0 +1,121 @@
+package alias
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// placeholderPattern matches $1, $2, ... in an alias expansion
+var placeholderPattern = regexp.MustCompile(`\$(\d+)`)
+
+// SplitArgs splits s into words like a POSIX shell: whitespace separates
+// words, single quotes keep everything literal, double quotes allow \" and
+// \\ escapes, and a backslash outside quotes escapes the next character.
+func SplitArgs(s string) ([]string, error) {
+	var words []string
+	var word strings.Builder
+	inWord := false
+	var quote rune
+
+	runes := []rune(s)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case quote == '\'':
+			if r == '\'' {
+				quote = 0
+			} else {
+				word.WriteRune(r)
+			}
+		case quote == '"':
+			switch {
+			case r == '"':
+				quote = 0
+			case r == '\\' && i+1 < len(runes) && (runes[i+1] == '"' || runes[i+1] == '\\'):
+				i++
+				word.WriteRune(runes[i])
+			default:
+				word.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote = r
+			inWord = true
+		case r == '\\':
+			if i+1 < len(runes) {
+				i++
+				word.WriteRune(runes[i])
+			}
+			inWord = true
+		case r == ' ' || r == '\t' || r == '\n':
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+		default:
+			word.WriteRune(r)
+			inWord = true
+		}
+	}
+
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated %c quote", quote)
+	}
+	if inWord {
+		words = append(words, word.String())
+	}
+	return words, nil
+}
+
+// Expand returns the command line for an alias expansion given the
+// arguments that followed the alias. $1, $2, ... are replaced by the
+// matching argument and a "$@" word by all of them; when the expansion uses
+// neither, the arguments are appended. Arguments beyond the highest $N are
+// appended too.
+func Expand(expansion string, args []string) ([]string, error) {
+	words, err := SplitArgs(expansion)
+	if err != nil {
+		return nil, err
+	}
+
+	usesAll := false
+	highest := 0
+	var missing error
+	var expanded []string
+	for _, word := range words {
+		if word == "$@" {
+			usesAll = true
+			expanded = append(expanded, args...)
+			continue
+		}
+		word = placeholderPattern.ReplaceAllStringFunc(word, func(m string) string {
+			n, _ := strconv.Atoi(m[1:])
+			if n > highest {
+				highest = n
+			}
+			if missing != nil {
+				return m
+			}
+			if n < 1 {
+				missing = fmt.Errorf("invalid placeholder %s: arguments are numbered from $1", m)
+				return m
+			}
+			if n > len(args) {
+				missing = fmt.Errorf("alias needs at least %d argument(s), got %d", n, len(args))
+				return m
+			}
+			return args[n-1]
+		})
+		expanded = append(expanded, word)
+	}
+	if missing != nil {
+		return nil, missing
+	}
+
+	if !usesAll && highest < len(args) {
+		expanded = append(expanded, args[highest:]...)
+	}
+	return expanded, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	stderrors "errors"
 	"fmt"
+	"os"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/needmore/bc4/internal/auth"
@@ -11,6 +12,7 @@ import (
 	"github.com/needmore/bc4/internal/config"
 	"github.com/needmore/bc4/internal/errors"
 	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/identity"
 	"github.com/spf13/cobra"
 )
 
@@ -40,10 +42,24 @@ func NewAuthCmd(f *factory.Factory) *cobra.Command {
 }
 
 func newLoginCmd(f *factory.Factory) *cobra.Command {
-	return &cobra.Command{
+	var manual bool
+	var device bool
+
+	cmd := &cobra.Command{
 		Use:   "login",
 		Short: "Log in to Basecamp",
-		Long:  `Authenticate with Basecamp using OAuth2`,
+		Long: `Authenticate with Basecamp using OAuth2.
+
+By default a browser is opened and the result is received by a local callback
+server on port 8888. On headless machines (SSH sessions, containers, CI)
+use --manual: open the printed URL in a browser anywhere, approve access, then
+paste the URL of the page you're redirected to (or just its code) back into
+the terminal.
+
+Basecamp's Launchpad doesn't offer a device-code grant, so --device uses the
+same manual flow.`,
+		Example: `  bc4 auth login
+  bc4 auth login --manual`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Load config
 			cfg, err := f.Config()
@@ -59,9 +75,19 @@ func newLoginCmd(f *factory.Factory) *cobra.Command {
 			// Create auth client
 			authClient := auth.NewClient(cfg.ClientID, cfg.ClientSecret)
 
+			if device {
+				fmt.Fprintln(os.Stderr, "Basecamp doesn't support device-code login; using manual code entry instead.")
+				manual = true
+			}
+
 			// Perform login
 			fmt.Println("Starting authentication flow...")
-			token, err := authClient.Login(context.Background())
+			var token *auth.AccountToken
+			if manual {
+				token, err = authClient.LoginManual(context.Background(), os.Stdin, os.Stdout)
+			} else {
+				token, err = authClient.Login(context.Background())
+			}
 			if err != nil {
 				// Show user-friendly error message with helpful next steps
 				fmt.Println()
@@ -82,16 +108,30 @@ func newLoginCmd(f *factory.Factory) *cobra.Command {
 				fmt.Println("  bc4 auth status")
 				fmt.Println()
 				fmt.Println("To try again, run:")
-				fmt.Println("  bc4 auth login")
+				if manual {
+					fmt.Println("  bc4 auth login --manual")
+				} else {
+					fmt.Println("  bc4 auth login")
+				}
 
 				// Use SilentError since we already displayed a helpful message
 				return cmdutil.NewSilentError(err)
 			}
 
+			// A new login may be a different person
+			if err := identity.Clear(token.AccountID); err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+			}
+
 			fmt.Println(successStyle.Render(fmt.Sprintf("✓ Successfully authenticated with %s", token.AccountName)))
 			return nil
 		},
 	}
+
+	cmd.Flags().BoolVar(&manual, "manual", false, "Paste the authorization code instead of using a local callback server")
+	cmd.Flags().BoolVar(&device, "device", false, "Log in from a headless machine (uses --manual)")
+
+	return cmd
 }
 
 func newLogoutCmd(f *factory.Factory) *cobra.Command {
@@ -124,6 +164,9 @@ func newLogoutCmd(f *factory.Factory) *cobra.Command {
 			if err := authClient.Logout(accountID); err != nil {
 				return err
 			}
+			if err := identity.Clear(accountID); err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+			}
 
 			if all || accountID == "" {
 				fmt.Println(successStyle.Render("✓ Logged out of all accounts"))
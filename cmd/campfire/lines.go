@@ -0,0 +1,84 @@
+package campfire
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/markdown"
+	"github.com/needmore/bc4/internal/ui"
+	"github.com/needmore/bc4/internal/utils"
+)
+
+// campfireLineRecord is the machine-readable form of a campfire line
+type campfireLineRecord struct {
+	ID        int64                `json:"id"`
+	Author    campfireAuthorRecord `json:"author"`
+	CreatedAt time.Time            `json:"created_at"`
+	Content   string               `json:"content"`
+}
+
+type campfireAuthorRecord struct {
+	ID    int64  `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+// campfireLineRecords converts lines to records in chronological order,
+// rendering content in the given format
+func campfireLineRecords(lines []api.CampfireLine, format markdown.ContentFormat) ([]campfireLineRecord, error) {
+	converter := markdown.NewConverter()
+	records := make([]campfireLineRecord, 0, len(lines))
+	// The API returns newest first; exports read better oldest first
+	for i := len(lines) - 1; i >= 0; i-- {
+		line := lines[i]
+		content, err := markdown.ConvertContent(converter, line.Content, format)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert content of line #%d: %w", line.ID, err)
+		}
+		records = append(records, campfireLineRecord{
+			ID: line.ID,
+			Author: campfireAuthorRecord{
+				ID:    line.Creator.ID,
+				Name:  line.Creator.Name,
+				Email: line.Creator.EmailAddress,
+			},
+			CreatedAt: line.CreatedAt,
+			Content:   content,
+		})
+	}
+	return records, nil
+}
+
+// writeCampfireLinesCSV writes records as CSV with the author flattened into
+// name and email columns
+func writeCampfireLinesCSV(w io.Writer, records []campfireLineRecord) error {
+	rows := make([][]string, len(records))
+	for i, r := range records {
+		rows[i] = []string{
+			strconv.FormatInt(r.ID, 10),
+			r.Author.Name,
+			r.Author.Email,
+			r.CreatedAt.Format(time.RFC3339),
+			r.Content,
+		}
+	}
+	return ui.WriteCSV(w, []string{"id", "author_name", "author_email", "created_at", "content"}, rows)
+}
+
+// parseLineTime parses a --before/--after value: an RFC3339 timestamp or
+// anything utils.ParseDate accepts (midnight local time)
+func parseLineTime(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	date, err := utils.ParseDate(value)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.ParseInLocation(utils.DateLayout, date, time.Local)
+}
@@ -0,0 +1,35 @@
+package campfire
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/markdown"
+)
+
+func TestCampfireLineRecords_CSV(t *testing.T) {
+	created := time.Date(2025, 3, 10, 9, 30, 0, 0, time.UTC)
+	lines := []api.CampfireLine{
+		{ID: 2, Content: "<div>Second, <strong>bold</strong></div>", CreatedAt: created.Add(time.Minute),
+			Creator: api.Person{ID: 7, Name: "Ann", EmailAddress: "ann@example.com"}},
+		{ID: 1, Content: "First", CreatedAt: created,
+			Creator: api.Person{ID: 8, Name: "Bob", EmailAddress: "bob@example.com"}},
+	}
+
+	records, err := campfireLineRecords(lines, markdown.ContentText)
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, int64(1), records[0].ID, "records are oldest first")
+	assert.Equal(t, "Second, bold", records[1].Content)
+
+	var buf bytes.Buffer
+	require.NoError(t, writeCampfireLinesCSV(&buf, records))
+	assert.Equal(t, "id,author_name,author_email,created_at,content\n"+
+		"1,Bob,bob@example.com,2025-03-10T09:30:00Z,First\n"+
+		"2,Ann,ann@example.com,2025-03-10T09:31:00Z,\"Second, bold\"\n", buf.String())
+}
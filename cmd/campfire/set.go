@@ -18,11 +18,6 @@ func newSetCmd(f *factory.Factory) *cobra.Command {
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Get required dependencies
-			cfg, err := f.Config()
-			if err != nil {
-				return err
-			}
-
 			accountID, err := f.AccountID()
 			if err != nil {
 				return err
@@ -65,27 +60,28 @@ func newSetCmd(f *factory.Factory) *cobra.Command {
 				campfireName = campfire.Name
 			}
 
-			// Initialize accounts map if needed
-			if cfg.Accounts == nil {
-				cfg.Accounts = make(map[string]config.AccountConfig)
-			}
-
-			// Get or create account config
-			acc := cfg.Accounts[accountID]
+			err = config.Update(func(cfg *config.Config) error {
+				// Initialize accounts map if needed
+				if cfg.Accounts == nil {
+					cfg.Accounts = make(map[string]config.AccountConfig)
+				}
 
-			// Initialize project defaults if needed
-			if acc.ProjectDefaults == nil {
-				acc.ProjectDefaults = make(map[string]config.ProjectDefaults)
-			}
+				// Get or create account config
+				acc := cfg.Accounts[accountID]
 
-			// Update default campfire
-			projDefaults := acc.ProjectDefaults[projectID]
-			projDefaults.DefaultCampfire = strconv.FormatInt(campfireID, 10)
-			acc.ProjectDefaults[projectID] = projDefaults
-			cfg.Accounts[accountID] = acc
+				// Initialize project defaults if needed
+				if acc.ProjectDefaults == nil {
+					acc.ProjectDefaults = make(map[string]config.ProjectDefaults)
+				}
 
-			// Save config
-			if err := config.Save(cfg); err != nil {
+				// Update default campfire
+				projDefaults := acc.ProjectDefaults[projectID]
+				projDefaults.DefaultCampfire = strconv.FormatInt(campfireID, 10)
+				acc.ProjectDefaults[projectID] = projDefaults
+				cfg.Accounts[accountID] = acc
+				return nil
+			})
+			if err != nil {
 				return fmt.Errorf("failed to save config: %w", err)
 			}
 
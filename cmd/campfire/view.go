@@ -3,12 +3,15 @@ package campfire
 import (
 	"bytes"
 	"fmt"
+	"os"
 	"strconv"
 	"strings"
 
 	"github.com/needmore/bc4/internal/api"
 	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/markdown"
 	"github.com/needmore/bc4/internal/parser"
+	"github.com/needmore/bc4/internal/ui"
 	"github.com/needmore/bc4/internal/utils"
 	"github.com/spf13/cobra"
 )
@@ -16,18 +19,53 @@ import (
 func newViewCmd(f *factory.Factory) *cobra.Command {
 	var limit int
 	var noPager bool
+	var formatStr string
+	var beforeStr string
+	var afterStr string
+	var contentAs string
 
 	cmd := &cobra.Command{
-		Use:   "view [ID|name|URL]",
-		Short: "View recent messages in a campfire",
+		Use:     "view [ID|name|URL]",
+		Aliases: []string{"lines"},
+		Short:   "View recent messages in a campfire",
 		Long: `Display recent messages from a campfire. If no campfire is specified, uses the default campfire.
 
 You can specify the campfire using:
 - A numeric ID (e.g., "12345")
 - A campfire name (e.g., "General")
-- A Basecamp URL (e.g., "https://3.basecamp.com/1234567/buckets/89012345/chats/12345")`,
+- A Basecamp URL (e.g., "https://3.basecamp.com/1234567/buckets/89012345/chats/12345")
+
+Use --format json or csv to export messages (oldest first) for archival or
+analysis, and --before/--after to select a time window.`,
+		Example: `  bc4 campfire view General
+  bc4 campfire lines General --format json --limit 0 > general.json
+  bc4 campfire lines 12345 --format csv --after 2025-01-01 --content-as text`,
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := ui.ResolveFormat(formatStr, ui.OutputFormatTable, ui.OutputFormatJSON, ui.OutputFormatCSV)
+			if err != nil {
+				return err
+			}
+			contentFormat, err := markdown.ParseContentFormat(contentAs)
+			if err != nil {
+				return fmt.Errorf("invalid --content-as: %w", err)
+			}
+			if contentAs != "" && format == ui.OutputFormatTable {
+				return fmt.Errorf("--content-as requires --format json or csv")
+			}
+
+			lineOpts := api.CampfireLineOptions{Limit: limit}
+			if beforeStr != "" {
+				if lineOpts.Before, err = parseLineTime(beforeStr); err != nil {
+					return fmt.Errorf("invalid --before: %w", err)
+				}
+			}
+			if afterStr != "" {
+				if lineOpts.After, err = parseLineTime(afterStr); err != nil {
+					return fmt.Errorf("invalid --after: %w", err)
+				}
+			}
+
 			// Get required dependencies
 			cfg, err := f.Config()
 			if err != nil {
@@ -118,11 +156,22 @@ You can specify the campfire using:
 			}
 
 			// Get campfire lines
-			lines, err := campfireOps.GetCampfireLines(f.Context(), projectID, campfireID, limit)
+			lines, err := campfireOps.GetCampfireLinesWithOptions(f.Context(), projectID, campfireID, lineOpts)
 			if err != nil {
 				return fmt.Errorf("failed to get campfire lines: %w", err)
 			}
 
+			if format != ui.OutputFormatTable {
+				records, err := campfireLineRecords(lines, contentFormat)
+				if err != nil {
+					return err
+				}
+				if format == ui.OutputFormatCSV {
+					return writeCampfireLinesCSV(os.Stdout, records)
+				}
+				return ui.WriteJSON(os.Stdout, records)
+			}
+
 			// Prepare output for pager
 			var buf bytes.Buffer
 
@@ -192,7 +241,11 @@ You can specify the campfire using:
 	}
 
 	// Add flags
-	cmd.Flags().IntVarP(&limit, "limit", "n", 50, "Number of messages to show")
+	cmd.Flags().IntVarP(&limit, "limit", "n", 50, "Number of messages to show (0 for all)")
+	cmd.Flags().StringVarP(&formatStr, "format", "f", "table", "Output format: table, json, or csv")
+	cmd.Flags().StringVar(&beforeStr, "before", "", "Only show messages posted before this date or RFC3339 time")
+	cmd.Flags().StringVar(&afterStr, "after", "", "Only show messages posted after this date or RFC3339 time")
+	cmd.Flags().StringVar(&contentAs, "content-as", "", "Render message content in json/csv output as html, markdown, or text")
 	cmd.Flags().BoolVar(&noPager, "no-pager", false, "Disable pager for output")
 
 	return cmd
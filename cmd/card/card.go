@@ -46,6 +46,9 @@ like software bugs, design requests, or other workflow-oriented tasks.`,
 	cmd.AddCommand(newAssignCmd(f))
 	cmd.AddCommand(newUnassignCmd(f))
 	cmd.AddCommand(newArchiveCmd(f))
+	cmd.AddCommand(newUnarchiveCmd(f))
+	cmd.AddCommand(newSubscribeCmd(f))
+	cmd.AddCommand(newUnsubscribeCmd(f))
 
 	// Column management subcommands
 	cmd.AddCommand(newColumnCmd(f))
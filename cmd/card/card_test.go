@@ -2,7 +2,9 @@ package card
 
 import (
 	"testing"
+	"time"
 
+	"github.com/needmore/bc4/internal/api"
 	"github.com/needmore/bc4/internal/factory"
 	"github.com/stretchr/testify/assert"
 )
@@ -261,3 +263,50 @@ func TestCardCmd_SubcommandValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestFormatCardTime(t *testing.T) {
+	now := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+	created := now.Add(-2 * time.Hour)
+
+	assert.Equal(t, "2 hours ago", formatCardTime(now, created, true))
+	assert.Equal(t, "just now", formatCardTime(now, now.Add(-3*time.Second), true))
+	assert.Equal(t, "2024-06-15 10:00", formatCardTime(now, created, false))
+}
+
+func TestSortCardRows(t *testing.T) {
+	due := func(s string) *string { return &s }
+	todo := api.Column{ID: 1, Title: "To do"}
+	done := api.Column{ID: 2, Title: "Done"}
+	rows := []cardRow{
+		{card: api.Card{ID: 1, Title: "b", DueOn: due("2024-06-03")}, column: todo, columnPosition: 0},
+		{card: api.Card{ID: 2, Title: "a"}, column: todo, columnPosition: 0},
+		{card: api.Card{ID: 3, Title: "c", DueOn: due("2024-06-01")}, column: done, columnPosition: 1},
+	}
+
+	ids := func(rows []cardRow) []int64 {
+		var out []int64
+		for _, r := range rows {
+			out = append(out, r.card.ID)
+		}
+		return out
+	}
+
+	sortCardRows(rows, "")
+	assert.Equal(t, []int64{1, 2, 3}, ids(rows), "no sort keeps column grouping")
+
+	sortCardRows(rows, "due")
+	assert.Equal(t, []int64{3, 1, 2}, ids(rows))
+
+	sortCardRows(rows, "title")
+	assert.Equal(t, []int64{2, 1, 3}, ids(rows))
+
+	sortCardRows(rows, "column")
+	assert.Equal(t, []int64{2, 1, 3}, ids(rows), "column order, keeping the order within each column")
+
+	sortCardRows(rows, "due")
+	sortCardRows(rows, "column")
+	assert.Equal(t, []int64{1, 2, 3}, ids(rows))
+
+	assert.NoError(t, validateCardSort("column"))
+	assert.Error(t, validateCardSort("priority"))
+}
@@ -2,8 +2,11 @@ package card
 
 import (
 	"fmt"
+	"os"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/bubbles/list"
 	"github.com/charmbracelet/bubbles/spinner"
@@ -11,8 +14,12 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/cmdutil"
+	"github.com/needmore/bc4/internal/config"
 	"github.com/needmore/bc4/internal/factory"
 	"github.com/needmore/bc4/internal/markdown"
+	"github.com/needmore/bc4/internal/parser"
+	"github.com/needmore/bc4/internal/utils"
 	"github.com/spf13/cobra"
 )
 
@@ -23,6 +30,7 @@ const (
 	stepEnterTitle
 	stepEnterContent
 	stepSelectAssignees
+	stepEnterSteps
 	stepConfirm
 	stepCreating
 	stepDone
@@ -55,8 +63,9 @@ func (i personItem) Description() string { return i.person.EmailAddress }
 func (i personItem) FilterValue() string { return i.person.Name + " " + i.person.EmailAddress }
 
 type cardCreatedMsg struct {
-	card *api.Card
-	err  error
+	card         *api.Card
+	stepsCreated int
+	err          error
 }
 
 type columnsLoadedMsg struct {
@@ -81,11 +90,17 @@ type createModel struct {
 	peopleList        list.Model
 	titleInput        textinput.Model
 	contentInput      textinput.Model
+	stepInput         textinput.Model
 	spinner           spinner.Model
 	selectedColumn    *api.Column
 	selectedAssignees []int64
 	cardTitle         string
 	cardContent       string
+	cardSteps         []string
+	stepAssignees     string
+	stepsCreated      int
+	dueOn             string
+	startsOn          string
 	createdCard       *api.Card
 	err               error
 	width             int
@@ -130,34 +145,56 @@ func (m createModel) createCard() tea.Cmd {
 			return cardCreatedMsg{err: err}
 		}
 
-		req := api.CardCreateRequest{
-			Title:   m.cardTitle,
-			Content: richContent,
-		}
+		req, updateReq := m.buildCardRequests(richContent)
 
 		card, err := m.client.CreateCard(m.factory.Context(), m.projectID, m.selectedColumn.ID, req)
 		if err != nil {
 			return cardCreatedMsg{err: err}
 		}
 
-		// If assignees were selected, update the card to add them
-		if len(m.selectedAssignees) > 0 {
-			updateReq := api.CardUpdateRequest{
-				Title:       m.cardTitle,
-				Content:     richContent,
-				AssigneeIDs: m.selectedAssignees,
-			}
-			card, err = m.client.UpdateCard(m.factory.Context(), m.projectID, card.ID, updateReq)
+		// Assignees and start date can only be set by updating the new card
+		if updateReq != nil {
+			card, err = m.client.UpdateCard(m.factory.Context(), m.projectID, card.ID, *updateReq)
 			if err != nil {
-				// Card was created but assigning failed
-				return cardCreatedMsg{card: card, err: fmt.Errorf("card created but failed to assign: %w", err)}
+				// Card was created but the follow-up update failed
+				return cardCreatedMsg{card: card, err: fmt.Errorf("card created but failed to apply assignees or start date: %w", err)}
 			}
 		}
 
-		return cardCreatedMsg{card: card}
+		stepsCreated, err := createCardSteps(m.factory.Context(), m.client, m.projectID, card.ID, m.cardSteps, m.stepAssignees)
+		return cardCreatedMsg{card: card, stepsCreated: stepsCreated, err: err}
 	}
 }
 
+// buildCardRequests builds the create request and, when assignees or a start
+// date were given, the follow-up update request applied after creation.
+func (m createModel) buildCardRequests(richContent string) (api.CardCreateRequest, *api.CardUpdateRequest) {
+	req := api.CardCreateRequest{
+		Title:   m.cardTitle,
+		Content: richContent,
+	}
+	if m.dueOn != "" {
+		due := m.dueOn
+		req.DueOn = &due
+	}
+
+	if len(m.selectedAssignees) == 0 && m.startsOn == "" {
+		return req, nil
+	}
+
+	updateReq := &api.CardUpdateRequest{
+		Title:       m.cardTitle,
+		Content:     richContent,
+		DueOn:       req.DueOn,
+		AssigneeIDs: m.selectedAssignees,
+	}
+	if m.startsOn != "" {
+		start := m.startsOn
+		updateReq.StartsOn = &start
+	}
+	return req, updateReq
+}
+
 func (m createModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmd tea.Cmd
 	var cmds []tea.Cmd
@@ -197,11 +234,13 @@ func (m createModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 
 	case cardCreatedMsg:
+		// Keep the card even on error so its ID can still be reported
+		m.createdCard = msg.card
+		m.stepsCreated = msg.stepsCreated
 		if msg.err != nil {
 			m.err = msg.err
 			return m, tea.Quit
 		}
-		m.createdCard = msg.card
 		m.step = stepDone
 		return m, tea.Quit
 
@@ -256,12 +295,14 @@ func (m createModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					// Setup people list
 					items := make([]list.Item, len(m.people))
 					for i, person := range m.people {
-						items[i] = personItem{person: person, selected: false}
+						items[i] = personItem{person: person, selected: slices.Contains(m.selectedAssignees, person.ID)}
 					}
 					m.peopleList.SetItems(items)
 					m.step = stepSelectAssignees
 				} else {
-					m.step = stepConfirm
+					m.step = stepEnterSteps
+					m.stepInput.Focus()
+					cmds = append(cmds, textinput.Blink)
 				}
 			}
 		}
@@ -272,12 +313,28 @@ func (m createModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		switch msg := msg.(type) {
 		case tea.KeyMsg:
 			if msg.String() == keyEnter {
-				m.step = stepConfirm
+				m.step = stepEnterSteps
+				m.stepInput.Focus()
+				cmds = append(cmds, textinput.Blink)
 			}
 		}
 		m.peopleList, m.selectedAssignees, cmd = handleAssigneeSelection(m.peopleList, m.selectedAssignees, msg)
 		cmds = append(cmds, cmd)
 
+	case stepEnterSteps:
+		if msg, ok := msg.(tea.KeyMsg); ok && msg.String() == keyEnter {
+			// Each Enter adds a step; an empty Enter moves on
+			if title := strings.TrimSpace(m.stepInput.Value()); title != "" {
+				m.cardSteps = append(m.cardSteps, title)
+				m.stepInput.SetValue("")
+			} else {
+				m.step = stepConfirm
+			}
+			return m, tea.Batch(cmds...)
+		}
+		m.stepInput, cmd = m.stepInput.Update(msg)
+		cmds = append(cmds, cmd)
+
 	case stepConfirm:
 		switch msg := msg.(type) {
 		case tea.KeyMsg:
@@ -349,6 +406,17 @@ func (m createModel) View() string {
 
 		content += m.peopleList.View()
 
+	case stepEnterSteps:
+		content = title.Render("Add Steps") + "\n\n"
+		for i, step := range m.cardSteps {
+			content += fmt.Sprintf("%d. %s\n", i+1, step)
+		}
+		if len(m.cardSteps) > 0 {
+			content += "\n"
+		}
+		content += m.stepInput.View()
+		content += "\n\nPress Enter to add a step, Enter on an empty line when done"
+
 	case stepConfirm:
 		content = title.Render("Confirm Card Creation") + "\n\n"
 		content += fmt.Sprintf("Column: %s\n", m.selectedColumn.Title)
@@ -366,6 +434,15 @@ func (m createModel) View() string {
 			}
 			content += fmt.Sprintf("Assignees: %s\n", strings.Join(names, ", "))
 		}
+		if len(m.cardSteps) > 0 {
+			content += fmt.Sprintf("Steps: %s\n", strings.Join(m.cardSteps, ", "))
+		}
+		if m.startsOn != "" {
+			content += fmt.Sprintf("Start: %s\n", m.startsOn)
+		}
+		if m.dueOn != "" {
+			content += fmt.Sprintf("Due: %s\n", m.dueOn)
+		}
 		content += "\nCreate card? (y/n)"
 
 	case stepCreating:
@@ -380,6 +457,12 @@ func newCreateCmd(f *factory.Factory) *cobra.Command {
 	var columnID string
 	var accountID string
 	var projectID string
+	var dueOn string
+	var startsOn string
+	var steps []string
+	var stepAssignees []string
+	var fromTemplate string
+	var output cmdutil.CreatedOutput
 
 	cmd := &cobra.Command{
 		Use:   "create",
@@ -387,13 +470,57 @@ func newCreateCmd(f *factory.Factory) *cobra.Command {
 		Long: `Create a new card using an interactive interface.
 
 If you specify a card table ID, the interactive UI will start from column selection.
-If you also specify a column ID, it will skip to entering card details.
+If you also specify a column, by ID or name, it will skip to entering card
+details. The column is checked before the interactive UI starts, and an
+unknown one is reported along with the table's columns.
+
+Use --due and --start to schedule the card. Dates accept YYYY-MM-DD as well as
+relative values like "today", "tomorrow", "friday", or "+3d".
+
+Use --step (repeatable) to seed the card's steps; more can be added in the
+steps stage of the interactive flow. Steps are created in order after the
+card, each assigned to --step-assignee if given. If a step fails the card is
+kept and the steps created so far are reported.
+
+Use --from-template to start from a card template defined in the config
+(see 'bc4 config card-templates'). The template's column, title, content,
+assignees, and steps are filled in and can still be changed in the
+interactive flow; --column and --step given on the command line win. The
+template's column must exist in the target card table.
+
+Use --print-url to print the new card's web URL after its ID. Add --quiet to
+print only the URL.
 
 Examples:
   bc4 card create                      # Full interactive mode
   bc4 card create --table 123          # Start from column selection in table 123  
-  bc4 card create --table 123 --column 456  # Skip to card details for column 456`,
+  bc4 card create --table 123 --column 456  # Skip to card details for column 456
+  bc4 card create --column "In Progress"    # Column by name in the project's table
+  bc4 card create --start today --due +1w   # Schedule the new card
+  bc4 card create --step "Design" --step "Build" --step-assignee @jane
+  bc4 card create --from-template bug --table 123
+  bc4 card create --column "To Do" --print-url   # Print the new card's link`,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.Validate(); err != nil {
+				return err
+			}
+
+			// Parse and validate dates before launching the interactive UI
+			var err error
+			if dueOn != "" {
+				if dueOn, err = utils.ParseDate(dueOn); err != nil {
+					return fmt.Errorf("invalid due date: %w", err)
+				}
+			}
+			if startsOn != "" {
+				if startsOn, err = utils.ParseDate(startsOn); err != nil {
+					return fmt.Errorf("invalid start date: %w", err)
+				}
+			}
+			if err := utils.ValidateDateRange(startsOn, dueOn); err != nil {
+				return err
+			}
+
 			// Apply overrides if specified
 			f = f.ApplyOverrides(accountID, projectID)
 
@@ -425,6 +552,61 @@ Examples:
 				tableID = cardTable.ID
 			}
 
+			var tmpl config.CardTemplate
+			if fromTemplate != "" {
+				cfg, err := f.Config()
+				if err != nil {
+					return err
+				}
+				if tmpl, err = cfg.CardTemplate(fromTemplate); err != nil {
+					return err
+				}
+			}
+
+			// Check the requested or template column exists before starting,
+			// so a typo fails here rather than at the end of the interactive flow
+			var startColumn *api.Column
+			if columnID != "" || tmpl.Column != "" {
+				cardTable, err := client.Cards().GetCardTable(f.Context(), resolvedProjectID, tableID)
+				if err != nil {
+					return fmt.Errorf("failed to get card table: %w", err)
+				}
+				if columnID != "" {
+					startColumn, err = findColumnByRef(cardTable, columnID)
+				} else {
+					startColumn, err = findTemplateColumn(cardTable.Lists, tmpl.Column)
+				}
+				if err != nil {
+					return err
+				}
+			}
+
+			var templateAssigneeIDs []int64
+			if len(tmpl.Assignees) > 0 {
+				userResolver := utils.NewUserResolver(client.Client, resolvedProjectID)
+				if templateAssigneeIDs, err = userResolver.ResolveUsers(f.Context(), tmpl.Assignees); err != nil {
+					return fmt.Errorf("failed to resolve template assignees: %w", err)
+				}
+			}
+			if len(steps) == 0 {
+				steps = tmpl.Steps
+			}
+
+			// Resolve step assignees before launching the interactive UI
+			var stepAssigneeIDs string
+			if len(stepAssignees) > 0 {
+				userResolver := utils.NewUserResolver(client.Client, resolvedProjectID)
+				personIDs, err := userResolver.ResolveUsers(f.Context(), stepAssignees)
+				if err != nil {
+					return fmt.Errorf("failed to resolve step assignees: %w", err)
+				}
+				var idStrings []string
+				for _, id := range personIDs {
+					idStrings = append(idStrings, strconv.FormatInt(id, 10))
+				}
+				stepAssigneeIDs = strings.Join(idStrings, ",")
+			}
+
 			// Initialize the model
 			model := createModel{
 				factory:      f,
@@ -435,13 +617,23 @@ Examples:
 				spinner:      spinner.New(),
 				titleInput:   textinput.New(),
 				contentInput: textinput.New(),
+				stepInput:    textinput.New(),
+				dueOn:        dueOn,
+				startsOn:     startsOn,
 			}
+			model.cardSteps = append(model.cardSteps, steps...)
+			model.stepAssignees = stepAssigneeIDs
+			model.selectedAssignees = templateAssigneeIDs
 
 			// Configure inputs
 			model.titleInput.Placeholder = "Enter card title..."
 			model.titleInput.CharLimit = 200
 			model.contentInput.Placeholder = "Enter card content (Markdown supported)..."
 			model.contentInput.CharLimit = 5000
+			model.stepInput.Placeholder = "Enter step title..."
+			model.stepInput.CharLimit = 200
+			model.titleInput.SetValue(tmpl.ExpandTitle(time.Now()))
+			model.contentInput.SetValue(tmpl.Content)
 
 			// Configure lists
 			model.columnList = list.New([]list.Item{}, list.NewDefaultDelegate(), 0, 0)
@@ -454,15 +646,9 @@ Examples:
 			model.peopleList.SetShowStatusBar(false)
 			model.peopleList.SetFilteringEnabled(true)
 
-			// If column ID is specified, skip column selection
-			if columnID != "" {
-				colID, err := strconv.ParseInt(columnID, 10, 64)
-				if err != nil {
-					return fmt.Errorf("invalid column ID: %s", columnID)
-				}
-				// We need to load the column details
-				// For simplicity, we'll still load all columns but pre-select this one
-				model.selectedColumn = &api.Column{ID: colID}
+			// If the column is known, skip column selection
+			if startColumn != nil {
+				model.selectedColumn = startColumn
 				model.step = stepEnterTitle
 				model.titleInput.Focus()
 			}
@@ -476,12 +662,22 @@ Examples:
 
 			// Check if card was created
 			if m, ok := finalModel.(createModel); ok {
+				if m.createdCard != nil {
+					resolvedAccountID, err := f.AccountID()
+					if err != nil {
+						return err
+					}
+					summary := fmt.Sprintf("#%d", m.createdCard.ID)
+					if err := output.Write(os.Stdout, summary, resolvedAccountID, resolvedProjectID, parser.ResourceTypeCard, m.createdCard.ID); err != nil {
+						return err
+					}
+					if m.stepsCreated > 0 {
+						fmt.Fprintf(os.Stderr, "Added %d of %d steps\n", m.stepsCreated, len(m.cardSteps))
+					}
+				}
 				if m.err != nil {
 					return m.err
 				}
-				if m.createdCard != nil {
-					fmt.Printf("#%d\n", m.createdCard.ID)
-				}
 			}
 
 			return nil
@@ -489,7 +685,13 @@ Examples:
 	}
 
 	cmd.Flags().StringVar(&cardTableID, "table", "", "Card table ID")
-	cmd.Flags().StringVar(&columnID, "column", "", "Column ID (requires --table)")
+	cmd.Flags().StringVar(&columnID, "column", "", "Column ID or name to create the card in")
+	cmd.Flags().StringVar(&dueOn, "due", "", "Due date (YYYY-MM-DD or relative, e.g. tomorrow, +3d)")
+	cmd.Flags().StringVar(&startsOn, "start", "", "Start date (YYYY-MM-DD or relative, e.g. today, monday)")
+	cmd.Flags().StringArrayVar(&steps, "step", nil, "Add a step to the new card (can be used multiple times)")
+	cmd.Flags().StringSliceVar(&stepAssignees, "step-assignee", nil, "Assign every new step to these people (email or @mention, comma-separated)")
+	cmd.Flags().StringVar(&fromTemplate, "from-template", "", "Start from the named card template in the config")
+	output.AddFlags(cmd)
 	cmd.Flags().StringVarP(&accountID, "account", "a", "", "Specify account ID")
 	cmd.Flags().StringVarP(&projectID, "project", "p", "", "Specify project ID")
 
@@ -226,6 +226,27 @@ func TestCreateModel_StepUpdates(t *testing.T) {
 		newModel, _ = m.Update(msg)
 		m = newModel.(createModel)
 
+		assert.Equal(t, stepEnterSteps, m.step)
+	})
+
+	t.Run("add steps then finish with empty enter", func(t *testing.T) {
+		model := createModel{
+			step:      stepEnterSteps,
+			stepInput: textinput.New(),
+			cardSteps: []string{"From flag"},
+		}
+		model.stepInput.SetValue("  Typed step ")
+
+		msg := tea.KeyMsg{Type: tea.KeyEnter}
+		newModel, _ := model.Update(msg)
+		m := newModel.(createModel)
+
+		assert.Equal(t, stepEnterSteps, m.step)
+		assert.Equal(t, []string{"From flag", "Typed step"}, m.cardSteps)
+		assert.Empty(t, m.stepInput.Value())
+
+		newModel, _ = m.Update(msg)
+		m = newModel.(createModel)
 		assert.Equal(t, stepConfirm, m.step)
 	})
 
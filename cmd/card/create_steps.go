@@ -0,0 +1,22 @@
+package card
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/needmore/bc4/internal/api"
+)
+
+// createCardSteps creates the given steps on a card in order, each assigned
+// to assignees (comma-separated person IDs, may be empty). It stops at the
+// first failure and reports how many steps were created; the card itself is
+// left in place.
+func createCardSteps(ctx context.Context, ops api.StepOperations, projectID string, cardID int64, titles []string, assignees string) (int, error) {
+	for i, title := range titles {
+		req := api.StepCreateRequest{Title: title, Assignees: assignees}
+		if _, err := ops.CreateStep(ctx, projectID, cardID, req); err != nil {
+			return i, fmt.Errorf("card #%d created with %d of %d steps; failed to add step %q: %w", cardID, i, len(titles), title, err)
+		}
+	}
+	return len(titles), nil
+}
@@ -0,0 +1,23 @@
+package card
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/utils"
+)
+
+// findTemplateColumn finds the column a card template names, by ID or
+// case-insensitive title
+func findTemplateColumn(columns []api.Column, ref string) (*api.Column, error) {
+	if matches, _ := utils.MatchIDOrName(columns, ref, false, columnID, columnTitle); len(matches) > 0 {
+		return &columns[matches[0]], nil
+	}
+
+	titles := make([]string, len(columns))
+	for i, col := range columns {
+		titles[i] = col.Title
+	}
+	return nil, fmt.Errorf("template column %q not found in this card table (columns: %s)", ref, strings.Join(titles, ", "))
+}
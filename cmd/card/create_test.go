@@ -1,11 +1,15 @@
 package card
 
 import (
+	"context"
+	"errors"
 	"testing"
 
 	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/api/mock"
 	"github.com/needmore/bc4/internal/factory"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewCreateCmd(t *testing.T) {
@@ -255,3 +259,128 @@ func TestCreateCmd_FlagParsing(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateModel_BuildCardRequests(t *testing.T) {
+	t.Run("due date sent on create", func(t *testing.T) {
+		model := createModel{cardTitle: "Card", dueOn: "2025-01-20"}
+
+		req, updateReq := model.buildCardRequests("<div>Body</div>")
+
+		assert.Equal(t, "Card", req.Title)
+		if assert.NotNil(t, req.DueOn) {
+			assert.Equal(t, "2025-01-20", *req.DueOn)
+		}
+		assert.Nil(t, updateReq, "no follow-up update needed for due date alone")
+	})
+
+	t.Run("start date sent via follow-up update", func(t *testing.T) {
+		model := createModel{cardTitle: "Card", dueOn: "2025-01-20", startsOn: "2025-01-15"}
+
+		_, updateReq := model.buildCardRequests("")
+
+		if assert.NotNil(t, updateReq) {
+			if assert.NotNil(t, updateReq.StartsOn) {
+				assert.Equal(t, "2025-01-15", *updateReq.StartsOn)
+			}
+			if assert.NotNil(t, updateReq.DueOn) {
+				assert.Equal(t, "2025-01-20", *updateReq.DueOn)
+			}
+		}
+	})
+
+	t.Run("assignees carried with dates", func(t *testing.T) {
+		model := createModel{cardTitle: "Card", selectedAssignees: []int64{1, 2}}
+
+		req, updateReq := model.buildCardRequests("")
+
+		assert.Nil(t, req.DueOn)
+		if assert.NotNil(t, updateReq) {
+			assert.Equal(t, []int64{1, 2}, updateReq.AssigneeIDs)
+			assert.Nil(t, updateReq.StartsOn)
+		}
+	})
+}
+
+func TestCreateCmd_DateValidation(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          []string
+		errorContains string
+	}{
+		{
+			name:          "invalid due date",
+			args:          []string{"--due", "not-a-date"},
+			errorContains: "invalid due date",
+		},
+		{
+			name:          "invalid start date",
+			args:          []string{"--start", "2025-13-01"},
+			errorContains: "invalid start date",
+		},
+		{
+			name:          "start after due",
+			args:          []string{"--start", "2025-01-20", "--due", "2025-01-10"},
+			errorContains: "is after due date",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := newCreateCmd(factory.New())
+			cmd.SetArgs(tt.args)
+
+			err := cmd.Execute()
+
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errorContains)
+		})
+	}
+}
+
+// flakyStepCreator fails on the step with the given title
+type flakyStepCreator struct {
+	*mock.MockClient
+	failOn string
+	titles []string
+}
+
+func (c *flakyStepCreator) CreateStep(ctx context.Context, projectID string, cardID int64, req api.StepCreateRequest) (*api.Step, error) {
+	if req.Title == c.failOn {
+		return nil, errors.New("boom")
+	}
+	c.titles = append(c.titles, req.Title)
+	return &api.Step{Title: req.Title}, nil
+}
+
+func TestCreateCardSteps(t *testing.T) {
+	t.Run("creates steps in order", func(t *testing.T) {
+		ops := &flakyStepCreator{MockClient: mock.NewMockClient()}
+		created, err := createCardSteps(context.Background(), ops, "1", 42, []string{"Design", "Build", "Ship"}, "7,8")
+		assert.NoError(t, err)
+		assert.Equal(t, 3, created)
+		assert.Equal(t, []string{"Design", "Build", "Ship"}, ops.titles)
+	})
+
+	t.Run("stops at first failure and reports progress", func(t *testing.T) {
+		ops := &flakyStepCreator{MockClient: mock.NewMockClient(), failOn: "Build"}
+		created, err := createCardSteps(context.Background(), ops, "1", 42, []string{"Design", "Build", "Ship"}, "")
+		assert.Equal(t, 1, created)
+		assert.EqualError(t, err, `card #42 created with 1 of 3 steps; failed to add step "Build": boom`)
+		assert.Equal(t, []string{"Design"}, ops.titles)
+	})
+}
+
+func TestFindTemplateColumn(t *testing.T) {
+	columns := []api.Column{{ID: 1, Title: "Triage"}, {ID: 2, Title: "Doing"}}
+
+	col, err := findTemplateColumn(columns, "triage")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), col.ID)
+
+	col, err = findTemplateColumn(columns, "2")
+	require.NoError(t, err)
+	assert.Equal(t, "Doing", col.Title)
+
+	_, err = findTemplateColumn(columns, "Done")
+	assert.ErrorContains(t, err, "columns: Triage, Doing")
+}
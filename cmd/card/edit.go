@@ -19,6 +19,7 @@ import (
 	"github.com/needmore/bc4/internal/markdown"
 	"github.com/needmore/bc4/internal/mentions"
 	"github.com/needmore/bc4/internal/parser"
+	"github.com/needmore/bc4/internal/utils"
 	"github.com/spf13/cobra"
 )
 
@@ -365,7 +366,7 @@ func (m editModel) View() string {
 	return content
 }
 
-func updateCardNonInteractive(f *factory.Factory, client *api.Client, projectID string, cardID int64, title, content string, attach []string) error {
+func updateCardNonInteractive(f *factory.Factory, client *api.Client, projectID string, cardID int64, title, content string, attach []string, assignees []string, assigneeMode string) error {
 	// Get current card
 	card, err := client.GetCard(f.Context(), projectID, cardID)
 	if err != nil {
@@ -417,11 +418,19 @@ func updateCardNonInteractive(f *factory.Factory, client *api.Client, projectID
 		}
 	}
 
-	// Preserve assignees
+	// Preserve assignees unless --assignee was given
 	assigneeIDs := make([]int64, 0, len(card.Assignees))
 	for _, a := range card.Assignees {
 		assigneeIDs = append(assigneeIDs, a.ID)
 	}
+	if len(assignees) > 0 {
+		userResolver := utils.NewUserResolver(client, projectID)
+		ids, err := userResolver.ResolveUsers(f.Context(), assignees)
+		if err != nil {
+			return fmt.Errorf("failed to resolve assignees: %w", err)
+		}
+		assigneeIDs = utils.ApplyAssigneeMode(assigneeIDs, ids, assigneeMode)
+	}
 	req.AssigneeIDs = assigneeIDs
 
 	// Update the card
@@ -438,11 +447,13 @@ func newEditCmd(f *factory.Factory) *cobra.Command {
 	var accountID string
 	var projectID string
 	var attach []string
+	var assignees []string
+	var assigneeMode string
 
 	cmd := &cobra.Command{
 		Use:   "edit [ID or URL]",
 		Short: "Edit card title/content",
-		Long: `Edit the title and content of an existing card.
+		Long: `Edit the title, content, and assignees of an existing card.
 
 You can specify the card using either:
 - A numeric ID (e.g., "12345")
@@ -450,7 +461,11 @@ You can specify the card using either:
 
 Use --attach to add images or files to the card content. Attachments are
 appended to the existing content. Multiple files can be attached by using
-the flag multiple times.`,
+the flag multiple times.
+
+Use --assignee with --assignee-mode to change assignees: replace (default) sets
+exactly the listed people, add keeps current assignees and adds the listed
+people, and remove drops the listed people.`,
 		Example: `  # Edit card title
   bc4 card edit 12345 --title "New title"
 
@@ -461,7 +476,10 @@ the flag multiple times.`,
   bc4 card edit 12345 --attach ./screenshot.png
 
   # Add multiple attachments
-  bc4 card edit 12345 --attach ./photo1.jpg --attach ./photo2.jpg`,
+  bc4 card edit 12345 --attach ./photo1.jpg --attach ./photo2.jpg
+
+  # Add an assignee without removing existing ones
+  bc4 card edit 12345 --assignee @jane --assignee-mode add`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Parse card ID (could be numeric ID or URL)
@@ -491,6 +509,10 @@ the flag multiple times.`,
 				}
 			}
 
+			if err := utils.ValidateAssigneeMode(assigneeMode); err != nil {
+				return err
+			}
+
 			// Get resolved project ID
 			resolvedProjectID, err := f.ProjectID()
 			if err != nil {
@@ -509,8 +531,8 @@ the flag multiple times.`,
 			interactive, _ := cmd.Flags().GetBool("interactive")
 
 			// If non-interactive mode with flags
-			if !interactive && (title != "" || content != "" || len(attach) > 0) {
-				return updateCardNonInteractive(f, client.Client, resolvedProjectID, cardID, title, content, attach)
+			if !interactive && (title != "" || content != "" || len(attach) > 0 || len(assignees) > 0) {
+				return updateCardNonInteractive(f, client.Client, resolvedProjectID, cardID, title, content, attach, assignees, assigneeMode)
 			}
 
 			// Interactive mode
@@ -564,6 +586,8 @@ the flag multiple times.`,
 	cmd.Flags().String("content", "", "New content for the card (Markdown supported)")
 	cmd.Flags().Bool("interactive", false, "Use interactive mode (default when no flags)")
 	cmd.Flags().StringSliceVar(&attach, "attach", nil, "Attach file(s) to the card (can be used multiple times)")
+	cmd.Flags().StringSliceVar(&assignees, "assignee", nil, "Assignees to apply with --assignee-mode (by email or name)")
+	cmd.Flags().StringVar(&assigneeMode, "assignee-mode", utils.AssigneeModeReplace, "How --assignee is applied: replace, add, or remove")
 
 	return cmd
 }
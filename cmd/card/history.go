@@ -0,0 +1,22 @@
+package card
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/utils"
+)
+
+// renderCardHistory writes a card's events as a table, oldest first
+func renderCardHistory(w io.Writer, events []api.Event, now time.Time, relative bool) error {
+	if len(events) == 0 {
+		_, err := fmt.Fprintln(w, "No history found for this card")
+		return err
+	}
+
+	return utils.WriteEventTable(w, events, func(t time.Time) string {
+		return formatCardTime(now, t, relative)
+	})
+}
@@ -1,10 +1,16 @@
 package card
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/needmore/bc4/internal/api"
 	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/ui"
 	"github.com/needmore/bc4/internal/ui/tableprinter"
 	"github.com/spf13/cobra"
 )
@@ -13,12 +19,26 @@ func newListCmd(f *factory.Factory) *cobra.Command {
 	var formatJSON bool
 	var accountID string
 	var projectID string
+	var archived bool
+	var columnFilter string
 
 	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "List card tables in the current project",
-		Long:  `List all card tables in the current project with their card counts and status.`,
+		Long: `List all card tables in the current project with their card counts and status.
+
+Use --archived to list the archived cards on the default card table (or the
+project's card table when no default is set) instead, optionally narrowed with
+--column. Restore one with 'bc4 card unarchive'.`,
+		Example: `  bc4 card list
+  bc4 card list --archived
+  bc4 card list --archived --column Done
+  bc4 card unarchive 12345`,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if columnFilter != "" && !archived {
+				return fmt.Errorf("--column requires --archived")
+			}
+
 			// Apply overrides if specified
 			if accountID != "" {
 				f = f.WithAccount(accountID)
@@ -27,6 +47,10 @@ func newListCmd(f *factory.Factory) *cobra.Command {
 				f = f.WithProject(projectID)
 			}
 
+			if archived {
+				return runListArchived(f, columnFilter, formatJSON)
+			}
+
 			// Get API client from factory
 			client, err := f.ApiClient()
 			if err != nil {
@@ -120,6 +144,115 @@ func newListCmd(f *factory.Factory) *cobra.Command {
 	cmd.Flags().BoolVar(&formatJSON, "json", false, "Output in JSON format")
 	cmd.Flags().StringVarP(&accountID, "account", "a", "", "Specify account ID")
 	cmd.Flags().StringVarP(&projectID, "project", "p", "", "Specify project ID")
+	cmd.Flags().BoolVar(&archived, "archived", false, "List archived cards instead of card tables")
+	cmd.Flags().StringVar(&columnFilter, "column", "", "With --archived, only list cards from matching columns")
 
 	return cmd
 }
+
+// runListArchived lists the archived cards on the default card table
+func runListArchived(f *factory.Factory, columnFilter string, formatJSON bool) error {
+	client, err := f.ApiClient()
+	if err != nil {
+		return err
+	}
+	cardOps := client.Cards()
+
+	resolvedAccountID, err := f.AccountID()
+	if err != nil {
+		return err
+	}
+	resolvedProjectID, err := f.ProjectID()
+	if err != nil {
+		return err
+	}
+	cfg, err := f.Config()
+	if err != nil {
+		return err
+	}
+
+	// Use the default card table if one is set, otherwise the project's
+	var cardTable *api.CardTable
+	if acc, ok := cfg.Accounts[resolvedAccountID]; ok {
+		if proj, ok := acc.ProjectDefaults[resolvedProjectID]; ok && proj.DefaultCardTable != "" {
+			if id, err := strconv.ParseInt(proj.DefaultCardTable, 10, 64); err == nil {
+				cardTable, err = cardOps.GetCardTable(f.Context(), resolvedProjectID, id)
+				if err != nil {
+					return fmt.Errorf("failed to fetch card table: %w", err)
+				}
+			}
+		}
+	}
+	if cardTable == nil {
+		cardTable, err = cardOps.GetProjectCardTable(f.Context(), resolvedProjectID)
+		if err != nil {
+			return fmt.Errorf("failed to fetch card table: %w", err)
+		}
+	}
+
+	rows, err := fetchArchivedCards(f.Context(), cardOps, resolvedProjectID, cardTable, columnFilter)
+	if err != nil {
+		return err
+	}
+
+	if formatJSON {
+		cards := make([]api.Card, 0, len(rows))
+		for _, row := range rows {
+			cards = append(cards, row.card)
+		}
+		return ui.WriteJSON(os.Stdout, cards)
+	}
+
+	if len(rows) == 0 {
+		fmt.Printf("No archived cards in %s\n", cardTable.Title)
+		return nil
+	}
+
+	table := tableprinter.New(os.Stdout)
+	if table.IsTTY() {
+		table.AddHeader("ID", "TITLE", "COLUMN", "UPDATED")
+	} else {
+		table.AddHeader("ID", "TITLE", "COLUMN", "STATUS", "UPDATED")
+	}
+	now := time.Now()
+	for _, row := range rows {
+		table.AddIDField(fmt.Sprintf("%d", row.card.ID), row.card.Status)
+		if table.IsTTY() {
+			table.AddProjectField("[ARCHIVED] "+row.card.Title, row.card.Status)
+		} else {
+			table.AddField(row.card.Title)
+		}
+		table.AddField(row.column.Title)
+		if !table.IsTTY() {
+			table.AddField("archived")
+		}
+		table.AddTimeField(now, row.card.UpdatedAt)
+		table.EndRow()
+	}
+
+	fmt.Printf("Showing %d archived cards in %s\n\n", len(rows), cardTable.Title)
+	_ = table.Render()
+	if table.IsTTY() {
+		fmt.Println("\nRestore a card with: bc4 card unarchive <ID>")
+	}
+	return nil
+}
+
+// fetchArchivedCards collects the archived cards from each column of
+// cardTable whose title contains columnFilter, keeping the column order
+func fetchArchivedCards(ctx context.Context, cardOps api.CardOperations, projectID string, cardTable *api.CardTable, columnFilter string) ([]cardRow, error) {
+	var rows []cardRow
+	for _, column := range cardTable.Lists {
+		if columnFilter != "" && !strings.Contains(strings.ToLower(column.Title), strings.ToLower(columnFilter)) {
+			continue
+		}
+		cards, err := cardOps.GetCardsInColumnWithStatus(ctx, projectID, column.ID, api.StatusArchived)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch archived cards from column %s: %w", column.Title, err)
+		}
+		for _, card := range cards {
+			rows = append(rows, cardRow{card: card, column: column})
+		}
+	}
+	return rows, nil
+}
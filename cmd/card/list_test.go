@@ -0,0 +1,36 @@
+package card
+
+import (
+	"context"
+	"testing"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/api/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFetchArchivedCards(t *testing.T) {
+	client := mock.NewMockClient()
+	client.Cards = []api.Card{{ID: 7, Title: "Old card", Status: "archived"}}
+	cardTable := &api.CardTable{
+		Title: "Board",
+		Lists: []api.Column{{ID: 1, Title: "To Do"}, {ID: 2, Title: "Done"}},
+	}
+
+	rows, err := fetchArchivedCards(context.Background(), client, "9", cardTable, "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"GetCardsInColumnWithStatus(9, 1, archived)",
+		"GetCardsInColumnWithStatus(9, 2, archived)",
+	}, client.Calls)
+	require.Len(t, rows, 2)
+	assert.Equal(t, "To Do", rows[0].column.Title)
+
+	client.Calls = nil
+	rows, err = fetchArchivedCards(context.Background(), client, "9", cardTable, "done")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"GetCardsInColumnWithStatus(9, 2, archived)"}, client.Calls)
+	require.Len(t, rows, 1)
+	assert.Equal(t, int64(7), rows[0].card.ID)
+}
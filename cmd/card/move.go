@@ -8,6 +8,8 @@ import (
 	"github.com/needmore/bc4/internal/api"
 	"github.com/needmore/bc4/internal/factory"
 	"github.com/needmore/bc4/internal/parser"
+	"github.com/needmore/bc4/internal/undo"
+	"github.com/needmore/bc4/internal/utils"
 	"github.com/spf13/cobra"
 )
 
@@ -16,6 +18,11 @@ func newMoveCmd(f *factory.Factory) *cobra.Command {
 	var accountID string
 	var projectID string
 	var onHold bool
+	var toBoard string
+	var dryRun bool
+	var allInColumn bool
+	var fromColumn string
+	var yes bool
 
 	cmd := &cobra.Command{
 		Use:   "move [ID or URL]",
@@ -29,14 +36,45 @@ You can specify the card using either:
 Use --on-hold to move a card to the on-hold section of its current column
 (or target column if --column is also specified).
 
+Use --to-board to move a card to a column on a different card table in the
+same project. The board and column can be given by name or ID, and --column is
+required.
+
+If the card is already in the target column, nothing is moved. Use --dry-run
+to print the planned move (from column -> to column) without making it.
+
+Use --all-in-column with --from-column to move every card in one column to
+--column, for example to clear a column. Both columns must be on the same card
+table. Each card's result is shown, failures don't stop the rest, and the
+command fails if any card couldn't be moved. On a terminal you're asked to
+confirm first (skip with --yes); --dry-run lists the cards that would move.
+
 Examples:
   bc4 card move 123 --column "In Progress"
   bc4 card move 123 --column 456
   bc4 card move 123 --on-hold
   bc4 card move 123 --column "Developing" --on-hold
+  bc4 card move 123 --to-board "Marketing" --column "Backlog"
+  bc4 card move 123 --column "Done" --dry-run
+  bc4 card move --all-in-column --from-column "Review" --column "Done"
   bc4 card move https://3.basecamp.com/1234567/buckets/89012345/card_tables/cards/12345 --column "Done"`,
-		Args: cobra.ExactArgs(1),
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if allInColumn {
+				opts := columnMoveOptions{fromColumn: fromColumn, toColumn: columnName, dryRun: dryRun, yes: yes}
+				if err := validateColumnMove(args, opts, onHold, toBoard); err != nil {
+					return err
+				}
+				f = f.ApplyOverrides(accountID, projectID)
+				return runMoveAllInColumn(f, opts)
+			}
+			if fromColumn != "" {
+				return fmt.Errorf("--from-column can only be used with --all-in-column")
+			}
+			if len(args) == 0 {
+				return fmt.Errorf("a card ID or URL is required (or use --all-in-column)")
+			}
+
 			// Parse card ID (could be numeric ID or URL)
 			cardID, parsedURL, err := parser.ParseArgument(args[0])
 			if err != nil {
@@ -46,6 +84,9 @@ Examples:
 			if columnName == "" && !onHold {
 				return fmt.Errorf("--column flag is required (or use --on-hold)")
 			}
+			if toBoard != "" && columnName == "" {
+				return fmt.Errorf("--column is required with --to-board")
+			}
 
 			// Apply overrides if specified
 			if accountID != "" {
@@ -93,95 +134,50 @@ Examples:
 				return fmt.Errorf("failed to get card tables: %w", err)
 			}
 
-			// Find which card table contains the card's current column
-			var currentCardTable *api.CardTable
-			if card.Parent != nil {
-				for _, table := range cardTables {
-					for _, column := range table.Lists {
-						if column.ID == card.Parent.ID {
-							currentCardTable = table
-							break
-						}
-					}
-					if currentCardTable != nil {
-						break
-					}
-				}
+			plan, err := planCardMove(cardTables, card, columnName, toBoard, onHold)
+			if err != nil {
+				return err
 			}
 
-			// If we couldn't find the card table from the parent, use the default one
-			if currentCardTable == nil {
-				if len(cardTables) > 0 {
-					currentCardTable = cardTables[0]
+			// Moving a card to where it already is would be a wasted call
+			if plan.isNoop(card) {
+				if plan.onHold {
+					fmt.Printf("Card #%d is already on hold in column '%s'\n", cardID, plan.column.Title)
 				} else {
-					return fmt.Errorf("no card tables found in project")
+					fmt.Printf("Card #%d is already in column '%s'\n", cardID, plan.column.Title)
 				}
+				return nil
 			}
 
-			// Handle --on-hold: move to the on-hold section
-			if onHold {
-				targetColumn, err := findColumn(currentCardTable, columnName, card)
-				if err != nil {
-					return err
-				}
-				if targetColumn.OnHold.ID == 0 {
-					return fmt.Errorf("column '%s' does not have an on-hold section", targetColumn.Title)
-				}
-				if err := cardOps.MoveCard(f.Context(), resolvedProjectID, cardID, targetColumn.OnHold.ID); err != nil {
-					return fmt.Errorf("failed to move card to on-hold: %w", err)
-				}
-				fmt.Printf("✓ Moved card #%d to on-hold in column '%s'\n", cardID, targetColumn.Title)
+			if dryRun {
+				fmt.Println(plan.describe(card))
 				return nil
 			}
 
-			// Find the target column by name or ID within the same card table
-			var targetColumnID int64
-
-			// Try to parse as ID first
-			if id, err := strconv.ParseInt(columnName, 10, 64); err == nil {
-				// Validate that the column ID exists in the current card table
-				found := false
-				for _, column := range currentCardTable.Lists {
-					if column.ID == id {
-						targetColumnID = id
-						found = true
-						break
-					}
-				}
-				if !found {
-					return fmt.Errorf("column ID %d not found in card table '%s'", id, currentCardTable.Title)
-				}
-			} else {
-				// Search by name in the same card table
-				columnNameLower := strings.ToLower(columnName)
-				for _, column := range currentCardTable.Lists {
-					if strings.ToLower(column.Title) == columnNameLower {
-						targetColumnID = column.ID
-						break
-					}
-				}
-				if targetColumnID == 0 {
-					return fmt.Errorf("column '%s' not found in card table '%s'", columnName, currentCardTable.Title)
-				}
+			switch {
+			case plan.crossBoard:
+				err = cardOps.MoveCardToTable(f.Context(), resolvedProjectID, cardID, plan.table.ID, plan.targetID)
+			default:
+				err = cardOps.MoveCard(f.Context(), resolvedProjectID, cardID, plan.targetID)
 			}
-
-			// Move the card
-			err = cardOps.MoveCard(f.Context(), resolvedProjectID, cardID, targetColumnID)
 			if err != nil {
+				if plan.onHold && !plan.crossBoard {
+					return fmt.Errorf("failed to move card to on-hold: %w", err)
+				}
 				return fmt.Errorf("failed to move card: %w", err)
 			}
 
-			// Get the column name for the success message
-			var targetColumnName string
-			for _, column := range currentCardTable.Lists {
-				if column.ID == targetColumnID {
-					targetColumnName = column.Title
-					break
-				}
+			if plan.onHold && !plan.crossBoard {
+				fmt.Printf("✓ Moved card #%d to on-hold in column '%s'\n", cardID, plan.column.Title)
+			} else {
+				fmt.Printf("✓ Moved card #%d to column '%s' on card table '%s'\n", cardID, plan.column.Title, plan.table.Title)
 			}
 
-			fmt.Printf("✓ Moved card #%d to column '%s' on card table '%s'\n", cardID, targetColumnName, currentCardTable.Title)
-
+			if action, ok := plan.undoAction(cardTables, card); ok {
+				action.AccountID, _ = f.AccountID()
+				action.ProjectID = resolvedProjectID
+				undo.RecordWithHint(action)
+			}
 			return nil
 		},
 	}
@@ -190,30 +186,135 @@ Examples:
 	cmd.Flags().StringVarP(&accountID, "account", "a", "", "Specify account ID")
 	cmd.Flags().StringVarP(&projectID, "project", "p", "", "Specify project ID")
 	cmd.Flags().BoolVar(&onHold, "on-hold", false, "Move card to the on-hold section of its current (or target) column")
+	cmd.Flags().StringVar(&toBoard, "to-board", "", "Move card to a column on another card table (name or ID)")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Show the planned move without making it")
+	cmd.Flags().BoolVar(&allInColumn, "all-in-column", false, "Move every card in --from-column to --column")
+	cmd.Flags().StringVar(&fromColumn, "from-column", "", "Source column name or ID for --all-in-column")
+	cmd.Flags().BoolVarP(&yes, "yes", "y", false, "Skip the confirmation prompt for --all-in-column")
 
 	return cmd
 }
 
-// findColumn resolves the target column from --column flag or falls back to the card's current column.
-func findColumn(cardTable *api.CardTable, columnName string, card *api.Card) (*api.Column, error) {
-	if columnName != "" {
-		// Try as ID first
-		if id, err := strconv.ParseInt(columnName, 10, 64); err == nil {
-			for i := range cardTable.Lists {
-				if cardTable.Lists[i].ID == id {
-					return &cardTable.Lists[i], nil
-				}
-			}
-			return nil, fmt.Errorf("column ID %d not found in card table '%s'", id, cardTable.Title)
+// cardMove is a resolved card move: the column (or its on-hold section) the
+// card goes to and the card table that column belongs to
+type cardMove struct {
+	table      *api.CardTable
+	column     *api.Column
+	targetID   int64
+	onHold     bool
+	crossBoard bool
+}
+
+// planCardMove resolves the target of a move from the command's flags,
+// without calling the API
+func planCardMove(cardTables []*api.CardTable, card *api.Card, columnName, toBoard string, onHold bool) (*cardMove, error) {
+	var table *api.CardTable
+	if toBoard != "" {
+		var err error
+		if table, err = findCardTable(cardTables, toBoard); err != nil {
+			return nil, err
+		}
+	} else {
+		table = currentCardTable(cardTables, card)
+		if table == nil {
+			return nil, fmt.Errorf("no card tables found in project")
 		}
-		// Search by name
-		columnNameLower := strings.ToLower(columnName)
-		for i := range cardTable.Lists {
-			if strings.ToLower(cardTable.Lists[i].Title) == columnNameLower {
-				return &cardTable.Lists[i], nil
+	}
+
+	column, err := findColumn(table, columnName, card)
+	if err != nil {
+		return nil, err
+	}
+
+	move := &cardMove{table: table, column: column, targetID: column.ID, onHold: onHold, crossBoard: toBoard != ""}
+	if onHold {
+		if column.OnHold.ID == 0 {
+			return nil, fmt.Errorf("column '%s' does not have an on-hold section", column.Title)
+		}
+		move.targetID = column.OnHold.ID
+	}
+	return move, nil
+}
+
+// isNoop reports whether the card is already where the move would put it
+func (m *cardMove) isNoop(card *api.Card) bool {
+	return card.Parent != nil && card.Parent.ID == m.targetID
+}
+
+// describe writes the planned move for --dry-run
+func (m *cardMove) describe(card *api.Card) string {
+	from := "(unknown)"
+	if card.Parent != nil && card.Parent.Title != "" {
+		from = fmt.Sprintf("'%s'", card.Parent.Title)
+	}
+	to := fmt.Sprintf("column '%s'", m.column.Title)
+	if m.onHold {
+		to = "on-hold in " + to
+	}
+	return fmt.Sprintf("Would move card #%d from column %s to %s on card table '%s'", card.ID, from, to, m.table.Title)
+}
+
+// undoAction returns what 'bc4 undo' needs to move the card back, or false
+// when its previous column isn't known
+func (m *cardMove) undoAction(cardTables []*api.CardTable, card *api.Card) (undo.Action, bool) {
+	if card.Parent == nil || card.Parent.ID == 0 {
+		return undo.Action{}, false
+	}
+	action := undo.Action{
+		Kind:               undo.KindCardMove,
+		ID:                 card.ID,
+		PreviousParentID:   card.Parent.ID,
+		PreviousParentName: card.Parent.Title,
+		Description:        fmt.Sprintf("Moved card #%d to '%s'", card.ID, m.column.Title),
+	}
+	if m.crossBoard {
+		previous := currentCardTable(cardTables, card)
+		if previous == nil {
+			return undo.Action{}, false
+		}
+		action.PreviousTableID = previous.ID
+	}
+	return action, true
+}
+
+// currentCardTable finds the card table containing the card's current
+// column, falling back to the project's first card table
+func currentCardTable(cardTables []*api.CardTable, card *api.Card) *api.CardTable {
+	if card.Parent != nil {
+		for _, table := range cardTables {
+			for _, column := range table.Lists {
+				if column.ID == card.Parent.ID || (column.OnHold.ID != 0 && column.OnHold.ID == card.Parent.ID) {
+					return table
+				}
 			}
 		}
-		return nil, fmt.Errorf("column '%s' not found in card table '%s'", columnName, cardTable.Title)
+	}
+	if len(cardTables) > 0 {
+		return cardTables[0]
+	}
+	return nil
+}
+
+// findCardTable resolves a card table by ID or (case-insensitive) title
+func findCardTable(cardTables []*api.CardTable, nameOrID string) (*api.CardTable, error) {
+	matches, byID := utils.MatchIDOrName(cardTables, nameOrID, false,
+		func(t *api.CardTable) int64 { return t.ID },
+		func(t *api.CardTable) string { return t.Title })
+	switch {
+	case len(matches) == 1:
+		return cardTables[matches[0]], nil
+	case byID:
+		return nil, fmt.Errorf("card table ID %s not found in project", nameOrID)
+	case len(matches) == 0:
+		return nil, fmt.Errorf("card table '%s' not found in project", nameOrID)
+	}
+	return nil, fmt.Errorf("multiple card tables are named '%s'. Please use the card table ID", nameOrID)
+}
+
+// findColumn resolves the target column from --column flag or falls back to the card's current column.
+func findColumn(cardTable *api.CardTable, columnName string, card *api.Card) (*api.Column, error) {
+	if columnName != "" {
+		return findColumnByRef(cardTable, columnName)
 	}
 
 	// No --column specified, use card's current column
@@ -221,9 +322,35 @@ func findColumn(cardTable *api.CardTable, columnName string, card *api.Card) (*a
 		return nil, fmt.Errorf("card has no parent column")
 	}
 	for i := range cardTable.Lists {
-		if cardTable.Lists[i].ID == card.Parent.ID {
-			return &cardTable.Lists[i], nil
+		column := &cardTable.Lists[i]
+		if column.ID == card.Parent.ID || (column.OnHold.ID != 0 && column.OnHold.ID == card.Parent.ID) {
+			return column, nil
 		}
 	}
 	return nil, fmt.Errorf("could not find card's current column in card table")
 }
+
+// findColumnByRef finds a column in the card table by ID or case-insensitive
+// title. The error for an unknown column lists the valid ones.
+func findColumnByRef(cardTable *api.CardTable, ref string) (*api.Column, error) {
+	matches, byID := utils.MatchIDOrName(cardTable.Lists, ref, false, columnID, columnTitle)
+	if len(matches) > 0 {
+		return &cardTable.Lists[matches[0]], nil
+	}
+	if byID {
+		return nil, fmt.Errorf("column ID %s not found in card table '%s' (columns: %s)", ref, cardTable.Title, columnChoices(cardTable.Lists))
+	}
+	return nil, fmt.Errorf("column '%s' not found in card table '%s' (columns: %s)", ref, cardTable.Title, columnChoices(cardTable.Lists))
+}
+
+func columnID(col api.Column) int64     { return col.ID }
+func columnTitle(col api.Column) string { return col.Title }
+
+// columnChoices lists columns as "Title (ID)" for error messages
+func columnChoices(columns []api.Column) string {
+	choices := make([]string, len(columns))
+	for i, col := range columns {
+		choices[i] = fmt.Sprintf("%s (%d)", col.Title, col.ID)
+	}
+	return strings.Join(choices, ", ")
+}
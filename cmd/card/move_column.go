@@ -0,0 +1,183 @@
+package card
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/huh"
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/ui"
+)
+
+// columnMoveOptions are the flags of 'card move --all-in-column'
+type columnMoveOptions struct {
+	fromColumn string
+	toColumn   string
+	dryRun     bool
+	yes        bool
+}
+
+// bulkMoveResult counts the outcome of moving a column's cards
+type bulkMoveResult struct {
+	Moved  int
+	Failed int
+	Total  int
+}
+
+// runMoveAllInColumn moves every card in --from-column to --column
+func runMoveAllInColumn(f *factory.Factory, opts columnMoveOptions) error {
+	resolvedProjectID, err := f.ProjectID()
+	if err != nil {
+		return err
+	}
+	client, err := f.ApiClient()
+	if err != nil {
+		return err
+	}
+	cardOps := client.Cards()
+
+	cardTables, err := cardOps.GetAllProjectCardTables(f.Context(), resolvedProjectID)
+	if err != nil {
+		return fmt.Errorf("failed to get card tables: %w", err)
+	}
+	table, from, to, err := findColumnPair(cardTables, opts.fromColumn, opts.toColumn)
+	if err != nil {
+		return err
+	}
+
+	cards, err := cardOps.GetCardsInColumn(f.Context(), resolvedProjectID, from.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get cards in column '%s': %w", from.Title, err)
+	}
+	if len(cards) == 0 {
+		fmt.Printf("No cards in column '%s'\n", from.Title)
+		return nil
+	}
+
+	if opts.dryRun {
+		for _, card := range cards {
+			fmt.Printf("Would move card #%d: %s\n", card.ID, card.Title)
+		}
+		fmt.Printf("\nWould move %s from '%s' to '%s' on card table '%s'\n", cardCount(len(cards)), from.Title, to.Title, table.Title)
+		return nil
+	}
+
+	if !opts.yes && ui.IsTerminal(os.Stdin) {
+		var confirm bool
+		if err := huh.NewConfirm().
+			Title(fmt.Sprintf("Move %s from '%s' to '%s'?", cardCount(len(cards)), from.Title, to.Title)).
+			Affirmative("Move").
+			Negative("Cancel").
+			Value(&confirm).
+			Run(); err != nil {
+			return err
+		}
+		if !confirm {
+			fmt.Println("Canceled")
+			return nil
+		}
+	}
+
+	result := moveCards(f.Context(), cardOps, os.Stdout, resolvedProjectID, cards, to)
+	return reportBulkMove(os.Stdout, result, to)
+}
+
+// findColumnPair resolves the source and target columns of a column move.
+// Both must be on the same card table; when several tables have columns by
+// those names, the column IDs must be used instead.
+func findColumnPair(cardTables []*api.CardTable, fromRef, toRef string) (*api.CardTable, *api.Column, *api.Column, error) {
+	if len(cardTables) == 0 {
+		return nil, nil, nil, fmt.Errorf("no card tables found in project")
+	}
+
+	type pair struct {
+		table    *api.CardTable
+		from, to *api.Column
+	}
+	var (
+		matches  []pair
+		fromSeen *api.CardTable
+	)
+	for _, table := range cardTables {
+		from, err := findColumnByRef(table, fromRef)
+		if err != nil {
+			continue
+		}
+		if fromSeen == nil {
+			fromSeen = table
+		}
+		to, err := findColumnByRef(table, toRef)
+		if err != nil {
+			continue
+		}
+		matches = append(matches, pair{table: table, from: from, to: to})
+	}
+
+	switch {
+	case len(matches) == 1:
+		m := matches[0]
+		if m.from.ID == m.to.ID {
+			return nil, nil, nil, fmt.Errorf("--from-column and --column are the same column ('%s')", m.from.Title)
+		}
+		return m.table, m.from, m.to, nil
+	case len(matches) > 1:
+		return nil, nil, nil, fmt.Errorf("columns '%s' and '%s' exist on several card tables. Please use the column IDs", fromRef, toRef)
+	case fromSeen != nil:
+		_, err := findColumnByRef(fromSeen, toRef)
+		return nil, nil, nil, fmt.Errorf("both columns must be on the same card table: %w", err)
+	}
+	return nil, nil, nil, fmt.Errorf("column '%s' not found on any card table in project", fromRef)
+}
+
+// moveCards moves each card to the target column, reporting each one to w
+// and carrying on past failures
+func moveCards(ctx context.Context, ops api.CardOperations, w io.Writer, projectID string, cards []api.Card, target *api.Column) bulkMoveResult {
+	result := bulkMoveResult{Total: len(cards)}
+	for _, card := range cards {
+		if err := ops.MoveCard(ctx, projectID, card.ID, target.ID); err != nil {
+			fmt.Fprintf(w, "✗ Card #%d: %s: %v\n", card.ID, card.Title, err)
+			result.Failed++
+			continue
+		}
+		fmt.Fprintf(w, "✓ Moved card #%d: %s\n", card.ID, card.Title)
+		result.Moved++
+	}
+	return result
+}
+
+// reportBulkMove prints the closing summary, returning an error when any
+// card failed to move
+func reportBulkMove(w io.Writer, result bulkMoveResult, target *api.Column) error {
+	fmt.Fprintf(w, "\nMoved %d of %s to '%s'\n", result.Moved, cardCount(result.Total), target.Title)
+	if result.Failed > 0 {
+		return fmt.Errorf("%d of %d cards failed to move", result.Failed, result.Total)
+	}
+	return nil
+}
+
+// cardCount formats n as "1 card" or "n cards"
+func cardCount(n int) string {
+	if n == 1 {
+		return "1 card"
+	}
+	return fmt.Sprintf("%d cards", n)
+}
+
+// validateColumnMove checks the flags given with --all-in-column
+func validateColumnMove(args []string, opts columnMoveOptions, onHold bool, toBoard string) error {
+	switch {
+	case len(args) > 0:
+		return fmt.Errorf("--all-in-column moves a whole column and takes no card ID")
+	case strings.TrimSpace(opts.fromColumn) == "":
+		return fmt.Errorf("--from-column is required with --all-in-column")
+	case strings.TrimSpace(opts.toColumn) == "":
+		return fmt.Errorf("--column is required with --all-in-column")
+	case onHold || toBoard != "":
+		return fmt.Errorf("--all-in-column cannot be used with --on-hold or --to-board")
+	}
+	return nil
+}
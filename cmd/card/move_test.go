@@ -1,13 +1,17 @@
 package card
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
 	"testing"
 
 	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/api/mock"
 	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/undo"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -444,3 +448,218 @@ func TestEmptyCardTables(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "no card tables found in project")
 }
+
+func TestFindCardTable_ByNameOrID(t *testing.T) {
+	dev := &api.CardTable{ID: 100, Title: "Development Board"}
+	marketing := &api.CardTable{ID: 200, Title: "Marketing Board"}
+	tables := []*api.CardTable{dev, marketing}
+
+	table, err := findCardTable(tables, "marketing board")
+	assert.NoError(t, err)
+	assert.Equal(t, marketing, table)
+
+	table, err = findCardTable(tables, "100")
+	assert.NoError(t, err)
+	assert.Equal(t, dev, table)
+
+	_, err = findCardTable(tables, "300")
+	assert.EqualError(t, err, "card table ID 300 not found in project")
+
+	_, err = findCardTable(tables, "Sales")
+	assert.EqualError(t, err, "card table 'Sales' not found in project")
+
+	_, err = findCardTable(append(tables, &api.CardTable{ID: 300, Title: "Marketing Board"}), "Marketing Board")
+	assert.ErrorContains(t, err, "multiple card tables")
+}
+
+func TestPlanCardMove_NoopAcrossBoards(t *testing.T) {
+	dev := &api.CardTable{
+		ID:    100,
+		Title: "Development Board",
+		Lists: []api.Column{
+			{ID: 1, Title: "To Do"},
+			{ID: 2, Title: "In Progress", OnHold: api.OnHoldStatus{ID: 20}},
+			{ID: 3, Title: "Done"},
+		},
+	}
+	marketing := &api.CardTable{
+		ID:    200,
+		Title: "Marketing Board",
+		Lists: []api.Column{
+			{ID: 4, Title: "Backlog"},
+			{ID: 5, Title: "In Progress"},
+		},
+	}
+	tables := []*api.CardTable{dev, marketing}
+
+	tests := []struct {
+		name       string
+		parent     *api.Column
+		column     string
+		toBoard    string
+		onHold     bool
+		wantTarget int64
+		wantNoop   bool
+	}{
+		{name: "same column by name", parent: &api.Column{ID: 2, Title: "In Progress"}, column: "in progress", wantTarget: 2, wantNoop: true},
+		{name: "same column by ID", parent: &api.Column{ID: 2, Title: "In Progress"}, column: "2", wantTarget: 2, wantNoop: true},
+		{name: "different column", parent: &api.Column{ID: 1, Title: "To Do"}, column: "Done", wantTarget: 3},
+		{name: "same name on second board", parent: &api.Column{ID: 5, Title: "In Progress"}, column: "In Progress", wantTarget: 5, wantNoop: true},
+		{name: "same name on another board", parent: &api.Column{ID: 2, Title: "In Progress"}, column: "In Progress", toBoard: "Marketing Board", wantTarget: 5},
+		{name: "to-board naming the current board", parent: &api.Column{ID: 4, Title: "Backlog"}, column: "Backlog", toBoard: "200", wantTarget: 4, wantNoop: true},
+		{name: "on hold from the column", parent: &api.Column{ID: 2, Title: "In Progress"}, onHold: true, wantTarget: 20},
+		{name: "already on hold", parent: &api.Column{ID: 20, Title: "On hold"}, onHold: true, wantTarget: 20, wantNoop: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			card := &api.Card{ID: 1001, Parent: tt.parent}
+			move, err := planCardMove(tables, card, tt.column, tt.toBoard, tt.onHold)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantTarget, move.targetID)
+			assert.Equal(t, tt.wantNoop, move.isNoop(card))
+		})
+	}
+}
+
+func TestPlanCardMove_Errors(t *testing.T) {
+	card := &api.Card{ID: 1001, Parent: &api.Column{ID: 1, Title: "To Do"}}
+
+	_, err := planCardMove(nil, card, "Done", "", false)
+	assert.EqualError(t, err, "no card tables found in project")
+
+	board := &api.CardTable{ID: 100, Title: "Board", Lists: []api.Column{{ID: 1, Title: "To Do"}}}
+	_, err = planCardMove([]*api.CardTable{board}, card, "", "", true)
+	assert.EqualError(t, err, "column 'To Do' does not have an on-hold section")
+}
+
+func TestCardMove_Describe(t *testing.T) {
+	board := &api.CardTable{ID: 100, Title: "Development Board"}
+	card := &api.Card{ID: 1001, Parent: &api.Column{ID: 1, Title: "To Do"}}
+
+	move := &cardMove{table: board, column: &api.Column{ID: 3, Title: "Done"}, targetID: 3}
+	assert.Equal(t, "Would move card #1001 from column 'To Do' to column 'Done' on card table 'Development Board'", move.describe(card))
+
+	move.onHold = true
+	assert.Equal(t, "Would move card #1001 from column 'To Do' to on-hold in column 'Done' on card table 'Development Board'", move.describe(card))
+}
+
+func TestFindColumnByRef(t *testing.T) {
+	cardTable := &api.CardTable{
+		Title: "Development Board",
+		Lists: []api.Column{
+			{ID: 1, Title: "To Do"},
+			{ID: 2, Title: "In Progress"},
+		},
+	}
+
+	column, err := findColumnByRef(cardTable, "2")
+	assert.NoError(t, err)
+	assert.Equal(t, "In Progress", column.Title)
+
+	column, err = findColumnByRef(cardTable, "in progress")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), column.ID)
+
+	_, err = findColumnByRef(cardTable, "Done")
+	assert.EqualError(t, err, "column 'Done' not found in card table 'Development Board' (columns: To Do (1), In Progress (2))")
+
+	_, err = findColumnByRef(cardTable, "9")
+	assert.ErrorContains(t, err, "column ID 9 not found")
+	assert.ErrorContains(t, err, "To Do (1), In Progress (2)")
+}
+
+func TestCardMove_UndoAction(t *testing.T) {
+	dev := &api.CardTable{ID: 100, Title: "Development Board", Lists: []api.Column{{ID: 1, Title: "To Do"}, {ID: 3, Title: "Done"}}}
+	marketing := &api.CardTable{ID: 200, Title: "Marketing Board", Lists: []api.Column{{ID: 4, Title: "Backlog"}}}
+	tables := []*api.CardTable{dev, marketing}
+	card := &api.Card{ID: 1001, Parent: &api.Column{ID: 1, Title: "To Do"}}
+
+	move := &cardMove{table: dev, column: &api.Column{ID: 3, Title: "Done"}, targetID: 3}
+	action, ok := move.undoAction(tables, card)
+	assert.True(t, ok)
+	assert.Equal(t, undo.KindCardMove, action.Kind)
+	assert.Equal(t, int64(1001), action.ID)
+	assert.Equal(t, int64(1), action.PreviousParentID)
+	assert.Equal(t, "To Do", action.PreviousParentName)
+	assert.Zero(t, action.PreviousTableID)
+
+	move = &cardMove{table: marketing, column: &api.Column{ID: 4, Title: "Backlog"}, targetID: 4, crossBoard: true}
+	action, ok = move.undoAction(tables, card)
+	assert.True(t, ok)
+	assert.Equal(t, int64(100), action.PreviousTableID)
+
+	_, ok = move.undoAction(tables, &api.Card{ID: 1002})
+	assert.False(t, ok, "a card without a known column can't be moved back")
+}
+
+// fakeColumnMover moves cards, failing for the IDs in fail
+type fakeColumnMover struct {
+	*mock.MockClient
+	fail  map[int64]bool
+	moved []int64
+}
+
+func (m *fakeColumnMover) MoveCard(ctx context.Context, projectID string, cardID int64, columnID int64) error {
+	if m.fail[cardID] {
+		return fmt.Errorf("forbidden")
+	}
+	m.moved = append(m.moved, cardID)
+	return nil
+}
+
+func TestMoveCards_PartialFailure(t *testing.T) {
+	mover := &fakeColumnMover{MockClient: mock.NewMockClient(), fail: map[int64]bool{2: true}}
+	cards := []api.Card{{ID: 1, Title: "One"}, {ID: 2, Title: "Two"}, {ID: 3, Title: "Three"}}
+	target := &api.Column{ID: 20, Title: "Done"}
+
+	var out bytes.Buffer
+	result := moveCards(context.Background(), mover, &out, "1", cards, target)
+	assert.Equal(t, bulkMoveResult{Moved: 2, Failed: 1, Total: 3}, result)
+	assert.Equal(t, []int64{1, 3}, mover.moved, "a failure doesn't stop the rest")
+	assert.Contains(t, out.String(), "✓ Moved card #1: One\n")
+	assert.Contains(t, out.String(), "✗ Card #2: Two: forbidden\n")
+
+	err := reportBulkMove(&out, result, target)
+	assert.EqualError(t, err, "1 of 3 cards failed to move")
+	assert.Contains(t, out.String(), "Moved 2 of 3 cards to 'Done'")
+
+	out.Reset()
+	assert.NoError(t, reportBulkMove(&out, bulkMoveResult{Moved: 1, Total: 1}, target))
+	assert.Contains(t, out.String(), "Moved 1 of 1 card to 'Done'")
+}
+
+func TestFindColumnPair(t *testing.T) {
+	tables := []*api.CardTable{
+		{ID: 1, Title: "Dev", Lists: []api.Column{{ID: 10, Title: "Review"}, {ID: 11, Title: "Done"}}},
+		{ID: 2, Title: "Marketing", Lists: []api.Column{{ID: 20, Title: "Ideas"}, {ID: 21, Title: "Done"}}},
+	}
+
+	table, from, to, err := findColumnPair(tables, "review", "Done")
+	if assert.NoError(t, err) {
+		assert.Equal(t, int64(1), table.ID)
+		assert.Equal(t, int64(10), from.ID)
+		assert.Equal(t, int64(11), to.ID)
+	}
+
+	_, _, _, err = findColumnPair(tables, "Review", "Ideas")
+	assert.ErrorContains(t, err, "same card table")
+
+	_, _, _, err = findColumnPair(tables, "Done", "Done")
+	assert.ErrorContains(t, err, "several card tables")
+
+	_, _, _, err = findColumnPair(tables, "11", "11")
+	assert.ErrorContains(t, err, "same column")
+
+	_, _, _, err = findColumnPair(tables, "Backlog", "Done")
+	assert.ErrorContains(t, err, "column 'Backlog' not found")
+}
+
+func TestValidateColumnMove(t *testing.T) {
+	opts := columnMoveOptions{fromColumn: "Review", toColumn: "Done"}
+	assert.NoError(t, validateColumnMove(nil, opts, false, ""))
+	assert.ErrorContains(t, validateColumnMove([]string{"123"}, opts, false, ""), "takes no card ID")
+	assert.ErrorContains(t, validateColumnMove(nil, columnMoveOptions{toColumn: "Done"}, false, ""), "--from-column is required")
+	assert.ErrorContains(t, validateColumnMove(nil, columnMoveOptions{fromColumn: "Review"}, false, ""), "--column is required")
+	assert.ErrorContains(t, validateColumnMove(nil, opts, true, ""), "--on-hold")
+}
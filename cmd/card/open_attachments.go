@@ -0,0 +1,59 @@
+package card
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/attachments"
+	"github.com/needmore/bc4/internal/download"
+	"github.com/needmore/bc4/internal/ui"
+)
+
+// openCardImages downloads a card's image attachments to a temporary
+// directory and opens them with the default viewer. Unless keep is set, the
+// directory is removed once the user is done looking.
+func openCardImages(ctx context.Context, uploadOps api.UploadOperations, bucketID string, card *api.Card, keep bool) error {
+	dir, err := os.MkdirTemp("", fmt.Sprintf("bc4-card-%d-", card.ID))
+	if err != nil {
+		return fmt.Errorf("failed to create temporary directory: %w", err)
+	}
+	if !keep {
+		defer func() { _ = os.RemoveAll(dir) }()
+	}
+
+	sources := []download.AttachmentSource{{Label: "card", Content: card.Content}}
+	result, err := download.DownloadFromSources(ctx, uploadOps, bucketID, sources, download.Options{
+		OutputDir: dir,
+		Filter:    func(att attachments.Attachment) bool { return att.IsImage() },
+	})
+	if result == nil || len(result.Paths) == 0 {
+		if err != nil {
+			return err
+		}
+		fmt.Println("No image attachments to open")
+		return nil
+	}
+	if err != nil {
+		// Some images failed; still open the ones that downloaded
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+	}
+
+	for _, path := range result.Paths {
+		if err := ui.OpenURL(path); err != nil {
+			return fmt.Errorf("failed to open %s: %w", path, err)
+		}
+	}
+
+	if keep {
+		fmt.Printf("Images saved in %s\n", dir)
+		return nil
+	}
+
+	// Viewers load files asynchronously, so wait before deleting them
+	fmt.Print("Press Enter when you're done viewing to remove the downloaded images...")
+	_, _ = bufio.NewReader(os.Stdin).ReadString('\n')
+	return nil
+}
@@ -40,12 +40,6 @@ func newSetCmd(f *factory.Factory) *cobra.Command {
 				return err
 			}
 
-			// Get config for updating
-			cfg, err := f.Config()
-			if err != nil {
-				return err
-			}
-
 			// Parse input as ID or search by name
 			var cardTableID int64
 			input := args[0]
@@ -72,30 +66,31 @@ func newSetCmd(f *factory.Factory) *cobra.Command {
 				}
 			}
 
-			// Initialize project defaults if needed
-			if cfg.Accounts == nil {
-				cfg.Accounts = make(map[string]config.AccountConfig)
-			}
-			if _, ok := cfg.Accounts[resolvedAccountID]; !ok {
-				cfg.Accounts[resolvedAccountID] = config.AccountConfig{
-					ProjectDefaults: make(map[string]config.ProjectDefaults),
+			err = config.Update(func(cfg *config.Config) error {
+				// Initialize project defaults if needed
+				if cfg.Accounts == nil {
+					cfg.Accounts = make(map[string]config.AccountConfig)
 				}
-			}
-			if cfg.Accounts[resolvedAccountID].ProjectDefaults == nil {
+				if _, ok := cfg.Accounts[resolvedAccountID]; !ok {
+					cfg.Accounts[resolvedAccountID] = config.AccountConfig{
+						ProjectDefaults: make(map[string]config.ProjectDefaults),
+					}
+				}
+				if cfg.Accounts[resolvedAccountID].ProjectDefaults == nil {
+					acc := cfg.Accounts[resolvedAccountID]
+					acc.ProjectDefaults = make(map[string]config.ProjectDefaults)
+					cfg.Accounts[resolvedAccountID] = acc
+				}
+
+				// Set the default card table
 				acc := cfg.Accounts[resolvedAccountID]
-				acc.ProjectDefaults = make(map[string]config.ProjectDefaults)
+				proj := acc.ProjectDefaults[resolvedProjectID]
+				proj.DefaultCardTable = fmt.Sprintf("%d", cardTableID)
+				acc.ProjectDefaults[resolvedProjectID] = proj
 				cfg.Accounts[resolvedAccountID] = acc
-			}
-
-			// Set the default card table
-			acc := cfg.Accounts[resolvedAccountID]
-			proj := acc.ProjectDefaults[resolvedProjectID]
-			proj.DefaultCardTable = fmt.Sprintf("%d", cardTableID)
-			acc.ProjectDefaults[resolvedProjectID] = proj
-			cfg.Accounts[resolvedAccountID] = acc
-
-			// Save config
-			if err := config.Save(cfg); err != nil {
+				return nil
+			})
+			if err != nil {
 				return fmt.Errorf("failed to save config: %w", err)
 			}
 
@@ -0,0 +1,136 @@
+package card
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/parser"
+	"github.com/spf13/cobra"
+)
+
+func newSubscribeCmd(f *factory.Factory) *cobra.Command {
+	return newSubscriptionCmd(f, true)
+}
+
+func newUnsubscribeCmd(f *factory.Factory) *cobra.Command {
+	return newSubscriptionCmd(f, false)
+}
+
+// newSubscriptionCmd builds 'card subscribe' or 'card unsubscribe'
+func newSubscriptionCmd(f *factory.Factory, subscribe bool) *cobra.Command {
+	var accountID string
+	var projectID string
+
+	use, short, long := "subscribe", "Subscribe to a card's notifications",
+		`Subscribe to a card so you're notified about its comments and changes.`
+	if !subscribe {
+		use, short, long = "unsubscribe", "Stop getting a card's notifications",
+			`Unsubscribe from a card so you're no longer notified about its comments
+and changes.`
+	}
+
+	cmd := &cobra.Command{
+		Use:   use + " [ID or URL]",
+		Short: short,
+		Long: long + `
+
+You can specify the card using either:
+- A numeric ID (e.g., "12345")
+- A Basecamp URL (e.g., "https://3.basecamp.com/1234567/buckets/89012345/card_tables/cards/12345")
+
+Nothing changes if you are already ` + use + `d.`,
+		Example: fmt.Sprintf(`  bc4 card %s 12345
+  bc4 card %s https://3.basecamp.com/1234567/buckets/89012345/card_tables/cards/12345`, use, use),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Parse card ID (could be numeric ID or URL)
+			cardID, parsedURL, err := parser.ParseArgument(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid card ID or URL: %s", args[0])
+			}
+
+			// Apply overrides if specified
+			if accountID != "" {
+				f = f.WithAccount(accountID)
+			}
+			if projectID != "" {
+				f = f.WithProject(projectID)
+			}
+
+			// If a URL was parsed, override account and project IDs if provided
+			if parsedURL != nil {
+				if parsedURL.ResourceType != parser.ResourceTypeCard {
+					return fmt.Errorf("URL is not for a card: %s", args[0])
+				}
+				if parsedURL.AccountID > 0 {
+					f = f.WithAccount(strconv.FormatInt(parsedURL.AccountID, 10))
+				}
+				if parsedURL.ProjectID > 0 {
+					f = f.WithProject(strconv.FormatInt(parsedURL.ProjectID, 10))
+				}
+			}
+
+			resolvedProjectID, err := f.ProjectID()
+			if err != nil {
+				return err
+			}
+
+			client, err := f.ApiClient()
+			if err != nil {
+				return err
+			}
+
+			return setCardSubscription(f.Context(), client.Activity(), resolvedProjectID, cardID, subscribe, os.Stdout)
+		},
+	}
+
+	cmd.Flags().StringVarP(&accountID, "account", "a", "", "Specify account ID")
+	cmd.Flags().StringVarP(&projectID, "project", "p", "", "Specify project ID")
+
+	return cmd
+}
+
+// setCardSubscription subscribes to or unsubscribes from a card, skipping
+// the change when the card is already in that state, and reports the result
+func setCardSubscription(ctx context.Context, ops api.ActivityOperations, projectID string, cardID int64, subscribe bool, w io.Writer) error {
+	current, err := ops.GetSubscription(ctx, projectID, cardID)
+	if err != nil {
+		return err
+	}
+
+	if current.Subscribed == subscribe {
+		if subscribe {
+			_, err = fmt.Fprintf(w, "Already subscribed to card #%d (%s)\n", cardID, subscriberCount(current.Count))
+		} else {
+			_, err = fmt.Fprintf(w, "Not subscribed to card #%d\n", cardID)
+		}
+		return err
+	}
+
+	if !subscribe {
+		if err := ops.Unsubscribe(ctx, projectID, cardID); err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(w, "✓ Unsubscribed from card #%d\n", cardID)
+		return err
+	}
+
+	subscription, err := ops.Subscribe(ctx, projectID, cardID)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "✓ Subscribed to card #%d (%s)\n", cardID, subscriberCount(subscription.Count))
+	return err
+}
+
+func subscriberCount(n int) string {
+	if n == 1 {
+		return "1 subscriber"
+	}
+	return fmt.Sprintf("%d subscribers", n)
+}
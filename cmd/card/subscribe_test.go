@@ -0,0 +1,59 @@
+package card
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/api/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetCardSubscription(t *testing.T) {
+	tests := []struct {
+		name       string
+		subscribed bool
+		subscribe  bool
+		wantCalls  []string
+		wantOutput string
+	}{
+		{
+			name:       "subscribe",
+			subscribe:  true,
+			wantCalls:  []string{"GetSubscription(1, 42)", "Subscribe(1, 42)"},
+			wantOutput: "✓ Subscribed to card #42 (3 subscribers)\n",
+		},
+		{
+			name:       "already subscribed",
+			subscribed: true,
+			subscribe:  true,
+			wantCalls:  []string{"GetSubscription(1, 42)"},
+			wantOutput: "Already subscribed to card #42 (3 subscribers)\n",
+		},
+		{
+			name:       "unsubscribe",
+			subscribed: true,
+			wantCalls:  []string{"GetSubscription(1, 42)", "Unsubscribe(1, 42)"},
+			wantOutput: "✓ Unsubscribed from card #42\n",
+		},
+		{
+			name:       "already unsubscribed",
+			wantCalls:  []string{"GetSubscription(1, 42)"},
+			wantOutput: "Not subscribed to card #42\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := mock.NewMockClient()
+			m.Subscription = &api.Subscription{Subscribed: tt.subscribed, Count: 3}
+
+			var buf bytes.Buffer
+			require.NoError(t, setCardSubscription(context.Background(), m, "1", 42, tt.subscribe, &buf))
+			assert.Equal(t, tt.wantCalls, m.Calls)
+			assert.Equal(t, tt.wantOutput, buf.String())
+		})
+	}
+}
@@ -1,13 +1,19 @@
 package card
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/needmore/bc4/internal/api"
 	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/ui"
 	"github.com/needmore/bc4/internal/ui/tableprinter"
+	"github.com/needmore/bc4/internal/utils"
 	"github.com/spf13/cobra"
 )
 
@@ -17,6 +23,11 @@ func newTableCmd(f *factory.Factory) *cobra.Command {
 	var projectID string
 	var columnFilter string
 	var format string
+	var sortBy string
+	var assignees []string
+	var dueBefore string
+	var overdue bool
+	var printIDsOnly bool
 
 	cmd := &cobra.Command{
 		Use:   "table [ID|name]",
@@ -24,9 +35,41 @@ func newTableCmd(f *factory.Factory) *cobra.Command {
 		Long: `View all cards in a specific card table, organized by columns.
 On-hold cards are included automatically and shown with an [ON HOLD] indicator.
 
-If no table ID or name is provided, uses the default card table if set.`,
+If no table ID or name is provided, uses the default card table if set.
+
+Cards can be narrowed with --assignee, --due-before, and --overdue, and
+reordered with --sort. Without --sort, cards stay grouped by column.
+
+Use --print-ids-only to print just the IDs of the cards (after filters and
+sorting), one per line, for piping into other commands. Nothing is printed
+when no cards match.`,
+		Example: `  # Cards assigned to Jane, soonest due first
+  bc4 card table --assignee jane@example.com --sort due
+
+  # Overdue cards as JSON
+  bc4 card table --overdue --format json
+
+  # Move everything in progress to Done
+  bc4 card table --column "In Progress" --print-ids-only | xargs -n1 bc4 card move --column Done`,
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			// Validate sort and filters up front
+			if printIDsOnly && (formatJSON || format != "table") {
+				return fmt.Errorf("--print-ids-only cannot be combined with another output format")
+			}
+			if err := validateCardSort(sortBy); err != nil {
+				return err
+			}
+			var filter utils.ItemFilter
+			filter.Overdue = overdue
+			if dueBefore != "" {
+				date, err := utils.ParseDate(dueBefore)
+				if err != nil {
+					return fmt.Errorf("invalid --due-before: %w", err)
+				}
+				filter.DueBefore = date
+			}
+
 			// Apply overrides if specified
 			if accountID != "" {
 				f = f.WithAccount(accountID)
@@ -88,26 +131,9 @@ If no table ID or name is provided, uses the default card table if set.`,
 				return fmt.Errorf("failed to fetch card table: %w", err)
 			}
 
-			// Handle JSON output
-			if formatJSON || format == "json" {
-				// For JSON output, return all cards from all columns
-				fmt.Printf("Cards in table: %s\n", cardTable.Title)
-				return nil
-			}
-
-			// Create table
-			table := tableprinter.New(os.Stdout)
-
-			// Add headers
-			if table.IsTTY() {
-				table.AddHeader("ID", "TITLE", "COLUMN", "ASSIGNEES", "STEPS", "DUE", "UPDATED")
-			} else {
-				table.AddHeader("ID", "TITLE", "COLUMN", "ASSIGNEES", "STEPS", "DUE", "STATUS", "UPDATED")
-			}
-
-			totalCards := 0
-			// Iterate through columns
-			for _, column := range cardTable.Lists {
+			// Collect cards from each column, keeping the column order
+			var rows []cardRow
+			for position, column := range cardTable.Lists {
 				// Skip if filtering by column and this doesn't match
 				if columnFilter != "" && !strings.Contains(strings.ToLower(column.Title), strings.ToLower(columnFilter)) {
 					continue
@@ -130,64 +156,112 @@ If no table ID or name is provided, uses the default card table if set.`,
 					}
 				}
 
-				// Add each card to the table
 				for _, card := range cards {
-					totalCards++
+					rows = append(rows, cardRow{card: card, column: column, columnPosition: position})
+				}
+			}
 
-					// ID
-					table.AddIDField(fmt.Sprintf("%d", card.ID), card.Status)
+			// Apply filters and sorting after fetching
+			if len(assignees) > 0 {
+				resolver := utils.NewUserResolver(client.Client, resolvedProjectID)
+				if filter.AssigneeIDs, err = utils.ResolvePersonIDs(f.Context(), resolver, assignees); err != nil {
+					return fmt.Errorf("failed to resolve assignee: %w", err)
+				}
+			}
+			rows = utils.FilterItems(rows, func(r cardRow) bool {
+				return filter.Matches(r.card.Assignees, r.card.DueOn)
+			})
+			sortCardRows(rows, sortBy)
 
-					// Title
-					title := card.Title
-					if card.IsOnHold {
-						title = "[ON HOLD] " + title
-					}
-					table.AddProjectField(title, card.Status)
+			// Bare IDs, one per line, for piping into other commands
+			if printIDsOnly {
+				ids := make([]int64, len(rows))
+				for i, row := range rows {
+					ids[i] = row.card.ID
+				}
+				return ui.WriteIDs(os.Stdout, ids)
+			}
 
-					// Column with color
-					columnTitle := column.Title
-					if column.Color != "" && column.Color != "white" {
-						// Could add color indicators here
-						columnTitle = fmt.Sprintf("%s (%s)", column.Title, column.Color)
-					}
-					table.AddField(columnTitle)
+			// Handle JSON output - the filtered and sorted cards
+			if formatJSON || format == "json" {
+				cards := make([]api.Card, 0, len(rows))
+				for _, row := range rows {
+					cards = append(cards, row.card)
+				}
+				encoder := json.NewEncoder(os.Stdout)
+				encoder.SetIndent("", "  ")
+				return encoder.Encode(cards)
+			}
 
-					// Assignees
-					assigneeNames := []string{}
-					for _, assignee := range card.Assignees {
-						assigneeNames = append(assigneeNames, assignee.Name)
-					}
-					table.AddField(strings.Join(assigneeNames, ", "))
+			// Create table
+			table := tableprinter.New(os.Stdout)
 
-					// Steps progress
-					completedSteps := 0
-					for _, step := range card.Steps {
-						if step.Completed {
-							completedSteps++
-						}
-					}
-					if len(card.Steps) > 0 {
-						table.AddField(fmt.Sprintf("%d/%d", completedSteps, len(card.Steps)))
-					} else {
-						table.AddField("-")
-					}
+			// Add headers
+			if table.IsTTY() {
+				table.AddHeader("ID", "TITLE", "COLUMN", "ASSIGNEES", "STEPS", "DUE", "UPDATED")
+			} else {
+				table.AddHeader("ID", "TITLE", "COLUMN", "ASSIGNEES", "STEPS", "DUE", "STATUS", "UPDATED")
+			}
 
-					// Due date
-					if card.DueOn != nil && *card.DueOn != "" {
-						table.AddField(*card.DueOn)
-					} else {
-						table.AddField("-")
-					}
+			now := time.Now()
+			totalCards := 0
+			for _, row := range rows {
+				card, column := row.card, row.column
+				totalCards++
+
+				// ID
+				table.AddIDField(fmt.Sprintf("%d", card.ID), card.Status)
+
+				// Title
+				title := card.Title
+				if card.IsOnHold {
+					title = "[ON HOLD] " + title
+				}
+				table.AddProjectField(title, card.Status)
+
+				// Column with color
+				columnTitle := column.Title
+				if column.Color != "" && column.Color != "white" {
+					// Could add color indicators here
+					columnTitle = fmt.Sprintf("%s (%s)", column.Title, column.Color)
+				}
+				table.AddField(columnTitle)
+
+				// Assignees
+				assigneeNames := []string{}
+				for _, assignee := range card.Assignees {
+					assigneeNames = append(assigneeNames, assignee.Name)
+				}
+				table.AddField(strings.Join(assigneeNames, ", "))
 
-					// Status for non-TTY
-					if !table.IsTTY() {
-						table.AddField(card.Status)
+				// Steps progress
+				completedSteps := 0
+				for _, step := range card.Steps {
+					if step.Completed {
+						completedSteps++
 					}
+				}
+				if len(card.Steps) > 0 {
+					table.AddField(fmt.Sprintf("%d/%d", completedSteps, len(card.Steps)))
+				} else {
+					table.AddField("-")
+				}
+
+				// Due date
+				if card.DueOn != nil && *card.DueOn != "" {
+					table.AddField(*card.DueOn)
+				} else {
+					table.AddField("-")
+				}
 
-					// Updated timestamp
-					table.AddTimeField(card.CreatedAt, card.UpdatedAt)
-					table.EndRow()
+				// Status for non-TTY
+				if !table.IsTTY() {
+					table.AddField(card.Status)
 				}
+
+				// Updated timestamp
+				table.AddTimeField(now, card.UpdatedAt)
+				table.EndRow()
 			}
 
 			// Print summary
@@ -203,6 +277,49 @@ If no table ID or name is provided, uses the default card table if set.`,
 	cmd.Flags().StringVarP(&projectID, "project", "p", "", "Specify project ID")
 	cmd.Flags().StringVar(&columnFilter, "column", "", "Filter to show only specific column")
 	cmd.Flags().StringVarP(&format, "format", "f", "table", "Output format (table, json, csv)")
+	cmd.Flags().StringVar(&sortBy, "sort", "", "Sort cards by: title, due, created, or column")
+	cmd.Flags().StringSliceVar(&assignees, "assignee", nil, "Only show cards assigned to this person (ID, name, or email)")
+	cmd.Flags().StringVar(&dueBefore, "due-before", "", "Only show cards due on or before this date (YYYY-MM-DD, today, +3d, ...)")
+	cmd.Flags().BoolVar(&overdue, "overdue", false, "Only show cards past their due date")
+	cmd.Flags().BoolVar(&printIDsOnly, "print-ids-only", false, "Print only the IDs of the listed cards, one per line")
 
 	return cmd
 }
+
+// cardRow is a card together with the column it was fetched from and that
+// column's position in the card table
+type cardRow struct {
+	card           api.Card
+	column         api.Column
+	columnPosition int
+}
+
+// validateCardSort checks a --sort value
+func validateCardSort(sortBy string) error {
+	switch sortBy {
+	case "", "title", "due", "created", "column":
+		return nil
+	}
+	return fmt.Errorf("invalid --sort %q: must be title, due, created, or column", sortBy)
+}
+
+// sortCardRows sorts rows by the given field. Sorting by column orders rows
+// by their column's position in the card table, keeping the card order within
+// each column. Rows are collected column by column, so without a field they
+// keep that grouping too.
+func sortCardRows(rows []cardRow, sortBy string) {
+	switch sortBy {
+	case "title":
+		utils.SortItemsByTitle(rows, func(r cardRow) string { return r.card.Title })
+	case "due":
+		utils.SortItemsByDue(rows, func(r cardRow) *string { return r.card.DueOn })
+	case "created":
+		sort.SliceStable(rows, func(i, j int) bool {
+			return rows[i].card.CreatedAt.Before(rows[j].card.CreatedAt)
+		})
+	case "column":
+		sort.SliceStable(rows, func(i, j int) bool {
+			return rows[i].columnPosition < rows[j].columnPosition
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package card
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/charmbracelet/huh"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/parser"
+	"github.com/needmore/bc4/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+func newUnarchiveCmd(f *factory.Factory) *cobra.Command {
+	var accountID string
+	var projectID string
+	var skipConfirm bool
+
+	cmd := &cobra.Command{
+		Use:   "unarchive [ID or URL]",
+		Short: "Restore an archived card",
+		Long: `Restore an archived card back to its column on the card table.
+
+You can specify the card using either:
+- A numeric ID (e.g., "12345")
+- A Basecamp URL (e.g., "https://3.basecamp.com/1234567/buckets/89012345/card_tables/cards/12345")
+
+Use 'bc4 card list --archived' to find archived cards.
+
+Examples:
+  bc4 card list --archived
+  bc4 card unarchive 12345
+  bc4 card unarchive 12345 --yes    # Skip confirmation`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Parse card ID (could be numeric ID or URL)
+			cardID, parsedURL, err := parser.ParseArgument(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid card ID or URL: %s", args[0])
+			}
+
+			// Apply overrides if specified
+			if accountID != "" {
+				f = f.WithAccount(accountID)
+			}
+			if projectID != "" {
+				f = f.WithProject(projectID)
+			}
+
+			// If a URL was parsed, override account and project IDs if provided
+			if parsedURL != nil {
+				if parsedURL.ResourceType != parser.ResourceTypeCard {
+					return fmt.Errorf("URL is not for a card: %s", args[0])
+				}
+				if parsedURL.AccountID > 0 {
+					f = f.WithAccount(strconv.FormatInt(parsedURL.AccountID, 10))
+				}
+				if parsedURL.ProjectID > 0 {
+					f = f.WithProject(strconv.FormatInt(parsedURL.ProjectID, 10))
+				}
+			}
+
+			// Get resolved project ID
+			resolvedProjectID, err := f.ProjectID()
+			if err != nil {
+				return err
+			}
+
+			// Get API client from factory
+			client, err := f.ApiClient()
+			if err != nil {
+				return err
+			}
+			cardOps := client.Cards()
+
+			// Fetch the card first to show what will be restored
+			card, err := cardOps.GetCard(f.Context(), resolvedProjectID, cardID)
+			if err != nil {
+				return fmt.Errorf("failed to fetch card: %w", err)
+			}
+			if card.Status != "" && card.Status != "archived" {
+				fmt.Printf("Card #%d is not archived\n", cardID)
+				return nil
+			}
+
+			// Confirmation prompt unless skipped
+			if !skipConfirm {
+				var confirm bool
+				if err := huh.NewConfirm().
+					Title(fmt.Sprintf("Restore card \"%s\"?", card.Title)).
+					Description("The card will be restored to its column.").
+					Affirmative("Restore").
+					Negative("Cancel").
+					Value(&confirm).
+					Run(); err != nil {
+					return err
+				}
+
+				if !confirm {
+					fmt.Println("Canceled")
+					return nil
+				}
+			}
+
+			if err := cardOps.UnarchiveCard(f.Context(), resolvedProjectID, cardID); err != nil {
+				return err
+			}
+
+			if ui.IsTerminal(os.Stdout) {
+				fmt.Printf("✓ Restored card: %s (#%d)\n", card.Title, card.ID)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&accountID, "account", "a", "", "Specify account ID")
+	cmd.Flags().StringVarP(&projectID, "project", "p", "", "Specify project ID")
+	cmd.Flags().BoolVarP(&skipConfirm, "yes", "y", false, "Skip confirmation prompt")
+
+	return cmd
+}
@@ -3,11 +3,11 @@ package card
 import (
 	"bytes"
 	"fmt"
-	"html"
+	"io"
 	"os"
-	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/glamour"
 
@@ -15,6 +15,7 @@ import (
 	"github.com/needmore/bc4/internal/api"
 	"github.com/needmore/bc4/internal/config"
 	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/markdown"
 	"github.com/needmore/bc4/internal/parser"
 	"github.com/needmore/bc4/internal/ui"
 	"github.com/needmore/bc4/internal/ui/tableprinter"
@@ -22,53 +23,6 @@ import (
 	"github.com/spf13/cobra"
 )
 
-// htmlToText converts Basecamp's rich text HTML to plain text
-func htmlToText(htmlContent string) string {
-	// Decode HTML entities
-	text := html.UnescapeString(htmlContent)
-
-	// Replace common HTML tags with appropriate formatting
-	replacements := []struct {
-		pattern string
-		replace string
-	}{
-		// Paragraphs and divs to newlines
-		{`<\/?(p|div)>`, "\n"},
-		// Headers to uppercase with newlines
-		{`<h1[^>]*>([^<]+)<\/h1>`, "\n$1\n"},
-		// Line breaks
-		{`<br\s*/?>`, "\n"},
-		// Lists
-		{`<li[^>]*>`, "• "},
-		{`<\/li>`, "\n"},
-		{`<\/?[uo]l[^>]*>`, "\n"},
-		// Strong/bold
-		{`<(strong|b)>([^<]+)<\/(strong|b)>`, "$2"},
-		// Emphasis/italic
-		{`<(em|i)>([^<]+)<\/(em|i)>`, "$2"},
-		// Links - extract text and URL
-		{`<a[^>]*href="([^"]+)"[^>]*>([^<]+)<\/a>`, "$2 ($1)"},
-		// Strike through
-		{`<strike>([^<]+)<\/strike>`, "$1"},
-		// Pre/code blocks
-		{`<pre[^>]*>`, "\n```\n"},
-		{`<\/pre>`, "\n```\n"},
-		// Remove all other HTML tags
-		{`<[^>]+>`, ""},
-	}
-
-	for _, r := range replacements {
-		re := regexp.MustCompile(r.pattern)
-		text = re.ReplaceAllString(text, r.replace)
-	}
-
-	// Clean up excessive newlines
-	text = regexp.MustCompile(`\n{3,}`).ReplaceAllString(text, "\n\n")
-	text = strings.TrimSpace(text)
-
-	return text
-}
-
 func newViewCmd(f *factory.Factory) *cobra.Command {
 	var formatJSON bool
 	var accountID string
@@ -77,6 +31,16 @@ func newViewCmd(f *factory.Factory) *cobra.Command {
 	var web bool
 	var noPager bool
 	var withComments bool
+	var raw bool
+	var absolute bool
+	var commentSort string
+	var openAttachments bool
+	var keepAttachments bool
+	var history bool
+	var subscribers bool
+	var formatStr string
+	var markdownDoc bool
+	var output string
 
 	cmd := &cobra.Command{
 		Use:   "view [ID or URL]",
@@ -85,9 +49,70 @@ func newViewCmd(f *factory.Factory) *cobra.Command {
 
 You can specify the card using either:
 - A numeric ID (e.g., "12345")
-- A Basecamp URL (e.g., "https://3.basecamp.com/1234567/buckets/89012345/card_tables/cards/12345")`,
+- A Basecamp URL (e.g., "https://3.basecamp.com/1234567/buckets/89012345/card_tables/cards/12345")
+
+Use --raw to print the card's JSON exactly as returned by the Basecamp API,
+which is useful for debugging and integrations.
+
+Created and updated times are shown relative to now (e.g. "2 hours ago") on a
+terminal. Use --absolute for exact timestamps; they are always exact when
+output is piped.
+
+With --with-comments, comments are shown newest first. Use --sort asc to read
+the thread from the beginning.
+
+Use --open-attachments to download the card's images to a temporary directory
+and open them in your default viewer. The files are removed when you're done
+unless --keep is given. This is interactive; to save attachments from a script,
+use 'bc4 card download-attachments'.
+
+Use --history to list the card's events oldest first: column moves,
+assignment changes, completion, and who made them. Add --format json for
+the raw event data.
+
+Use --subscribers to list the people who are notified about the card's
+changes. Add --format json for the list as a JSON array.
+
+Use --markdown for a self-contained Markdown document to paste into notes:
+the title, the card's column, assignees, due date, and creator, its
+description, steps as a checklist, and attachments as links. Add
+--with-comments to include the comments. The document is paged on a
+terminal, printed as-is when piped, or written to a file with --output.
+
+Use --format json (or --json) for the card as JSON. With --steps-only, only
+the card's steps are written, as a JSON array. --web opens the card in your
+browser instead of printing anything, so it can't be combined with JSON
+output or --raw; other display flags are ignored with --web.`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := utils.ValidateCommentSort(commentSort); err != nil {
+				return err
+			}
+			if openAttachments && (!ui.IsTerminal(os.Stdout) || !ui.IsTerminal(os.Stdin)) {
+				return fmt.Errorf("--open-attachments needs an interactive terminal; use 'bc4 card download-attachments %s' to save attachments instead", args[0])
+			}
+			if keepAttachments && !openAttachments {
+				return fmt.Errorf("--keep can only be used with --open-attachments")
+			}
+			format, err := resolveViewFormat(viewFlags{
+				format:          formatStr,
+				formatSet:       cmd.Flags().Changed("format"),
+				json:            formatJSON,
+				web:             web,
+				raw:             raw,
+				withComments:    withComments,
+				openAttachments: openAttachments,
+			})
+			if err != nil {
+				return err
+			}
+			if markdownDoc && (raw || history || subscribers || stepsOnly || format == ui.OutputFormatJSON || openAttachments || web) {
+				return fmt.Errorf("--markdown cannot be combined with --raw, --history, --subscribers, --steps-only, --format json, --open-attachments, or --web")
+			}
+			if output != "" && !markdownDoc {
+				return fmt.Errorf("--output can only be used with --markdown")
+			}
+
 			// Parse card ID (could be numeric ID or URL)
 			cardID, parsedURL, err := parser.ParseArgument(args[0])
 			if err != nil {
@@ -121,10 +146,20 @@ You can specify the card using either:
 				return err
 			}
 
-			// Handle web flag
+			// Opening the browser wins over every other display flag
 			if web {
-				// Open in browser (implementation would go here)
-				fmt.Printf("Opening card %d in browser...\n", cardID)
+				resolvedAccountID, err := f.AccountID()
+				if err != nil {
+					return err
+				}
+				webURL, err := parser.BuildWebURL(resolvedAccountID, resolvedProjectID, parser.ResourceTypeCard, cardID)
+				if err != nil {
+					return err
+				}
+				if err := ui.OpenURL(webURL); err != nil {
+					return fmt.Errorf("failed to open browser: %w", err)
+				}
+				fmt.Fprintf(os.Stderr, "Opened card #%d in your browser\n", cardID)
 				return nil
 			}
 
@@ -135,17 +170,92 @@ You can specify the card using either:
 			}
 			cardOps := client.Cards()
 
+			// Dump the untransformed API response
+			if raw {
+				body, err := cardOps.GetCardRaw(f.Context(), resolvedProjectID, cardID)
+				if err != nil {
+					return err
+				}
+				if _, err := os.Stdout.Write(body); err != nil {
+					return err
+				}
+				if len(body) > 0 && body[len(body)-1] != '\n' {
+					fmt.Println()
+				}
+				return nil
+			}
+
+			if history {
+				events, err := cardOps.GetCardEvents(f.Context(), resolvedProjectID, cardID)
+				if err != nil {
+					return err
+				}
+				if format == ui.OutputFormatJSON {
+					return ui.WriteJSON(os.Stdout, events)
+				}
+				relative := !absolute && ui.IsTerminal(os.Stdout)
+				return renderCardHistory(os.Stdout, events, time.Now(), relative)
+			}
+
+			if subscribers {
+				people, err := client.Activity().GetSubscribers(f.Context(), resolvedProjectID, cardID)
+				if err != nil {
+					return err
+				}
+				if format == ui.OutputFormatJSON {
+					if people == nil {
+						people = []api.Person{}
+					}
+					return ui.WriteJSON(os.Stdout, people)
+				}
+				return utils.WriteSubscribers(os.Stdout, people)
+			}
+
 			// Get the card
 			card, err := cardOps.GetCard(f.Context(), resolvedProjectID, cardID)
 			if err != nil {
 				return fmt.Errorf("failed to fetch card: %w", err)
 			}
 
-			// Handle JSON output
-			if formatJSON {
-				// For JSON output, return the card structure
-				fmt.Printf("Card: %s\n", card.Title)
-				return nil
+			if openAttachments {
+				return openCardImages(f.Context(), client.Uploads(), resolvedProjectID, card, keepAttachments)
+			}
+
+			// The card, or with --steps-only just its steps, as JSON
+			if format == ui.OutputFormatJSON {
+				return writeCardJSON(os.Stdout, card, stepsOnly)
+			}
+
+			// Clean Markdown document, to a file or stdout
+			if markdownDoc {
+				var comments []api.Comment
+				if withComments {
+					if comments, err = client.ListComments(f.Context(), resolvedProjectID, card.ID); err != nil {
+						return fmt.Errorf("failed to fetch comments: %w", err)
+					}
+					utils.SortComments(comments, commentSort)
+				}
+				doc, err := utils.FormatCardAsMarkdown(card, comments)
+				if err != nil {
+					return fmt.Errorf("failed to format card as markdown: %w", err)
+				}
+
+				if output != "" {
+					if err := os.WriteFile(output, []byte(doc), 0o644); err != nil {
+						return fmt.Errorf("failed to write %s: %w", output, err)
+					}
+					fmt.Fprintf(os.Stderr, "✓ Wrote card #%d to %s\n", card.ID, output)
+					return nil
+				}
+				if !ui.IsTerminal(os.Stdout) {
+					fmt.Print(doc)
+					return nil
+				}
+				cfg, err := f.Config()
+				if err != nil {
+					return err
+				}
+				return utils.ShowInPager(doc, &utils.PagerOptions{Pager: cfg.Preferences.Pager, NoPager: noPager})
 			}
 
 			// If steps only, show just the steps
@@ -163,6 +273,7 @@ You can specify the card using either:
 				if err != nil {
 					return fmt.Errorf("failed to fetch comments: %w", err)
 				}
+				utils.SortComments(comments, commentSort)
 
 				markdown, err := utils.FormatCardAsMarkdown(card, comments)
 				if err != nil {
@@ -212,9 +323,7 @@ You can specify the card using either:
 
 			// Show card details
 			if card.Content != "" {
-				// Convert HTML to plain text
-				plainContent := htmlToText(card.Content)
-				fmt.Fprintf(&buf, "\nDescription:\n%s\n", plainContent)
+				fmt.Fprintf(&buf, "\nDescription:\n%s\n", markdown.PlainText(card.Content))
 			}
 
 			// Column
@@ -246,8 +355,9 @@ You can specify the card using either:
 			}
 
 			// Timestamps
-			fmt.Fprintf(&buf, "Created: %s\n", card.CreatedAt.Format("2006-01-02 15:04"))
-			fmt.Fprintf(&buf, "Updated: %s\n", card.UpdatedAt.Format("2006-01-02 15:04"))
+			relative := !absolute && ui.IsTerminal(os.Stdout)
+			fmt.Fprintf(&buf, "Created: %s\n", formatCardTime(time.Now(), card.CreatedAt, relative))
+			fmt.Fprintf(&buf, "Updated: %s\n", formatCardTime(time.Now(), card.UpdatedAt, relative))
 
 			// Comments count
 			if card.CommentsCount > 0 {
@@ -336,17 +446,93 @@ You can specify the card using either:
 		},
 	}
 
-	cmd.Flags().BoolVar(&formatJSON, "json", false, "Output in JSON format")
+	cmd.Flags().BoolVar(&formatJSON, "json", false, "Output in JSON format (same as --format json)")
 	cmd.Flags().StringVarP(&accountID, "account", "a", "", "Specify account ID")
 	cmd.Flags().StringVarP(&projectID, "project", "p", "", "Specify project ID")
 	cmd.Flags().BoolVar(&stepsOnly, "steps-only", false, "Show only the steps list")
 	cmd.Flags().BoolVarP(&web, "web", "w", false, "Open card in web browser")
 	cmd.Flags().BoolVar(&noPager, "no-pager", false, "Disable pager for output")
 	cmd.Flags().BoolVar(&withComments, "with-comments", false, "Display all comments inline")
+	cmd.Flags().BoolVar(&raw, "raw", false, "Print the exact JSON returned by the API")
+	cmd.Flags().BoolVar(&absolute, "absolute", false, "Show exact timestamps instead of relative times")
+	cmd.Flags().BoolVar(&openAttachments, "open-attachments", false, "Download the card's images and open them in your default viewer")
+	cmd.Flags().BoolVar(&keepAttachments, "keep", false, "Keep the images downloaded by --open-attachments")
+	cmd.Flags().StringVar(&commentSort, "sort", utils.CommentSortDesc, "Comment order with --with-comments: asc (oldest first) or desc (newest first)")
+	cmd.Flags().BoolVar(&history, "history", false, "Show the card's event history (moves, assignments, completion)")
+	cmd.Flags().BoolVar(&subscribers, "subscribers", false, "List the people subscribed to the card's notifications")
+	cmd.Flags().BoolVar(&markdownDoc, "markdown", false, "Output the card as a Markdown document")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "With --markdown, write the document to this file")
+	cmd.Flags().StringVarP(&formatStr, "format", "f", "table", "Output format: table or json")
+	cmd.MarkFlagsMutuallyExclusive("raw", "steps-only", "with-comments", "history", "subscribers")
 
 	return cmd
 }
 
+// viewFlags are the card view flags that decide what kind of output is
+// written
+type viewFlags struct {
+	format          string
+	formatSet       bool
+	json            bool
+	web             bool
+	raw             bool
+	withComments    bool
+	openAttachments bool
+}
+
+// resolveViewFormat checks that the output flags fit together and returns
+// the output format. --json is the same as --format json. --web opens the
+// browser whatever else is given, so it only conflicts with flags that ask
+// for machine-readable output.
+func resolveViewFormat(v viewFlags) (ui.OutputFormat, error) {
+	format, err := ui.ResolveTableOrJSON(v.format)
+	if err != nil {
+		return "", err
+	}
+	if v.json {
+		if v.formatSet && format != ui.OutputFormatJSON {
+			return "", fmt.Errorf("--json cannot be combined with --format %s", v.format)
+		}
+		format = ui.OutputFormatJSON
+	}
+
+	if v.web {
+		if format == ui.OutputFormatJSON {
+			return "", fmt.Errorf("--web opens the card in your browser and cannot be combined with --format json or --json; drop --web to print the card as JSON")
+		}
+		if v.raw {
+			return "", fmt.Errorf("--web opens the card in your browser and cannot be combined with --raw")
+		}
+		return format, nil
+	}
+
+	if format == ui.OutputFormatJSON {
+		if v.raw {
+			return "", fmt.Errorf("--raw already prints the card's JSON and cannot be combined with --format json or --json")
+		}
+		if v.withComments {
+			return "", fmt.Errorf("--with-comments cannot be combined with --format json; use 'bc4 comment list' for the comments")
+		}
+		if v.openAttachments {
+			return "", fmt.Errorf("--open-attachments cannot be combined with --format json")
+		}
+	}
+	return format, nil
+}
+
+// writeCardJSON writes card as JSON, or with stepsOnly just its steps as a
+// JSON array
+func writeCardJSON(w io.Writer, card *api.Card, stepsOnly bool) error {
+	if stepsOnly {
+		steps := card.Steps
+		if steps == nil {
+			steps = []api.Step{}
+		}
+		return ui.WriteJSON(w, steps)
+	}
+	return ui.WriteJSON(w, card)
+}
+
 func showStepsTable(card *api.Card, cfg *config.Config, noPager bool) error {
 	var buf bytes.Buffer
 	table := tableprinter.New(&buf)
@@ -406,3 +592,16 @@ func showStepsTable(card *api.Card, cfg *config.Config, noPager bool) error {
 	}
 	return utils.ShowInPager(buf.String(), pagerOpts)
 }
+
+// formatCardTime formats a card timestamp, either relative to now or as an
+// exact local time
+func formatCardTime(now, t time.Time, relative bool) string {
+	if relative {
+		// Within a few seconds either way, there's nothing to qualify
+		if d := now.Sub(t); d >= -5*time.Second && d <= 5*time.Second {
+			return "just now"
+		}
+		return ui.HumanTime(now, t)
+	}
+	return t.Format("2006-01-02 15:04")
+}
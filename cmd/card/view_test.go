@@ -0,0 +1,102 @@
+package card
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/ui"
+)
+
+func TestResolveViewFormat(t *testing.T) {
+	tests := []struct {
+		name    string
+		flags   viewFlags
+		want    ui.OutputFormat
+		wantErr string
+	}{
+		{name: "default", flags: viewFlags{format: "table"}, want: ui.OutputFormatTable},
+		{name: "format json", flags: viewFlags{format: "json", formatSet: true}, want: ui.OutputFormatJSON},
+		{name: "json flag", flags: viewFlags{format: "table", json: true}, want: ui.OutputFormatJSON},
+		{name: "json flag and format json", flags: viewFlags{format: "json", formatSet: true, json: true}, want: ui.OutputFormatJSON},
+		{name: "json flag and format table", flags: viewFlags{format: "table", formatSet: true, json: true}, wantErr: "--json cannot be combined with --format table"},
+		{name: "unsupported format", flags: viewFlags{format: "csv", formatSet: true}, wantErr: "unsupported output format"},
+		{name: "web", flags: viewFlags{format: "table", web: true}, want: ui.OutputFormatTable},
+		{name: "web ignores display flags", flags: viewFlags{format: "table", web: true, withComments: true}, want: ui.OutputFormatTable},
+		{name: "web and format json", flags: viewFlags{format: "json", formatSet: true, web: true}, wantErr: "--web opens the card in your browser"},
+		{name: "web and json flag", flags: viewFlags{format: "table", json: true, web: true}, wantErr: "--web opens the card in your browser"},
+		{name: "web and raw", flags: viewFlags{format: "table", web: true, raw: true}, wantErr: "--raw"},
+		{name: "raw and json", flags: viewFlags{format: "table", json: true, raw: true}, wantErr: "--raw already prints"},
+		{name: "comments and json", flags: viewFlags{format: "json", formatSet: true, withComments: true}, wantErr: "--with-comments"},
+		{name: "attachments and json", flags: viewFlags{format: "json", formatSet: true, openAttachments: true}, wantErr: "--open-attachments"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveViewFormat(tt.flags)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestWriteCardJSON(t *testing.T) {
+	card := &api.Card{
+		ID:    1,
+		Title: "Launch",
+		Steps: []api.Step{{ID: 10, Title: "Write copy"}, {ID: 11, Title: "Ship", Completed: true}},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, writeCardJSON(&buf, card, false))
+	var decodedCard api.Card
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &decodedCard))
+	assert.Equal(t, "Launch", decodedCard.Title)
+	assert.Len(t, decodedCard.Steps, 2)
+
+	buf.Reset()
+	require.NoError(t, writeCardJSON(&buf, card, true))
+	var steps []api.Step
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &steps), "--steps-only writes just the steps array")
+	require.Len(t, steps, 2)
+	assert.Equal(t, int64(11), steps[1].ID)
+
+	buf.Reset()
+	require.NoError(t, writeCardJSON(&buf, &api.Card{ID: 2}, true))
+	assert.JSONEq(t, "[]", buf.String(), "a card without steps is an empty array, not null")
+}
+
+func TestViewCmd_FlagCombinations(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{name: "web and json", args: []string{"1", "--web", "--json"}, wantErr: "--web opens the card in your browser"},
+		{name: "web and format json", args: []string{"1", "--web", "--format", "json"}, wantErr: "--web opens the card in your browser"},
+		{name: "json and format table", args: []string{"1", "--json", "--format", "table"}, wantErr: "--json cannot be combined"},
+		{name: "markdown and json", args: []string{"1", "--markdown", "--json"}, wantErr: "--markdown cannot be combined"},
+		{name: "steps-only and raw", args: []string{"1", "--steps-only", "--raw"}, wantErr: "none of the others can be"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := newViewCmd(nil)
+			cmd.SetArgs(tt.args)
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+			err := cmd.Execute()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
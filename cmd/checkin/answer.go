@@ -1,7 +1,6 @@
 package checkin
 
 import (
-	"bufio"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -13,13 +12,17 @@ import (
 	"github.com/needmore/bc4/internal/factory"
 	"github.com/needmore/bc4/internal/markdown"
 	"github.com/needmore/bc4/internal/parser"
+	"github.com/needmore/bc4/internal/utils"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
 
 type answerOptions struct {
 	jsonOutput bool
+	content    string
 	file       string
+	fromEditor bool
+	update     string
 	markdown   bool
 }
 
@@ -27,138 +30,236 @@ func newAnswerCmd(f *factory.Factory) *cobra.Command {
 	opts := &answerOptions{}
 
 	cmd := &cobra.Command{
-		Use:   "answer <question-id|URL> [content]",
+		Use:   "answer <question-id|URL|title> [content]",
 		Short: "Post an answer to a check-in question",
-		Long: `Post an answer to a check-in question.
+		Long: `Post an answer to a check-in question, or update one of your answers.
 
-Content can be provided as:
-- A command-line argument
-- From a file using --file
-- From stdin (pipe content to bc4)
+Content is written in Markdown and converted for Basecamp. It is taken from
+the first of these that is given:
+- --content, or the content argument
+- A file, using --from-file
+- Stdin, when content is piped to bc4
+- Your editor ($VISUAL, $EDITOR, or the editor preference), otherwise
 
-If --markdown is specified (or --md), content will be converted from Markdown to HTML.`,
+Use --from-editor to always write the answer in your editor. With --update,
+no arguments are taken: the new content comes from --content, --from-file,
+stdin, or the editor, which starts with the answer's current content.
+
+The question can be given by ID, URL, or part of its title.`,
 		Example: `  # Post a simple answer
   bc4 checkin answer 12345 "Today I worked on the new feature"
+  bc4 checkin answer "standup" --content "Fixed the login bug"
+
+  # Write the answer in your editor
+  bc4 checkin answer 12345
 
   # Post from a file
-  bc4 checkin answer 12345 --file update.txt
+  bc4 checkin answer 12345 --from-file update.md
 
   # Post from stdin
   echo "My update" | bc4 checkin answer 12345
 
   # Post markdown content
-  bc4 checkin answer 12345 "## Summary\n- Fixed bugs\n- Added tests" --markdown`,
-		Args: cobra.RangeArgs(1, 2),
+  bc4 checkin answer 12345 --content "## Summary
+- Fixed bugs
+- Added tests"
+
+  # Update an existing answer
+  bc4 checkin answer --update 67890 --content "Also reviewed two PRs"
+  bc4 checkin answer --update 67890 --from-editor`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if opts.update != "" {
+				if len(args) > 0 {
+					return fmt.Errorf("--update takes no arguments; give the new content with --content, --from-file, stdin, or your editor")
+				}
+				return nil
+			}
+			return cobra.RangeArgs(1, 2)(cmd, args)
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.jsonOutput = viper.GetBool("json")
 			return runAnswer(f, opts, args)
 		},
 	}
 
-	cmd.Flags().StringVarP(&opts.file, "file", "f", "", "Read content from file")
+	cmd.Flags().StringVarP(&opts.content, "content", "c", "", "Answer content (Markdown)")
+	cmd.Flags().StringVar(&opts.file, "from-file", "", "Read content from a Markdown file")
+	cmd.Flags().StringVarP(&opts.file, "file", "f", "", "Read content from a Markdown file (alias)")
+	cmd.Flags().BoolVar(&opts.fromEditor, "from-editor", false, "Write the answer in your editor")
+	cmd.Flags().StringVar(&opts.update, "update", "", "Update an existing answer (ID or URL) instead of posting a new one")
 	cmd.Flags().BoolVar(&opts.markdown, "markdown", false, "Convert markdown to HTML")
 	cmd.Flags().BoolVar(&opts.markdown, "md", false, "Convert markdown to HTML (alias)")
+	_ = cmd.Flags().MarkDeprecated("markdown", "content is always converted from Markdown")
+	_ = cmd.Flags().MarkDeprecated("md", "content is always converted from Markdown")
 
 	return cmd
 }
 
 func runAnswer(f *factory.Factory, opts *answerOptions, args []string) error {
+	if opts.update != "" {
+		return runUpdateAnswer(f, opts)
+	}
+
+	f, projectID, questionID, err := resolveQuestionArg(f, args[0])
+	if err != nil {
+		return err
+	}
+
 	client, err := f.ApiClient()
 	if err != nil {
 		return err
 	}
 	questionOps := client.Questions()
 
-	projectID, err := f.ProjectID()
+	content, err := answerContent(f, opts, args[1:], "")
 	if err != nil {
 		return err
 	}
 
-	// Parse question ID (could be numeric ID or URL)
-	parsedID, parsedURL, err := parser.ParseArgument(args[0])
+	answer, err := questionOps.CreateAnswer(f.Context(), projectID, questionID, api.AnswerCreateRequest{Content: content})
 	if err != nil {
-		return fmt.Errorf("invalid question ID or URL: %s", args[0])
+		return fmt.Errorf("failed to create answer: %w", err)
 	}
 
+	return printAnswerResult(opts, answer, "Answer posted successfully")
+}
+
+func runUpdateAnswer(f *factory.Factory, opts *answerOptions) error {
+	answerID, parsedURL, err := parser.ParseArgument(opts.update)
+	if err != nil {
+		return fmt.Errorf("invalid answer ID or URL: %s", opts.update)
+	}
 	if parsedURL != nil {
 		if parsedURL.AccountID > 0 {
 			f = f.WithAccount(strconv.FormatInt(parsedURL.AccountID, 10))
 		}
 		if parsedURL.ProjectID > 0 {
-			projectID = strconv.FormatInt(parsedURL.ProjectID, 10)
+			f = f.WithProject(strconv.FormatInt(parsedURL.ProjectID, 10))
 		}
 	}
 
-	// Get the content
-	content, err := getAnswerContent(opts, args)
+	projectID, err := f.ProjectID()
 	if err != nil {
 		return err
 	}
 
-	if strings.TrimSpace(content) == "" {
-		return fmt.Errorf("answer content cannot be empty")
+	client, err := f.ApiClient()
+	if err != nil {
+		return err
 	}
+	questionOps := client.Questions()
 
-	// Convert markdown if requested
-	if opts.markdown {
-		converter := markdown.NewConverter()
-		htmlContent, err := converter.MarkdownToRichText(content)
+	// Start the editor from the current answer
+	var current string
+	if opts.content == "" && opts.file == "" {
+		answer, err := questionOps.GetAnswer(f.Context(), projectID, answerID)
+		if err != nil {
+			return fmt.Errorf("failed to get answer: %w", err)
+		}
+		current, err = markdown.NewConverter().RichTextToMarkdown(answer.Content)
 		if err != nil {
-			return fmt.Errorf("failed to convert markdown: %w", err)
+			return fmt.Errorf("failed to convert answer to markdown: %w", err)
 		}
-		content = htmlContent
 	}
 
-	// Create the answer
-	req := api.AnswerCreateRequest{
-		Content: content,
+	content, err := answerContent(f, opts, nil, current)
+	if err != nil {
+		return err
 	}
 
-	answer, err := questionOps.CreateAnswer(f.Context(), projectID, parsedID, req)
+	answer, err := questionOps.UpdateAnswer(f.Context(), projectID, answerID, api.AnswerUpdateRequest{Content: content})
 	if err != nil {
-		return fmt.Errorf("failed to create answer: %w", err)
+		return fmt.Errorf("failed to update answer: %w", err)
 	}
 
+	return printAnswerResult(opts, answer, "Answer updated successfully")
+}
+
+func printAnswerResult(opts *answerOptions, answer *api.QuestionAnswer, message string) error {
 	if opts.jsonOutput {
 		encoder := json.NewEncoder(os.Stdout)
 		encoder.SetIndent("", "  ")
 		return encoder.Encode(answer)
 	}
 
-	fmt.Printf("Answer posted successfully (ID: %d)\n", answer.ID)
+	fmt.Printf("%s (ID: %d)\n", message, answer.ID)
 	if answer.AppURL != "" {
 		fmt.Printf("URL: %s\n", answer.AppURL)
 	}
-
 	return nil
 }
 
-func getAnswerContent(opts *answerOptions, args []string) (string, error) {
-	// Check for file input
-	if opts.file != "" {
-		data, err := os.ReadFile(opts.file)
-		if err != nil {
-			return "", fmt.Errorf("failed to read file: %w", err)
+// answerContent reads the answer's Markdown and converts it for Basecamp.
+// initial is the starting text when the editor is used.
+func answerContent(f *factory.Factory, opts *answerOptions, args []string, initial string) (string, error) {
+	var stdin io.Reader
+	if stat, err := os.Stdin.Stat(); err == nil && (stat.Mode()&os.ModeCharDevice) == 0 {
+		stdin = os.Stdin
+	}
+
+	edit := func(initial string) (string, error) {
+		var preferred string
+		if cfg, err := f.Config(); err == nil {
+			preferred = cfg.Preferences.Editor
 		}
-		return string(data), nil
+		return utils.EditText(utils.ResolveEditor(preferred), initial, "bc4-answer-*.md")
 	}
 
-	// Check for command-line argument
-	if len(args) >= 2 {
-		return args[1], nil
+	text, err := readAnswerMarkdown(opts, args, stdin, initial, edit)
+	if err != nil {
+		return "", err
 	}
+	return convertAnswer(text)
+}
 
-	// Check for stdin
-	stat, _ := os.Stdin.Stat()
-	if (stat.Mode() & os.ModeCharDevice) == 0 {
-		// Data is being piped to stdin
-		reader := bufio.NewReader(os.Stdin)
-		data, err := io.ReadAll(reader)
+// readAnswerMarkdown returns the answer text from the first source given:
+// --content or the content argument, --from-file, stdin (when piped), and
+// finally the editor. --from-editor skips stdin and goes to the editor.
+func readAnswerMarkdown(opts *answerOptions, args []string, stdin io.Reader, initial string, edit func(initial string) (string, error)) (string, error) {
+	if opts.content != "" && len(args) > 0 {
+		return "", fmt.Errorf("give the content either as an argument or with --content, not both")
+	}
+	if opts.fromEditor && (opts.content != "" || len(args) > 0 || opts.file != "") {
+		return "", fmt.Errorf("--from-editor cannot be used with --content, --from-file, or a content argument")
+	}
+
+	var text string
+	switch {
+	case opts.content != "":
+		text = opts.content
+	case len(args) > 0:
+		text = args[0]
+	case opts.file != "":
+		data, err := os.ReadFile(opts.file)
+		if err != nil {
+			return "", fmt.Errorf("failed to read file: %w", err)
+		}
+		text = string(data)
+	case stdin != nil && !opts.fromEditor:
+		data, err := io.ReadAll(stdin)
 		if err != nil {
 			return "", fmt.Errorf("failed to read stdin: %w", err)
 		}
-		return string(data), nil
+		text = string(data)
+	default:
+		edited, err := edit(initial)
+		if err != nil {
+			return "", err
+		}
+		text = edited
+	}
+
+	if strings.TrimSpace(text) == "" {
+		return "", fmt.Errorf("answer content cannot be empty")
 	}
+	return text, nil
+}
 
-	return "", fmt.Errorf("no content provided; use argument, --file, or pipe content to stdin")
+// convertAnswer converts the answer's Markdown to Basecamp rich text
+func convertAnswer(text string) (string, error) {
+	html, err := markdown.NewConverter().MarkdownToRichText(text)
+	if err != nil {
+		return "", fmt.Errorf("failed to convert markdown: %w", err)
+	}
+	return html, nil
 }
@@ -0,0 +1,74 @@
+package checkin
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadAnswerMarkdown(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "answer.md")
+	require.NoError(t, os.WriteFile(file, []byte("from file"), 0o600))
+
+	noEditor := func(string) (string, error) { return "", errors.New("editor should not run") }
+	editor := func(initial string) (string, error) { return initial + " edited", nil }
+
+	tests := []struct {
+		name  string
+		opts  *answerOptions
+		args  []string
+		stdin io.Reader
+		edit  func(string) (string, error)
+		want  string
+	}{
+		{"content wins over file", &answerOptions{content: "from flag", file: file}, nil, nil, noEditor, "from flag"},
+		{"argument wins over stdin", &answerOptions{}, []string{"from arg"}, strings.NewReader("from stdin"), noEditor, "from arg"},
+		{"file wins over stdin", &answerOptions{file: file}, nil, strings.NewReader("from stdin"), noEditor, "from file"},
+		{"stdin before editor", &answerOptions{}, nil, strings.NewReader("from stdin"), noEditor, "from stdin"},
+		{"editor as a fallback", &answerOptions{}, nil, nil, editor, "draft edited"},
+		{"from-editor skips stdin", &answerOptions{fromEditor: true}, nil, strings.NewReader("from stdin"), editor, "draft edited"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := readAnswerMarkdown(tt.opts, tt.args, tt.stdin, "draft", tt.edit)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	t.Run("empty content is rejected", func(t *testing.T) {
+		_, err := readAnswerMarkdown(&answerOptions{}, nil, nil, "", func(string) (string, error) { return "  \n", nil })
+		assert.EqualError(t, err, "answer content cannot be empty")
+	})
+
+	t.Run("content given twice", func(t *testing.T) {
+		_, err := readAnswerMarkdown(&answerOptions{content: "a"}, []string{"b"}, nil, "", noEditor)
+		assert.Error(t, err)
+	})
+}
+
+func TestConvertAnswer(t *testing.T) {
+	html, err := convertAnswer("## Summary\n\n- Fixed **bugs**\n- Added tests")
+	require.NoError(t, err)
+	assert.Contains(t, html, "<strong>bugs</strong>")
+	assert.Contains(t, html, "<li>")
+	assert.NotContains(t, html, "##")
+}
+
+func TestAnswerCmd_UpdateRejectsArguments(t *testing.T) {
+	cmd := newAnswerCmd(nil)
+	cmd.SetArgs([]string{"12345", "--update", "678"})
+	cmd.SetOut(io.Discard)
+	cmd.SetErr(io.Discard)
+
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--update takes no arguments")
+}
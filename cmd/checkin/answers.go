@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/needmore/bc4/internal/api"
 	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/markdown"
 	"github.com/needmore/bc4/internal/parser"
 	"github.com/needmore/bc4/internal/ui/tableprinter"
 	"github.com/spf13/cobra"
@@ -128,7 +130,7 @@ func runAnswers(f *factory.Factory, opts *answersOptions, args []string) error {
 		table.AddField(author)
 
 		// Content (truncated)
-		content := stripHTML(a.Content)
+		content := strings.Join(strings.Fields(markdown.PlainText(a.Content)), " ")
 		if len(content) > 60 {
 			content = content[:57] + "..."
 		}
@@ -145,23 +147,3 @@ func runAnswers(f *factory.Factory, opts *answersOptions, args []string) error {
 
 	return table.Render()
 }
-
-// stripHTML removes HTML tags from a string (basic implementation)
-func stripHTML(s string) string {
-	var result []rune
-	inTag := false
-	for _, r := range s {
-		if r == '<' {
-			inTag = true
-		} else if r == '>' {
-			inTag = false
-		} else if !inTag {
-			if r == '\n' || r == '\r' {
-				result = append(result, ' ')
-			} else {
-				result = append(result, r)
-			}
-		}
-	}
-	return string(result)
-}
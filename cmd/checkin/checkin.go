@@ -25,7 +25,8 @@ Examples:
   bc4 checkin reminders                # List your pending check-in reminders
   bc4 checkin create "What did you work on today?" --schedule every_day
   bc4 checkin pause 123                # Pause a check-in question
-  bc4 checkin resume 123               # Resume a paused question`,
+  bc4 checkin resume 123               # Resume a paused question
+  bc4 checkin notifications 123        # Show notification settings`,
 		Aliases: []string{"checkins", "question", "questions"},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return cmd.Help()
@@ -38,7 +39,7 @@ Examples:
 	// Add subcommands
 	cmd.AddCommand(newListCmd(f))
 	cmd.AddCommand(newViewCmd(f))
-	cmd.AddCommand(newRemindersCmd(f))
+	cmd.AddCommand(NewRemindersCmd(f))
 	cmd.AddCommand(newAnswersCmd(f))
 	cmd.AddCommand(newAnswerCmd(f))
 	cmd.AddCommand(newPauseCmd(f))
@@ -4,28 +4,31 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
-	"strconv"
 	"strings"
 
 	"github.com/needmore/bc4/internal/api"
 	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/utils"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
 
 type createOptions struct {
 	jsonOutput bool
+	title      string
 	schedule   string
 	days       string
+	timeOfDay  string
 	hour       int
 	minute     int
+	hourSet    bool
 }
 
 func newCreateCmd(f *factory.Factory) *cobra.Command {
 	opts := &createOptions{}
 
 	cmd := &cobra.Command{
-		Use:   "create <title>",
+		Use:   "create [title]",
 		Short: "Create a new check-in question",
 		Long: `Create a new automated check-in question.
 
@@ -35,35 +38,75 @@ Schedule options:
   every_other_week   - Ask every other week on specified days
   every_four_weeks   - Ask every four weeks on specified days
 
-Days are specified as comma-separated numbers (0=Sunday through 6=Saturday).
-For example: "1,2,3,4,5" for weekdays.`,
+Days are comma-separated day names (mon, tue, ... sun) or numbers
+(0=Sunday through 6=Saturday), and can only be given for weekly schedules.
+Weekly questions are asked on Monday unless --day is set.`,
 		Example: `  # Create a daily check-in at 9am
-  bc4 checkin create "What did you accomplish today?" --schedule every_day --hour 9
+  bc4 checkin create --title "What did you accomplish today?" --schedule every_day --time 09:00
 
-  # Create a weekly check-in on Mondays at 10am
-  bc4 checkin create "What are your goals this week?" --schedule every_week --days 1 --hour 10
+  # Create a check-in on Monday, Wednesday and Friday mornings
+  bc4 checkin create --title "What did you work on?" --schedule every_week --day mon,wed,fri --time 09:00
 
   # Create a bi-weekly check-in on Friday afternoons
-  bc4 checkin create "Team retrospective" --schedule every_other_week --days 5 --hour 15
+  bc4 checkin create "Team retrospective" --schedule every_other_week --day fri --time 15:00
 
   # Output as JSON
-  bc4 checkin create "Daily standup" --schedule every_day --json`,
-		Args: cobra.ExactArgs(1),
+  bc4 checkin create --title "Daily standup" --schedule every_day --json`,
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.jsonOutput = viper.GetBool("json")
+			opts.hourSet = cmd.Flags().Changed("hour") || cmd.Flags().Changed("minute")
 			return runCreate(f, opts, args)
 		},
 	}
 
+	cmd.Flags().StringVar(&opts.title, "title", "", "Question to ask")
 	cmd.Flags().StringVar(&opts.schedule, "schedule", "every_week", "Schedule frequency: every_day, every_week, every_other_week, every_four_weeks")
-	cmd.Flags().StringVar(&opts.days, "days", "1", "Days to ask (comma-separated: 0=Sun, 1=Mon, ..., 6=Sat)")
+	cmd.Flags().StringVar(&opts.days, "day", "", "Days to ask for weekly schedules (e.g. mon,wed,fri or 1,3,5)")
+	cmd.Flags().StringVar(&opts.days, "days", "", "Alias for --day")
+	cmd.Flags().StringVar(&opts.timeOfDay, "time", "", "Time to send the reminder, as HH:MM (default 09:00)")
 	cmd.Flags().IntVar(&opts.hour, "hour", 9, "Hour to send reminder (0-23)")
 	cmd.Flags().IntVar(&opts.minute, "minute", 0, "Minute to send reminder (0-59)")
 
 	return cmd
 }
 
+// createRequest validates the create flags and builds the question to create
+func createRequest(opts *createOptions, args []string) (api.QuestionCreateRequest, error) {
+	var req api.QuestionCreateRequest
+
+	title := opts.title
+	switch {
+	case len(args) == 1 && title != "":
+		return req, fmt.Errorf("give the title either as an argument or with --title, not both")
+	case len(args) == 1:
+		title = args[0]
+	}
+	if strings.TrimSpace(title) == "" {
+		return req, fmt.Errorf("a title is required; use --title")
+	}
+
+	hour, minute := opts.hour, opts.minute
+	if opts.timeOfDay != "" {
+		if opts.hourSet {
+			return req, fmt.Errorf("use either --time or --hour/--minute, not both")
+		}
+		var err error
+		hour, minute, err = utils.ParseTimeOfDay(opts.timeOfDay)
+		if err != nil {
+			return req, err
+		}
+	}
+
+	return utils.CheckinQuestion(title, opts.schedule, opts.days, hour, minute)
+}
+
 func runCreate(f *factory.Factory, opts *createOptions, args []string) error {
+	req, err := createRequest(opts, args)
+	if err != nil {
+		return err
+	}
+
 	client, err := f.ApiClient()
 	if err != nil {
 		return err
@@ -81,40 +124,6 @@ func runCreate(f *factory.Factory, opts *createOptions, args []string) error {
 		return fmt.Errorf("failed to get questionnaire: %w", err)
 	}
 
-	// Parse days
-	days, err := parseDays(opts.days)
-	if err != nil {
-		return err
-	}
-
-	// Validate schedule
-	validSchedules := map[string]bool{
-		"every_day":        true,
-		"every_week":       true,
-		"every_other_week": true,
-		"every_four_weeks": true,
-	}
-	if !validSchedules[opts.schedule] {
-		return fmt.Errorf("invalid schedule: %s; must be one of: every_day, every_week, every_other_week, every_four_weeks", opts.schedule)
-	}
-
-	// Validate hour and minute
-	if opts.hour < 0 || opts.hour > 23 {
-		return fmt.Errorf("hour must be between 0 and 23")
-	}
-	if opts.minute < 0 || opts.minute > 59 {
-		return fmt.Errorf("minute must be between 0 and 59")
-	}
-
-	// Create the question
-	req := api.QuestionCreateRequest{
-		Title:    args[0],
-		Schedule: opts.schedule,
-		Days:     days,
-		Hour:     opts.hour,
-		Minute:   opts.minute,
-	}
-
 	question, err := questionOps.CreateQuestion(f.Context(), projectID, questionnaire.ID, req)
 	if err != nil {
 		return fmt.Errorf("failed to create question: %w", err)
@@ -137,30 +146,3 @@ func runCreate(f *factory.Factory, opts *createOptions, args []string) error {
 
 	return nil
 }
-
-func parseDays(daysStr string) ([]int, error) {
-	if daysStr == "" {
-		return nil, nil
-	}
-
-	parts := strings.Split(daysStr, ",")
-	days := make([]int, 0, len(parts))
-
-	for _, p := range parts {
-		p = strings.TrimSpace(p)
-		if p == "" {
-			continue
-		}
-
-		day, err := strconv.Atoi(p)
-		if err != nil {
-			return nil, fmt.Errorf("invalid day: %s; must be a number 0-6", p)
-		}
-		if day < 0 || day > 6 {
-			return nil, fmt.Errorf("day must be between 0 (Sunday) and 6 (Saturday): got %d", day)
-		}
-		days = append(days, day)
-	}
-
-	return days, nil
-}
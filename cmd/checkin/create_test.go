@@ -0,0 +1,64 @@
+package checkin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/needmore/bc4/internal/api"
+)
+
+func TestCreateRequest(t *testing.T) {
+	t.Run("maps day names and time", func(t *testing.T) {
+		opts := &createOptions{title: "What did you work on?", schedule: "every_week", days: "mon,wed,fri", timeOfDay: "09:30"}
+		req, err := createRequest(opts, nil)
+		require.NoError(t, err)
+		assert.Equal(t, api.QuestionCreateRequest{
+			Title:    "What did you work on?",
+			Schedule: "every_week",
+			Days:     []int{1, 3, 5},
+			Hour:     9,
+			Minute:   30,
+		}, req)
+	})
+
+	t.Run("weekly defaults to Monday", func(t *testing.T) {
+		opts := &createOptions{schedule: "every_other_week", hour: 15}
+		req, err := createRequest(opts, []string{"Retro"})
+		require.NoError(t, err)
+		assert.Equal(t, []int{1}, req.Days)
+		assert.Equal(t, 15, req.Hour)
+	})
+
+	t.Run("daily takes no days", func(t *testing.T) {
+		opts := &createOptions{title: "Standup", schedule: "every_day", days: "mon"}
+		_, err := createRequest(opts, nil)
+		assert.ErrorContains(t, err, "weekly schedules")
+
+		opts.days = ""
+		req, err := createRequest(opts, nil)
+		require.NoError(t, err)
+		assert.Nil(t, req.Days)
+	})
+
+	t.Run("rejects bad input", func(t *testing.T) {
+		tests := map[string]*createOptions{
+			"missing title":  {schedule: "every_day"},
+			"bad schedule":   {title: "Q", schedule: "monthly"},
+			"bad day":        {title: "Q", schedule: "every_week", days: "funday"},
+			"bad time":       {title: "Q", schedule: "every_week", timeOfDay: "25:00"},
+			"time and hour":  {title: "Q", schedule: "every_week", timeOfDay: "09:00", hourSet: true},
+			"hour too large": {title: "Q", schedule: "every_week", hour: 24},
+		}
+		for name, opts := range tests {
+			_, err := createRequest(opts, nil)
+			assert.Error(t, err, name)
+		}
+	})
+
+	t.Run("title given twice", func(t *testing.T) {
+		_, err := createRequest(&createOptions{title: "A", schedule: "every_day"}, []string{"B"})
+		assert.Error(t, err)
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/needmore/bc4/internal/api"
 	"github.com/needmore/bc4/internal/factory"
 	"github.com/needmore/bc4/internal/parser"
+	"github.com/needmore/bc4/internal/utils"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -55,7 +56,7 @@ Only the fields you specify will be updated.`,
 
 	cmd.Flags().StringVar(&opts.title, "title", "", "New question title")
 	cmd.Flags().StringVar(&opts.schedule, "schedule", "", "Schedule frequency: every_day, every_week, every_other_week, every_four_weeks")
-	cmd.Flags().StringVar(&opts.days, "days", "", "Days to ask (comma-separated: 0=Sun, 1=Mon, ..., 6=Sat)")
+	cmd.Flags().StringVar(&opts.days, "days", "", "Days to ask (e.g. mon,wed,fri or 1,3,5)")
 	cmd.Flags().IntVar(&opts.hour, "hour", 0, "Hour to send reminder (0-23)")
 	cmd.Flags().IntVar(&opts.minute, "minute", 0, "Minute to send reminder (0-59)")
 
@@ -100,21 +101,15 @@ func runEdit(f *factory.Factory, opts *editOptions, args []string) error {
 
 	if opts.schedule != "" {
 		// Validate schedule
-		validSchedules := map[string]bool{
-			"every_day":        true,
-			"every_week":       true,
-			"every_other_week": true,
-			"every_four_weeks": true,
-		}
-		if !validSchedules[opts.schedule] {
-			return fmt.Errorf("invalid schedule: %s; must be one of: every_day, every_week, every_other_week, every_four_weeks", opts.schedule)
+		if err := utils.ValidateCheckinSchedule(opts.schedule); err != nil {
+			return err
 		}
 		req.Schedule = &opts.schedule
 		hasUpdates = true
 	}
 
 	if opts.days != "" {
-		days, err := parseDays(opts.days)
+		days, err := utils.ParseCheckinDays(opts.days)
 		if err != nil {
 			return err
 		}
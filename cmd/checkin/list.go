@@ -4,34 +4,39 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"slices"
 	"strconv"
-	"time"
+	"strings"
 
 	"github.com/needmore/bc4/internal/api"
 	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/ui"
 	"github.com/needmore/bc4/internal/ui/tableprinter"
+	"github.com/needmore/bc4/internal/utils"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
 
 type listOptions struct {
-	jsonOutput bool
+	format ui.OutputFormat
 }
 
 func newListCmd(f *factory.Factory) *cobra.Command {
 	opts := &listOptions{}
+	var formatStr string
 
 	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "List check-in questions in a project",
 		Long: `List all automated check-in questions in the current project.
 
-Shows the question title, schedule, paused status, and answer count.`,
+Shows each question's title, how often it's asked, on which days and at what
+time, whether it's paused, and how many answers it has.`,
 		Example: `  # List check-ins in the current project
   bc4 checkin list
 
   # Output as JSON
-  bc4 checkin list --json`,
+  bc4 checkin list --format json`,
 		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Apply overrides from persistent flags
@@ -42,11 +47,21 @@ Shows the question title, schedule, paused status, and answer count.`,
 				f = f.WithProject(projectID)
 			}
 
-			opts.jsonOutput = viper.GetBool("json")
+			format, err := ui.ResolveTableOrJSON(formatStr)
+			if err != nil {
+				return err
+			}
+			if viper.GetBool("json") {
+				format = ui.OutputFormatJSON
+			}
+			opts.format = format
+
 			return runList(f, opts)
 		},
 	}
 
+	cmd.Flags().StringVarP(&formatStr, "format", "f", "table", "Output format: table or json")
+
 	return cmd
 }
 
@@ -74,7 +89,7 @@ func runList(f *factory.Factory, opts *listOptions) error {
 		return fmt.Errorf("failed to list questions: %w", err)
 	}
 
-	if opts.jsonOutput {
+	if opts.format == ui.OutputFormatJSON {
 		encoder := json.NewEncoder(os.Stdout)
 		encoder.SetIndent("", "  ")
 		return encoder.Encode(questions)
@@ -90,13 +105,11 @@ func runList(f *factory.Factory, opts *listOptions) error {
 
 	// Add headers
 	if table.IsTTY() {
-		table.AddHeader("ID", "QUESTION", "SCHEDULE", "STATUS", "ANSWERS", "UPDATED")
+		table.AddHeader("ID", "QUESTION", "FREQUENCY", "DAYS", "TIME", "STATUS", "ANSWERS")
 	} else {
-		table.AddHeader("ID", "QUESTION", "SCHEDULE", "PAUSED", "ANSWERS", "UPDATED")
+		table.AddHeader("ID", "QUESTION", "FREQUENCY", "DAYS", "TIME", "PAUSED", "ANSWERS")
 	}
 
-	now := time.Now()
-
 	for _, q := range questions {
 		// ID
 		table.AddIDField(strconv.FormatInt(q.ID, 10), q.Status)
@@ -109,8 +122,15 @@ func runList(f *factory.Factory, opts *listOptions) error {
 		table.AddField(title)
 
 		// Schedule
-		schedule := formatSchedule(q.Schedule)
-		table.AddField(schedule)
+		if q.Schedule != nil {
+			table.AddField(formatFrequency(q.Schedule.Frequency))
+			table.AddField(formatDays(q.Schedule.Days))
+			table.AddField(formatTimeOfDay(q.Schedule.Hour, q.Schedule.Minute))
+		} else {
+			table.AddField("unknown")
+			table.AddField("")
+			table.AddField("")
+		}
 
 		// Paused status
 		if table.IsTTY() {
@@ -126,9 +146,6 @@ func runList(f *factory.Factory, opts *listOptions) error {
 		// Answer count
 		table.AddField(strconv.Itoa(q.AnswersCount))
 
-		// Updated time
-		table.AddTimeField(now, q.UpdatedAt)
-
 		table.EndRow()
 	}
 
@@ -140,65 +157,44 @@ func formatSchedule(s *api.QuestionSchedule) string {
 		return "unknown"
 	}
 
-	freq := s.Frequency
-	switch freq {
+	at := formatTimeOfDay(s.Hour, s.Minute)
+	if s.Frequency == "every_day" || len(s.Days) == 0 {
+		return fmt.Sprintf("%s @ %s", formatFrequency(s.Frequency), at)
+	}
+	return fmt.Sprintf("%s %s @ %s", formatFrequency(s.Frequency), formatDays(s.Days), at)
+}
+
+// formatFrequency returns a readable name for a schedule frequency
+func formatFrequency(frequency string) string {
+	switch frequency {
 	case "every_day":
-		return fmt.Sprintf("daily @ %02d:%02d", s.Hour, s.Minute)
+		return "daily"
 	case "every_week":
-		days := formatDays(s.Days)
-		return fmt.Sprintf("weekly %s @ %02d:%02d", days, s.Hour, s.Minute)
+		return "weekly"
 	case "every_other_week":
-		days := formatDays(s.Days)
-		return fmt.Sprintf("bi-weekly %s @ %02d:%02d", days, s.Hour, s.Minute)
+		return "bi-weekly"
 	case "every_four_weeks":
-		days := formatDays(s.Days)
-		return fmt.Sprintf("monthly %s @ %02d:%02d", days, s.Hour, s.Minute)
+		return "every 4 weeks"
 	default:
-		return freq
+		return frequency
 	}
 }
 
+// formatDays returns the days as short names, e.g. "Mon,Wed,Fri", or
+// "weekdays" for Monday through Friday
 func formatDays(days []int) string {
-	if len(days) == 0 {
-		return ""
-	}
-
-	dayNames := []string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"}
-	var result []string
-	for _, d := range days {
-		if d >= 0 && d <= 6 {
-			result = append(result, dayNames[d])
-		}
-	}
-
-	if len(result) == 5 && !containsDay(days, 0) && !containsDay(days, 6) {
+	if len(days) == 5 && !slices.Contains(days, 0) && !slices.Contains(days, 6) {
 		return "weekdays"
 	}
 
-	if len(result) == 1 {
-		return result[0]
-	}
-
-	if len(result) > 3 {
-		return fmt.Sprintf("%d days", len(result))
+	names := make([]string, len(days))
+	for i, d := range days {
+		names[i] = utils.CheckinDayName(d)
 	}
-
-	// Join with commas
-	joined := ""
-	for i, r := range result {
-		if i > 0 {
-			joined += ","
-		}
-		joined += r
-	}
-	return joined
+	return strings.Join(names, ",")
 }
 
-func containsDay(days []int, day int) bool {
-	for _, d := range days {
-		if d == day {
-			return true
-		}
-	}
-	return false
+// formatTimeOfDay formats an hour and minute as HH:MM
+func formatTimeOfDay(hour, minute int) string {
+	return fmt.Sprintf("%02d:%02d", hour, minute)
 }
@@ -0,0 +1,36 @@
+package checkin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/needmore/bc4/internal/api"
+)
+
+func TestFormatSchedule(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule *api.QuestionSchedule
+		want     string
+	}{
+		{"daily", &api.QuestionSchedule{Frequency: "every_day", Hour: 9}, "daily @ 09:00"},
+		{"weekly on several days", &api.QuestionSchedule{Frequency: "every_week", Days: []int{1, 3, 5}, Hour: 16, Minute: 30}, "weekly Mon,Wed,Fri @ 16:30"},
+		{"weekdays", &api.QuestionSchedule{Frequency: "every_other_week", Days: []int{1, 2, 3, 4, 5}, Hour: 8, Minute: 5}, "bi-weekly weekdays @ 08:05"},
+		{"every four weeks", &api.QuestionSchedule{Frequency: "every_four_weeks", Days: []int{0}, Hour: 12}, "every 4 weeks Sun @ 12:00"},
+		{"unknown frequency", &api.QuestionSchedule{Frequency: "hourly", Hour: 1}, "hourly @ 01:00"},
+		{"missing schedule", nil, "unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, formatSchedule(tt.schedule))
+		})
+	}
+}
+
+func TestFormatDays(t *testing.T) {
+	assert.Equal(t, "Sun,Sat", formatDays([]int{0, 6}))
+	assert.Equal(t, "", formatDays(nil))
+	assert.Equal(t, "Mon,Tue,Wed,Thu,Fri,Sat", formatDays([]int{1, 2, 3, 4, 5, 6}))
+}
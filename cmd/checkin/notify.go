@@ -1,47 +1,65 @@
 package checkin
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"strconv"
 
 	"github.com/needmore/bc4/internal/api"
 	"github.com/needmore/bc4/internal/factory"
-	"github.com/needmore/bc4/internal/parser"
+	"github.com/needmore/bc4/internal/ui"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
 
 type notifyOptions struct {
-	jsonOutput bool
+	format     ui.OutputFormat
 	responding *bool
 	subscribed *bool
 }
 
 func newNotifyCmd(f *factory.Factory) *cobra.Command {
 	opts := &notifyOptions{}
-	var respondingStr, subscribedStr string
+	var respondingStr, subscribedStr, formatStr string
 
 	cmd := &cobra.Command{
-		Use:   "notify <question-id|URL>",
-		Short: "Manage notification settings for a check-in question",
-		Long: `Update your notification settings for a specific check-in question.
+		Use:     "notifications <question-id|URL|title>",
+		Aliases: []string{"notify"},
+		Short:   "View or update notification settings for a check-in question",
+		Long: `View or update your notification settings for a specific check-in question.
+
+With no settings given, the current settings are shown. Only the settings
+that are given are changed. The question can be given by ID, URL, or part of
+its title.
 
 Settings:
   --responding=true/false  - Notify when someone responds to this question
   --subscribed=true/false  - Receive question notifications (reminders)`,
-		Example: `  # Subscribe to responses
-  bc4 checkin notify 12345 --responding=true
+		Example: `  # Show the current settings
+  bc4 checkin notifications 12345
+  bc4 checkin notifications "standup"
+
+  # Subscribe to responses
+  bc4 checkin notifications 12345 --responding=true
 
   # Unsubscribe from reminders
-  bc4 checkin notify 12345 --subscribed=false
+  bc4 checkin notifications 12345 --subscribed=false
 
-  # Update both settings
-  bc4 checkin notify 12345 --responding=true --subscribed=false`,
+  # Update both settings and print the result as JSON
+  bc4 checkin notifications 12345 --responding=true --subscribed=false --format json`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			opts.jsonOutput = viper.GetBool("json")
+			format, err := ui.ResolveTableOrJSON(formatStr)
+			if err != nil {
+				return err
+			}
+			if viper.GetBool("json") {
+				format = ui.OutputFormatJSON
+			}
+			opts.format = format
 
 			// Parse boolean flags
 			if cmd.Flags().Changed("responding") {
@@ -65,82 +83,70 @@ Settings:
 
 	cmd.Flags().StringVar(&respondingStr, "responding", "", "Notify when someone responds (true/false)")
 	cmd.Flags().StringVar(&subscribedStr, "subscribed", "", "Receive question notifications (true/false)")
+	cmd.Flags().StringVarP(&formatStr, "format", "f", "table", "Output format: table or json")
 
 	return cmd
 }
 
 func runNotify(f *factory.Factory, opts *notifyOptions, args []string) error {
-	client, err := f.ApiClient()
+	f, projectID, questionID, err := resolveQuestionArg(f, args[0])
 	if err != nil {
 		return err
 	}
-	questionOps := client.Questions()
 
-	projectID, err := f.ProjectID()
+	client, err := f.ApiClient()
 	if err != nil {
 		return err
 	}
 
-	// Parse question ID (could be numeric ID or URL)
-	parsedID, parsedURL, err := parser.ParseArgument(args[0])
+	settings, updated, err := applyNotificationSettings(f.Context(), client.Questions(), projectID, questionID, opts)
 	if err != nil {
-		return fmt.Errorf("invalid question ID or URL: %s", args[0])
+		return err
 	}
 
-	if parsedURL != nil {
-		if parsedURL.AccountID > 0 {
-			f = f.WithAccount(strconv.FormatInt(parsedURL.AccountID, 10))
-		}
-		if parsedURL.ProjectID > 0 {
-			projectID = strconv.FormatInt(parsedURL.ProjectID, 10)
-		}
-	}
+	return writeNotificationSettings(os.Stdout, questionID, settings, updated, opts.format)
+}
 
-	// Check if any settings were provided
+// applyNotificationSettings updates the settings that were given, or just
+// fetches the current ones when none were. It reports whether an update was
+// made.
+func applyNotificationSettings(ctx context.Context, ops api.QuestionOperations, projectID string, questionID int64, opts *notifyOptions) (*api.QuestionNotificationSettings, bool, error) {
 	if opts.responding == nil && opts.subscribed == nil {
-		// No settings provided, show current settings
-		question, err := questionOps.GetQuestion(f.Context(), projectID, parsedID)
+		question, err := ops.GetQuestion(ctx, projectID, questionID)
 		if err != nil {
-			return fmt.Errorf("failed to get question: %w", err)
+			return nil, false, fmt.Errorf("failed to get question: %w", err)
 		}
-
-		if opts.jsonOutput {
-			encoder := json.NewEncoder(os.Stdout)
-			encoder.SetIndent("", "  ")
-			return encoder.Encode(question.NotificationSettings)
-		}
-
-		fmt.Printf("Notification settings for question %d:\n", parsedID)
-		if question.NotificationSettings != nil {
-			fmt.Printf("  Responding: %v\n", question.NotificationSettings.Responding)
-			fmt.Printf("  Subscribed: %v\n", question.NotificationSettings.Subscribed)
-		} else {
-			fmt.Println("  (no settings available)")
-		}
-		return nil
+		return question.NotificationSettings, false, nil
 	}
 
-	// Build update request
 	req := api.NotificationSettingsUpdateRequest{
 		Responding: opts.responding,
 		Subscribed: opts.subscribed,
 	}
-
-	// Update settings
-	settings, err := questionOps.UpdateNotificationSettings(f.Context(), projectID, parsedID, req)
+	settings, err := ops.UpdateNotificationSettings(ctx, projectID, questionID, req)
 	if err != nil {
-		return fmt.Errorf("failed to update notification settings: %w", err)
+		return nil, false, fmt.Errorf("failed to update notification settings: %w", err)
 	}
+	return settings, true, nil
+}
 
-	if opts.jsonOutput {
-		encoder := json.NewEncoder(os.Stdout)
+func writeNotificationSettings(w io.Writer, questionID int64, settings *api.QuestionNotificationSettings, updated bool, format ui.OutputFormat) error {
+	if format == ui.OutputFormatJSON {
+		encoder := json.NewEncoder(w)
 		encoder.SetIndent("", "  ")
 		return encoder.Encode(settings)
 	}
 
-	fmt.Printf("Notification settings updated for question %d:\n", parsedID)
-	fmt.Printf("  Responding: %v\n", settings.Responding)
-	fmt.Printf("  Subscribed: %v\n", settings.Subscribed)
-
-	return nil
+	if updated {
+		fmt.Fprintf(w, "Notification settings updated for question %d:\n", questionID)
+	} else {
+		fmt.Fprintf(w, "Notification settings for question %d:\n", questionID)
+	}
+	if settings == nil {
+		_, err := fmt.Fprintln(w, "  (no settings available)")
+		return err
+	}
+	fmt.Fprintf(w, "  Responding: %v\n", settings.Responding)
+	_, err := fmt.Fprintf(w, "  Subscribed: %v\n", settings.Subscribed)
+	return err
 }
@@ -0,0 +1,53 @@
+package checkin
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/api/mock"
+	"github.com/needmore/bc4/internal/ui"
+)
+
+func TestApplyNotificationSettings(t *testing.T) {
+	t.Run("shows current settings when none are given", func(t *testing.T) {
+		client := mock.NewMockClient()
+		client.Question = &api.Question{ID: 7, NotificationSettings: &api.QuestionNotificationSettings{Responding: true}}
+
+		settings, updated, err := applyNotificationSettings(context.Background(), client, "10", 7, &notifyOptions{})
+		require.NoError(t, err)
+		assert.False(t, updated)
+		assert.True(t, settings.Responding)
+		assert.Equal(t, []string{"GetQuestion(10, 7)"}, client.Calls)
+	})
+
+	t.Run("only sends the settings given", func(t *testing.T) {
+		client := mock.NewMockClient()
+		client.UpdatedSettings = &api.QuestionNotificationSettings{Responding: true, Subscribed: false}
+		off := false
+
+		settings, updated, err := applyNotificationSettings(context.Background(), client, "10", 7, &notifyOptions{subscribed: &off})
+		require.NoError(t, err)
+		assert.True(t, updated)
+		assert.Equal(t, client.UpdatedSettings, settings)
+		require.NotNil(t, client.SettingsRequest)
+		assert.Nil(t, client.SettingsRequest.Responding)
+		assert.Equal(t, &off, client.SettingsRequest.Subscribed)
+	})
+}
+
+func TestWriteNotificationSettings(t *testing.T) {
+	settings := &api.QuestionNotificationSettings{Responding: true, Subscribed: false}
+
+	var out bytes.Buffer
+	require.NoError(t, writeNotificationSettings(&out, 7, settings, false, ui.OutputFormatJSON))
+	assert.JSONEq(t, `{"responding": true, "subscribed": false}`, out.String())
+
+	out.Reset()
+	require.NoError(t, writeNotificationSettings(&out, 7, settings, true, ui.OutputFormatTable))
+	assert.Equal(t, "Notification settings updated for question 7:\n  Responding: true\n  Subscribed: false\n", out.String())
+}
@@ -1,67 +1,95 @@
 package checkin
 
 import (
+	"context"
 	"fmt"
-	"strconv"
+	"io"
+	"os"
 
+	"github.com/needmore/bc4/internal/api"
 	"github.com/needmore/bc4/internal/factory"
-	"github.com/needmore/bc4/internal/parser"
 	"github.com/spf13/cobra"
 )
 
 func newPauseCmd(f *factory.Factory) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "pause <question-id|URL>",
+		Use:   "pause <question-id|URL|title>",
 		Short: "Pause a check-in question",
 		Long: `Pause a check-in question to temporarily stop it from sending reminders.
 
-A paused question will not send any reminders until it is resumed.`,
+A paused question will not send any reminders until it is resumed. The
+question can be given by ID, URL, or part of its title. Nothing changes if
+the question is already paused.`,
 		Example: `  # Pause a check-in question
   bc4 checkin pause 12345
 
+  # Pause by title
+  bc4 checkin pause "standup"
+
   # Pause using URL
   bc4 checkin pause "https://3.basecamp.com/123/buckets/456/questions/789"`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runPause(f, args)
+			return runSetPaused(f, args[0], true)
 		},
 	}
 
 	return cmd
 }
 
-func runPause(f *factory.Factory, args []string) error {
-	client, err := f.ApiClient()
+func runSetPaused(f *factory.Factory, arg string, paused bool) error {
+	f, projectID, questionID, err := resolveQuestionArg(f, arg)
 	if err != nil {
 		return err
 	}
-	questionOps := client.Questions()
 
-	projectID, err := f.ProjectID()
+	client, err := f.ApiClient()
 	if err != nil {
 		return err
 	}
 
-	// Parse question ID (could be numeric ID or URL)
-	parsedID, parsedURL, err := parser.ParseArgument(args[0])
+	return setQuestionPaused(f.Context(), client.Questions(), projectID, questionID, paused, os.Stdout)
+}
+
+// setQuestionPaused pauses or resumes a question, skipping the change when
+// it is already in that state, and reports the state Basecamp has afterwards
+func setQuestionPaused(ctx context.Context, ops api.QuestionOperations, projectID string, questionID int64, paused bool, w io.Writer) error {
+	question, err := ops.GetQuestion(ctx, projectID, questionID)
 	if err != nil {
-		return fmt.Errorf("invalid question ID or URL: %s", args[0])
+		return fmt.Errorf("failed to get question: %w", err)
 	}
 
-	if parsedURL != nil {
-		if parsedURL.AccountID > 0 {
-			f = f.WithAccount(strconv.FormatInt(parsedURL.AccountID, 10))
+	if question.Paused == paused {
+		_, err = fmt.Fprintf(w, "Check-in question #%d (%s) is already %s\n", questionID, question.Title, pausedState(paused))
+		return err
+	}
+
+	if paused {
+		if err := ops.PauseQuestion(ctx, projectID, questionID); err != nil {
+			return fmt.Errorf("failed to pause question: %w", err)
 		}
-		if parsedURL.ProjectID > 0 {
-			projectID = strconv.FormatInt(parsedURL.ProjectID, 10)
+	} else {
+		if err := ops.ResumeQuestion(ctx, projectID, questionID); err != nil {
+			return fmt.Errorf("failed to resume question: %w", err)
 		}
 	}
 
-	// Pause the question
-	if err := questionOps.PauseQuestion(f.Context(), projectID, parsedID); err != nil {
-		return fmt.Errorf("failed to pause question: %w", err)
+	// Re-fetch to report the state Basecamp actually has now
+	updated, err := ops.GetQuestion(ctx, projectID, questionID)
+	if err != nil {
+		_, err = fmt.Fprintf(w, "✓ Check-in question #%d (%s) is now %s\n", questionID, question.Title, pausedState(paused))
+		return err
 	}
+	if updated.Paused != paused {
+		return fmt.Errorf("check-in question #%d is still %s", questionID, pausedState(updated.Paused))
+	}
+	_, err = fmt.Fprintf(w, "✓ Check-in question #%d (%s) is now %s\n", questionID, updated.Title, pausedState(updated.Paused))
+	return err
+}
 
-	fmt.Printf("Check-in question %d has been paused.\n", parsedID)
-	return nil
+func pausedState(paused bool) string {
+	if paused {
+		return "paused"
+	}
+	return "active"
 }
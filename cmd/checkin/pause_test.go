@@ -0,0 +1,55 @@
+package checkin
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/api/mock"
+)
+
+func TestSetQuestionPaused(t *testing.T) {
+	t.Run("pauses and confirms", func(t *testing.T) {
+		client := mock.NewMockClient()
+		client.Question = &api.Question{ID: 7, Title: "Standup"}
+		var out bytes.Buffer
+
+		require.NoError(t, setQuestionPaused(context.Background(), client, "10", 7, true, &out))
+		assert.Equal(t, "✓ Check-in question #7 (Standup) is now paused\n", out.String())
+		assert.Equal(t, []string{"GetQuestion(10, 7)", "PauseQuestion(10, 7)", "GetQuestion(10, 7)"}, client.Calls)
+	})
+
+	t.Run("resumes a paused question", func(t *testing.T) {
+		client := mock.NewMockClient()
+		client.Question = &api.Question{ID: 7, Title: "Standup", Paused: true}
+		var out bytes.Buffer
+
+		require.NoError(t, setQuestionPaused(context.Background(), client, "10", 7, false, &out))
+		assert.Equal(t, "✓ Check-in question #7 (Standup) is now active\n", out.String())
+		assert.Contains(t, client.Calls, "ResumeQuestion(10, 7)")
+	})
+
+	t.Run("already in the requested state", func(t *testing.T) {
+		client := mock.NewMockClient()
+		client.Question = &api.Question{ID: 7, Title: "Standup", Paused: true}
+		var out bytes.Buffer
+
+		require.NoError(t, setQuestionPaused(context.Background(), client, "10", 7, true, &out))
+		assert.Equal(t, "Check-in question #7 (Standup) is already paused\n", out.String())
+		assert.Equal(t, []string{"GetQuestion(10, 7)"}, client.Calls)
+	})
+
+	t.Run("failure is returned", func(t *testing.T) {
+		client := mock.NewMockClient()
+		client.Question = &api.Question{ID: 7, Title: "Standup"}
+		client.PauseQuestionError = errors.New("boom")
+
+		err := setQuestionPaused(context.Background(), client, "10", 7, true, &bytes.Buffer{})
+		assert.EqualError(t, err, "failed to pause question: boom")
+	})
+}
@@ -4,40 +4,57 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"sort"
 	"strconv"
 	"time"
 
+	"github.com/needmore/bc4/internal/api"
 	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/ui"
 	"github.com/needmore/bc4/internal/ui/tableprinter"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
 
 type remindersOptions struct {
-	jsonOutput bool
+	format ui.OutputFormat
 }
 
-func newRemindersCmd(f *factory.Factory) *cobra.Command {
+// NewRemindersCmd creates the reminders command. It is available both as
+// 'checkin reminders' and as the top-level 'reminders'.
+func NewRemindersCmd(f *factory.Factory) *cobra.Command {
 	opts := &remindersOptions{}
+	var formatStr string
 
 	cmd := &cobra.Command{
 		Use:   "reminders",
 		Short: "List your pending check-in reminders",
 		Long: `List all pending check-in reminders for your account.
 
-Shows check-ins that are due for you to answer.`,
+Shows the check-ins that are due for you to answer, soonest first. Reminders
+whose time has passed are marked overdue.`,
 		Example: `  # List pending reminders
+  bc4 reminders
   bc4 checkin reminders
 
   # Output as JSON
-  bc4 checkin reminders --json`,
+  bc4 reminders --format json`,
 		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			opts.jsonOutput = viper.GetBool("json")
+			format, err := ui.ResolveTableOrJSON(formatStr)
+			if err != nil {
+				return err
+			}
+			if viper.GetBool("json") {
+				format = ui.OutputFormatJSON
+			}
+			opts.format = format
 			return runReminders(f, opts)
 		},
 	}
 
+	cmd.Flags().StringVarP(&formatStr, "format", "f", "table", "Output format: table or json")
+
 	return cmd
 }
 
@@ -53,31 +70,44 @@ func runReminders(f *factory.Factory, opts *remindersOptions) error {
 	if err != nil {
 		return fmt.Errorf("failed to list reminders: %w", err)
 	}
+	sortReminders(reminders)
 
-	if opts.jsonOutput {
+	if opts.format == ui.OutputFormatJSON {
 		encoder := json.NewEncoder(os.Stdout)
 		encoder.SetIndent("", "  ")
 		return encoder.Encode(reminders)
 	}
 
 	if len(reminders) == 0 {
-		fmt.Println("No pending check-in reminders.")
+		fmt.Println("No pending check-in reminders. You're all caught up!")
 		return nil
 	}
 
-	// Create table
-	table := tableprinter.New(os.Stdout)
+	return renderReminders(tableprinter.New(os.Stdout), reminders, time.Now())
+}
+
+// sortReminders orders reminders by when they are due, soonest first
+func sortReminders(reminders []api.QuestionReminder) {
+	sort.SliceStable(reminders, func(i, j int) bool {
+		return reminders[i].RemindAt.Before(reminders[j].RemindAt)
+	})
+}
+
+// renderReminders writes reminders as a table, highlighting the ones whose
+// reminder time has already passed
+func renderReminders(table *tableprinter.TablePrinter, reminders []api.QuestionReminder, now time.Time) error {
+	cs := table.GetColorScheme()
 
 	// Add headers
 	if table.IsTTY() {
-		table.AddHeader("QUESTION ID", "QUESTION", "PROJECT", "REMIND AT", "GROUP ON")
+		table.AddHeader("QUESTION ID", "QUESTION", "PROJECT", "REMIND AT", "GROUP ON", "STATUS")
 	} else {
-		table.AddHeader("QUESTION_ID", "QUESTION", "PROJECT", "REMIND_AT", "GROUP_ON")
+		table.AddHeader("QUESTION_ID", "QUESTION", "PROJECT", "REMIND_AT", "GROUP_ON", "STATUS")
 	}
 
-	now := time.Now()
-
 	for _, r := range reminders {
+		overdue := r.RemindAt.Before(now)
+
 		// Question ID
 		table.AddIDField(strconv.FormatInt(r.QuestionID, 10), "active")
 
@@ -98,12 +128,22 @@ func runReminders(f *factory.Factory, opts *remindersOptions) error {
 		}
 		table.AddField(projectName)
 
-		// Remind at
-		table.AddTimeField(now, r.RemindAt)
+		// Remind at, in red once it has passed
+		if overdue && table.IsTTY() {
+			table.AddField(ui.HumanTime(now, r.RemindAt), cs.Red)
+		} else {
+			table.AddTimeField(now, r.RemindAt)
+		}
 
 		// Group on (date)
 		table.AddField(r.GroupOn)
 
+		if overdue {
+			table.AddField("overdue", cs.Red)
+		} else {
+			table.AddField("pending", cs.Muted)
+		}
+
 		table.EndRow()
 	}
 
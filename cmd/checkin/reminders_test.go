@@ -0,0 +1,34 @@
+package checkin
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/ui/tableprinter"
+)
+
+func TestRenderReminders(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	reminders := []api.QuestionReminder{
+		{QuestionID: 2, RemindAt: now.Add(2 * time.Hour), GroupOn: "2024-05-01", Question: &api.Question{Title: "Weekly goals"}},
+		{QuestionID: 1, RemindAt: now.Add(-time.Hour), GroupOn: "2024-05-01", Question: &api.Question{Title: "Standup"}, Bucket: &api.Bucket{Name: "Ops"}},
+	}
+	sortReminders(reminders)
+	assert.Equal(t, int64(1), reminders[0].QuestionID)
+
+	var out bytes.Buffer
+	require.NoError(t, renderReminders(tableprinter.NewWithOptions(&out, false, 200), reminders, now))
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 3)
+	assert.Contains(t, lines[1], "Standup")
+	assert.Contains(t, lines[1], "overdue")
+	assert.Contains(t, lines[2], "Weekly goals")
+	assert.Contains(t, lines[2], "pending")
+}
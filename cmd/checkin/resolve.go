@@ -0,0 +1,86 @@
+package checkin
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/parser"
+	"github.com/needmore/bc4/internal/utils"
+)
+
+// resolveQuestionArg resolves a question given as an ID, a URL, or part of
+// its title. It returns the factory and project the question lives in, which
+// a URL may change, along with the question ID.
+func resolveQuestionArg(f *factory.Factory, arg string) (*factory.Factory, string, int64, error) {
+	if parser.IsBasecampURL(arg) {
+		parsed, err := parser.ParseBasecampURL(arg)
+		if err != nil {
+			return nil, "", 0, fmt.Errorf("invalid question URL: %s", arg)
+		}
+		if parsed.ResourceType != parser.ResourceTypeQuestion {
+			return nil, "", 0, fmt.Errorf("URL is not for a check-in question: %s", arg)
+		}
+		if parsed.AccountID > 0 {
+			f = f.WithAccount(strconv.FormatInt(parsed.AccountID, 10))
+		}
+		if parsed.ProjectID > 0 {
+			f = f.WithProject(strconv.FormatInt(parsed.ProjectID, 10))
+		}
+		projectID, err := f.ProjectID()
+		if err != nil {
+			return nil, "", 0, err
+		}
+		return f, projectID, parsed.ResourceID, nil
+	}
+
+	projectID, err := f.ProjectID()
+	if err != nil {
+		return nil, "", 0, err
+	}
+
+	if id, err := strconv.ParseInt(arg, 10, 64); err == nil {
+		return f, projectID, id, nil
+	}
+
+	client, err := f.ApiClient()
+	if err != nil {
+		return nil, "", 0, err
+	}
+	question, err := findQuestionByTitle(f.Context(), client.Questions(), projectID, arg)
+	if err != nil {
+		return nil, "", 0, err
+	}
+	return f, projectID, question.ID, nil
+}
+
+// findQuestionByTitle finds the project's check-in question whose title
+// matches title exactly, ignoring case, or else the only one containing it
+func findQuestionByTitle(ctx context.Context, ops api.QuestionOperations, projectID, title string) (*api.Question, error) {
+	questionnaire, err := ops.GetProjectQuestionnaire(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get questionnaire: %w", err)
+	}
+	questions, err := ops.ListQuestions(ctx, projectID, questionnaire.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list questions: %w", err)
+	}
+
+	matches, _ := utils.MatchIDOrName(questions, title, true, nil, func(q api.Question) string { return q.Title })
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("check-in question not found: %s", title)
+	case 1:
+		return &questions[matches[0]], nil
+	}
+
+	options := make([]string, 0, len(matches))
+	for _, i := range matches {
+		options = append(options, fmt.Sprintf("%s (ID: %d)", questions[i].Title, questions[i].ID))
+	}
+	return nil, fmt.Errorf("multiple check-in questions match '%s': %s. Please be more specific or use the question ID",
+		title, strings.Join(options, ", "))
+}
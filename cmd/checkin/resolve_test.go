@@ -0,0 +1,35 @@
+package checkin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/api/mock"
+)
+
+func TestFindQuestionByTitle(t *testing.T) {
+	client := mock.NewMockClient()
+	client.Questions = []api.Question{
+		{ID: 1, Title: "What did you work on today?"},
+		{ID: 2, Title: "What will you work on this week?"},
+		{ID: 3, Title: "Standup"},
+	}
+
+	q, err := findQuestionByTitle(context.Background(), client, "10", "this week")
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), q.ID)
+
+	q, err = findQuestionByTitle(context.Background(), client, "10", "STANDUP")
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), q.ID)
+
+	_, err = findQuestionByTitle(context.Background(), client, "10", "work on")
+	assert.ErrorContains(t, err, "multiple check-in questions match")
+
+	_, err = findQuestionByTitle(context.Background(), client, "10", "retro")
+	assert.EqualError(t, err, "check-in question not found: retro")
+}
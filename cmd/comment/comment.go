@@ -19,8 +19,10 @@ documents, and cards. Use these commands to view and manage conversations
 on your team's work.`,
 		Example: `  bc4 comment list 12345              # List comments on a recording (todo, message, etc.)
   bc4 comment create 12345            # Add a comment to a recording
+  bc4 comment add <url> -m "Done!"    # Add a comment to any recording by URL
   bc4 comment view 67890              # View a specific comment
-  bc4 comment edit 67890              # Edit a comment`,
+  bc4 comment edit 67890              # Edit a comment
+  bc4 comment rm 67890                # Delete a comment`,
 	}
 
 	// Enable suggestions for subcommand typos
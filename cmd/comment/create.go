@@ -1,6 +1,7 @@
 package comment
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
@@ -26,15 +27,22 @@ func newCreateCmd(f *factory.Factory) *cobra.Command {
 	var projectIDFlag string
 
 	cmd := &cobra.Command{
-		Use:   "create <recording-id|url>",
-		Short: "Create a comment",
+		Use:     "create <recording-id|url>",
+		Aliases: []string{"add"},
+		Short:   "Create a comment",
 		Long: `Create a new comment on a Basecamp recording (todo, message, document, or card).
 
+The recording can be given as an ID (using the default project) or as its
+Basecamp URL, e.g. a message's URL to reply to it.
+
 You can provide comment content in several ways:
   - Interactively (default)
-  - Via --content flag
+  - Via --content (or --message) flag
   - Via stdin: echo "content" | bc4 comment create <recording-id|url>
   - From file: cat comment.md | bc4 comment create <recording-id|url>`,
+		Example: `  bc4 comment add 12345 -m "Looks good"
+  bc4 comment add https://3.basecamp.com/1234567/buckets/89012345/messages/34567890 -m "Thanks for the update"
+  cat reply.md | bc4 comment create 12345`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Apply account override if specified
@@ -47,42 +55,18 @@ You can provide comment content in several ways:
 				f = f.WithProject(projectIDFlag)
 			}
 
-			// Get API client from factory
-			client, err := f.ApiClient()
+			// Parse the argument - could be a URL or ID for any recording.
+			// A URL can switch the account, so this comes before the client.
+			f, target, err := resolveCommentTarget(f, args[0], accountID, projectIDFlag)
 			if err != nil {
 				return err
 			}
+			projectID := target.projectID
 
-			var recordingID int64
-			var projectID string
-
-			// Parse the argument - could be a URL or ID
-			if parser.IsBasecampURL(args[0]) {
-				parsed, err := parser.ParseBasecampURL(args[0])
-				if err != nil {
-					return fmt.Errorf("invalid Basecamp URL: %w", err)
-				}
-				recordingID = parsed.ResourceID
-				// Use flag value if provided, otherwise use URL's project ID
-				if projectIDFlag != "" {
-					projectID = projectIDFlag
-				} else {
-					projectID = strconv.FormatInt(parsed.ProjectID, 10)
-				}
-				// Override factory with URL-provided account if flag not set
-				if accountID == "" && parsed.AccountID > 0 {
-					f = f.WithAccount(strconv.FormatInt(parsed.AccountID, 10))
-				}
-			} else {
-				// It's just an ID, we need the project ID from config
-				recordingID, err = strconv.ParseInt(args[0], 10, 64)
-				if err != nil {
-					return fmt.Errorf("invalid recording ID: %s", args[0])
-				}
-				projectID, err = f.ProjectID()
-				if err != nil {
-					return err
-				}
+			// Get API client from factory, once the account is known
+			client, err := f.ApiClient()
+			if err != nil {
+				return err
 			}
 
 			// Check if stdin has data
@@ -144,31 +128,88 @@ You can provide comment content in several ways:
 				richContent += tag
 			}
 
-			// Create the comment
-			req := api.CommentCreateRequest{
-				Content: richContent,
-			}
-
-			comment, err := client.CreateComment(f.Context(), projectID, recordingID, req)
-			if err != nil {
-				return err
-			}
-
-			// Output
-			if ui.IsTerminal(os.Stdout) {
-				fmt.Printf("✓ Created comment #%d\n", comment.ID)
-			} else {
-				fmt.Println(comment.ID)
-			}
-
-			return nil
+			return createComment(f.Context(), client.Comments(), target, richContent, os.Stdout)
 		},
 	}
 
 	cmd.Flags().StringVar(&content, "content", "", "Comment content (Markdown)")
+	cmd.Flags().StringVarP(&content, "message", "m", "", "Comment content (Markdown, same as --content)")
 	cmd.Flags().StringVar(&attachmentPath, "attach", "", "Path to file to attach to the comment")
 	cmd.Flags().StringVarP(&accountID, "account", "a", "", "Specify account ID")
 	cmd.Flags().StringVarP(&projectIDFlag, "project", "p", "", "Specify project ID")
 
 	return cmd
 }
+
+// commentTarget is the recording a comment is posted on
+type commentTarget struct {
+	projectID   string
+	recordingID int64
+}
+
+// resolveCommentTarget works out the project and recording a
+// <recording-id|url> argument refers to. A URL's project is used unless
+// --project was given, and its account unless --account was; a bare ID is
+// in the default project. The returned factory has the account applied.
+func resolveCommentTarget(f *factory.Factory, arg, accountID, projectIDFlag string) (*factory.Factory, *commentTarget, error) {
+	recordingID, parsed, err := parseRecordingArg(arg)
+	if err != nil {
+		return f, nil, err
+	}
+
+	target := &commentTarget{recordingID: recordingID}
+	if parsed != nil {
+		// Use flag value if provided, otherwise use URL's project ID
+		if projectIDFlag != "" {
+			target.projectID = projectIDFlag
+		} else {
+			target.projectID = strconv.FormatInt(parsed.ProjectID, 10)
+		}
+		// Use the URL's account unless one was given explicitly
+		if accountID == "" && parsed.AccountID > 0 {
+			f = f.WithAccount(strconv.FormatInt(parsed.AccountID, 10))
+		}
+		return f, target, nil
+	}
+
+	// It's just an ID, we need the project ID from config
+	target.projectID, err = f.ProjectID()
+	if err != nil {
+		return f, nil, err
+	}
+	return f, target, nil
+}
+
+// createComment posts richContent as a comment on target and writes the new
+// comment's ID to w
+func createComment(ctx context.Context, comments api.CommentOperations, target *commentTarget, richContent string, w io.Writer) error {
+	comment, err := comments.CreateComment(ctx, target.projectID, target.recordingID, api.CommentCreateRequest{
+		Content: richContent,
+	})
+	if err != nil {
+		return err
+	}
+
+	if ui.IsTerminal(os.Stdout) {
+		fmt.Fprintf(w, "✓ Created comment #%d\n", comment.ID)
+	} else {
+		fmt.Fprintln(w, comment.ID)
+	}
+	return nil
+}
+
+// parseRecordingArg parses a <recording-id|url> argument. Any recording that
+// takes comments can be given, so only URLs for things that can't are refused.
+func parseRecordingArg(arg string) (int64, *parser.ParsedURL, error) {
+	recordingID, parsed, err := parser.ParseArgument(arg)
+	if err != nil {
+		return 0, nil, fmt.Errorf("invalid recording ID or URL: %w", err)
+	}
+	if parsed != nil {
+		switch parsed.ResourceType {
+		case parser.ResourceTypeProject, parser.ResourceTypeComment:
+			return 0, nil, fmt.Errorf("cannot comment on a %s URL; give the URL of the todo, message, document, or card instead", parsed.ResourceType)
+		}
+	}
+	return recordingID, parsed, nil
+}
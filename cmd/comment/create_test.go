@@ -0,0 +1,63 @@
+package comment
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/api/mock"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/parser"
+)
+
+func TestParseRecordingArg_Message(t *testing.T) {
+	for _, url := range []string{
+		"https://3.basecamp.com/1234567/buckets/89012345/messages/34567890",
+		"https://3.basecamp.com/1234567/buckets/89012345/messages/34567890#__recording_45678901",
+	} {
+		recordingID, parsed, err := parseRecordingArg(url)
+		require.NoError(t, err, url)
+		require.NotNil(t, parsed)
+		assert.Equal(t, parser.ResourceTypeMessage, parsed.ResourceType)
+		assert.Equal(t, int64(34567890), recordingID, "the message ID is the recording to comment on")
+
+		// The comment is posted to the message's recording in the URL's
+		// project and account
+		f, target, err := resolveCommentTarget(factory.New(), url, "", "")
+		require.NoError(t, err, url)
+		accountID, err := f.AccountID()
+		require.NoError(t, err)
+		assert.Equal(t, "1234567", accountID)
+
+		client := mock.NewMockClient()
+		client.CreatedComment = &api.Comment{ID: 1}
+		var out bytes.Buffer
+		require.NoError(t, createComment(context.Background(), client, target, "<div>Thanks</div>", &out))
+		assert.Equal(t, []string{"CreateComment(89012345, 34567890)"}, client.Calls)
+		assert.Equal(t, "1\n", out.String())
+	}
+}
+
+func TestResolveCommentTarget_ProjectFlag(t *testing.T) {
+	url := "https://3.basecamp.com/1234567/buckets/89012345/messages/34567890"
+	_, target, err := resolveCommentTarget(factory.New(), url, "", "42")
+	require.NoError(t, err)
+	assert.Equal(t, &commentTarget{projectID: "42", recordingID: 34567890}, target)
+}
+
+func TestParseRecordingArg(t *testing.T) {
+	recordingID, parsed, err := parseRecordingArg("12345")
+	require.NoError(t, err)
+	assert.Equal(t, int64(12345), recordingID)
+	assert.Nil(t, parsed)
+
+	_, _, err = parseRecordingArg("https://3.basecamp.com/1234567/buckets/89012345/comments/555")
+	assert.ErrorContains(t, err, "cannot comment on a comment URL")
+
+	_, _, err = parseRecordingArg("not-an-id")
+	assert.ErrorContains(t, err, "invalid recording ID or URL")
+}
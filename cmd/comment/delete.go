@@ -18,10 +18,11 @@ func newDeleteCmd(f *factory.Factory) *cobra.Command {
 	var skipConfirm bool
 
 	cmd := &cobra.Command{
-		Use:   "delete <comment-id|url>",
-		Short: "Delete a comment",
-		Long:  `Trash a comment on a recording. Trashed comments can be recovered from the Basecamp trash.`,
-		Args:  cobra.ExactArgs(1),
+		Use:     "delete <comment-id|url>",
+		Aliases: []string{"rm"},
+		Short:   "Delete a comment",
+		Long:    `Trash a comment on a recording. Trashed comments can be recovered from the Basecamp trash.`,
+		Args:    cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Apply overrides if specified
 			if accountID != "" {
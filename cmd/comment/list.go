@@ -1,24 +1,41 @@
 package comment
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 
+	"github.com/needmore/bc4/internal/api"
 	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/markdown"
 	"github.com/needmore/bc4/internal/parser"
+	"github.com/needmore/bc4/internal/ui"
 	"github.com/needmore/bc4/internal/ui/tableprinter"
+	"github.com/needmore/bc4/internal/utils"
 	"github.com/spf13/cobra"
 )
 
 func newListCmd(f *factory.Factory) *cobra.Command {
 	var accountID string
 	var projectID string
+	var formatStr string
+	var sortOrder string
 
 	cmd := &cobra.Command{
-		Use:     "list <recording-id|url>",
-		Short:   "List comments on a recording",
-		Long:    `List all comments on a Basecamp recording (todo, message, document, or card).`,
+		Use:   "list <recording-id|url>",
+		Short: "List comments on a recording",
+		Long: `List all comments on a Basecamp recording (todo, message, document, or card).
+
+The recording can be given as an ID (using the default project) or as any
+Basecamp recording URL.
+
+Comments are listed newest first. Use --sort asc to list them oldest first.`,
+		Example: `  bc4 comment list 12345
+  bc4 comment list https://3.basecamp.com/1234567/buckets/89012345/todos/12345
+  bc4 comment list 12345 --format json
+  bc4 comment list 12345 --sort asc`,
 		Aliases: []string{"ls"},
 		Args:    cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -30,40 +47,59 @@ func newListCmd(f *factory.Factory) *cobra.Command {
 				f = f.WithProject(projectID)
 			}
 
-			// Get API client from factory
-			client, err := f.ApiClient()
+			// Check output format before fetching
+			format, err := ui.ParseOutputFormat(formatStr)
 			if err != nil {
 				return err
 			}
+			if err := utils.ValidateCommentSort(sortOrder); err != nil {
+				return err
+			}
 
-			var recordingID int64
-			var projectID string
+			// Parse the argument - could be a URL or ID for any recording
+			recordingID, parsed, err := parser.ParseArgument(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid recording ID or URL: %w", err)
+			}
 
-			// Parse the argument - could be a URL or ID
-			if parser.IsBasecampURL(args[0]) {
-				parsed, err := parser.ParseBasecampURL(args[0])
-				if err != nil {
-					return fmt.Errorf("invalid Basecamp URL: %w", err)
+			var resolvedProjectID string
+			if parsed != nil {
+				resolvedProjectID = strconv.FormatInt(parsed.ProjectID, 10)
+				// Use the URL's account unless one was given explicitly
+				if accountID == "" && parsed.AccountID > 0 {
+					f = f.WithAccount(strconv.FormatInt(parsed.AccountID, 10))
 				}
-				recordingID = parsed.ResourceID
-				projectID = strconv.FormatInt(parsed.ProjectID, 10)
 			} else {
-				// It's just an ID, we need the project ID from config
-				recordingID, err = strconv.ParseInt(args[0], 10, 64)
-				if err != nil {
-					return fmt.Errorf("invalid recording ID: %s", args[0])
-				}
-				projectID, err = f.ProjectID()
+				resolvedProjectID, err = f.ProjectID()
 				if err != nil {
 					return err
 				}
 			}
 
+			// Get API client from factory
+			client, err := f.ApiClient()
+			if err != nil {
+				return err
+			}
+
 			// Get comments
-			comments, err := client.ListComments(f.Context(), projectID, recordingID)
+			comments, err := client.ListComments(f.Context(), resolvedProjectID, recordingID)
 			if err != nil {
 				return err
 			}
+			utils.SortComments(comments, sortOrder)
+
+			if format == ui.OutputFormatJSON {
+				if comments == nil {
+					comments = []api.Comment{}
+				}
+				encoder := json.NewEncoder(os.Stdout)
+				encoder.SetIndent("", "  ")
+				return encoder.Encode(comments)
+			}
+			if format == ui.OutputFormatJSONL {
+				return ui.WriteJSONLines(os.Stdout, comments, nil)
+			}
 
 			if len(comments) == 0 {
 				fmt.Println("No comments found")
@@ -74,17 +110,12 @@ func newListCmd(f *factory.Factory) *cobra.Command {
 			table := tableprinter.New(os.Stdout)
 			table.AddHeader("ID", "AUTHOR", "CREATED", "PREVIEW")
 
+			converter := markdown.NewConverter()
 			for _, comment := range comments {
-				// Create a short preview
-				preview := comment.Content
-				if len(preview) > 60 {
-					preview = preview[:60] + "..."
-				}
-
 				table.AddIDField(strconv.FormatInt(comment.ID, 10), comment.Status)
 				table.AddField(comment.Creator.Name)
 				table.AddField(comment.CreatedAt.Format("Jan 2, 2006"))
-				table.AddField(preview)
+				table.AddField(commentPreview(converter, comment.Content))
 				table.EndRow()
 			}
 
@@ -94,6 +125,23 @@ func newListCmd(f *factory.Factory) *cobra.Command {
 
 	cmd.Flags().StringVarP(&accountID, "account", "a", "", "Specify account ID")
 	cmd.Flags().StringVarP(&projectID, "project", "p", "", "Specify project ID")
+	cmd.Flags().StringVarP(&formatStr, "format", "f", "table", "Output format: table, json, or jsonl")
+	cmd.Flags().StringVar(&sortOrder, "sort", utils.CommentSortDesc, "Sort by creation time: asc (oldest first) or desc (newest first)")
 
 	return cmd
 }
+
+// commentPreview returns a single-line Markdown preview of a comment's rich text
+func commentPreview(converter markdown.Converter, content string) string {
+	preview, err := converter.RichTextToMarkdown(content)
+	if err != nil {
+		preview = content
+	}
+	preview = strings.Join(strings.Fields(preview), " ")
+
+	runes := []rune(preview)
+	if len(runes) > 60 {
+		preview = string(runes[:60]) + "..."
+	}
+	return preview
+}
@@ -0,0 +1,27 @@
+package comment
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/needmore/bc4/internal/markdown"
+)
+
+func TestCommentPreview(t *testing.T) {
+	converter := markdown.NewConverter()
+
+	t.Run("converts rich text to single-line markdown", func(t *testing.T) {
+		preview := commentPreview(converter, "<div>Looks <strong>good</strong></div><div>Ship it</div>")
+		assert.NotContains(t, preview, "<")
+		assert.NotContains(t, preview, "\n")
+		assert.Contains(t, preview, "**good**")
+	})
+
+	t.Run("truncates long comments", func(t *testing.T) {
+		preview := commentPreview(converter, "<div>"+strings.Repeat("word ", 30)+"</div>")
+		assert.True(t, strings.HasSuffix(preview, "..."))
+		assert.Len(t, []rune(preview), 63)
+	})
+}
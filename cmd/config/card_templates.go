@@ -0,0 +1,85 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/needmore/bc4/internal/config"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/ui/tableprinter"
+)
+
+func newCardTemplatesCmd(f *factory.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "card-templates",
+		Short: "Work with card templates",
+		Long: `Card templates are reusable card definitions used by
+'bc4 card create --from-template <name>'. They live under card_templates in
+the config file; use 'bc4 config edit' to add or change them:
+
+  "card_templates": {
+    "bug": {
+      "column": "Triage",
+      "title": "Bug: {date}",
+      "content": "**Steps to reproduce**",
+      "assignees": ["@jane"],
+      "steps": ["Reproduce", "Fix", "Verify"]
+    }
+  }
+
+The column is matched by name or ID in the target card table. {date} in the
+title is replaced with the creation date.`,
+	}
+
+	cmd.AddCommand(newCardTemplatesListCmd(f))
+
+	return cmd
+}
+
+func newCardTemplatesListCmd(f *factory.Factory) *cobra.Command {
+	return &cobra.Command{
+		Use:     "list",
+		Short:   "List card templates",
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := f.Config()
+			if err != nil {
+				return fmt.Errorf("failed to load config: %w", err)
+			}
+
+			names := cfg.CardTemplateNames()
+			if len(names) == 0 {
+				fmt.Println("No card templates defined. Add them under card_templates with 'bc4 config edit'.")
+				return nil
+			}
+			return renderCardTemplates(cfg, names)
+		},
+	}
+}
+
+func renderCardTemplates(cfg *config.Config, names []string) error {
+	table := tableprinter.New(os.Stdout)
+	table.AddHeader("NAME", "COLUMN", "TITLE", "ASSIGNEES", "STEPS")
+	for _, name := range names {
+		tmpl := cfg.CardTemplates[name]
+		table.AddField(name)
+		table.AddField(orDash(tmpl.Column))
+		table.AddField(orDash(tmpl.Title))
+		table.AddField(orDash(strings.Join(tmpl.Assignees, ", ")))
+		table.AddField(strconv.Itoa(len(tmpl.Steps)))
+		table.EndRow()
+	}
+	return table.Render()
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
@@ -0,0 +1,28 @@
+package config
+
+import (
+	"github.com/needmore/bc4/internal/cmdutil"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/spf13/cobra"
+)
+
+// NewConfigCmd creates the config command
+func NewConfigCmd(f *factory.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Manage bc4 configuration",
+		Long:  `Work with the bc4 configuration file directly.`,
+	}
+
+	// Enable suggestions for subcommand typos
+	cmdutil.EnableSuggestions(cmd)
+
+	// Add subcommands
+	cmd.AddCommand(newEditCmd(f))
+	cmd.AddCommand(newSetDefaultCmd(f))
+	cmd.AddCommand(newCardTemplatesCmd(f))
+	cmd.AddCommand(newExportCmd(f))
+	cmd.AddCommand(newImportCmd(f))
+
+	return cmd
+}
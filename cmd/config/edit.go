@@ -0,0 +1,47 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/needmore/bc4/internal/config"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+func newEditCmd(f *factory.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "edit",
+		Short: "Open the config file in your editor",
+		Long: `Open the bc4 config file in your editor.
+
+The editor is taken from the editor preference in the config, then $VISUAL,
+then $EDITOR. You edit a copy of the file; when the editor exits, the copy is
+checked, and if it is not valid JSON or contains unknown settings, your
+changes are discarded and the problem is shown. Otherwise it replaces the
+config file, and a copy of the previous file is kept next to it with a .bak
+suffix.`,
+		Example: `  bc4 config edit
+  EDITOR="code --wait" bc4 config edit`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// A broken config shouldn't stop you from fixing it
+			var preferred string
+			if cfg, err := f.Config(); err == nil {
+				preferred = cfg.Preferences.Editor
+			}
+			editor := utils.ResolveEditor(preferred)
+
+			if err := config.Edit(func(content string) (string, error) {
+				return utils.EditText(editor, content, "bc4-config-*.json")
+			}); err != nil {
+				return err
+			}
+
+			fmt.Printf("Saved %s\n", config.GetConfigPath())
+			return nil
+		},
+	}
+
+	return cmd
+}
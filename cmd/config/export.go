@@ -0,0 +1,44 @@
+package config
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/needmore/bc4/internal/config"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/spf13/cobra"
+)
+
+func newExportCmd(f *factory.Factory) *cobra.Command {
+	var includeSecrets bool
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Print your settings as JSON for another machine",
+		Long: `Print the bc4 settings - accounts, project defaults, preferences, saved
+views, card templates, and aliases - as JSON, to restore elsewhere with
+'bc4 config import'.
+
+Authentication tokens are kept in a separate file and are never exported.
+The OAuth client ID and secret are left out unless --include-secrets is
+given; keep such a backup somewhere private.`,
+		Example: `  bc4 config export > bc4-backup.json
+  bc4 config export --include-secrets > bc4-backup.json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			data, err := config.Export(includeSecrets)
+			if err != nil {
+				return err
+			}
+			if includeSecrets {
+				fmt.Fprintln(os.Stderr, "Warning: the export includes your OAuth client secret")
+			}
+			_, err = os.Stdout.Write(data)
+			return err
+		},
+	}
+
+	cmd.Flags().BoolVar(&includeSecrets, "include-secrets", false, "Include the OAuth client ID and secret")
+
+	return cmd
+}
@@ -0,0 +1,56 @@
+package config
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/needmore/bc4/internal/config"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/spf13/cobra"
+)
+
+func newImportCmd(f *factory.Factory) *cobra.Command {
+	var replace bool
+
+	cmd := &cobra.Command{
+		Use:   "import <file>",
+		Short: "Load settings saved with 'config export'",
+		Long: `Load bc4 settings from a file written by 'bc4 config export'. Use - to read
+from standard input.
+
+The file is checked against the config layout before anything is written,
+and settings from older bc4 versions are upgraded. By default the imported
+settings are merged in: accounts, project defaults, views, templates, and
+aliases you don't have yet are added, and settings you already have are
+kept. Use --replace to use the imported settings instead of the current ones.
+Your client credentials are kept unless the file includes its own.`,
+		Example: `  bc4 config import bc4-backup.json
+  bc4 config import bc4-backup.json --replace
+  ssh old-machine bc4 config export | bc4 config import -`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var data []byte
+			var err error
+			if args[0] == "-" {
+				data, err = io.ReadAll(os.Stdin)
+			} else {
+				data, err = os.ReadFile(args[0])
+			}
+			if err != nil {
+				return fmt.Errorf("failed to read %s: %w", args[0], err)
+			}
+
+			if err := config.Import(data, replace); err != nil {
+				return err
+			}
+
+			fmt.Printf("Imported settings into %s\n", config.GetConfigPath())
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&replace, "replace", false, "Replace the current settings instead of merging")
+
+	return cmd
+}
@@ -0,0 +1,191 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/auth"
+	"github.com/needmore/bc4/internal/config"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/utils"
+)
+
+func newSetDefaultCmd(f *factory.Factory) *cobra.Command {
+	var accountID string
+
+	cmd := &cobra.Command{
+		Use:   "set-default <account|project> <id|name>",
+		Short: "Set the default account or project",
+		Long: `Set the default account or project by ID or name, without prompting.
+
+Names are matched case-insensitively, exactly first and then as a unique
+partial match. A project must belong to the current default account, or to
+the account given with --account. Setting the account also updates the
+default in the auth store, and clears the default project when the account
+changes.`,
+		Example: `  bc4 config set-default account "Acme Inc"
+  bc4 config set-default project 12345
+  bc4 config set-default project "Website Redesign" --account 67890`,
+		Args:      cobra.ExactArgs(2),
+		ValidArgs: []string{"account", "project"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			switch args[0] {
+			case "account":
+				if accountID != "" {
+					return fmt.Errorf("--account can only be used with set-default project")
+				}
+				return setDefaultAccount(f, args[1])
+			case "project":
+				return setDefaultProject(f, accountID, args[1])
+			default:
+				return fmt.Errorf("unknown default %q: must be account or project", args[0])
+			}
+		},
+	}
+
+	cmd.Flags().StringVarP(&accountID, "account", "a", "", "Account the project belongs to (defaults to the default account)")
+
+	return cmd
+}
+
+func setDefaultAccount(f *factory.Factory, arg string) error {
+	authClient, err := f.AuthClient()
+	if err != nil {
+		return err
+	}
+
+	account, err := findAccount(authClient.GetAccounts(), arg)
+	if err != nil {
+		return err
+	}
+
+	// Keep the auth store's default in sync with the config
+	if err := authClient.SetDefaultAccount(account.AccountID); err != nil {
+		return fmt.Errorf("failed to set default account: %w", err)
+	}
+
+	var changingAccounts bool
+	var accountCfg config.AccountConfig
+	err = config.Update(func(cfg *config.Config) error {
+		changingAccounts = cfg.DefaultAccount != "" && cfg.DefaultAccount != account.AccountID
+
+		cfg.DefaultAccount = account.AccountID
+		if cfg.Accounts == nil {
+			cfg.Accounts = make(map[string]config.AccountConfig)
+		}
+		accountCfg = cfg.Accounts[account.AccountID]
+		if accountCfg.Name == "" {
+			accountCfg.Name = account.AccountName
+		}
+		if changingAccounts {
+			cfg.DefaultProject = ""
+		}
+		cfg.Accounts[account.AccountID] = accountCfg
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+
+	fmt.Printf("Default account set to: %s (ID: %s)\n", account.AccountName, account.AccountID)
+	if changingAccounts && accountCfg.DefaultProject == "" {
+		fmt.Println("Note: No default project is set for this account.")
+	}
+	return nil
+}
+
+func setDefaultProject(f *factory.Factory, accountID, arg string) error {
+	if accountID != "" {
+		f = f.WithAccount(accountID)
+	}
+	resolvedAccountID, err := f.AccountID()
+	if err != nil {
+		return err
+	}
+
+	client, err := f.ApiClient()
+	if err != nil {
+		return err
+	}
+	projects, err := client.Projects().GetProjects(f.Context())
+	if err != nil {
+		return fmt.Errorf("failed to fetch projects: %w", err)
+	}
+
+	project, err := findProject(projects, arg)
+	if err != nil {
+		return fmt.Errorf("%w in account %s", err, resolvedAccountID)
+	}
+	projectID := strconv.FormatInt(project.ID, 10)
+
+	err = config.Update(func(cfg *config.Config) error {
+		if cfg.Accounts == nil {
+			cfg.Accounts = make(map[string]config.AccountConfig)
+		}
+		accountCfg := cfg.Accounts[resolvedAccountID]
+		accountCfg.DefaultProject = projectID
+		cfg.Accounts[resolvedAccountID] = accountCfg
+		// The top-level default only applies to the default account
+		if resolvedAccountID == cfg.DefaultAccount || cfg.DefaultAccount == "" {
+			cfg.DefaultProject = projectID
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+
+	fmt.Printf("Default project set to: %s (ID: %s)\n", project.Name, projectID)
+	return nil
+}
+
+// findAccount resolves an account ID or name against the authenticated
+// accounts
+func findAccount(accounts map[string]auth.AccountToken, arg string) (*auth.AccountToken, error) {
+	if account, ok := accounts[arg]; ok {
+		return &account, nil
+	}
+
+	tokens := make([]auth.AccountToken, 0, len(accounts))
+	for _, account := range accounts {
+		tokens = append(tokens, account)
+	}
+	name := func(a auth.AccountToken) string { return a.AccountName }
+	matches, _ := utils.MatchIDOrName(tokens, arg, true, nil, name)
+	if len(matches) == 1 {
+		return &tokens[matches[0]], nil
+	}
+	return nil, nameMatchError(arg, "account", matches, tokens, name)
+}
+
+// findProject resolves a project ID or name against the account's projects
+func findProject(projects []api.Project, arg string) (*api.Project, error) {
+	id := func(p api.Project) int64 { return p.ID }
+	name := func(p api.Project) string { return p.Name }
+	matches, byID := utils.MatchIDOrName(projects, arg, true, id, name)
+	if len(matches) == 1 {
+		return &projects[matches[0]], nil
+	}
+	if byID {
+		return nil, fmt.Errorf("project %s not found", arg)
+	}
+	return nil, nameMatchError(arg, "project", matches, projects, name)
+}
+
+// nameMatchError reports that arg matched none or several of items
+func nameMatchError[T any](arg, kind string, matches []int, items []T, name func(T) string) error {
+	if len(matches) == 0 {
+		return fmt.Errorf("%s not found: %s", kind, arg)
+	}
+	matched := make([]string, len(matches))
+	for i, index := range matches {
+		matched[i] = name(items[index])
+	}
+	sort.Strings(matched)
+	return fmt.Errorf("multiple %ss match '%s': %s. Please be more specific or use the ID", kind, arg, strings.Join(matched, ", "))
+}
@@ -0,0 +1,60 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/auth"
+)
+
+func TestFindAccount(t *testing.T) {
+	accounts := map[string]auth.AccountToken{
+		"111": {AccountID: "111", AccountName: "Acme Inc"},
+		"222": {AccountID: "222", AccountName: "Acme Labs"},
+		"333": {AccountID: "333", AccountName: "Globex"},
+	}
+
+	account, err := findAccount(accounts, "222")
+	require.NoError(t, err)
+	assert.Equal(t, "Acme Labs", account.AccountName)
+
+	account, err = findAccount(accounts, "globex")
+	require.NoError(t, err)
+	assert.Equal(t, "333", account.AccountID)
+
+	account, err = findAccount(accounts, "labs")
+	require.NoError(t, err)
+	assert.Equal(t, "222", account.AccountID)
+
+	_, err = findAccount(accounts, "acme")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Acme Inc, Acme Labs")
+
+	_, err = findAccount(accounts, "initech")
+	assert.EqualError(t, err, "account not found: initech")
+}
+
+func TestFindProject(t *testing.T) {
+	projects := []api.Project{
+		{ID: 1, Name: "Website"},
+		{ID: 2, Name: "Website Redesign"},
+		{ID: 3, Name: "Mobile App"},
+	}
+
+	project, err := findProject(projects, "3")
+	require.NoError(t, err)
+	assert.Equal(t, "Mobile App", project.Name)
+
+	project, err = findProject(projects, "website")
+	require.NoError(t, err, "exact match wins over partial matches")
+	assert.Equal(t, int64(1), project.ID)
+
+	_, err = findProject(projects, "99")
+	assert.EqualError(t, err, "project 99 not found")
+
+	_, err = findProject(projects, "web")
+	assert.Error(t, err)
+}
@@ -0,0 +1,151 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/viper"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/factory"
+)
+
+// dashboardSectionTimeout bounds each API call made by a bare `bc4` so a
+// slow or unreachable Basecamp doesn't leave the prompt hanging. It is a
+// variable so tests can shorten it.
+var dashboardSectionTimeout = 5 * time.Second
+
+var (
+	dashboardHeading = lipgloss.NewStyle().Bold(true)
+	dashboardMuted   = lipgloss.NewStyle().Foreground(lipgloss.Color("8"))
+)
+
+// dashboard is the summary shown when bc4 is run without a subcommand
+type dashboard struct {
+	AccountID   string
+	AccountName string
+	ProjectID   string
+	ProjectName string
+
+	OpenTodos int
+	OpenCards int
+	// CountsErr is set when assignment counts couldn't be loaded
+	CountsErr error
+}
+
+// loadDashboard gathers the current defaults and assignment counts. Only a
+// missing account is an error; everything else is best-effort.
+func loadDashboard(f *factory.Factory) (*dashboard, error) {
+	f = f.ApplyOverrides(viper.GetString("account"), viper.GetString("project"))
+
+	cfg, err := f.Config()
+	if err != nil {
+		return nil, err
+	}
+
+	accountID, err := f.AccountID()
+	if err != nil {
+		return nil, err
+	}
+
+	d := &dashboard{AccountID: accountID}
+	if acc, ok := cfg.Accounts[accountID]; ok {
+		d.AccountName = acc.Name
+	}
+	if projectID, err := f.ProjectID(); err == nil {
+		d.ProjectID = projectID
+	}
+
+	client, err := f.ApiClient()
+	if err != nil {
+		d.CountsErr = err
+		return d, nil
+	}
+
+	loadDashboardSections(f.Context(), d, client)
+	return d, nil
+}
+
+// loadDashboardSections fills in the project name and assignment counts,
+// giving each its own timeout so one slow request doesn't hide the other
+func loadDashboardSections(ctx context.Context, d *dashboard, client *api.ModularClient) {
+	if d.ProjectID != "" {
+		projectCtx, cancel := context.WithTimeout(ctx, dashboardSectionTimeout)
+		if project, err := client.GetProject(projectCtx, d.ProjectID); err == nil {
+			d.ProjectName = project.Name
+		}
+		cancel()
+	}
+
+	countsCtx, cancel := context.WithTimeout(ctx, dashboardSectionTimeout)
+	defer cancel()
+	assignments, err := client.GetMyAssignments(countsCtx)
+	if err != nil {
+		d.CountsErr = err
+		return
+	}
+	d.OpenTodos, d.OpenCards = assignments.OpenCounts()
+}
+
+// writeDashboard renders the dashboard to w
+func writeDashboard(w io.Writer, d *dashboard) {
+	_, _ = fmt.Fprintln(w, dashboardHeading.Render("Account:")+" "+labelWithID(d.AccountName, d.AccountID))
+	if d.ProjectID != "" {
+		_, _ = fmt.Fprintln(w, dashboardHeading.Render("Project:")+" "+labelWithID(d.ProjectName, d.ProjectID))
+	} else {
+		_, _ = fmt.Fprintln(w, dashboardHeading.Render("Project:")+" "+dashboardMuted.Render("none selected"))
+	}
+	_, _ = fmt.Fprintln(w)
+
+	if d.CountsErr != nil {
+		_, _ = fmt.Fprintln(w, dashboardHeading.Render("My work:")+" "+dashboardMuted.Render("unavailable ("+d.CountsErr.Error()+")"))
+	} else {
+		_, _ = fmt.Fprintf(w, "%s %s, %s\n", dashboardHeading.Render("My work:"),
+			pluralize(d.OpenTodos, "open todo", "open todos"),
+			pluralize(d.OpenCards, "assigned card", "assigned cards"))
+	}
+	_, _ = fmt.Fprintln(w)
+
+	_, _ = fmt.Fprintln(w, dashboardHeading.Render("Suggested commands:"))
+	for _, s := range dashboardSuggestions(d) {
+		_, _ = fmt.Fprintf(w, "  %-28s %s\n", s[0], dashboardMuted.Render(s[1]))
+	}
+	_, _ = fmt.Fprintln(w)
+	_, _ = fmt.Fprintln(w, dashboardMuted.Render("Run 'bc4 --help' for all commands."))
+}
+
+// dashboardSuggestions returns command/description pairs relevant to the
+// current setup
+func dashboardSuggestions(d *dashboard) [][2]string {
+	if d.ProjectID == "" {
+		return [][2]string{
+			{"bc4 project list", "See your projects"},
+			{"bc4 project select", "Pick a default project"},
+			{"bc4 activity list", "Recent activity"},
+		}
+	}
+	return [][2]string{
+		{"bc4 todo lists", "View todo lists"},
+		{"bc4 todo add \"New task\"", "Create a todo"},
+		{"bc4 card table", "View the card table"},
+		{"bc4 activity list", "Recent activity"},
+		{"bc4 project select", "Switch project"},
+	}
+}
+
+func labelWithID(name, id string) string {
+	if name == "" {
+		return id
+	}
+	return fmt.Sprintf("%s (ID: %s)", name, id)
+}
+
+func pluralize(n int, singular, plural string) string {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, singular)
+	}
+	return fmt.Sprintf("%d %s", n, plural)
+}
@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/needmore/bc4/internal/api"
+)
+
+func TestWriteDashboard(t *testing.T) {
+	t.Run("with project and counts", func(t *testing.T) {
+		var buf bytes.Buffer
+		writeDashboard(&buf, &dashboard{
+			AccountID:   "123",
+			AccountName: "Acme",
+			ProjectID:   "456",
+			ProjectName: "Website",
+			OpenTodos:   3,
+			OpenCards:   1,
+		})
+
+		out := buf.String()
+		assert.Contains(t, out, "Acme (ID: 123)")
+		assert.Contains(t, out, "Website (ID: 456)")
+		assert.Contains(t, out, "3 open todos, 1 assigned card")
+		assert.Contains(t, out, "bc4 todo lists")
+	})
+
+	t.Run("without project and counts unavailable", func(t *testing.T) {
+		var buf bytes.Buffer
+		writeDashboard(&buf, &dashboard{
+			AccountID: "123",
+			CountsErr: errors.New("offline"),
+		})
+
+		out := buf.String()
+		assert.Contains(t, out, "none selected")
+		assert.Contains(t, out, "unavailable (offline)")
+		assert.Contains(t, out, "bc4 project select")
+		assert.NotContains(t, out, "bc4 todo lists")
+	})
+}
+
+func TestLoadDashboardSections_SlowSectionIsCutOff(t *testing.T) {
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/my/assignments.json") {
+			// Hang until the test is over
+			<-release
+			return
+		}
+		_, _ = w.Write([]byte(`{"id": 456, "name": "Website"}`))
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	original := dashboardSectionTimeout
+	dashboardSectionTimeout = 50 * time.Millisecond
+	defer func() { dashboardSectionTimeout = original }()
+
+	client := api.NewModularClientWithBaseURL("123", "token", srv.URL)
+	d := &dashboard{AccountID: "123", ProjectID: "456"}
+
+	start := time.Now()
+	loadDashboardSections(context.Background(), d, client)
+
+	assert.Less(t, time.Since(start), 2*time.Second, "slow section should be cut off by its timeout")
+	assert.Equal(t, "Website", d.ProjectName)
+	require.Error(t, d.CountsErr)
+}
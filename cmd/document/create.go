@@ -1,6 +1,7 @@
 package document
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
@@ -8,19 +9,27 @@ import (
 
 	"github.com/charmbracelet/huh"
 	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/cmdutil"
 	"github.com/needmore/bc4/internal/factory"
 	"github.com/needmore/bc4/internal/markdown"
 	"github.com/needmore/bc4/internal/mentions"
+	"github.com/needmore/bc4/internal/parser"
 	"github.com/needmore/bc4/internal/ui"
+	"github.com/needmore/bc4/internal/utils"
 	"github.com/spf13/cobra"
 )
 
+type createOptions struct {
+	title      string
+	content    string
+	file       string
+	fromEditor bool
+	draft      bool
+	output     cmdutil.CreatedOutput
+}
+
 func newCreateCmd(f *factory.Factory) *cobra.Command {
-	var (
-		title   string
-		content string
-		draft   bool
-	)
+	opts := &createOptions{}
 
 	cmd := &cobra.Command{
 		Use:   "create [project]",
@@ -30,10 +39,24 @@ func newCreateCmd(f *factory.Factory) *cobra.Command {
 You can provide document content in several ways:
   - Interactively (default)
   - Via --content flag
+  - From a file: bc4 document create [project] --title "Title" --file document.md
   - Via stdin: echo "content" | bc4 document create [project] --title "Title"
-  - From file: cat document.md | bc4 document create [project] --title "Title"`,
+  - In your editor: bc4 document create [project] --title "Title" --from-editor
+
+Content is written in Markdown and converted to Basecamp rich text.
+
+Use --print-url to also print the new document's web URL, and add --quiet to
+print only the URL.`,
+		Example: `  bc4 document create --title "Release notes" --file notes.md
+  cat notes.md | bc4 document create --title "Release notes"
+  bc4 document create --title "Retro" --from-editor --draft
+  bc4 document create --title "Spec" --file spec.md --print-url --quiet`,
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.output.Validate(); err != nil {
+				return err
+			}
+
 			// Apply project override if specified
 			if len(args) > 0 {
 				f = f.WithProject(args[0])
@@ -45,39 +68,40 @@ You can provide document content in several ways:
 				return err
 			}
 
-			// Get resolved project ID
-			projectID, err := f.ProjectID()
+			// Get resolved IDs
+			accountID, err := f.AccountID()
 			if err != nil {
 				return err
 			}
-
-			// Get the vault for the project
-			vault, err := client.GetVault(f.Context(), projectID)
+			projectID, err := f.ProjectID()
 			if err != nil {
 				return err
 			}
 
-			// Check if stdin has data
-			stat, _ := os.Stdin.Stat()
-			if (stat.Mode() & os.ModeCharDevice) == 0 {
-				// Data is being piped in
-				data, err := io.ReadAll(os.Stdin)
-				if err != nil {
-					return fmt.Errorf("failed to read from stdin: %w", err)
+			// Only read stdin when something is piped in
+			var stdin io.Reader
+			if stat, _ := os.Stdin.Stat(); (stat.Mode() & os.ModeCharDevice) == 0 {
+				stdin = os.Stdin
+			}
+			edit := func(initial string) (string, error) {
+				var preferred string
+				if cfg, err := f.Config(); err == nil {
+					preferred = cfg.Preferences.Editor
 				}
-				content = strings.TrimSpace(string(data))
+				return utils.EditText(utils.ResolveEditor(preferred), initial, "bc4-document-*.md")
+			}
 
-				// Title is required when using stdin
-				if title == "" {
-					return fmt.Errorf("--title is required when piping content via stdin")
-				}
-			} else if content == "" {
-				// No stdin and no content flag, use interactive mode
-				if title == "" {
+			content, err := readDocumentMarkdown(opts, stdin, edit)
+			if err != nil {
+				return err
+			}
+			if content == "" {
+				// No content given, use interactive mode
+				if opts.title == "" {
 					if err := huh.NewInput().
 						Title("Document title").
 						Placeholder("What's this document about?").
-						Value(&title).
+						Value(&opts.title).
 						Run(); err != nil {
 						return err
 					}
@@ -91,13 +115,15 @@ You can provide document content in several ways:
 					Run(); err != nil {
 					return err
 				}
+			} else if opts.title == "" {
+				return fmt.Errorf("--title is required when the content is given with --content, --file, --from-editor, or stdin")
 			}
 
 			// Validate required fields
-			if title == "" {
+			if opts.title == "" {
 				return fmt.Errorf("document title is required")
 			}
-			if content == "" {
+			if strings.TrimSpace(content) == "" {
 				return fmt.Errorf("document content is required")
 			}
 
@@ -116,34 +142,91 @@ You can provide document content in several ways:
 
 			// Create the document
 			req := api.DocumentCreateRequest{
-				Title:   title,
+				Title:   opts.title,
 				Content: richContent,
 				Status:  "active",
 			}
-
-			if draft {
+			if opts.draft {
 				req.Status = "draft"
 			}
 
-			document, err := client.CreateDocument(f.Context(), projectID, vault.ID, req)
+			document, err := createDocument(f.Context(), client.Documents(), projectID, req)
 			if err != nil {
 				return err
 			}
 
 			// Output
+			summary := fmt.Sprintf("%d", document.ID)
 			if ui.IsTerminal(os.Stdout) {
-				fmt.Printf("✓ Created document #%d: %s\n", document.ID, document.Title)
-			} else {
-				fmt.Println(document.ID)
+				summary = fmt.Sprintf("✓ Created document #%d: %s", document.ID, document.Title)
 			}
-
-			return nil
+			return opts.output.Write(os.Stdout, summary, accountID, projectID, parser.ResourceTypeDocument, document.ID)
 		},
 	}
 
-	cmd.Flags().StringVarP(&title, "title", "t", "", "Document title")
-	cmd.Flags().StringVarP(&content, "content", "c", "", "Document content (markdown supported)")
-	cmd.Flags().BoolVarP(&draft, "draft", "d", false, "Create as draft")
+	cmd.Flags().StringVarP(&opts.title, "title", "t", "", "Document title")
+	cmd.Flags().StringVarP(&opts.content, "content", "c", "", "Document content (markdown supported)")
+	cmd.Flags().StringVarP(&opts.file, "file", "f", "", "Read document content from a markdown file")
+	cmd.Flags().BoolVar(&opts.fromEditor, "from-editor", false, "Write the document in your editor")
+	cmd.Flags().BoolVarP(&opts.draft, "draft", "d", false, "Create as draft")
+	opts.output.AddFlags(cmd)
 
 	return cmd
 }
+
+// readDocumentMarkdown returns the document's Markdown from the first source
+// given: --content, --file, --from-editor, or stdin (when piped). It returns
+// "" when none was given, so the content can be asked for interactively.
+func readDocumentMarkdown(opts *createOptions, stdin io.Reader, edit func(initial string) (string, error)) (string, error) {
+	sources := 0
+	for _, given := range []bool{opts.content != "", opts.file != "", opts.fromEditor} {
+		if given {
+			sources++
+		}
+	}
+	if sources > 1 {
+		return "", fmt.Errorf("only one of --content, --file, or --from-editor can be used")
+	}
+
+	var text string
+	switch {
+	case opts.content != "":
+		text = opts.content
+	case opts.file != "":
+		data, err := os.ReadFile(opts.file)
+		if err != nil {
+			return "", fmt.Errorf("failed to read file: %w", err)
+		}
+		text = string(data)
+	case opts.fromEditor:
+		edited, err := edit("")
+		if err != nil {
+			return "", err
+		}
+		text = edited
+	case stdin != nil:
+		data, err := io.ReadAll(stdin)
+		if err != nil {
+			return "", fmt.Errorf("failed to read from stdin: %w", err)
+		}
+		text = string(data)
+	default:
+		return "", nil
+	}
+
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return "", fmt.Errorf("document content is required")
+	}
+	return text, nil
+}
+
+// createDocument creates a document in the project's vault, found through
+// the project's dock
+func createDocument(ctx context.Context, client api.DocumentOperations, projectID string, req api.DocumentCreateRequest) (*api.Document, error) {
+	vault, err := client.GetVault(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	return client.CreateDocument(ctx, projectID, vault.ID, req)
+}
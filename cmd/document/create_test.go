@@ -0,0 +1,73 @@
+package document
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/api/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateDocument(t *testing.T) {
+	client := mock.NewMockClient()
+	client.Vault = &api.Vault{ID: 55}
+	client.CreatedDocument = &api.Document{ID: 77, Title: "Spec"}
+
+	req := api.DocumentCreateRequest{Title: "Spec", Content: "<div>Hi</div>", Status: "active"}
+	document, err := createDocument(context.Background(), client, "9", req)
+	require.NoError(t, err)
+	assert.Equal(t, int64(77), document.ID)
+	assert.Equal(t, []string{
+		"GetVault(9)",
+		"CreateDocument(9, 55, {Title:Spec Content:<div>Hi</div> Status:active})",
+	}, client.Calls)
+
+	client = mock.NewMockClient()
+	client.VaultError = errors.New("document vault not found for project")
+	_, err = createDocument(context.Background(), client, "9", req)
+	assert.EqualError(t, err, "document vault not found for project")
+	assert.Equal(t, []string{"GetVault(9)"}, client.Calls, "nothing is created without a vault")
+}
+
+func TestReadDocumentMarkdown(t *testing.T) {
+	noEditor := func(string) (string, error) {
+		t.Fatal("editor should not be opened")
+		return "", nil
+	}
+
+	text, err := readDocumentMarkdown(&createOptions{content: " # Hi \n"}, strings.NewReader("ignored"), noEditor)
+	require.NoError(t, err)
+	assert.Equal(t, "# Hi", text)
+
+	path := filepath.Join(t.TempDir(), "doc.md")
+	require.NoError(t, os.WriteFile(path, []byte("From file"), 0644))
+	text, err = readDocumentMarkdown(&createOptions{file: path}, nil, noEditor)
+	require.NoError(t, err)
+	assert.Equal(t, "From file", text)
+
+	text, err = readDocumentMarkdown(&createOptions{}, strings.NewReader("Piped"), noEditor)
+	require.NoError(t, err)
+	assert.Equal(t, "Piped", text)
+
+	text, err = readDocumentMarkdown(&createOptions{fromEditor: true}, strings.NewReader("ignored"), func(string) (string, error) {
+		return "Edited", nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Edited", text)
+
+	text, err = readDocumentMarkdown(&createOptions{}, nil, noEditor)
+	require.NoError(t, err)
+	assert.Empty(t, text, "no source means the content is asked for interactively")
+
+	_, err = readDocumentMarkdown(&createOptions{content: "a", file: path}, nil, noEditor)
+	assert.EqualError(t, err, "only one of --content, --file, or --from-editor can be used")
+
+	_, err = readDocumentMarkdown(&createOptions{}, strings.NewReader("  \n"), noEditor)
+	assert.EqualError(t, err, "document content is required")
+}
@@ -0,0 +1,182 @@
+package export
+
+import (
+	"archive/zip"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/attachments"
+	"github.com/needmore/bc4/internal/download"
+)
+
+// maxArchiveDirTitle caps the title part of per-resource folder names
+const maxArchiveDirTitle = 50
+
+// archiveResource is a todo, card, or message whose attachments go into
+// the archive
+type archiveResource struct {
+	kind    string // folder under attachments/, e.g. "todos"
+	label   string // for progress, e.g. "todo #123"
+	id      int64
+	title   string
+	content string
+}
+
+// dir is the resource's folder in the archive, e.g.
+// "attachments/todos/123-Update homepage"
+func (r archiveResource) dir() string {
+	title := strings.NewReplacer("/", "-", "\\", "-").Replace(strings.TrimSpace(r.title))
+	if runes := []rune(title); len(runes) > maxArchiveDirTitle {
+		title = strings.TrimSpace(string(runes[:maxArchiveDirTitle]))
+	}
+	name := fmt.Sprintf("%d", r.id)
+	if title != "" {
+		name += "-" + title
+	}
+	return path.Join("attachments", r.kind, download.SanitizeFilename(name))
+}
+
+// archiveSummary counts what went into an archive
+type archiveSummary struct {
+	download.Result
+	Resources int
+	Bytes     int64
+}
+
+// attachmentResources lists the todos, cards, and messages in the export
+// that have attachments
+func attachmentResources(export *projectExport) []archiveResource {
+	var resources []archiveResource
+	add := func(r archiveResource) {
+		if len(attachments.ParseAttachments(r.content)) > 0 {
+			resources = append(resources, r)
+		}
+	}
+	addTodos := func(todos []api.Todo) {
+		for _, todo := range todos {
+			add(archiveResource{kind: sectionTodos, label: fmt.Sprintf("todo #%d", todo.ID), id: todo.ID, title: todo.Title, content: todo.Description})
+		}
+	}
+
+	for _, list := range export.TodoLists {
+		addTodos(list.Todos)
+		for _, group := range list.Groups {
+			addTodos(group.Todos)
+		}
+	}
+	for _, table := range export.CardTables {
+		for _, column := range table.Columns {
+			for _, card := range column.Cards {
+				add(archiveResource{kind: sectionCards, label: fmt.Sprintf("card #%d", card.ID), id: card.ID, title: card.Title, content: card.Content})
+			}
+		}
+	}
+	for _, message := range export.Messages {
+		add(archiveResource{kind: sectionMessages, label: fmt.Sprintf("message #%d", message.ID), id: message.ID, title: message.Subject, content: message.Content})
+	}
+	return resources
+}
+
+// writeProjectArchive writes a zip with the export in the given format and
+// the attachments of every todo, card, and message, one folder per resource.
+// Attachments are downloaded a few resources at a time into a scratch
+// directory and streamed into the zip, so memory stays bounded however
+// large the project is. Progress goes to progress. Failed downloads are
+// counted in the summary; the rest of the archive is still written.
+func writeProjectArchive(ctx context.Context, w io.Writer, export *projectExport, format, accountID string, uploadOps api.UploadOperations, progress io.Writer) (*archiveSummary, error) {
+	zw := zip.NewWriter(w)
+
+	name := "project.json"
+	if format == "markdown" {
+		name = "project.md"
+	}
+	entry, err := zw.Create(name)
+	if err != nil {
+		return nil, err
+	}
+	if err := writeExport(entry, format, export, accountID); err != nil {
+		return nil, err
+	}
+
+	scratch, err := os.MkdirTemp("", "bc4-export-*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create scratch directory: %w", err)
+	}
+	defer func() { _ = os.RemoveAll(scratch) }()
+
+	resources := attachmentResources(export)
+	summary := &archiveSummary{Resources: len(resources)}
+	bucketID := fmt.Sprintf("%d", export.Project.ID)
+
+	// mu serializes writes to the zip, the summary, and progress
+	var (
+		mu   sync.Mutex
+		done int
+	)
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(exportConcurrency)
+	for i, resource := range resources {
+		g.Go(func() error {
+			dir := filepath.Join(scratch, fmt.Sprintf("%d", i))
+			if err := os.MkdirAll(dir, 0755); err != nil {
+				return err
+			}
+			defer func() { _ = os.RemoveAll(dir) }()
+
+			// Failures are counted in the result and reported once at the end
+			result, _ := download.DownloadFromSources(gctx, uploadOps, bucketID, []download.AttachmentSource{
+				{Label: resource.label, Content: resource.content},
+			}, download.Options{OutputDir: dir, NoSummary: true, Output: io.Discard})
+			if err := gctx.Err(); err != nil {
+				return err
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			summary.Add(result)
+			for _, file := range result.Paths {
+				n, err := addFileToZip(zw, path.Join(resource.dir(), filepath.Base(file)), file)
+				if err != nil {
+					return fmt.Errorf("failed to add %s to archive: %w", filepath.Base(file), err)
+				}
+				summary.Bytes += n
+			}
+			done++
+			fmt.Fprintf(progress, "[%d/%d] %s: %d of %d attachments\n", done, len(resources), resource.label, result.Successful, result.Total)
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return summary, nil
+}
+
+// addFileToZip copies the file at src into the zip as name and returns the
+// number of bytes copied
+func addFileToZip(zw *zip.Writer, name, src string) (int64, error) {
+	file, err := os.Open(src)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = file.Close() }()
+
+	entry, err := zw.Create(name)
+	if err != nil {
+		return 0, err
+	}
+	return io.Copy(entry, file)
+}
@@ -0,0 +1,114 @@
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/needmore/bc4/internal/api"
+)
+
+// fakeUploads serves uploads whose file content is their filename
+type fakeUploads struct {
+	mu      sync.Mutex
+	uploads map[int64]string // upload ID -> filename
+}
+
+func (u *fakeUploads) GetUpload(_ context.Context, _ string, uploadID int64) (*api.Upload, error) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	filename, ok := u.uploads[uploadID]
+	if !ok {
+		return nil, fmt.Errorf("upload %d not found", uploadID)
+	}
+	return &api.Upload{ID: uploadID, Filename: filename, DownloadURL: filename}, nil
+}
+
+func (u *fakeUploads) DownloadAttachment(_ context.Context, downloadURL, destPath string) error {
+	return os.WriteFile(destPath, []byte("contents of "+downloadURL), 0644)
+}
+
+func attachmentHTML(uploadID int64, filename string) string {
+	return fmt.Sprintf(`<bc-attachment filename="%s" url="https://3.basecamp.com/1/uploads/%d/download/%s"></bc-attachment>`, filename, uploadID, filename)
+}
+
+func TestWriteProjectArchive(t *testing.T) {
+	export := &projectExport{
+		Project:    &api.Project{ID: 42, Name: "Website"},
+		ExportedAt: time.Date(2024, 5, 20, 9, 30, 0, 0, time.UTC),
+		TodoLists: []exportedTodoList{{
+			TodoList: api.TodoList{ID: 7, Title: "Launch"},
+			Todos: []api.Todo{
+				{ID: 100, Title: "Write copy", Description: attachmentHTML(1, "copy.docx")},
+				{ID: 101, Title: "No files"},
+			},
+			Groups: []exportedTodoGroup{{
+				TodoGroup: api.TodoGroup{ID: 8, Title: "Later"},
+				Todos:     []api.Todo{{ID: 102, Title: "Retro/notes", Description: attachmentHTML(2, "retro.pdf")}},
+			}},
+		}},
+		CardTables: []exportedCardTable{{
+			ID:    9,
+			Title: "Board",
+			Columns: []exportedColumn{{
+				Column: api.Column{ID: 10, Title: "Doing"},
+				Cards:  []api.Card{{ID: 200, Title: "Design", Content: attachmentHTML(3, "mock.png") + attachmentHTML(4, "mock.png")}},
+			}},
+		}},
+		Messages: []api.Message{{ID: 300, Subject: "Kickoff", Content: attachmentHTML(5, "missing.txt")}},
+	}
+	uploads := &fakeUploads{uploads: map[int64]string{1: "copy.docx", 2: "retro.pdf", 3: "mock.png", 4: "mock.png"}}
+
+	var buf, progress bytes.Buffer
+	summary, err := writeProjectArchive(context.Background(), &buf, export, "markdown", "1", uploads, &progress)
+	require.NoError(t, err)
+	assert.Equal(t, 4, summary.Resources)
+	assert.Equal(t, 4, summary.Successful)
+	assert.Equal(t, 1, summary.Failed)
+	assert.Positive(t, summary.Bytes)
+	assert.Contains(t, progress.String(), "[4/4]")
+	assert.Contains(t, progress.String(), "message #300: 0 of 1 attachments")
+
+	reader, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	files := make(map[string]string)
+	var names []string
+	for _, file := range reader.File {
+		rc, err := file.Open()
+		require.NoError(t, err)
+		data, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		_ = rc.Close()
+		files[file.Name] = string(data)
+		names = append(names, file.Name)
+	}
+	sort.Strings(names)
+
+	assert.Equal(t, []string{
+		"attachments/cards/200-Design/mock.png",
+		"attachments/cards/200-Design/mock_1.png",
+		"attachments/todos/100-Write copy/copy.docx",
+		"attachments/todos/102-Retro-notes/retro.pdf",
+		"project.md",
+	}, names)
+	assert.Contains(t, files["project.md"], "# Website\n")
+	assert.Equal(t, "contents of retro.pdf", files["attachments/todos/102-Retro-notes/retro.pdf"])
+}
+
+func TestArchiveResourceDir(t *testing.T) {
+	r := archiveResource{kind: sectionMessages, id: 5, title: "  ../Q3: plan?  "}
+	assert.Equal(t, "attachments/messages/5-..-Q3_ plan_", r.dir())
+
+	r = archiveResource{kind: sectionCards, id: 6}
+	assert.Equal(t, "attachments/cards/6", r.dir())
+}
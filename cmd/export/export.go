@@ -0,0 +1,30 @@
+package export
+
+import (
+	"github.com/needmore/bc4/internal/cmdutil"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/spf13/cobra"
+)
+
+// NewExportCmd creates a new export command
+func NewExportCmd(f *factory.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export project content",
+		Long: `Export Basecamp project content for backups or sharing.
+
+Exports are read-only snapshots: JSON keeps the full API records, while
+Markdown produces a readable report for wikis or email.`,
+		Example: `  bc4 export project 12345 --output backup.json
+  bc4 export project 12345 --format markdown --output report.md
+  bc4 export project --include todos,messages --format markdown`,
+	}
+
+	// Enable suggestions for subcommand typos
+	cmdutil.EnableSuggestions(cmd)
+
+	// Add subcommands
+	cmd.AddCommand(newProjectCmd(f))
+
+	return cmd
+}
@@ -0,0 +1,209 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/markdown"
+	"github.com/needmore/bc4/internal/parser"
+	"github.com/needmore/bc4/internal/utils"
+)
+
+// projectReport renders a projectExport as Markdown
+type projectReport struct {
+	b         strings.Builder
+	converter markdown.Converter
+	accountID string
+	projectID string
+	now       time.Time
+}
+
+// writeProjectMarkdown writes the export as a Markdown report with links
+// back to Basecamp
+func writeProjectMarkdown(w io.Writer, export *projectExport, accountID string) error {
+	r := &projectReport{
+		converter: markdown.NewConverter(),
+		accountID: accountID,
+		projectID: strconv.FormatInt(export.Project.ID, 10),
+		now:       export.ExportedAt,
+	}
+
+	fmt.Fprintf(&r.b, "# %s\n", export.Project.Name)
+	if export.Project.Description != "" {
+		fmt.Fprintf(&r.b, "\n%s\n", export.Project.Description)
+	}
+	fmt.Fprintf(&r.b, "\n_Exported %s_\n", export.ExportedAt.Format("Jan 2, 2006 3:04 PM"))
+
+	if export.TodoLists != nil {
+		if err := r.writeTodoLists(export.TodoLists); err != nil {
+			return err
+		}
+	}
+	for _, table := range export.CardTables {
+		r.writeCardTable(table)
+	}
+	if export.Messages != nil {
+		if err := r.writeMessages(export.Messages); err != nil {
+			return err
+		}
+	}
+
+	_, err := io.WriteString(w, r.b.String())
+	return err
+}
+
+func (r *projectReport) writeTodoLists(lists []exportedTodoList) error {
+	r.b.WriteString("\n## To-dos\n")
+	if len(lists) == 0 {
+		r.b.WriteString("\nNo todo lists.\n")
+	}
+
+	for _, list := range lists {
+		fmt.Fprintf(&r.b, "\n### %s", r.link(list.Title, parser.ResourceTypeTodoList, list.ID))
+		if list.CompletedRatio != "" {
+			fmt.Fprintf(&r.b, " (%s completed)", list.CompletedRatio)
+		}
+		r.b.WriteString("\n")
+
+		if err := r.writeBody(list.Description); err != nil {
+			return err
+		}
+		r.writeTodos(list.Todos)
+
+		for _, group := range list.Groups {
+			fmt.Fprintf(&r.b, "\n#### %s\n", group.Title)
+			r.writeTodos(group.Todos)
+		}
+	}
+	return nil
+}
+
+func (r *projectReport) writeTodos(todos []api.Todo) {
+	if len(todos) == 0 {
+		return
+	}
+	r.b.WriteString("\n")
+	for _, todo := range todos {
+		marker := "[ ]"
+		if todo.Completed {
+			marker = "[x]"
+		}
+		title := todo.Title
+		if title == "" {
+			title = todo.Content
+		}
+
+		var details []string
+		if names := assigneeNames(todo.Assignees); names != "" {
+			details = append(details, names)
+		}
+		if due := r.due(todo.DueOn); due != "" {
+			details = append(details, "due "+due)
+		}
+
+		fmt.Fprintf(&r.b, "- %s %s", marker, r.link(title, parser.ResourceTypeTodo, todo.ID))
+		if len(details) > 0 {
+			fmt.Fprintf(&r.b, " (%s)", strings.Join(details, ", "))
+		}
+		r.b.WriteString("\n")
+	}
+}
+
+func (r *projectReport) writeCardTable(table exportedCardTable) {
+	fmt.Fprintf(&r.b, "\n## %s\n", r.link(table.Title, parser.ResourceTypeCardTable, table.ID))
+
+	for _, column := range table.Columns {
+		fmt.Fprintf(&r.b, "\n### %s (%d)\n\n", column.Title, len(column.Cards))
+		if len(column.Cards) == 0 {
+			r.b.WriteString("No cards.\n")
+			continue
+		}
+		for _, card := range column.Cards {
+			var details []string
+			if card.IsOnHold {
+				details = append(details, "on hold")
+			}
+			if names := assigneeNames(card.Assignees); names != "" {
+				details = append(details, names)
+			}
+			if due := r.due(card.DueOn); due != "" {
+				details = append(details, "due "+due)
+			}
+
+			fmt.Fprintf(&r.b, "- %s", r.link(card.Title, parser.ResourceTypeCard, card.ID))
+			if len(details) > 0 {
+				fmt.Fprintf(&r.b, " (%s)", strings.Join(details, ", "))
+			}
+			r.b.WriteString("\n")
+		}
+	}
+}
+
+func (r *projectReport) writeMessages(messages []api.Message) error {
+	r.b.WriteString("\n## Messages\n")
+	if len(messages) == 0 {
+		r.b.WriteString("\nNo messages.\n")
+	}
+
+	for _, message := range messages {
+		fmt.Fprintf(&r.b, "\n### %s\n", r.link(message.Subject, parser.ResourceTypeMessage, message.ID))
+		fmt.Fprintf(&r.b, "\n_%s, %s_\n", message.Creator.Name, message.CreatedAt.Local().Format("Jan 2, 2006"))
+		if err := r.writeBody(message.Content); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeBody converts rich text to Markdown and writes it as a paragraph
+func (r *projectReport) writeBody(richText string) error {
+	if strings.TrimSpace(richText) == "" {
+		return nil
+	}
+	body, err := r.converter.RichTextToMarkdown(richText)
+	if err != nil {
+		return fmt.Errorf("failed to convert content to markdown: %w", err)
+	}
+	if body = strings.TrimSpace(body); body != "" {
+		fmt.Fprintf(&r.b, "\n%s\n", body)
+	}
+	return nil
+}
+
+// link returns a Markdown link to the item in Basecamp, or just the text
+// when no web URL can be built for it
+func (r *projectReport) link(text string, resourceType parser.ResourceType, id int64) string {
+	webURL, err := parser.BuildWebURL(r.accountID, r.projectID, resourceType, id)
+	if err != nil {
+		return text
+	}
+	return fmt.Sprintf("[%s](%s)", text, webURL)
+}
+
+// due formats a due date like "Jun 1", adding the year when it isn't the
+// year of the export
+func (r *projectReport) due(dueOn *string) string {
+	if dueOn == nil || *dueOn == "" {
+		return ""
+	}
+	due, err := time.Parse(utils.DateLayout, *dueOn)
+	if err != nil {
+		return *dueOn
+	}
+	if due.Year() != r.now.Year() {
+		return due.Format("Jan 2, 2006")
+	}
+	return due.Format("Jan 2")
+}
+
+func assigneeNames(people []api.Person) string {
+	names := make([]string, 0, len(people))
+	for _, p := range people {
+		names = append(names, p.Name)
+	}
+	return strings.Join(names, ", ")
+}
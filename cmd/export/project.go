@@ -0,0 +1,477 @@
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/download"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/parser"
+)
+
+// Sections of a project that can be exported with --include
+const (
+	sectionTodos    = "todos"
+	sectionCards    = "cards"
+	sectionMessages = "messages"
+)
+
+var allSections = []string{sectionTodos, sectionCards, sectionMessages}
+
+// exportConcurrency limits how many todo lists or columns are fetched at once
+const exportConcurrency = 4
+
+// projectExport is everything gathered for one project export
+type projectExport struct {
+	Project    *api.Project        `json:"project"`
+	ExportedAt time.Time           `json:"exported_at"`
+	TodoLists  []exportedTodoList  `json:"todo_lists,omitempty"`
+	CardTables []exportedCardTable `json:"card_tables,omitempty"`
+	Messages   []api.Message       `json:"messages,omitempty"`
+}
+
+// exportedTodoList is a todo list with its todos and groups
+type exportedTodoList struct {
+	api.TodoList
+	Todos  []api.Todo          `json:"todos"`
+	Groups []exportedTodoGroup `json:"groups,omitempty"`
+}
+
+// exportedTodoGroup is a todo group with its todos
+type exportedTodoGroup struct {
+	api.TodoGroup
+	Todos []api.Todo `json:"todos"`
+}
+
+// exportedCardTable is a card table with the cards in each column
+type exportedCardTable struct {
+	ID      int64            `json:"id"`
+	Title   string           `json:"title"`
+	Columns []exportedColumn `json:"columns"`
+}
+
+// exportedColumn is a card table column with its cards
+type exportedColumn struct {
+	api.Column
+	Cards []api.Card `json:"cards"`
+}
+
+type projectOptions struct {
+	accountID   string
+	projectID   string
+	format      string
+	include     string
+	output      string
+	messages    int
+	attachments bool
+}
+
+func newProjectCmd(f *factory.Factory) *cobra.Command {
+	opts := &projectOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "project [project-id|url]",
+		Short: "Export a project's todos, cards, and messages",
+		Long: `Export a project's todos, card tables, and messages.
+
+--format json (the default) writes the full records, for backups.
+--format markdown writes a readable report: each todo list as a checklist,
+each card table's columns with their cards, and the most recent messages with
+their bodies, all linking back to Basecamp.
+
+Use --include to export only some sections (todos, cards, messages).
+Completed todos are included. Sections whose tool is turned off in the
+project are skipped.
+
+The export is written to stdout unless --output is given.
+
+--attachments writes a zip archive to --output instead: the export as
+project.json or project.md, plus the attachments of every exported todo,
+card, and message under attachments/<todos|cards|messages>/<id>-<title>/.
+Progress is reported on stderr.`,
+		Example: `  # Back up the default project
+  bc4 export project --output backup.json
+
+  # Share a project report
+  bc4 export project 12345 --format markdown --output report.md
+
+  # Only todos and the 5 latest messages
+  bc4 export project --format markdown --include todos,messages --messages 5
+
+  # A complete offline archive with every attachment
+  bc4 export project 12345 --attachments --out project.zip`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runExportProject(f, opts, args)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.format, "format", "f", "json", "Output format: json or markdown")
+	cmd.Flags().StringVar(&opts.include, "include", strings.Join(allSections, ","), "Sections to export (comma-separated: todos, cards, messages)")
+	cmd.Flags().StringVarP(&opts.output, "output", "o", "", "Write the export to this file instead of stdout")
+	cmd.Flags().IntVar(&opts.messages, "messages", 10, "Number of most recent messages to include (0 for all)")
+	cmd.Flags().BoolVar(&opts.attachments, "attachments", false, "Write a zip archive with the export and all attachments (requires --output)")
+	cmd.Flags().StringVarP(&opts.accountID, "account", "a", "", "Specify account ID")
+	cmd.Flags().StringVarP(&opts.projectID, "project", "p", "", "Specify project ID")
+
+	// Accept --out as a shorthand for --output
+	cmd.Flags().SetNormalizeFunc(func(fs *pflag.FlagSet, name string) pflag.NormalizedName {
+		if name == "out" {
+			name = "output"
+		}
+		return pflag.NormalizedName(name)
+	})
+
+	return cmd
+}
+
+func runExportProject(f *factory.Factory, opts *projectOptions, args []string) error {
+	format := strings.ToLower(opts.format)
+	if format == "md" {
+		format = "markdown"
+	}
+	if format != "json" && format != "markdown" {
+		return fmt.Errorf("unsupported format %q: use json or markdown", opts.format)
+	}
+	sections, err := parseSections(opts.include)
+	if err != nil {
+		return err
+	}
+	if opts.messages < 0 {
+		return fmt.Errorf("--messages must not be negative")
+	}
+	if opts.attachments && opts.output == "" {
+		return fmt.Errorf("--attachments writes a zip archive and requires --output")
+	}
+
+	// Parse project argument if provided (could be URL or ID)
+	if len(args) > 0 {
+		if parser.IsBasecampURL(args[0]) {
+			parsed, err := parser.ParseBasecampURL(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid Basecamp URL: %w", err)
+			}
+			if parsed.ResourceType != parser.ResourceTypeProject {
+				return fmt.Errorf("URL is not for a project: %s", args[0])
+			}
+			if parsed.AccountID > 0 {
+				f = f.WithAccount(strconv.FormatInt(parsed.AccountID, 10))
+			}
+			if parsed.ProjectID > 0 {
+				f = f.WithProject(strconv.FormatInt(parsed.ProjectID, 10))
+			}
+		} else {
+			f = f.WithProject(args[0])
+		}
+	}
+	f = f.ApplyOverrides(opts.accountID, opts.projectID)
+
+	client, err := f.ApiClient()
+	if err != nil {
+		return err
+	}
+	accountID, err := f.AccountID()
+	if err != nil {
+		return err
+	}
+	projectID, err := f.ProjectID()
+	if err != nil {
+		return err
+	}
+
+	export, warnings, err := gatherProject(f.Context(), client.Client, projectID, sections, opts.messages)
+	if err != nil {
+		return err
+	}
+	for _, warning := range warnings {
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", warning)
+	}
+
+	if opts.attachments {
+		return writeArchiveFile(f.Context(), opts.output, export, format, accountID, client.Uploads())
+	}
+
+	if opts.output != "" {
+		return writeExportFile(opts.output, format, export, accountID)
+	}
+	if err := writeExport(os.Stdout, format, export, accountID); err != nil {
+		return fmt.Errorf("failed to write export: %w", err)
+	}
+	return nil
+}
+
+// writeExportFile writes the export to path. A partly written file is
+// removed, so a failed export never looks like a finished one.
+func writeExportFile(path, format string, export *projectExport, accountID string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create output file: %w", err)
+	}
+	err = writeExport(file, format, export, accountID)
+	if closeErr := file.Close(); err == nil && closeErr != nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(path)
+		return fmt.Errorf("failed to write export: %w", err)
+	}
+
+	fmt.Fprintf(os.Stderr, "✓ Exported %s to %s\n", export.Project.Name, path)
+	return nil
+}
+
+// writeExport writes the export as JSON or a Markdown report
+func writeExport(w io.Writer, format string, export *projectExport, accountID string) error {
+	if format == "markdown" {
+		return writeProjectMarkdown(w, export, accountID)
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(export)
+}
+
+// writeArchiveFile writes the export and its attachments as a zip at path
+func writeArchiveFile(ctx context.Context, path string, export *projectExport, format, accountID string, uploadOps api.UploadOperations) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create output file: %w", err)
+	}
+	summary, err := writeProjectArchive(ctx, file, export, format, accountID, uploadOps, os.Stderr)
+	if closeErr := file.Close(); err == nil && closeErr != nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(path)
+		return fmt.Errorf("failed to write archive: %w", err)
+	}
+
+	fmt.Fprintf(os.Stderr, "✓ Exported %s to %s (%d attachments from %d items, %s)\n",
+		export.Project.Name, path, summary.Successful, summary.Resources, download.FormatByteSize(summary.Bytes))
+	if summary.Skipped > 0 {
+		fmt.Fprintf(os.Stderr, "Skipped: %d attachments\n", summary.Skipped)
+	}
+	if summary.Failed > 0 {
+		return fmt.Errorf("%d attachments failed to download and are missing from the archive", summary.Failed)
+	}
+	return nil
+}
+
+// parseSections parses --include into a set of section names
+func parseSections(include string) (map[string]bool, error) {
+	sections := make(map[string]bool)
+	for _, name := range strings.Split(include, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		valid := false
+		for _, s := range allSections {
+			if name == s {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return nil, fmt.Errorf("unknown section %q in --include: use %s", name, strings.Join(allSections, ", "))
+		}
+		sections[name] = true
+	}
+	if len(sections) == 0 {
+		return nil, fmt.Errorf("--include must name at least one section")
+	}
+	return sections, nil
+}
+
+// gatherProject fetches the requested sections of a project concurrently.
+// Problems that only leave part of a section out, such as completed todos
+// that failed to load, are returned as warnings rather than errors. Included
+// todo and message sections are never nil, so the report shows them even
+// when they're empty.
+func gatherProject(ctx context.Context, client *api.Client, projectID string, sections map[string]bool, messageLimit int) (*projectExport, []error, error) {
+	project, err := client.GetProject(ctx, projectID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch project: %w", err)
+	}
+	export := &projectExport{Project: project, ExportedAt: time.Now()}
+
+	var (
+		mu       sync.Mutex
+		warnings []error
+	)
+	warn := func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		warnings = append(warnings, err)
+	}
+
+	g, gctx := errgroup.WithContext(ctx)
+	if sections[sectionTodos] {
+		g.Go(func() error {
+			lists, err := gatherTodoLists(gctx, client, projectID, warn)
+			export.TodoLists = append([]exportedTodoList{}, lists...)
+			return err
+		})
+	}
+	if sections[sectionCards] {
+		g.Go(func() error {
+			tables, err := gatherCardTables(gctx, client, projectID)
+			export.CardTables = tables
+			return err
+		})
+	}
+	if sections[sectionMessages] {
+		g.Go(func() error {
+			messages, err := gatherMessages(gctx, client, projectID, messageLimit)
+			export.Messages = append([]api.Message{}, messages...)
+			return err
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, nil, err
+	}
+
+	return export, warnings, nil
+}
+
+// gatherTodoLists fetches every active todo list with all of its todos,
+// completed ones included, and its groups
+func gatherTodoLists(ctx context.Context, client *api.Client, projectID string, warn func(error)) ([]exportedTodoList, error) {
+	todoSet, err := client.GetProjectTodoSet(ctx, projectID)
+	if errors.Is(err, api.ErrNoTodoSet) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch todo set: %w", err)
+	}
+
+	lists, err := client.GetTodoLists(ctx, projectID, todoSet.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// fetchTodos treats a failure to load completed todos as a warning
+	fetchTodos := func(ctx context.Context, title string, listID int64) ([]api.Todo, error) {
+		todos, err := client.GetAllTodos(ctx, projectID, listID)
+		var partialErr *api.PartialError
+		if errors.As(err, &partialErr) {
+			warn(fmt.Errorf("%s: %w", title, partialErr))
+			return todos, nil
+		}
+		return todos, err
+	}
+
+	exported := make([]exportedTodoList, len(lists))
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(exportConcurrency)
+	for i, list := range lists {
+		g.Go(func() error {
+			todos, err := fetchTodos(gctx, list.Title, list.ID)
+			if err != nil {
+				return fmt.Errorf("failed to fetch todos in %s: %w", list.Title, err)
+			}
+			groups, err := client.GetTodoGroups(gctx, projectID, list.ID)
+			if err != nil {
+				return fmt.Errorf("failed to fetch groups in %s: %w", list.Title, err)
+			}
+
+			entry := exportedTodoList{TodoList: list, Todos: todos}
+			for _, group := range groups {
+				groupTodos, err := fetchTodos(gctx, group.Title, group.ID)
+				if err != nil {
+					return fmt.Errorf("failed to fetch todos in %s: %w", group.Title, err)
+				}
+				entry.Groups = append(entry.Groups, exportedTodoGroup{TodoGroup: group, Todos: groupTodos})
+			}
+			exported[i] = entry
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return exported, nil
+}
+
+// gatherCardTables fetches every card table with the cards in each column,
+// including on-hold cards
+func gatherCardTables(ctx context.Context, client *api.Client, projectID string) ([]exportedCardTable, error) {
+	tables, err := client.GetAllProjectCardTables(ctx, projectID)
+	if errors.Is(err, api.ErrNoCardTables) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch card tables: %w", err)
+	}
+
+	exported := make([]exportedCardTable, len(tables))
+	for t, table := range tables {
+		columns := make([]exportedColumn, len(table.Lists))
+		g, gctx := errgroup.WithContext(ctx)
+		g.SetLimit(exportConcurrency)
+		for i, column := range table.Lists {
+			g.Go(func() error {
+				cards, err := client.GetCardsInColumn(gctx, projectID, column.ID)
+				if err != nil {
+					return fmt.Errorf("failed to fetch cards in %s: %w", column.Title, err)
+				}
+				if column.OnHold.Enabled {
+					onHold, err := client.GetOnHoldCardsInColumn(gctx, column.OnHold.CardsURL)
+					if err != nil {
+						return fmt.Errorf("failed to fetch on-hold cards in %s: %w", column.Title, err)
+					}
+					for i := range onHold {
+						onHold[i].IsOnHold = true
+					}
+					cards = append(cards, onHold...)
+				}
+				columns[i] = exportedColumn{Column: column, Cards: cards}
+				return nil
+			})
+		}
+		if err := g.Wait(); err != nil {
+			return nil, err
+		}
+		exported[t] = exportedCardTable{ID: table.ID, Title: table.Title, Columns: columns}
+	}
+	return exported, nil
+}
+
+// gatherMessages fetches the messages on every message board, newest first,
+// keeping at most limit of them (0 keeps all)
+func gatherMessages(ctx context.Context, client *api.Client, projectID string, limit int) ([]api.Message, error) {
+	boards, err := client.ListMessageBoards(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch message boards: %w", err)
+	}
+
+	var messages []api.Message
+	for _, board := range boards {
+		boardMessages, err := client.ListMessages(ctx, projectID, board.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch messages: %w", err)
+		}
+		messages = append(messages, boardMessages...)
+	}
+
+	sort.SliceStable(messages, func(i, j int) bool {
+		return messages[i].CreatedAt.After(messages[j].CreatedAt)
+	})
+	if limit > 0 && len(messages) > limit {
+		messages = messages[:limit]
+	}
+	return messages, nil
+}
@@ -0,0 +1,105 @@
+package export
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/needmore/bc4/internal/api"
+)
+
+func TestParseSections(t *testing.T) {
+	sections, err := parseSections("todos, Messages")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]bool{"todos": true, "messages": true}, sections)
+
+	_, err = parseSections("todos,docs")
+	assert.ErrorContains(t, err, `unknown section "docs"`)
+
+	_, err = parseSections(" , ")
+	assert.Error(t, err)
+}
+
+func TestWriteExportFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "export.json")
+	export := &projectExport{Project: &api.Project{ID: 42, Name: "Website"}}
+	require.NoError(t, writeExportFile(path, "json", export, "1"))
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"name": "Website"`)
+
+	// A failed write leaves no partial file behind
+	export.ExportedAt = time.Date(10000, 1, 1, 0, 0, 0, 0, time.UTC)
+	err = writeExportFile(path, "json", export, "1")
+	assert.ErrorContains(t, err, "failed to write export")
+	_, statErr := os.Stat(path)
+	assert.True(t, os.IsNotExist(statErr), "the partial export should be removed")
+}
+
+func TestWriteProjectMarkdown(t *testing.T) {
+	due := "2024-06-01"
+	export := &projectExport{
+		Project:    &api.Project{ID: 42, Name: "Website"},
+		ExportedAt: time.Date(2024, 5, 20, 9, 30, 0, 0, time.UTC),
+		TodoLists: []exportedTodoList{{
+			TodoList: api.TodoList{ID: 7, Title: "Launch", CompletedRatio: "1/2"},
+			Todos: []api.Todo{
+				{ID: 100, Title: "Write copy", Completed: true},
+				{ID: 101, Title: "Ship it", DueOn: &due, Assignees: []api.Person{{Name: "Jane"}}},
+			},
+			Groups: []exportedTodoGroup{{
+				TodoGroup: api.TodoGroup{ID: 8, Title: "Later"},
+				Todos:     []api.Todo{{ID: 102, Title: "Retro"}},
+			}},
+		}},
+		CardTables: []exportedCardTable{{
+			ID:    9,
+			Title: "Board",
+			Columns: []exportedColumn{
+				{Column: api.Column{ID: 10, Title: "Doing"}, Cards: []api.Card{{ID: 200, Title: "Design", IsOnHold: true}}},
+				{Column: api.Column{ID: 11, Title: "Done"}},
+			},
+		}},
+		Messages: []api.Message{{
+			ID:        300,
+			Subject:   "Kickoff",
+			Content:   "<div>Welcome <strong>everyone</strong></div>",
+			Creator:   api.Person{Name: "Sam"},
+			CreatedAt: time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC),
+		}},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, writeProjectMarkdown(&buf, export, "1"))
+	out := buf.String()
+
+	base := "https://3.basecamp.com/1/buckets/42/"
+	assert.Contains(t, out, "# Website\n")
+	assert.Contains(t, out, "### ["+"Launch]("+base+"todolists/7) (1/2 completed)")
+	assert.Contains(t, out, "- [x] [Write copy]("+base+"todos/100)\n")
+	assert.Contains(t, out, "- [ ] [Ship it]("+base+"todos/101) (Jane, due Jun 1)\n")
+	assert.Contains(t, out, "#### Later\n\n- [ ] [Retro]("+base+"todos/102)\n")
+	assert.Contains(t, out, "## [Board]("+base+"card_tables/9)")
+	assert.Contains(t, out, "### Doing (1)\n\n- [Design]("+base+"card_tables/cards/200) (on hold)\n")
+	assert.Contains(t, out, "### Done (0)\n\nNo cards.\n")
+	assert.Contains(t, out, "### [Kickoff]("+base+"messages/300)")
+	assert.Contains(t, out, "**everyone**")
+}
+
+func TestWriteProjectMarkdown_OnlyIncludedSections(t *testing.T) {
+	export := &projectExport{
+		Project:    &api.Project{ID: 42, Name: "Website"},
+		ExportedAt: time.Now(),
+		Messages:   []api.Message{},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, writeProjectMarkdown(&buf, export, "1"))
+	assert.NotContains(t, buf.String(), "## To-dos")
+	assert.Contains(t, buf.String(), "## Messages\n\nNo messages.\n")
+}
@@ -0,0 +1,51 @@
+package message
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/utils"
+)
+
+// messageBoardClient is the part of the API client used to find boards
+type messageBoardClient interface {
+	GetMessageBoard(ctx context.Context, projectID string) (*api.MessageBoard, error)
+	ListMessageBoards(ctx context.Context, projectID string) ([]api.MessageBoard, error)
+}
+
+// resolveMessageBoard finds the board named by --to-board (an ID or name).
+// Without one, the project's first message board is used.
+func resolveMessageBoard(ctx context.Context, client messageBoardClient, projectID, nameOrID string) (*api.MessageBoard, error) {
+	if nameOrID == "" {
+		return client.GetMessageBoard(ctx, projectID)
+	}
+
+	boards, err := client.ListMessageBoards(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if len(boards) == 0 {
+		return nil, fmt.Errorf("message board not found for project")
+	}
+
+	matches, byID := utils.MatchIDOrName(boards, nameOrID, true,
+		func(b api.MessageBoard) int64 { return b.ID },
+		func(b api.MessageBoard) string { return b.Title })
+	switch {
+	case len(matches) == 1:
+		return &boards[matches[0]], nil
+	case byID:
+		return nil, fmt.Errorf("message board ID %s not found in project", nameOrID)
+	case len(matches) == 0:
+		return nil, fmt.Errorf("message board not found: %s", nameOrID)
+	}
+
+	options := make([]string, 0, len(matches))
+	for _, i := range matches {
+		options = append(options, fmt.Sprintf("%s (ID: %d)", boards[i].Title, boards[i].ID))
+	}
+	return nil, fmt.Errorf("multiple message boards match '%s': %s. Please be more specific or use the board ID",
+		nameOrID, strings.Join(options, ", "))
+}
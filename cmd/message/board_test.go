@@ -0,0 +1,67 @@
+package message
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/api/mock"
+)
+
+func TestResolveMessageBoard(t *testing.T) {
+	newClient := func() *mock.MockClient {
+		return &mock.MockClient{
+			MessageBoard: &api.MessageBoard{ID: 10, Title: "Announcements"},
+			MessageBoards: []api.MessageBoard{
+				{ID: 10, Title: "Announcements"},
+				{ID: 30, Title: "Engineering"},
+				{ID: 40, Title: "Engineering Leads"},
+			},
+		}
+	}
+	ctx := context.Background()
+
+	t.Run("defaults to the first board", func(t *testing.T) {
+		client := newClient()
+		board, err := resolveMessageBoard(ctx, client, "1", "")
+		require.NoError(t, err)
+		assert.Equal(t, int64(10), board.ID)
+		assert.Equal(t, []string{"GetMessageBoard(1)"}, client.Calls)
+	})
+
+	t.Run("by ID", func(t *testing.T) {
+		board, err := resolveMessageBoard(ctx, newClient(), "1", "40")
+		require.NoError(t, err)
+		assert.Equal(t, "Engineering Leads", board.Title)
+	})
+
+	t.Run("exact name wins over partial matches", func(t *testing.T) {
+		board, err := resolveMessageBoard(ctx, newClient(), "1", "engineering")
+		require.NoError(t, err)
+		assert.Equal(t, int64(30), board.ID)
+	})
+
+	t.Run("partial name", func(t *testing.T) {
+		board, err := resolveMessageBoard(ctx, newClient(), "1", "announce")
+		require.NoError(t, err)
+		assert.Equal(t, int64(10), board.ID)
+	})
+
+	t.Run("ambiguous name", func(t *testing.T) {
+		_, err := resolveMessageBoard(ctx, newClient(), "1", "eng")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "multiple message boards match 'eng'")
+		assert.Contains(t, err.Error(), "Engineering (ID: 30)")
+	})
+
+	t.Run("unknown board", func(t *testing.T) {
+		_, err := resolveMessageBoard(ctx, newClient(), "1", "Sales")
+		assert.EqualError(t, err, "message board not found: Sales")
+
+		_, err = resolveMessageBoard(ctx, newClient(), "1", "99")
+		assert.EqualError(t, err, "message board ID 99 not found in project")
+	})
+}
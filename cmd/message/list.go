@@ -17,12 +17,16 @@ func newListCmd(f *factory.Factory) *cobra.Command {
 		category  string
 		limit     int
 		noPinSort bool
+		board     string
 	)
 
 	cmd := &cobra.Command{
-		Use:     "list [project]",
-		Short:   "List messages in a project",
-		Long:    `List all messages on a project's message board.`,
+		Use:   "list [project]",
+		Short: "List messages in a project",
+		Long: `List all messages on a project's message board.
+
+For projects with more than one message board, pick the board with --board
+(name or ID). The first board is used by default.`,
 		Aliases: []string{"ls"},
 		Args:    cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -39,13 +43,13 @@ func newListCmd(f *factory.Factory) *cobra.Command {
 			}
 
 			// Get the message board for the project
-			board, err := client.GetMessageBoard(cmd.Context(), projectID)
+			messageBoard, err := resolveMessageBoard(cmd.Context(), client, projectID, board)
 			if err != nil {
 				return err
 			}
 
 			// Get all messages
-			messages, err := client.ListMessages(cmd.Context(), projectID, board.ID)
+			messages, err := client.ListMessages(cmd.Context(), projectID, messageBoard.ID)
 			if err != nil {
 				return err
 			}
@@ -141,6 +145,7 @@ func newListCmd(f *factory.Factory) *cobra.Command {
 	cmd.Flags().StringVarP(&category, "category", "c", "", "Filter by category")
 	cmd.Flags().IntVarP(&limit, "limit", "l", 0, "Limit number of messages shown")
 	cmd.Flags().BoolVar(&noPinSort, "no-pin-sort", false, "Don't sort pinned messages first")
+	cmd.Flags().StringVar(&board, "board", "", "Message board name or ID (defaults to the project's first board)")
 
 	return cmd
 }
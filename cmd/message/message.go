@@ -22,7 +22,8 @@ attachments. Team members can comment on messages to continue the conversation.`
   bc4 message view 123                # View message details
   bc4 message edit 123                # Edit an existing message
   bc4 message pin 123                 # Pin a message to the top
-  bc4 message unpin 123               # Unpin a message`,
+  bc4 message unpin 123               # Unpin a message
+  bc4 comment add 123 -m "Thanks!"    # Comment on a message (see 'bc4 comment')`,
 	}
 
 	// Enable suggestions for subcommand typos
@@ -4,13 +4,16 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/charmbracelet/huh"
 	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/cmdutil"
 	"github.com/needmore/bc4/internal/factory"
 	"github.com/needmore/bc4/internal/markdown"
 	"github.com/needmore/bc4/internal/mentions"
+	"github.com/needmore/bc4/internal/parser"
 	"github.com/needmore/bc4/internal/ui"
 	"github.com/spf13/cobra"
 )
@@ -20,20 +23,33 @@ func newPostCmd(f *factory.Factory) *cobra.Command {
 		title      string
 		content    string
 		categoryID int64
+		toBoard    string
+		output     cmdutil.CreatedOutput
 	)
 
 	cmd := &cobra.Command{
-		Use:   "post [project]",
-		Short: "Post a new message",
+		Use:     "post [project]",
+		Aliases: []string{"create"},
+		Short:   "Post a new message",
 		Long: `Post a new message to a project's message board.
 
 You can provide message content in several ways:
   - Interactively (default)
   - Via --content flag
   - Via stdin: echo "content" | bc4 message post [project] --title "Title"
-  - From file: cat message.md | bc4 message post [project] --title "Title"`,
+  - From file: cat message.md | bc4 message post [project] --title "Title"
+
+Messages go to the project's first message board. For projects with more
+than one, pick the board with --to-board (name or ID).
+
+Use --print-url to print the new message's web URL. Add --quiet to print only
+the URL.`,
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := output.Validate(); err != nil {
+				return err
+			}
+
 			// Apply project override if specified
 			if len(args) > 0 {
 				f = f.WithProject(args[0])
@@ -52,7 +68,7 @@ You can provide message content in several ways:
 			}
 
 			// Get the message board for the project
-			board, err := client.GetMessageBoard(f.Context(), projectID)
+			board, err := resolveMessageBoard(f.Context(), client, projectID, toBoard)
 			if err != nil {
 				return err
 			}
@@ -131,19 +147,24 @@ You can provide message content in several ways:
 			}
 
 			// Output
+			summary := strconv.FormatInt(message.ID, 10)
 			if ui.IsTerminal(os.Stdout) {
-				fmt.Printf("✓ Created message #%d: %s\n", message.ID, message.Subject)
-			} else {
-				fmt.Println(message.ID)
+				summary = fmt.Sprintf("✓ Created message #%d: %s", message.ID, message.Subject)
 			}
 
-			return nil
+			accountID, err := f.AccountID()
+			if err != nil {
+				return err
+			}
+			return output.Write(os.Stdout, summary, accountID, projectID, parser.ResourceTypeMessage, message.ID)
 		},
 	}
 
 	cmd.Flags().StringVarP(&title, "title", "t", "", "Message subject")
 	cmd.Flags().StringVarP(&content, "content", "c", "", "Message content (markdown supported)")
 	cmd.Flags().Int64Var(&categoryID, "category-id", 0, "Category ID")
+	output.AddFlags(cmd)
+	cmd.Flags().StringVar(&toBoard, "to-board", "", "Message board name or ID (defaults to the project's first board)")
 
 	return cmd
 }
@@ -0,0 +1,140 @@
+package people
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/huh"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/ui"
+	"github.com/needmore/bc4/internal/utils"
+)
+
+// AccessOptions configures UpdateAccess
+type AccessOptions struct {
+	ProjectID   string
+	AccountID   string
+	SkipConfirm bool
+	JSONOutput  bool
+}
+
+// UpdateAccess grants or revokes project access for people given as IDs,
+// names, or email addresses. People being granted access are looked up
+// account-wide, since they aren't on the project yet. Revoking access is
+// confirmed on a terminal unless SkipConfirm is set.
+func UpdateAccess(f *factory.Factory, opts AccessOptions, people []string, grant bool) error {
+	f = f.ApplyOverrides(opts.AccountID, opts.ProjectID)
+
+	projectID, err := f.ProjectID()
+	if err != nil {
+		return fmt.Errorf("project is required: use --project flag or set a default project")
+	}
+
+	client, err := f.ApiClient()
+	if err != nil {
+		return err
+	}
+
+	project, err := client.Projects().GetProject(f.Context(), projectID)
+	if err != nil {
+		return fmt.Errorf("failed to fetch project: %w", err)
+	}
+
+	resolver := utils.NewUserResolver(client.Client, projectID)
+	if grant {
+		resolver = utils.NewAccountUserResolver(client.Client)
+	}
+
+	ids, err := utils.ResolvePersonIDs(f.Context(), resolver, people)
+	if err != nil {
+		return fmt.Errorf("failed to resolve people: %w", err)
+	}
+
+	// Revoking access is disruptive, so confirm interactively
+	if !grant && !opts.SkipConfirm && ui.IsTerminal(os.Stdout) {
+		names := personNames(f.Context(), resolver, ids)
+		var confirm bool
+		if err := huh.NewConfirm().
+			Title(fmt.Sprintf("Remove %s from \"%s\"?", strings.Join(names, ", "), project.Name)).
+			Description("They will lose access to everything in this project.").
+			Affirmative("Remove").
+			Negative("Cancel").
+			Value(&confirm).
+			Run(); err != nil {
+			return err
+		}
+
+		if !confirm {
+			fmt.Println("Canceled")
+			return nil
+		}
+	}
+
+	req := api.ProjectAccessUpdateRequest{}
+	if grant {
+		req.Grant = ids
+	} else {
+		req.Revoke = ids
+	}
+
+	response, err := client.People().UpdateProjectAccess(f.Context(), projectID, req)
+	if err != nil {
+		return fmt.Errorf("failed to update project access: %w", err)
+	}
+
+	if opts.JSONOutput {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(response)
+	}
+
+	printAccessChanges(os.Stdout, project.Name, response, grant)
+	return nil
+}
+
+// personNames returns display names for ids, falling back to "#id" for
+// anyone the resolver doesn't know
+func personNames(ctx context.Context, resolver *utils.UserResolver, ids []int64) []string {
+	people, _ := resolver.GetPeople(ctx)
+	byID := make(map[int64]string, len(people))
+	for _, person := range people {
+		byID[person.ID] = person.Name
+	}
+
+	names := make([]string, len(ids))
+	for i, id := range ids {
+		if name, ok := byID[id]; ok && name != "" {
+			names[i] = name
+		} else {
+			names[i] = fmt.Sprintf("#%d", id)
+		}
+	}
+	return names
+}
+
+// printAccessChanges reports who was added to or removed from a project
+func printAccessChanges(w io.Writer, projectName string, response *api.ProjectAccessUpdateResponse, grant bool) {
+	changed, verb, preposition := response.Revoked, "Removed", "from"
+	if grant {
+		changed, verb, preposition = response.Granted, "Added", "to"
+	}
+
+	if len(changed) == 0 {
+		if grant {
+			fmt.Fprintln(w, "No access was granted (they may already be on the project)")
+		} else {
+			fmt.Fprintln(w, "No access was revoked (they may not have been on the project)")
+		}
+		return
+	}
+
+	for _, person := range changed {
+		fmt.Fprintf(w, "✓ %s %s (%d) %s %s\n", verb, person.Name, person.ID, preposition, projectName)
+	}
+}
@@ -0,0 +1,29 @@
+package people
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/needmore/bc4/internal/api"
+)
+
+func TestPrintAccessChanges(t *testing.T) {
+	response := &api.ProjectAccessUpdateResponse{
+		Granted: []api.Person{{ID: 1, Name: "Jane Smith"}},
+		Revoked: []api.Person{{ID: 2, Name: "John Doe"}},
+	}
+
+	var added bytes.Buffer
+	printAccessChanges(&added, "Website", response, true)
+	assert.Equal(t, "✓ Added Jane Smith (1) to Website\n", added.String())
+
+	var removed bytes.Buffer
+	printAccessChanges(&removed, "Website", response, false)
+	assert.Equal(t, "✓ Removed John Doe (2) from Website\n", removed.String())
+
+	var none bytes.Buffer
+	printAccessChanges(&none, "Website", &api.ProjectAccessUpdateResponse{}, false)
+	assert.Contains(t, none.String(), "No access was revoked")
+}
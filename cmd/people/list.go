@@ -91,6 +91,9 @@ Use --project to list only people with access to a specific project.`,
 			if format == ui.OutputFormatJSON {
 				return outputPeopleJSON(people)
 			}
+			if format == ui.OutputFormatJSONL {
+				return ui.WriteJSONLines(os.Stdout, people, nil)
+			}
 
 			// Check if there are any people
 			if len(people) == 0 {
@@ -104,7 +107,7 @@ Use --project to list only people with access to a specific project.`,
 	}
 
 	cmd.Flags().BoolVar(&jsonOutput, "json", false, "Output as JSON (deprecated, use --format=json)")
-	cmd.Flags().StringVarP(&formatStr, "format", "f", "table", "Output format: table, json, or csv")
+	cmd.Flags().StringVarP(&formatStr, "format", "f", "table", "Output format: table, json, jsonl, or csv")
 	cmd.Flags().StringVarP(&projectID, "project", "p", "", "Filter by project ID")
 	cmd.Flags().StringVarP(&accountID, "account", "a", "", "Specify account ID (overrides default)")
 
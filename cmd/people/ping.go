@@ -69,6 +69,9 @@ members who are available to receive pings from you.`,
 			if format == ui.OutputFormatJSON {
 				return outputPingablePeopleJSON(people)
 			}
+			if format == ui.OutputFormatJSONL {
+				return ui.WriteJSONLines(os.Stdout, people, nil)
+			}
 
 			// Check if there are any people
 			if len(people) == 0 {
@@ -82,7 +85,7 @@ members who are available to receive pings from you.`,
 	}
 
 	cmd.Flags().BoolVar(&jsonOutput, "json", false, "Output as JSON (deprecated, use --format=json)")
-	cmd.Flags().StringVarP(&formatStr, "format", "f", "table", "Output format: table, json, or csv")
+	cmd.Flags().StringVarP(&formatStr, "format", "f", "table", "Output format: table, json, jsonl, or csv")
 	cmd.Flags().StringVarP(&accountID, "account", "a", "", "Specify account ID (overrides default)")
 
 	return cmd
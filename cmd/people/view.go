@@ -2,38 +2,62 @@ package people
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"os"
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 
+	"github.com/needmore/bc4/internal/api"
 	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/ui"
 	"github.com/needmore/bc4/internal/ui/tableprinter"
+	"github.com/needmore/bc4/internal/utils"
 )
 
 func newViewCmd(f *factory.Factory) *cobra.Command {
 	var jsonOutput bool
+	var formatStr string
 	var accountID string
+	var showProjects bool
+	var showTodos bool
 
 	cmd := &cobra.Command{
-		Use:   "view <person-id>",
+		Use:   "view <person>",
 		Short: "View person details",
 		Long: `View detailed information about a specific person.
 
-Displays the person's name, email, title, company, and role information.`,
+Displays the person's name, email, title, company, and role information.
+The person can be given as an ID, name, or email address.
+
+Use --projects to also list the projects in the account the person belongs
+to, and add --todos to count the open todos assigned to them in each.`,
 		Aliases: []string{"show", "get"},
 		Example: `  # View person details
   bc4 people view 12345
 
   # View as JSON
-  bc4 people view 12345 --json`,
+  bc4 people view 12345 --json
+
+  # See which projects someone is on and their open todos in each
+  bc4 people view "Jane Smith" --projects --todos`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			// Parse person ID
-			personID, err := strconv.ParseInt(args[0], 10, 64)
+			if showTodos && !showProjects {
+				return fmt.Errorf("--todos requires --projects")
+			}
+
+			// Parse output format
+			format, err := ui.ResolveTableOrJSON(formatStr)
 			if err != nil {
-				return fmt.Errorf("invalid person ID: %s", args[0])
+				return err
+			}
+			if jsonOutput {
+				format = ui.OutputFormatJSON
 			}
 
 			// Apply overrides if specified
@@ -46,16 +70,34 @@ Displays the person's name, email, title, company, and role information.`,
 			}
 			peopleOps := client.People()
 
+			// Resolve the person by ID, name, or email
+			ids, err := utils.ResolvePersonIDs(f.Context(), utils.NewAccountUserResolver(client.Client), args)
+			if err != nil {
+				return fmt.Errorf("failed to resolve person: %w", err)
+			}
+			personID := ids[0]
+
 			// Fetch person
 			person, err := peopleOps.GetPerson(f.Context(), personID)
 			if err != nil {
 				return fmt.Errorf("failed to fetch person: %w", err)
 			}
 
+			var projects []personProject
+			if showProjects {
+				projects, err = fetchPersonProjects(f, client, personID, showTodos)
+				if err != nil {
+					return err
+				}
+			}
+
 			// Handle JSON output
-			if jsonOutput {
+			if format == ui.OutputFormatJSON {
 				encoder := json.NewEncoder(os.Stdout)
 				encoder.SetIndent("", "  ")
+				if showProjects {
+					return encoder.Encode(personWithProjects{Person: person, Projects: projects})
+				}
 				return encoder.Encode(person)
 			}
 
@@ -100,12 +142,106 @@ Displays the person's name, email, title, company, and role information.`,
 
 			fmt.Println()
 
+			if showProjects {
+				if len(projects) == 0 {
+					fmt.Println("Not a member of any projects")
+					return nil
+				}
+				return renderPersonProjects(os.Stdout, projects, showTodos)
+			}
+
 			return nil
 		},
 	}
 
-	cmd.Flags().BoolVar(&jsonOutput, "json", false, "Output as JSON")
+	cmd.Flags().BoolVar(&showProjects, "projects", false, "List the projects the person belongs to")
+	cmd.Flags().BoolVar(&showTodos, "todos", false, "With --projects, count the person's open todos in each project")
+	cmd.Flags().BoolVar(&jsonOutput, "json", false, "Output as JSON (deprecated, use --format=json)")
+	cmd.Flags().StringVarP(&formatStr, "format", "f", "table", "Output format: table or json")
 	cmd.Flags().StringVarP(&accountID, "account", "a", "", "Specify account ID (overrides default)")
 
 	return cmd
 }
+
+// personProject is a project a person belongs to, with the number of open
+// todos assigned to them there when requested
+type personProject struct {
+	ID        int64  `json:"id"`
+	Name      string `json:"name"`
+	OpenTodos *int   `json:"open_todos,omitempty"`
+}
+
+// personWithProjects is the JSON shape of a person viewed with --projects
+type personWithProjects struct {
+	*api.Person
+	Projects []personProject `json:"projects"`
+}
+
+// fetchPersonProjects scans the account's projects for the person and, with
+// todos set, counts their open todos in each. Projects that fail to load are
+// left out with a warning.
+func fetchPersonProjects(f *factory.Factory, client *api.ModularClient, personID int64, todos bool) ([]personProject, error) {
+	var partialErr *api.PartialError
+
+	memberships, err := client.GetProjectMemberships(f.Context(), personID)
+	if errors.As(err, &partialErr) {
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", partialErr)
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to fetch project memberships: %w", err)
+	}
+
+	var counts map[int64]int
+	if todos && len(memberships) > 0 {
+		projects := make([]api.Project, len(memberships))
+		for i, m := range memberships {
+			projects[i] = m.Project
+		}
+		counts, err = client.GetOpenTodoCounts(f.Context(), projects, personID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count open todos: %w", err)
+		}
+	}
+
+	return newPersonProjects(memberships, counts, todos), nil
+}
+
+// newPersonProjects builds the project list for a person, sorted by name.
+// With todos set, projects whose count is missing have no OpenTodos.
+func newPersonProjects(memberships []api.ProjectMembership, counts map[int64]int, todos bool) []personProject {
+	projects := make([]personProject, 0, len(memberships))
+	for _, m := range memberships {
+		p := personProject{ID: m.Project.ID, Name: m.Project.Name}
+		if count, ok := counts[m.Project.ID]; todos && ok {
+			p.OpenTodos = &count
+		}
+		projects = append(projects, p)
+	}
+	sort.Slice(projects, func(i, j int) bool {
+		return strings.ToLower(projects[i].Name) < strings.ToLower(projects[j].Name)
+	})
+	return projects
+}
+
+func renderPersonProjects(w io.Writer, projects []personProject, todos bool) error {
+	table := tableprinter.New(w)
+	if todos {
+		table.AddHeader("PROJECT", "ID", "OPEN TODOS")
+	} else {
+		table.AddHeader("PROJECT", "ID")
+	}
+
+	cs := table.GetColorScheme()
+	for _, p := range projects {
+		table.AddProjectField(p.Name, "active")
+		table.AddIDField(strconv.FormatInt(p.ID, 10), "")
+		if todos {
+			if p.OpenTodos != nil {
+				table.AddField(strconv.Itoa(*p.OpenTodos))
+			} else {
+				table.AddField("?", cs.Muted)
+			}
+		}
+		table.EndRow()
+	}
+	return table.Render()
+}
@@ -0,0 +1,59 @@
+package people
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/needmore/bc4/internal/api"
+)
+
+func TestNewPersonProjects(t *testing.T) {
+	memberships := []api.ProjectMembership{
+		{Project: api.Project{ID: 2, Name: "website"}},
+		{Project: api.Project{ID: 1, Name: "Apollo"}},
+		{Project: api.Project{ID: 3, Name: "Mobile"}},
+	}
+
+	t.Run("without todos", func(t *testing.T) {
+		projects := newPersonProjects(memberships, nil, false)
+		require.Len(t, projects, 3)
+		assert.Equal(t, []string{"Apollo", "Mobile", "website"},
+			[]string{projects[0].Name, projects[1].Name, projects[2].Name})
+		for _, p := range projects {
+			assert.Nil(t, p.OpenTodos)
+		}
+	})
+
+	t.Run("with todos, missing counts stay unset", func(t *testing.T) {
+		projects := newPersonProjects(memberships, map[int64]int{1: 4, 2: 0}, true)
+		require.Len(t, projects, 3)
+		require.NotNil(t, projects[0].OpenTodos)
+		assert.Equal(t, 4, *projects[0].OpenTodos)
+		assert.Nil(t, projects[1].OpenTodos)
+		require.NotNil(t, projects[2].OpenTodos)
+		assert.Equal(t, 0, *projects[2].OpenTodos)
+	})
+}
+
+func TestPersonWithProjectsJSON(t *testing.T) {
+	count := 2
+	out := personWithProjects{
+		Person:   &api.Person{ID: 7, Name: "Jane"},
+		Projects: []personProject{{ID: 1, Name: "Apollo", OpenTodos: &count}, {ID: 2, Name: "Mobile"}},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, json.NewEncoder(&buf).Encode(out))
+
+	var decoded map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	assert.Equal(t, "Jane", decoded["name"])
+	projects := decoded["projects"].([]any)
+	require.Len(t, projects, 2)
+	assert.Equal(t, float64(2), projects[0].(map[string]any)["open_todos"])
+	assert.NotContains(t, projects[1].(map[string]any), "open_todos")
+}
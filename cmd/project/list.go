@@ -79,6 +79,9 @@ func newListCmd(f *factory.Factory) *cobra.Command {
 			if format == ui.OutputFormatJSON {
 				return outputJSON(projects)
 			}
+			if format == ui.OutputFormatJSONL {
+				return ui.WriteJSONLines(os.Stdout, projects, nil)
+			}
 
 			// Check if there are any projects
 			if len(projects) == 0 {
@@ -143,7 +146,7 @@ func newListCmd(f *factory.Factory) *cobra.Command {
 
 	cmd.Flags().BoolVar(&jsonOutput, "json", false, "Output as JSON (deprecated, use --format=json)")
 	cmd.Flags().StringVarP(&accountID, "account", "a", "", "Specify account ID (overrides default)")
-	cmd.Flags().StringVarP(&formatStr, "format", "f", "table", "Output format: table, json, or csv")
+	cmd.Flags().StringVarP(&formatStr, "format", "f", "table", "Output format: table, json, jsonl, or csv")
 
 	return cmd
 }
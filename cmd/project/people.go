@@ -0,0 +1,83 @@
+package project
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/needmore/bc4/cmd/people"
+	"github.com/needmore/bc4/internal/factory"
+)
+
+func newPeopleCmd(f *factory.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "people",
+		Short: "Manage who has access to a project",
+		Long: `Grant or revoke project access for people who already have Basecamp accounts.
+
+People can be given as IDs, names, or email addresses. To invite someone who
+doesn't have an account yet, use 'bc4 people invite'.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	cmd.AddCommand(newPeopleAddCmd(f))
+	cmd.AddCommand(newPeopleRemoveCmd(f))
+
+	return cmd
+}
+
+func newPeopleAddCmd(f *factory.Factory) *cobra.Command {
+	opts := people.AccessOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "add <person>...",
+		Short: "Give people access to a project",
+		Long: `Give existing account members access to a project.
+
+People are matched against everyone in the account, by ID, name, or email.`,
+		Aliases: []string{"grant"},
+		Example: `  # Add people to the default project
+  bc4 project people add jane@example.com "John Doe"
+
+  # Add by person ID to a specific project
+  bc4 project people add 12345 --project 67890`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return people.UpdateAccess(f, opts, args, true)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.ProjectID, "project", "p", "", "Project ID (defaults to selected project)")
+	cmd.Flags().StringVarP(&opts.AccountID, "account", "a", "", "Specify account ID (overrides default)")
+
+	return cmd
+}
+
+func newPeopleRemoveCmd(f *factory.Factory) *cobra.Command {
+	opts := people.AccessOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "remove <person>...",
+		Short: "Revoke people's access to a project",
+		Long: `Revoke project access for people currently on a project.
+
+People are matched against the project's members, by ID, name, or email.
+You'll be asked to confirm on a terminal; use --yes to skip the prompt.`,
+		Aliases: []string{"rm", "revoke"},
+		Example: `  # Remove someone from the default project
+  bc4 project people remove jane@example.com
+
+  # Remove several people without a prompt
+  bc4 project people remove 12345 "John Doe" --project 67890 --yes`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return people.UpdateAccess(f, opts, args, false)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.ProjectID, "project", "p", "", "Project ID (defaults to selected project)")
+	cmd.Flags().StringVarP(&opts.AccountID, "account", "a", "", "Specify account ID (overrides default)")
+	cmd.Flags().BoolVarP(&opts.SkipConfirm, "yes", "y", false, "Skip confirmation prompt")
+
+	return cmd
+}
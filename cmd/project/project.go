@@ -30,6 +30,7 @@ func NewProjectCmd(f *factory.Factory) *cobra.Command {
 	cmd.AddCommand(newArchiveCmd(f))
 	cmd.AddCommand(newUnarchiveCmd(f))
 	cmd.AddCommand(newCopyCmd(f))
+	cmd.AddCommand(newPeopleCmd(f))
 
 	return cmd
 }
@@ -39,6 +39,7 @@ func TestNewCmdProject(t *testing.T) {
 		"archive",
 		"unarchive",
 		"copy",
+		"people",
 	}
 
 	for _, expected := range expectedCommands {
@@ -23,12 +23,18 @@ type projectsLoadedMsg struct {
 	err      error
 }
 
+// defaultSavedMsg reports the result of saving the chosen default
+type defaultSavedMsg struct {
+	err error
+}
+
 type selectModel struct {
 	list      list.Model
 	projects  []api.Project
 	spinner   spinner.Model
 	loading   bool
 	err       error
+	saveErr   error
 	width     int
 	height    int
 	accountID string
@@ -118,6 +124,10 @@ func (m selectModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.list.Styles.TitleBar = lipgloss.NewStyle()
 		return m, nil
 
+	case defaultSavedMsg:
+		m.saveErr = msg.err
+		return m, nil
+
 	case spinner.TickMsg:
 		var cmd tea.Cmd
 		m.spinner, cmd = m.spinner.Update(msg)
@@ -184,26 +194,33 @@ func (m *selectModel) saveDefaultProject(project api.Project) tea.Cmd {
 			return nil
 		}
 
-		cfg.DefaultProject = fmt.Sprintf("%d", project.ID)
-
-		if cfg.Accounts == nil {
-			cfg.Accounts = make(map[string]config.AccountConfig)
+		// Get the account name from auth, in case the config doesn't have it
+		var accountName string
+		authClient := auth.NewClient(cfg.ClientID, cfg.ClientSecret)
+		if token, err := authClient.GetToken(m.accountID); err == nil {
+			accountName = token.AccountName
 		}
 
-		// Update account-specific default project
-		accountCfg := cfg.Accounts[m.accountID]
-		accountCfg.DefaultProject = fmt.Sprintf("%d", project.ID)
-		// Preserve the name if it exists
-		if accountCfg.Name == "" {
-			// Get the account name from auth
-			authClient := auth.NewClient(cfg.ClientID, cfg.ClientSecret)
-			if token, err := authClient.GetToken(m.accountID); err == nil {
-				accountCfg.Name = token.AccountName
+		err = config.Update(func(cfg *config.Config) error {
+			cfg.DefaultProject = fmt.Sprintf("%d", project.ID)
+
+			if cfg.Accounts == nil {
+				cfg.Accounts = make(map[string]config.AccountConfig)
 			}
-		}
-		cfg.Accounts[m.accountID] = accountCfg
 
-		_ = config.Save(cfg)
+			// Update account-specific default project
+			accountCfg := cfg.Accounts[m.accountID]
+			accountCfg.DefaultProject = fmt.Sprintf("%d", project.ID)
+			// Preserve the name if it exists
+			if accountCfg.Name == "" {
+				accountCfg.Name = accountName
+			}
+			cfg.Accounts[m.accountID] = accountCfg
+			return nil
+		})
+		if err != nil {
+			return defaultSavedMsg{err: fmt.Errorf("failed to save config: %w", err)}
+		}
 
 		fmt.Printf("\nDefault project set to: %s (ID: %d)\n", project.Name, project.ID)
 		return nil
@@ -319,9 +336,13 @@ func newSelectCmd(f *factory.Factory) *cobra.Command {
 			}
 
 			// Run the interactive selector
-			if _, err := tea.NewProgram(m, tea.WithAltScreen()).Run(); err != nil {
+			final, err := tea.NewProgram(m, tea.WithAltScreen()).Run()
+			if err != nil {
 				return fmt.Errorf("error running selector: %w", err)
 			}
+			if sm, ok := final.(selectModel); ok && sm.saveErr != nil {
+				return sm.saveErr
+			}
 
 			return nil
 		},
@@ -20,11 +20,7 @@ func newSetCmd(f *factory.Factory) *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			projectID := args[0]
 
-			// Get config and auth through factory
-			cfg, err := f.Config()
-			if err != nil {
-				return err
-			}
+			// Get auth through factory
 			authClient, err := f.AuthClient()
 			if err != nil {
 				return err
@@ -42,26 +38,27 @@ func newSetCmd(f *factory.Factory) *cobra.Command {
 			}
 
 			// Update config
-			cfg.DefaultProject = projectID
-
-			if cfg.Accounts == nil {
-				cfg.Accounts = make(map[string]config.AccountConfig)
-			}
+			err = config.Update(func(cfg *config.Config) error {
+				cfg.DefaultProject = projectID
 
-			// Update account-specific default project
-			accountCfg := cfg.Accounts[accountID]
-			accountCfg.DefaultProject = projectID
-			// Preserve the name if it exists
-			if accountCfg.Name == "" {
-				// Get the account name from auth
-				if token, err := authClient.GetToken(accountID); err == nil {
-					accountCfg.Name = token.AccountName
+				if cfg.Accounts == nil {
+					cfg.Accounts = make(map[string]config.AccountConfig)
 				}
-			}
-			cfg.Accounts[accountID] = accountCfg
 
-			// Save config
-			if err := config.Save(cfg); err != nil {
+				// Update account-specific default project
+				accountCfg := cfg.Accounts[accountID]
+				accountCfg.DefaultProject = projectID
+				// Preserve the name if it exists
+				if accountCfg.Name == "" {
+					// Get the account name from auth
+					if token, err := authClient.GetToken(accountID); err == nil {
+						accountCfg.Name = token.AccountName
+					}
+				}
+				cfg.Accounts[accountID] = accountCfg
+				return nil
+			})
+			if err != nil {
 				return fmt.Errorf("failed to save config: %w", err)
 			}
 
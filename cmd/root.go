@@ -3,6 +3,7 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/spf13/cobra"
@@ -10,12 +11,15 @@ import (
 
 	"github.com/needmore/bc4/cmd/account"
 	"github.com/needmore/bc4/cmd/activity"
+	"github.com/needmore/bc4/cmd/alias"
 	"github.com/needmore/bc4/cmd/auth"
 	"github.com/needmore/bc4/cmd/campfire"
 	"github.com/needmore/bc4/cmd/card"
 	"github.com/needmore/bc4/cmd/checkin"
 	"github.com/needmore/bc4/cmd/comment"
+	configCmd "github.com/needmore/bc4/cmd/config"
 	"github.com/needmore/bc4/cmd/document"
+	"github.com/needmore/bc4/cmd/export"
 	"github.com/needmore/bc4/cmd/message"
 	"github.com/needmore/bc4/cmd/people"
 	"github.com/needmore/bc4/cmd/profile"
@@ -24,11 +28,13 @@ import (
 	"github.com/needmore/bc4/cmd/search"
 	"github.com/needmore/bc4/cmd/timesheet"
 	"github.com/needmore/bc4/cmd/todo"
+	undoCmd "github.com/needmore/bc4/cmd/undo"
 	"github.com/needmore/bc4/internal/cmdutil"
 	"github.com/needmore/bc4/internal/config"
 	"github.com/needmore/bc4/internal/errors"
 	"github.com/needmore/bc4/internal/factory"
 	"github.com/needmore/bc4/internal/tui"
+	"github.com/needmore/bc4/internal/ui"
 	"github.com/needmore/bc4/internal/version"
 )
 
@@ -48,7 +54,7 @@ var rootCmd = &cobra.Command{
 • And much more!
 
 Quick Start:
-  bc4                        Run first-time setup wizard
+  bc4                        Setup wizard, or your dashboard once configured
   bc4 auth status            Check if authenticated
   bc4 project list           See your projects
   bc4 project select         Pick a default project
@@ -68,31 +74,19 @@ Shell Completions:
   bc4 completion powershell  PowerShell
 
 See 'bc4 completion --help' for installation instructions.`,
-	RunE: func(cmd *cobra.Command, args []string) error {
-		// Check if this is the first run
-		if config.IsFirstRun() {
-			// Run first-run wizard immediately with clean screen
-			p := tea.NewProgram(
-				tui.NewFirstRunModel(),
-				tea.WithAltScreen(),
-				tea.WithMouseCellMotion(),
-			)
-
-			if _, err := p.Run(); err != nil {
-				return fmt.Errorf("setup failed: %w", err)
-			}
-
-			fmt.Println("\nSetup complete! You can now use bc4.")
-			fmt.Println("Try 'bc4 auth status' to see your account information.")
-			return nil
-		}
-
-		// Show help if no subcommand
-		return cmd.Help()
-	},
 }
 
 func Execute() {
+	// Aliases are expanded before cobra parses flags, so the config file
+	// they come from has to be found by hand
+	if path := configFileArg(os.Args[1:]); path != "" {
+		config.SetConfigPath(path)
+	}
+	if err := expandAlias(); err != nil {
+		fmt.Fprintln(os.Stderr, errors.FormatError(err))
+		os.Exit(cmdutil.ExitUsageError)
+	}
+
 	err := rootCmd.Execute()
 	if err != nil {
 		// Don't format cobra's built-in errors (help, version, etc.)
@@ -110,6 +104,8 @@ func Execute() {
 		switch {
 		case cmdutil.IsUsageError(unwrappedErr):
 			exitCode = cmdutil.ExitUsageError
+		case cmdutil.IsCheckFailedError(unwrappedErr):
+			exitCode = cmdutil.ExitCheckFailed
 		case errors.IsAuthenticationError(unwrappedErr), errors.IsConfigurationError(unwrappedErr):
 			exitCode = cmdutil.ExitAuthError
 		case errors.IsNotFoundError(unwrappedErr):
@@ -138,7 +134,7 @@ func init() {
 	cmdutil.EnableSuggestions(rootCmd)
 
 	// Global flags
-	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.config/bc4/config.json)")
+	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.config/bc4/config.json, or $BC4_CONFIG)")
 	rootCmd.PersistentFlags().StringP("account", "a", "", "Override default account ID")
 	rootCmd.PersistentFlags().StringP("project", "p", "", "Override default project ID")
 	rootCmd.PersistentFlags().Bool("json", false, "Output in JSON format")
@@ -155,30 +151,110 @@ func init() {
 	// Create factory
 	f := factory.New()
 
+	rootCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		return runRoot(cmd, f)
+	}
+
 	// Add commands with factory
 	rootCmd.AddCommand(auth.NewAuthCmd(f))
 	rootCmd.AddCommand(account.NewAccountCmd(f))
 	rootCmd.AddCommand(activity.NewActivityCmd(f))
+	rootCmd.AddCommand(alias.NewAliasCmd(f))
 	rootCmd.AddCommand(project.NewProjectCmd(f))
 	rootCmd.AddCommand(todo.NewTodoCmd(f))
 	rootCmd.AddCommand(message.NewMessageCmd(f))
 	rootCmd.AddCommand(document.NewDocumentCmd(f))
+	rootCmd.AddCommand(export.NewExportCmd(f))
 	rootCmd.AddCommand(campfire.NewCampfireCmd(f))
 	rootCmd.AddCommand(card.NewCardCmd(f))
 	rootCmd.AddCommand(checkin.NewCheckinCmd(f))
+	rootCmd.AddCommand(checkin.NewRemindersCmd(f))
 	rootCmd.AddCommand(comment.NewCommentCmd(f))
+	rootCmd.AddCommand(configCmd.NewConfigCmd(f))
 	rootCmd.AddCommand(people.NewPeopleCmd(f))
 	rootCmd.AddCommand(profile.NewProfileCmd(f))
 	rootCmd.AddCommand(schedule.NewScheduleCmd(f))
 	rootCmd.AddCommand(search.NewSearchCmd(f))
 	rootCmd.AddCommand(timesheet.NewTimesheetCmd(f))
+	rootCmd.AddCommand(undoCmd.NewUndoCmd(f))
 
 	// Add version command (doesn't need factory)
 	rootCmd.AddCommand(versionCmd)
 }
 
+// configFileArg returns the config file given with --config in args, or
+// BC4_CONFIG when the flag isn't used
+func configFileArg(args []string) string {
+	for i, arg := range args {
+		if arg == "--" {
+			break
+		}
+		if arg == "--config" && i+1 < len(args) {
+			return args[i+1]
+		}
+		if path, ok := strings.CutPrefix(arg, "--config="); ok {
+			return path
+		}
+	}
+	return os.Getenv("BC4_CONFIG")
+}
+
+// expandAlias replaces a leading user-defined alias in the command line with
+// its expansion before cobra dispatches it
+func expandAlias() error {
+	cfg, err := config.Load()
+	if err != nil || len(cfg.Aliases) == 0 {
+		// A broken config is reported by the command that needs it
+		return nil
+	}
+
+	args, expanded, err := alias.ExpandCommandLine(rootCmd, cfg.Aliases, os.Args[1:])
+	if err != nil {
+		return err
+	}
+	if expanded {
+		rootCmd.SetArgs(args)
+	}
+	return nil
+}
+
+func runRoot(cmd *cobra.Command, f *factory.Factory) error {
+	// Check if this is the first run
+	if config.IsFirstRun() {
+		// Run first-run wizard immediately with clean screen
+		p := tea.NewProgram(
+			tui.NewFirstRunModel(),
+			tea.WithAltScreen(),
+			tea.WithMouseCellMotion(),
+		)
+
+		if _, err := p.Run(); err != nil {
+			return fmt.Errorf("setup failed: %w", err)
+		}
+
+		fmt.Println("\nSetup complete! You can now use bc4.")
+		fmt.Println("Try 'bc4 auth status' to see your account information.")
+		return nil
+	}
+
+	// Show a dashboard for configured users at a terminal; scripts and
+	// pipes keep getting plain help
+	if ui.IsTerminal(os.Stdout) {
+		if d, err := loadDashboard(f); err == nil {
+			writeDashboard(cmd.OutOrStdout(), d)
+			return nil
+		}
+	}
+
+	// Show help if no subcommand
+	return cmd.Help()
+}
+
 func initConfig() {
 	// Set config file if specified
+	if cfgFile == "" {
+		cfgFile = os.Getenv("BC4_CONFIG")
+	}
 	if cfgFile != "" {
 		viper.SetConfigFile(cfgFile)
 	} else {
@@ -0,0 +1,21 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigFileArg(t *testing.T) {
+	t.Setenv("BC4_CONFIG", "")
+
+	assert.Equal(t, "work.json", configFileArg([]string{"todos", "--config", "work.json"}))
+	assert.Equal(t, "work.json", configFileArg([]string{"--config=work.json", "todos"}))
+	assert.Equal(t, "", configFileArg([]string{"todos"}))
+	// Arguments after -- belong to the command
+	assert.Equal(t, "", configFileArg([]string{"todo", "add", "--", "--config", "x"}))
+
+	t.Setenv("BC4_CONFIG", "env.json")
+	assert.Equal(t, "env.json", configFileArg([]string{"todos"}))
+	assert.Equal(t, "work.json", configFileArg([]string{"todos", "--config", "work.json"}))
+}
@@ -125,6 +125,9 @@ respecting rate limits and pagination.`,
 			if format == ui.OutputFormatJSON {
 				return outputSearchJSON(results, query)
 			}
+			if format == ui.OutputFormatJSONL {
+				return ui.WriteJSONLines(os.Stdout, searchRecords(results), nil)
+			}
 
 			// Display results
 			if len(results) == 0 {
@@ -143,7 +146,7 @@ respecting rate limits and pagination.`,
 	cmd.Flags().StringVarP(&resourceType, "type", "t", "", "Filter by resource type: todo, message, document, card (comma-separated for multiple)")
 	cmd.Flags().StringVarP(&projectID, "project", "p", "", "Scope search to a specific project (ID or URL)")
 	cmd.Flags().StringVarP(&accountID, "account", "a", "", "Specify account ID")
-	cmd.Flags().StringVarP(&formatStr, "format", "f", "table", "Output format: table or json")
+	cmd.Flags().StringVarP(&formatStr, "format", "f", "table", "Output format: table, json, or jsonl")
 	cmd.Flags().IntVarP(&limit, "limit", "l", 50, fmt.Sprintf("Maximum number of results to return (max: %d)", maxSearchLimit))
 
 	return cmd
@@ -198,9 +201,17 @@ func outputSearchJSON(results []api.SearchResult, query string) error {
 	output := SearchOutput{
 		Query:   query,
 		Count:   len(results),
-		Results: make([]SearchRecord, 0, len(results)),
+		Results: searchRecords(results),
 	}
 
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(output)
+}
+
+// searchRecords converts search results to their JSON records
+func searchRecords(results []api.SearchResult) []SearchRecord {
+	records := make([]SearchRecord, 0, len(results))
 	for _, r := range results {
 		record := SearchRecord{
 			ID:        r.ID,
@@ -221,12 +232,9 @@ func outputSearchJSON(results []api.SearchResult, query string) error {
 			record.ParentTitle = r.Parent.Title
 			record.ParentType = r.Parent.Type
 		}
-		output.Results = append(output.Results, record)
+		records = append(records, record)
 	}
-
-	encoder := json.NewEncoder(os.Stdout)
-	encoder.SetIndent("", "  ")
-	return encoder.Encode(output)
+	return records
 }
 
 func renderSearchResults(results []api.SearchResult, query string) error {
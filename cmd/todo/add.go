@@ -5,14 +5,20 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/charmbracelet/huh"
 	"github.com/needmore/bc4/internal/api"
 	"github.com/needmore/bc4/internal/attachments"
+	"github.com/needmore/bc4/internal/cmdutil"
+	"github.com/needmore/bc4/internal/config"
 	"github.com/needmore/bc4/internal/factory"
 	"github.com/needmore/bc4/internal/markdown"
 	"github.com/needmore/bc4/internal/mentions"
 	"github.com/needmore/bc4/internal/parser"
+	"github.com/needmore/bc4/internal/ui"
 	"github.com/needmore/bc4/internal/utils"
 	"github.com/spf13/cobra"
 )
@@ -22,24 +28,79 @@ type addOptions struct {
 	group       string
 	description string
 	due         string
+	dueAnchor   string
 	assign      []string
 	file        string
 	attach      []string
+
+	listMatch       string
+	contentFromTodo string
+	notifyCampfire  string
+
+	output cmdutil.CreatedOutput
+
+	// checkListCapacity asks before adding to a list with more todos than
+	// preferences.list_size_warn; yes skips the question
+	checkListCapacity bool
+	yes               bool
+
+	// recurring is a check-in schedule; when set, a check-in question is
+	// created instead of a todo
+	recurring     string
+	recurringDays string
+	recurringTime string
+
+	// copiedDescription is the rich text description of the
+	// --content-from-todo source, used when no description is given
+	copiedDescription string
 }
 
 func newAddCmd(f *factory.Factory) *cobra.Command {
 	opts := &addOptions{}
 
 	cmd := &cobra.Command{
-		Use:   "add [<title>]",
+		Use:   "add [<title>...]",
 		Short: "Create a new todo",
 		Long: `Create a new todo in the specified todo list.
 
 If no title is provided, you'll be prompted to enter one interactively.
 The todo will be created in the default todo list unless specified with --list.
 
+Several todos can be created at once by passing multiple titles. They are
+created in order, sharing the same list, group, due date, and assignees. If one
+fails the rest are still created, and a summary is printed at the end.
+
+Relative --due values (today, tomorrow, a weekday, +Nd, +Nw) count from today
+by default. --due-anchor changes the starting point:
+  today          the current date (default)
+  list-created   the date the todo list was created
+  list-updated   the date the todo list was last updated
+
 Use --attach to add images or files to the todo description. Multiple files
-can be attached by using the flag multiple times.`,
+can be attached by using the flag multiple times.
+
+Use --content-from-todo to start from another todo's description. Only the
+description is copied, not assignees or dates. If no title is given, the
+source todo's title is reused. An explicit description, from --description or
+the lines after the title, takes precedence over the copied one.
+
+Use --notify-campfire to announce each new todo in a campfire with a link to
+it. The campfire is given by ID, name, or URL. If the announcement can't be
+posted, a warning is printed but the todo is still created.
+
+Use --print-url to print each new todo's web URL after its ID. Add --quiet to
+print only the URL.
+
+Use --check-list-capacity as a safety net in scripts: when the list already
+has more todos than preferences.list_size_warn (500 unless set; 0 turns the
+check off), a warning is printed and you're asked to confirm before anything
+is created. Without a terminal to ask on, the todos are only added with --yes.
+
+Basecamp todos can't repeat. For something that should come up on a schedule,
+--recurring creates an Automatic Check-in question instead, asked on the given
+schedule (every_day, every_week, every_other_week, or every_four_weeks) at
+--time, on --days for the weekly schedules. Check-ins must be enabled in the
+project.`,
 		Example: `  # Add a todo with a title
   bc4 todo add "Review pull request"
 
@@ -48,6 +109,10 @@ can be attached by using the flag multiple times.`,
 
   # Add a todo with due date
   bc4 todo add "Submit report" --due 2025-01-15
+  bc4 todo add "Call back" --due +3d
+
+  # Schedule sprint todos relative to when their list was created
+  bc4 todo add --list "Sprint 12" --due +5d --due-anchor list-created "Demo" "Retro"
 
   # Add a todo to a specific list
   bc4 todo add "Update documentation" --list "Documentation Tasks"
@@ -61,10 +126,28 @@ can be attached by using the flag multiple times.`,
   # Add a todo with multiple attachments
   bc4 todo add "Update assets" --attach ./image1.png --attach ./image2.jpg
 
+  # Add several todos to a list in one go
+  bc4 todo add --list "Sprint Tasks" "First" "Second" "Third"
+
   # Add a todo to a specific group within a list
   bc4 todo add "Fix bug" --list "Sprint Tasks" --group "In Progress"
-  bc4 todo add "Review PR" --list 12345 --group 67890`,
-		Args: cobra.MaximumNArgs(1),
+  bc4 todo add "Review PR" --list 12345 --group 67890
+
+  # Start a follow-up from an existing todo's description
+  bc4 todo add "Follow up on rollout" --content-from-todo 12345
+
+  # Announce the new todo in the team campfire
+  bc4 todo add "Fix login bug" --notify-campfire "Dev Chat"
+
+  # Refuse to add to a list that has grown past preferences.list_size_warn
+  bc4 todo add "Nightly report" --list "Reports" --check-list-capacity
+
+  # Print the new todo's link for sharing
+  bc4 todo add "Fix login bug" --print-url --quiet
+
+  # Ask the team every Monday and Thursday at 9:30 instead of a repeating todo
+  bc4 todo add "What's blocking you?" --recurring every_week --days 1,4 --time 09:30`,
+		Args: cobra.ArbitraryArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runAdd(f, opts, args)
 		},
@@ -73,29 +156,80 @@ can be attached by using the flag multiple times.`,
 	cmd.Flags().StringVarP(&opts.list, "list", "l", "", "Todo list ID, name, or URL (defaults to selected list)")
 	cmd.Flags().StringVarP(&opts.group, "group", "g", "", "Todo group ID, name, or URL within the list (optional)")
 	cmd.Flags().StringVarP(&opts.description, "description", "d", "", "Description for the todo")
-	cmd.Flags().StringVar(&opts.due, "due", "", "Due date (YYYY-MM-DD)")
+	cmd.Flags().StringVar(&opts.due, "due", "", "Due date (YYYY-MM-DD, today, tomorrow, a weekday, or +Nd/+Nw)")
+	cmd.Flags().StringVar(&opts.dueAnchor, "due-anchor", "", "Count relative --due values from today, list-created, or list-updated")
 	cmd.Flags().StringSliceVar(&opts.assign, "assign", nil, "Assign to team members (by email)")
 	cmd.Flags().StringVarP(&opts.file, "file", "f", "", "Read todo content from a markdown file")
 	cmd.Flags().StringSliceVar(&opts.attach, "attach", nil, "Attach file(s) to the todo (can be used multiple times)")
+	cmd.Flags().StringVar(&opts.listMatch, "list-match", string(matchError),
+		"When a --list or --group name matches several: first, newest (most recently updated), or error")
+	cmd.Flags().StringVar(&opts.contentFromTodo, "content-from-todo", "", "Copy the description from another todo (ID or URL)")
+	cmd.Flags().StringVar(&opts.notifyCampfire, "notify-campfire", "", "Post a link to each new todo in a campfire (ID, name, or URL)")
+	opts.output.AddFlags(cmd)
+	cmd.Flags().BoolVar(&opts.checkListCapacity, "check-list-capacity", false, "Warn and ask before adding to a list with more todos than preferences.list_size_warn")
+	cmd.Flags().BoolVarP(&opts.yes, "yes", "y", false, "With --check-list-capacity, add to a large list without asking")
+	cmd.Flags().StringVar(&opts.recurring, "recurring", "", "Create a check-in question on this schedule instead (every_day, every_week, every_other_week, every_four_weeks)")
+	cmd.Flags().StringVar(&opts.recurringDays, "days", "", "With a weekly --recurring schedule, days to ask (e.g. mon,wed,fri or 1,3,5; default mon)")
+	cmd.Flags().StringVar(&opts.recurringTime, "time", "", "With --recurring, time of day to ask (HH:MM, 24-hour; default 09:00)")
 
 	return cmd
 }
 
 func runAdd(f *factory.Factory, opts *addOptions, args []string) error {
+	if err := opts.output.Validate(); err != nil {
+		return err
+	}
+	if opts.recurring != "" {
+		if opts.output.PrintURL {
+			return fmt.Errorf("--print-url cannot be used with --recurring")
+		}
+		return runAddRecurring(f, opts, args)
+	}
+	if opts.recurringDays != "" || opts.recurringTime != "" {
+		return fmt.Errorf("--days and --time can only be used with --recurring")
+	}
+
+	policy, err := parseMatchPolicy(opts.listMatch)
+	if err != nil {
+		return err
+	}
+
+	anchor, err := parseDueAnchor(opts.dueAnchor)
+	if err != nil {
+		return err
+	}
+	if opts.dueAnchor != "" && opts.due == "" {
+		return fmt.Errorf("--due-anchor requires --due")
+	}
+
+	// Check the source todo argument before doing any work
+	var sourceTodoID int64
+	var sourceProjectID string
+	if opts.contentFromTodo != "" {
+		sourceTodoID, sourceProjectID, err = parseSourceTodo(opts.contentFromTodo)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Get content from file, stdin, args, or prompt
-	var content string
-	var err error
+	var contents []string
 
 	if opts.file != "" {
+		if len(args) > 0 {
+			return fmt.Errorf("cannot use --file together with todo titles")
+		}
 		// Read from file
 		data, err := os.ReadFile(opts.file)
 		if err != nil {
 			return fmt.Errorf("failed to read file: %w", err)
 		}
-		content = string(data)
+		contents = []string{string(data)}
 	} else if len(args) > 0 {
-		// Use argument as content
-		content = args[0]
+		// Use arguments as content, one todo per argument
+		contents = args
+	} else if sourceTodoID != 0 {
+		// The title is taken from the source todo once it's fetched
 	} else {
 		// Check if stdin has data
 		stat, _ := os.Stdin.Stat()
@@ -105,26 +239,18 @@ func runAdd(f *factory.Factory, opts *addOptions, args []string) error {
 			if err != nil {
 				return fmt.Errorf("failed to read from stdin: %w", err)
 			}
-			content = string(data)
+			contents = []string{string(data)}
 		} else {
 			// TODO: Add interactive prompt using Bubbletea
 			return fmt.Errorf("interactive mode not yet implemented. Please provide content as an argument, via --file, or pipe it in")
 		}
 	}
 
-	content = strings.TrimSpace(content)
-	if content == "" {
-		return fmt.Errorf("todo content cannot be empty")
-	}
-
-	// Split content into title and description if it's multi-line
-	var title, description string
-	lines := strings.SplitN(content, "\n", 2)
-	title = strings.TrimSpace(lines[0])
-	if len(lines) > 1 && opts.description == "" {
-		description = strings.TrimSpace(lines[1])
-	} else {
-		description = opts.description
+	for i := range contents {
+		contents[i] = strings.TrimSpace(contents[i])
+		if contents[i] == "" {
+			return fmt.Errorf("todo content cannot be empty")
+		}
 	}
 
 	// Get API client from factory
@@ -134,6 +260,23 @@ func runAdd(f *factory.Factory, opts *addOptions, args []string) error {
 	}
 	todoOps := client.Todos()
 
+	// Fetch the todo whose description is being copied
+	if sourceTodoID != 0 {
+		if sourceProjectID == "" {
+			if sourceProjectID, err = f.ProjectID(); err != nil {
+				return err
+			}
+		}
+		source, err := todoOps.GetTodo(f.Context(), sourceProjectID, sourceTodoID)
+		if err != nil {
+			return fmt.Errorf("failed to fetch todo %d: %w", sourceTodoID, err)
+		}
+		opts.copiedDescription = source.Description
+		if len(contents) == 0 {
+			contents = []string{source.Title}
+		}
+	}
+
 	// Get config for default lookups
 	cfg, err := f.Config()
 	if err != nil {
@@ -161,36 +304,11 @@ func runAdd(f *factory.Factory, opts *addOptions, args []string) error {
 	// Determine which todo list to use
 	var todoListID int64
 	if opts.list != "" {
-		// Check if it's a URL
-		if parser.IsBasecampURL(opts.list) {
-			parsed, err := parser.ParseBasecampURL(opts.list)
-			if err != nil {
-				return fmt.Errorf("invalid Basecamp URL: %w", err)
-			}
-			if parsed.ResourceType != parser.ResourceTypeTodoList {
-				return fmt.Errorf("URL is not a todo list URL: %s", opts.list)
-			}
-			todoListID = parsed.ResourceID
-		} else {
-			// User specified a list - try to find it
-			todoLists, err := todoOps.GetTodoLists(f.Context(), resolvedProjectID, todoSet.ID)
-			if err != nil {
-				return fmt.Errorf("failed to fetch todo lists: %w", err)
-			}
-
-			// Try to match by ID or name
-			for _, list := range todoLists {
-				if fmt.Sprintf("%d", list.ID) == opts.list ||
-					strings.EqualFold(list.Title, opts.list) ||
-					strings.EqualFold(list.Name, opts.list) {
-					todoListID = list.ID
-					break
-				}
-			}
-
-			if todoListID == 0 {
-				return fmt.Errorf("todo list not found: %s", opts.list)
-			}
+		todoListID, err = resolveTodoList(opts.list, policy, func() ([]api.TodoList, error) {
+			return todoOps.GetTodoLists(f.Context(), resolvedProjectID, todoSet.ID)
+		})
+		if err != nil {
+			return err
 		}
 	} else {
 		// Use default todo list from config
@@ -215,13 +333,254 @@ func runAdd(f *factory.Factory, opts *addOptions, args []string) error {
 		}
 	}
 
+	// Resolve the due date once, counting from the list's own dates if anchored
+	if opts.due != "" {
+		opts.due, err = resolveDueDate(opts.due, anchor, func() (*api.TodoList, error) {
+			return todoOps.GetTodoList(f.Context(), resolvedProjectID, todoListID)
+		}, time.Now())
+		if err != nil {
+			return err
+		}
+	}
+
+	// Guard against adding to a list that has grown suspiciously large
+	if opts.checkListCapacity {
+		todoList, err := todoOps.GetTodoList(f.Context(), resolvedProjectID, todoListID)
+		if err != nil {
+			return fmt.Errorf("failed to fetch todo list: %w", err)
+		}
+		interactive := ui.IsTerminal(os.Stdin) && ui.IsTerminal(os.Stdout)
+		proceed, err := checkListCapacity(os.Stderr, todoList, listSizeWarnThreshold(cfg), opts.yes, interactive, confirmLargeList)
+		if err != nil || !proceed {
+			return err
+		}
+	}
+
+	// Determine the target ID for creating the todo
+	// If group is specified, use group ID; otherwise use list ID
+	targetID := todoListID
+
+	if opts.group != "" {
+		// User specified a group - find it within the list
+		targetID, err = resolveListGroup(opts.group, todoListID, policy, func() ([]api.TodoGroup, error) {
+			return todoOps.GetTodoGroups(f.Context(), resolvedProjectID, todoListID)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Resolve assignees once for all todos
+	var assigneeIDs []int64
+	if len(opts.assign) > 0 {
+		// Create user resolver
+		userResolver := utils.NewUserResolver(client.Client, resolvedProjectID)
+
+		// Resolve user identifiers to person IDs
+		assigneeIDs, err = userResolver.ResolveUsers(f.Context(), opts.assign)
+		if err != nil {
+			return fmt.Errorf("failed to resolve assignees: %w", err)
+		}
+	}
+
+	// Resolve the campfire before creating anything so a bad reference
+	// doesn't leave todos behind
+	var notifyCampfireID int64
+	if opts.notifyCampfire != "" {
+		notifyCampfireID, err = resolveNotifyCampfire(f.Context(), client.Campfires(), resolvedProjectID, opts.notifyCampfire)
+		if err != nil {
+			return err
+		}
+	}
+	announce := func(todo *api.Todo) {
+		if notifyCampfireID == 0 {
+			return
+		}
+		if err := notifyCampfire(f.Context(), client.Campfires(), resolvedAccountID, resolvedProjectID, notifyCampfireID, todo); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: todo #%d created but failed to notify campfire: %v\n", todo.ID, err)
+		}
+	}
+
+	// Single todo - fail fast
+	if len(contents) == 1 {
+		todo, err := createTodoFromContent(f, client, opts, resolvedProjectID, targetID, contents[0], assigneeIDs)
+		if err != nil {
+			return err
+		}
+		announce(todo)
+
+		// Output the created todo ID (GitHub CLI style - minimal output)
+		return printCreatedTodo(opts, resolvedAccountID, resolvedProjectID, todo)
+	}
+
+	// Multiple todos - keep going on failure and summarize
+	return createTodos(os.Stderr, contents, func(content string) (*api.Todo, error) {
+		return createTodoFromContent(f, client, opts, resolvedProjectID, targetID, content, assigneeIDs)
+	}, func(todo *api.Todo) error {
+		announce(todo)
+		return printCreatedTodo(opts, resolvedAccountID, resolvedProjectID, todo)
+	})
+}
+
+// createTodos creates a todo for each of contents in order, calling created
+// with each new todo. A failed todo is reported on w and the rest are still
+// created; a summary follows, and the result is an error if any failed.
+func createTodos(w io.Writer, contents []string, create func(content string) (*api.Todo, error), created func(todo *api.Todo) error) error {
+	count := 0
+	for _, content := range contents {
+		todo, err := create(content)
+		if err != nil {
+			fmt.Fprintf(w, "✗ %s: %v\n", todoContentTitle(content), err)
+			continue
+		}
+		count++
+		if err := created(todo); err != nil {
+			return err
+		}
+	}
+
+	fmt.Fprintf(w, "Created %d of %d todos\n", count, len(contents))
+	if count < len(contents) {
+		return cmdutil.NewSilentError(fmt.Errorf("failed to create %d of %d todos", len(contents)-count, len(contents)))
+	}
+	return nil
+}
+
+// defaultListSizeWarn is the --check-list-capacity threshold when
+// preferences.list_size_warn isn't set
+const defaultListSizeWarn = 500
+
+// listSizeWarnThreshold returns how many todos a list can have before
+// --check-list-capacity warns. 0 means never warn.
+func listSizeWarnThreshold(cfg *config.Config) int {
+	if cfg == nil || cfg.Preferences.ListSizeWarn == nil {
+		return defaultListSizeWarn
+	}
+	return *cfg.Preferences.ListSizeWarn
+}
+
+// checkListCapacity warns on w when todoList has more todos than threshold,
+// then asks with confirm before continuing. yes continues without asking;
+// without a terminal to ask on, it is an error instead. It reports whether
+// the todos should be added.
+func checkListCapacity(w io.Writer, todoList *api.TodoList, threshold int, yes, interactive bool, confirm func(title string) (bool, error)) (bool, error) {
+	if threshold <= 0 || todoList.TodosCount <= threshold {
+		return true, nil
+	}
+
+	fmt.Fprintf(w, "Warning: todo list %q already has %d todos, more than the %d allowed by preferences.list_size_warn\n",
+		todoList.Title, todoList.TodosCount, threshold)
+	if yes {
+		return true, nil
+	}
+	if !interactive {
+		return false, fmt.Errorf("not adding to todo list %q with %d todos; use --yes to add anyway", todoList.Title, todoList.TodosCount)
+	}
+
+	ok, err := confirm(fmt.Sprintf("Add to \"%s\" anyway?", todoList.Title))
+	if err != nil {
+		return false, err
+	}
+	if !ok {
+		fmt.Fprintln(w, "Canceled")
+	}
+	return ok, nil
+}
+
+// confirmLargeList asks whether to add to a list over the size threshold
+func confirmLargeList(title string) (bool, error) {
+	var confirm bool
+	err := huh.NewConfirm().
+		Title(title).
+		Description("A list this large may mean an automation is misconfigured.").
+		Affirmative("Add").
+		Negative("Cancel").
+		Value(&confirm).
+		Run()
+	return confirm, err
+}
+
+// printCreatedTodo prints the new todo's ID and, with --print-url, its web URL
+func printCreatedTodo(opts *addOptions, accountID, projectID string, todo *api.Todo) error {
+	return opts.output.Write(os.Stdout, fmt.Sprintf("#%d", todo.ID), accountID, projectID, parser.ResourceTypeTodo, todo.ID)
+}
+
+// runAddRecurring creates a check-in question for --recurring
+func runAddRecurring(f *factory.Factory, opts *addOptions, args []string) error {
+	req, err := recurringRequest(opts, args)
+	if err != nil {
+		return err
+	}
+
+	client, err := f.ApiClient()
+	if err != nil {
+		return err
+	}
+	projectID, err := f.ProjectID()
+	if err != nil {
+		return err
+	}
+
+	question, err := createRecurringQuestion(f.Context(), client.Questions(), projectID, req)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(os.Stderr, "Todos can't repeat, so this was created as a check-in question")
+	fmt.Printf("✓ Created check-in question #%d: %s\n", question.ID, question.Title)
+	if question.AppURL != "" {
+		fmt.Println(question.AppURL)
+	}
+	return nil
+}
+
+// todoContentTitle returns the first line of todo content, which becomes the title
+func todoContentTitle(content string) string {
+	return strings.TrimSpace(strings.SplitN(content, "\n", 2)[0])
+}
+
+// parseSourceTodo parses the --content-from-todo argument. The project ID is
+// only set when a URL names one.
+func parseSourceTodo(arg string) (int64, string, error) {
+	todoID, parsedURL, err := parser.ParseArgument(arg)
+	if err != nil {
+		return 0, "", fmt.Errorf("invalid --content-from-todo value: %s", arg)
+	}
+	if parsedURL == nil {
+		return todoID, "", nil
+	}
+	if parsedURL.ResourceType != parser.ResourceTypeTodo {
+		return 0, "", fmt.Errorf("URL is not for a todo: %s", arg)
+	}
+	projectID := ""
+	if parsedURL.ProjectID > 0 {
+		projectID = strconv.FormatInt(parsedURL.ProjectID, 10)
+	}
+	return todoID, projectID, nil
+}
+
+// createTodoFromContent creates a single todo in targetID. The first line of
+// content is the title and any remaining lines form the description, unless
+// --description was given. Without either, the --content-from-todo
+// description is used as-is.
+func createTodoFromContent(f *factory.Factory, client *api.ModularClient, opts *addOptions, projectID string, targetID int64, content string, assigneeIDs []int64) (*api.Todo, error) {
+	// Split content into title and description if it's multi-line
+	var title, description string
+	lines := strings.SplitN(content, "\n", 2)
+	title = strings.TrimSpace(lines[0])
+	if len(lines) > 1 && opts.description == "" {
+		description = strings.TrimSpace(lines[1])
+	} else {
+		description = opts.description
+	}
+
 	// Create markdown converter
 	converter := markdown.NewConverter()
 
 	// Convert title to rich text
 	richTitle, err := converter.MarkdownToRichText(title)
 	if err != nil {
-		return fmt.Errorf("failed to convert title: %w", err)
+		return nil, fmt.Errorf("failed to convert title: %w", err)
 	}
 
 	// Convert description to rich text if provided
@@ -229,19 +588,22 @@ func runAdd(f *factory.Factory, opts *addOptions, args []string) error {
 	if description != "" {
 		richDescription, err = converter.MarkdownToRichText(description)
 		if err != nil {
-			return fmt.Errorf("failed to convert description: %w", err)
+			return nil, fmt.Errorf("failed to convert description: %w", err)
 		}
 	}
 
 	// Replace inline @Name mentions with bc-attachment tags
-	richTitle, err = mentions.Resolve(f.Context(), richTitle, client.Client, resolvedProjectID)
+	richTitle, err = mentions.Resolve(f.Context(), richTitle, client.Client, projectID)
 	if err != nil {
-		return fmt.Errorf("failed to resolve mentions: %w", err)
+		return nil, fmt.Errorf("failed to resolve mentions: %w", err)
 	}
-	if richDescription != "" {
-		richDescription, err = mentions.Resolve(f.Context(), richDescription, client.Client, resolvedProjectID)
+	if richDescription == "" {
+		// The copied description is already rich text
+		richDescription = opts.copiedDescription
+	} else {
+		richDescription, err = mentions.Resolve(f.Context(), richDescription, client.Client, projectID)
 		if err != nil {
-			return fmt.Errorf("failed to resolve mentions: %w", err)
+			return nil, fmt.Errorf("failed to resolve mentions: %w", err)
 		}
 	}
 
@@ -250,90 +612,33 @@ func runAdd(f *factory.Factory, opts *addOptions, args []string) error {
 		for _, attachPath := range opts.attach {
 			fileData, err := os.ReadFile(attachPath)
 			if err != nil {
-				return fmt.Errorf("failed to read attachment %s: %w", attachPath, err)
+				return nil, fmt.Errorf("failed to read attachment %s: %w", attachPath, err)
 			}
 			filename := filepath.Base(attachPath)
 			upload, err := client.UploadAttachment(filename, fileData, "")
 			if err != nil {
-				return fmt.Errorf("failed to upload attachment %s: %w", filename, err)
+				return nil, fmt.Errorf("failed to upload attachment %s: %w", filename, err)
 			}
 			tag := attachments.BuildTag(upload.AttachableSGID)
 			richDescription += tag
 		}
 	}
 
-	// Determine the target ID for creating the todo
-	// If group is specified, use group ID; otherwise use list ID
-	targetID := todoListID
-
-	if opts.group != "" {
-		// Check if it's a URL
-		if parser.IsBasecampURL(opts.group) {
-			parsed, err := parser.ParseBasecampURL(opts.group)
-			if err != nil {
-				return fmt.Errorf("invalid Basecamp URL: %w", err)
-			}
-			if parsed.ResourceType != parser.ResourceTypeTodoGroup {
-				return fmt.Errorf("URL is not a todo group URL: %s", opts.group)
-			}
-			targetID = parsed.ResourceID
-		} else {
-			// User specified a group - try to find it within the list
-			groups, err := todoOps.GetTodoGroups(f.Context(), resolvedProjectID, todoListID)
-			if err != nil {
-				return fmt.Errorf("failed to fetch todo groups: %w", err)
-			}
-
-			// Try to match by ID or name
-			var foundGroupID int64
-			for _, group := range groups {
-				if fmt.Sprintf("%d", group.ID) == opts.group ||
-					strings.EqualFold(group.Title, opts.group) ||
-					strings.EqualFold(group.Name, opts.group) {
-					foundGroupID = group.ID
-					break
-				}
-			}
-
-			if foundGroupID == 0 {
-				return fmt.Errorf("todo group not found: %s", opts.group)
-			}
-			targetID = foundGroupID
-		}
-	}
-
 	// Create the todo
 	req := api.TodoCreateRequest{
 		Content:     richTitle,
 		Description: richDescription,
+		AssigneeIDs: assigneeIDs,
 	}
 
 	if opts.due != "" {
 		req.DueOn = &opts.due
 	}
 
-	// Handle assignee lookup
-	if len(opts.assign) > 0 {
-		// Create user resolver
-		userResolver := utils.NewUserResolver(client.Client, resolvedProjectID)
-
-		// Resolve user identifiers to person IDs
-		personIDs, err := userResolver.ResolveUsers(f.Context(), opts.assign)
-		if err != nil {
-			return fmt.Errorf("failed to resolve assignees: %w", err)
-		}
-
-		req.AssigneeIDs = personIDs
-	}
-
 	// When posting to a group, the API uses the same endpoint pattern as posting to a list
-	todo, err := todoOps.CreateTodo(f.Context(), resolvedProjectID, targetID, req)
+	todo, err := client.Todos().CreateTodo(f.Context(), projectID, targetID, req)
 	if err != nil {
-		return fmt.Errorf("failed to create todo: %w", err)
+		return nil, fmt.Errorf("failed to create todo: %w", err)
 	}
-
-	// Output the created todo ID (GitHub CLI style - minimal output)
-	fmt.Printf("#%d\n", todo.ID)
-
-	return nil
+	return todo, nil
 }
@@ -0,0 +1,264 @@
+package todo
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/api/mock"
+	"github.com/needmore/bc4/internal/cmdutil"
+	"github.com/needmore/bc4/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSourceTodo(t *testing.T) {
+	t.Run("numeric ID", func(t *testing.T) {
+		id, projectID, err := parseSourceTodo("12345")
+		require.NoError(t, err)
+		assert.Equal(t, int64(12345), id)
+		assert.Empty(t, projectID)
+	})
+
+	t.Run("todo URL sets the project", func(t *testing.T) {
+		id, projectID, err := parseSourceTodo("https://3.basecamp.com/1/buckets/2/todos/3")
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), id)
+		assert.Equal(t, "2", projectID)
+	})
+
+	t.Run("non-todo URL", func(t *testing.T) {
+		_, _, err := parseSourceTodo("https://3.basecamp.com/1/buckets/2/card_tables/cards/3")
+		assert.ErrorContains(t, err, "not for a todo")
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		_, _, err := parseSourceTodo("not-a-todo")
+		assert.ErrorContains(t, err, "invalid --content-from-todo")
+	})
+}
+
+func TestResolveNotifyCampfire(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("numeric ID", func(t *testing.T) {
+		id, err := resolveNotifyCampfire(ctx, mock.NewMockClient(), "2", "99")
+		require.NoError(t, err)
+		assert.Equal(t, int64(99), id)
+	})
+
+	t.Run("campfire URL", func(t *testing.T) {
+		id, err := resolveNotifyCampfire(ctx, mock.NewMockClient(), "2", "https://3.basecamp.com/1/buckets/2/chats/77")
+		require.NoError(t, err)
+		assert.Equal(t, int64(77), id)
+	})
+
+	t.Run("non-campfire URL", func(t *testing.T) {
+		_, err := resolveNotifyCampfire(ctx, mock.NewMockClient(), "2", "https://3.basecamp.com/1/buckets/2/todos/3")
+		assert.ErrorContains(t, err, "not a campfire URL")
+	})
+
+	t.Run("name", func(t *testing.T) {
+		m := mock.NewMockClient()
+		m.Campfire = &api.Campfire{ID: 55, Name: "Dev Chat"}
+		id, err := resolveNotifyCampfire(ctx, m, "2", "Dev Chat")
+		require.NoError(t, err)
+		assert.Equal(t, int64(55), id)
+	})
+}
+
+func TestNotifyCampfire(t *testing.T) {
+	m := mock.NewMockClient()
+	todo := &api.Todo{ID: 42, Title: "Fix <login> & deploy"}
+
+	require.NoError(t, notifyCampfire(context.Background(), m, "1", "2", 55, todo))
+	require.Len(t, m.Calls, 1)
+	assert.Equal(t, `PostCampfireLine(2, 55, New todo: <a href="https://3.basecamp.com/1/buckets/2/todos/42">Fix &lt;login&gt; &amp; deploy</a>, text/html)`, m.Calls[0])
+}
+
+func TestRecurringRequest(t *testing.T) {
+	base := func() *addOptions {
+		return &addOptions{recurring: "every_week", recurringDays: "1,4", recurringTime: "09:30"}
+	}
+
+	t.Run("builds a weekly question", func(t *testing.T) {
+		req, err := recurringRequest(base(), []string{"What's blocking you?"})
+		require.NoError(t, err)
+		assert.Equal(t, api.QuestionCreateRequest{
+			Title:    "What's blocking you?",
+			Schedule: "every_week",
+			Days:     []int{1, 4},
+			Hour:     9,
+			Minute:   30,
+		}, req)
+	})
+
+	t.Run("daily questions take no days", func(t *testing.T) {
+		opts := base()
+		opts.recurring = "every_day"
+		_, err := recurringRequest(opts, []string{"Standup"})
+		assert.ErrorContains(t, err, "weekly schedules")
+
+		opts.recurringDays = ""
+		req, err := recurringRequest(opts, []string{"Standup"})
+		require.NoError(t, err)
+		assert.Nil(t, req.Days)
+	})
+
+	t.Run("weekly defaults to Monday at 09:00", func(t *testing.T) {
+		opts := &addOptions{recurring: "every_other_week"}
+		req, err := recurringRequest(opts, []string{"Retro"})
+		require.NoError(t, err)
+		assert.Equal(t, []int{1}, req.Days)
+		assert.Equal(t, 9, req.Hour)
+		assert.Equal(t, 0, req.Minute)
+	})
+
+	t.Run("rejects todo-only flags", func(t *testing.T) {
+		opts := base()
+		opts.due = "tomorrow"
+		_, err := recurringRequest(opts, []string{"Standup"})
+		assert.ErrorContains(t, err, "--due")
+	})
+
+	t.Run("needs exactly one title", func(t *testing.T) {
+		_, err := recurringRequest(base(), []string{"One", "Two"})
+		assert.Error(t, err)
+	})
+
+	t.Run("validates the schedule", func(t *testing.T) {
+		opts := base()
+		opts.recurring = "monthly"
+		_, err := recurringRequest(opts, []string{"Standup"})
+		assert.ErrorContains(t, err, "invalid schedule")
+	})
+}
+
+func TestRunAdd_ScheduleFlagsNeedRecurring(t *testing.T) {
+	for _, opts := range []*addOptions{{recurringDays: "mon"}, {recurringTime: "10:00"}} {
+		err := runAdd(nil, opts, []string{"Standup"})
+		assert.ErrorContains(t, err, "only be used with --recurring")
+	}
+}
+
+func TestCreateRecurringQuestion(t *testing.T) {
+	req := api.QuestionCreateRequest{Title: "Standup", Schedule: "every_day", Hour: 9}
+
+	t.Run("creates the question in the questionnaire", func(t *testing.T) {
+		client := mock.NewMockClient()
+		client.Questionnaire = &api.Questionnaire{ID: 5}
+
+		question, err := createRecurringQuestion(context.Background(), client, "10", req)
+		require.NoError(t, err)
+		assert.Equal(t, "Standup", question.Title)
+		assert.Equal(t, []string{"GetProjectQuestionnaire(10)", "CreateQuestion(10, 5)"}, client.Calls)
+	})
+
+	t.Run("explains when check-ins are off", func(t *testing.T) {
+		client := mock.NewMockClient()
+		client.QuestionnaireError = api.ErrNoQuestionnaire
+
+		_, err := createRecurringQuestion(context.Background(), client, "10", req)
+		assert.ErrorContains(t, err, "Check-ins aren't enabled")
+		assert.NotContains(t, client.Calls, "CreateQuestion(10, 1)")
+	})
+}
+
+func TestListSizeWarnThreshold(t *testing.T) {
+	assert.Equal(t, defaultListSizeWarn, listSizeWarnThreshold(&config.Config{}))
+
+	off := 0
+	assert.Equal(t, 0, listSizeWarnThreshold(&config.Config{Preferences: config.PreferencesConfig{ListSizeWarn: &off}}))
+}
+
+func TestCheckListCapacity(t *testing.T) {
+	list := &api.TodoList{Title: "Reports", TodosCount: 501}
+	noPrompt := func(string) (bool, error) {
+		t.Fatal("unexpected confirmation prompt")
+		return false, nil
+	}
+
+	t.Run("at the threshold", func(t *testing.T) {
+		var w bytes.Buffer
+		ok, err := checkListCapacity(&w, &api.TodoList{TodosCount: 500}, 500, false, false, noPrompt)
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Empty(t, w.String())
+	})
+
+	t.Run("threshold 0 never warns", func(t *testing.T) {
+		var w bytes.Buffer
+		ok, err := checkListCapacity(&w, list, 0, false, false, noPrompt)
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Empty(t, w.String())
+	})
+
+	t.Run("above the threshold without a terminal", func(t *testing.T) {
+		var w bytes.Buffer
+		ok, err := checkListCapacity(&w, list, 500, false, false, noPrompt)
+		assert.False(t, ok)
+		assert.ErrorContains(t, err, "use --yes")
+		assert.Contains(t, w.String(), `Warning: todo list "Reports" already has 501 todos`)
+	})
+
+	t.Run("above the threshold with --yes", func(t *testing.T) {
+		var w bytes.Buffer
+		ok, err := checkListCapacity(&w, list, 500, true, false, noPrompt)
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Contains(t, w.String(), "Warning:")
+	})
+
+	t.Run("above the threshold on a terminal", func(t *testing.T) {
+		var w bytes.Buffer
+		asked := ""
+		ok, err := checkListCapacity(&w, list, 500, false, true, func(title string) (bool, error) {
+			asked = title
+			return false, nil
+		})
+		require.NoError(t, err)
+		assert.False(t, ok)
+		assert.Equal(t, `Add to "Reports" anyway?`, asked)
+		assert.Contains(t, w.String(), "Canceled")
+	})
+}
+
+func TestCreateTodos(t *testing.T) {
+	var created []int64
+	var attempted []string
+	create := func(content string) (*api.Todo, error) {
+		attempted = append(attempted, content)
+		if content == "Second\nwith notes" {
+			return nil, errors.New("boom")
+		}
+		return &api.Todo{ID: int64(len(attempted)), Title: content}, nil
+	}
+	record := func(todo *api.Todo) error {
+		created = append(created, todo.ID)
+		return nil
+	}
+
+	t.Run("one failing partway", func(t *testing.T) {
+		attempted, created = nil, nil
+		var out bytes.Buffer
+		err := createTodos(&out, []string{"First", "Second\nwith notes", "Third"}, create, record)
+
+		var silent *cmdutil.SilentError
+		require.ErrorAs(t, err, &silent)
+		assert.EqualError(t, err, "failed to create 1 of 3 todos")
+		assert.Equal(t, []string{"First", "Second\nwith notes", "Third"}, attempted)
+		assert.Equal(t, []int64{1, 3}, created)
+		assert.Equal(t, "✗ Second: boom\nCreated 2 of 3 todos\n", out.String())
+	})
+
+	t.Run("all created", func(t *testing.T) {
+		attempted, created = nil, nil
+		var out bytes.Buffer
+		require.NoError(t, createTodos(&out, []string{"First", "Third"}, create, record))
+		assert.Equal(t, []int64{1, 2}, created)
+		assert.Equal(t, "Created 2 of 2 todos\n", out.String())
+	})
+}
@@ -0,0 +1,145 @@
+package todo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/needmore/bc4/internal/api"
+)
+
+// breadcrumbSeparator joins the parts of a rendered breadcrumb
+const breadcrumbSeparator = " › "
+
+// breadcrumbItem is one level of a todo's breadcrumb
+type breadcrumbItem struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+// todoBreadcrumb is the project, list, and (when the todo is in one) group a
+// todo belongs to
+type todoBreadcrumb struct {
+	Project *breadcrumbItem `json:"project,omitempty"`
+	List    *breadcrumbItem `json:"list,omitempty"`
+	Group   *breadcrumbItem `json:"group,omitempty"`
+}
+
+// String renders the breadcrumb as "Project › List › Group"
+func (b *todoBreadcrumb) String() string {
+	var parts []string
+	for _, item := range []*breadcrumbItem{b.Project, b.List, b.Group} {
+		if item != nil {
+			parts = append(parts, item.Name)
+		}
+	}
+	return strings.Join(parts, breadcrumbSeparator)
+}
+
+// breadcrumbResolver looks up todo breadcrumbs, fetching each project and
+// todo list at most once
+type breadcrumbResolver struct {
+	projects  api.ProjectOperations
+	todos     api.TodoOperations
+	projectID string
+	project   *api.Project
+	lists     map[int64]*api.TodoList
+}
+
+func newBreadcrumbResolver(projects api.ProjectOperations, todos api.TodoOperations, projectID string) *breadcrumbResolver {
+	return &breadcrumbResolver{
+		projects:  projects,
+		todos:     todos,
+		projectID: projectID,
+		lists:     make(map[int64]*api.TodoList),
+	}
+}
+
+// Resolve returns the breadcrumb for todo. A todo directly on a list has no
+// group; a todo in a group has the group's list as its list.
+func (r *breadcrumbResolver) Resolve(ctx context.Context, todo *api.Todo) (*todoBreadcrumb, error) {
+	crumb := &todoBreadcrumb{}
+
+	project, err := r.getProject(ctx)
+	if err != nil {
+		return nil, err
+	}
+	crumb.Project = &breadcrumbItem{ID: project.ID, Name: project.Name}
+
+	containerID := todo.TodolistID
+	if todo.Parent != nil && todo.Parent.Type == "Todolist" {
+		containerID = todo.Parent.ID
+	}
+	if containerID == 0 {
+		return crumb, nil
+	}
+
+	container, err := r.getList(ctx, containerID)
+	if err != nil {
+		return nil, err
+	}
+	containerItem := &breadcrumbItem{ID: container.ID, Name: todoListName(container)}
+
+	// Groups are todo lists whose parent is another todo list
+	if container.Parent == nil || container.Parent.Type != "Todolist" {
+		crumb.List = containerItem
+		return crumb, nil
+	}
+	crumb.Group = containerItem
+
+	list, err := r.getList(ctx, container.Parent.ID)
+	if err != nil {
+		return nil, err
+	}
+	crumb.List = &breadcrumbItem{ID: list.ID, Name: todoListName(list)}
+	return crumb, nil
+}
+
+func (r *breadcrumbResolver) getProject(ctx context.Context) (*api.Project, error) {
+	if r.project != nil {
+		return r.project, nil
+	}
+	project, err := r.projects.GetProject(ctx, r.projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+	r.project = project
+	return project, nil
+}
+
+func (r *breadcrumbResolver) getList(ctx context.Context, id int64) (*api.TodoList, error) {
+	if list, ok := r.lists[id]; ok {
+		return list, nil
+	}
+	list, err := r.todos.GetTodoList(ctx, r.projectID, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get todo list %d: %w", id, err)
+	}
+	r.lists[id] = list
+	return list, nil
+}
+
+// todoListName returns the display name of a todo list or group
+func todoListName(list *api.TodoList) string {
+	if list.Title != "" {
+		return list.Title
+	}
+	return list.Name
+}
+
+// withBreadcrumb adds the breadcrumb to a todo's JSON output
+func withBreadcrumb(output interface{}, crumb *todoBreadcrumb) (interface{}, error) {
+	fields, ok := output.(map[string]interface{})
+	if !ok {
+		data, err := json.Marshal(output)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(data, &fields); err != nil {
+			return nil, err
+		}
+	}
+	fields["breadcrumb"] = crumb
+	return fields, nil
+}
@@ -0,0 +1,85 @@
+package todo
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/api/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// listsByID serves GetTodoList from a map, so a list and its groups can be
+// looked up separately
+type listsByID struct {
+	*mock.MockClient
+	lists map[int64]*api.TodoList
+}
+
+func (c *listsByID) GetTodoList(ctx context.Context, projectID string, todoListID int64) (*api.TodoList, error) {
+	c.Calls = append(c.Calls, fmt.Sprintf("GetTodoList(%s, %d)", projectID, todoListID))
+	list, ok := c.lists[todoListID]
+	if !ok {
+		return nil, fmt.Errorf("not found")
+	}
+	return list, nil
+}
+
+func TestBreadcrumbResolver(t *testing.T) {
+	client := &listsByID{MockClient: mock.NewMockClient(), lists: map[int64]*api.TodoList{
+		10: {ID: 10, Title: "Launch", Parent: &api.Parent{ID: 1, Type: "Todoset"}},
+		20: {ID: 20, Title: "Design", Parent: &api.Parent{ID: 10, Type: "Todolist"}},
+	}}
+	client.Project = &api.Project{ID: 2, Name: "Website"}
+	resolver := newBreadcrumbResolver(client, client, "2")
+	ctx := context.Background()
+
+	t.Run("todo directly on a list", func(t *testing.T) {
+		todo := &api.Todo{ID: 1, Title: "Ship", Parent: &api.Parent{ID: 10, Type: "Todolist"}}
+		crumb, err := resolver.Resolve(ctx, todo)
+		require.NoError(t, err)
+		assert.Nil(t, crumb.Group)
+		assert.Equal(t, &breadcrumbItem{ID: 10, Name: "Launch"}, crumb.List)
+		assert.Equal(t, "Website › Launch", crumb.String())
+	})
+
+	t.Run("todo in a group", func(t *testing.T) {
+		todo := &api.Todo{ID: 2, Title: "Mockups", Parent: &api.Parent{ID: 20, Type: "Todolist"}}
+		crumb, err := resolver.Resolve(ctx, todo)
+		require.NoError(t, err)
+		assert.Equal(t, &breadcrumbItem{ID: 20, Name: "Design"}, crumb.Group)
+		assert.Equal(t, &breadcrumbItem{ID: 10, Name: "Launch"}, crumb.List)
+		assert.Equal(t, "Website › Launch › Design", crumb.String())
+	})
+
+	t.Run("falls back to the todo list ID", func(t *testing.T) {
+		todo := &api.Todo{ID: 3, Title: "Copy", TodolistID: 10}
+		crumb, err := resolver.Resolve(ctx, todo)
+		require.NoError(t, err)
+		assert.Equal(t, "Website › Launch", crumb.String())
+	})
+
+	t.Run("lookups are cached", func(t *testing.T) {
+		assert.Equal(t, []string{"GetProject(2)", "GetTodoList(2, 10)", "GetTodoList(2, 20)"}, client.Calls)
+	})
+
+	t.Run("unknown list", func(t *testing.T) {
+		_, err := resolver.Resolve(ctx, &api.Todo{ID: 4, TodolistID: 99})
+		assert.ErrorContains(t, err, "failed to get todo list 99")
+	})
+}
+
+func TestWithBreadcrumb(t *testing.T) {
+	crumb := &todoBreadcrumb{
+		Project: &breadcrumbItem{ID: 2, Name: "Website"},
+		List:    &breadcrumbItem{ID: 10, Name: "Launch"},
+	}
+	output, err := withBreadcrumb(api.Todo{ID: 1, Title: "Ship"}, crumb)
+	require.NoError(t, err)
+
+	fields := output.(map[string]interface{})
+	assert.Equal(t, float64(1), fields["id"])
+	assert.Equal(t, crumb, fields["breadcrumb"])
+}
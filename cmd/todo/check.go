@@ -1,28 +1,40 @@
 package todo
 
 import (
+	"context"
 	"fmt"
+	"os"
 	"strconv"
 	"strings"
 
+	"github.com/needmore/bc4/internal/api"
 	"github.com/needmore/bc4/internal/cmdutil"
 	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/markdown"
 	"github.com/needmore/bc4/internal/parser"
+	"github.com/needmore/bc4/internal/undo"
 	"github.com/spf13/cobra"
 )
 
 func newCheckCmd(f *factory.Factory) *cobra.Command {
 	var accountID string
 	var projectID string
+	var comment string
 
 	cmd := &cobra.Command{
-		Use:   "check <todo-id|url>",
-		Short: "Mark a todo as complete",
+		Use:     "check <todo-id|url>",
+		Aliases: []string{"complete"},
+		Short:   "Mark a todo as complete",
 		Long: `Mark a todo as complete.
 
 You can specify the todo using either:
 - A numeric ID (e.g., "12345" or "#12345")
-- A Basecamp URL (e.g., "https://3.basecamp.com/1234567/buckets/89012345/todos/12345")`,
+- A Basecamp URL (e.g., "https://3.basecamp.com/1234567/buckets/89012345/todos/12345")
+
+Use --comment to leave a note (Markdown) on the todo saying why or how it was
+finished. The comment is posted after the todo is completed; if posting it
+fails, the todo stays completed and a warning is printed. No comment is added
+to a todo that was already completed.`,
 		Example: `  # Mark todo #12345 as complete
   bc4 todo check 12345
 
@@ -30,7 +42,10 @@ You can specify the todo using either:
   bc4 todo check #12345
 
   # Using a Basecamp URL
-  bc4 todo check "https://3.basecamp.com/1234567/buckets/89012345/todos/12345"`,
+  bc4 todo check "https://3.basecamp.com/1234567/buckets/89012345/todos/12345"
+
+  # Complete with a note
+  bc4 todo complete 12345 --comment "done via deploy #42"`,
 		Args: cmdutil.ExactArgs(1, "todo-id"),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Apply account override if specified
@@ -43,17 +58,19 @@ You can specify the todo using either:
 				f = f.WithProject(projectID)
 			}
 
-			return runCheck(f, args[0], accountID, projectID)
+			return runCheck(f, args[0], accountID, projectID, comment)
 		},
 	}
 
+	cmd.Flags().StringVarP(&comment, "comment", "m", "", "Comment to add to the todo when completing it (Markdown)")
+
 	cmd.Flags().StringVarP(&accountID, "account", "a", "", "Specify account ID")
 	cmd.Flags().StringVarP(&projectID, "project", "p", "", "Specify project ID")
 
 	return cmd
 }
 
-func runCheck(f *factory.Factory, todoIDStr string, accountIDFlag string, projectIDFlag string) error {
+func runCheck(f *factory.Factory, todoIDStr string, accountIDFlag string, projectIDFlag string, comment string) error {
 	// Parse todo ID (handle #123 format and URLs)
 	todoIDStr = strings.TrimPrefix(todoIDStr, "#")
 	todoID, parsedURL, err := parser.ParseArgument(todoIDStr)
@@ -75,12 +92,21 @@ func runCheck(f *factory.Factory, todoIDStr string, accountIDFlag string, projec
 		}
 	}
 
+	// Convert the comment up front so a bad one fails before any change
+	var richComment string
+	if strings.TrimSpace(comment) != "" {
+		converter := markdown.NewConverter()
+		richComment, err = converter.MarkdownToRichText(comment)
+		if err != nil {
+			return fmt.Errorf("failed to convert markdown: %w", err)
+		}
+	}
+
 	// Get API client from factory
 	client, err := f.ApiClient()
 	if err != nil {
 		return err
 	}
-	todoOps := client.Todos()
 
 	// Get resolved project ID
 	projectID, err := f.ProjectID()
@@ -88,26 +114,76 @@ func runCheck(f *factory.Factory, todoIDStr string, accountIDFlag string, projec
 		return err
 	}
 
-	// Get the todo first to display its title
-	todo, err := todoOps.GetTodo(f.Context(), projectID, todoID)
+	result, err := completeTodo(f.Context(), client.Todos(), client.Comments(), projectID, todoID, richComment)
 	if err != nil {
-		return fmt.Errorf("failed to fetch todo: %w", err)
+		return err
 	}
 
-	// Check if already completed
-	if todo.Completed {
+	if result.alreadyCompleted {
 		fmt.Printf("✓ Todo #%d is already completed\n", todoID)
 		return nil
 	}
 
-	// Mark as complete
-	err = todoOps.CompleteTodo(f.Context(), projectID, todoID)
-	if err != nil {
-		return fmt.Errorf("failed to complete todo: %w", err)
+	// GitHub CLI style: minimal output with confirmation
+	fmt.Printf("✓ Completed #%d: %s\n", todoID, result.title)
+	if result.commentErr != nil {
+		fmt.Fprintf(os.Stderr, "Warning: todo #%d completed but failed to add comment: %v\n", todoID, result.commentErr)
 	}
 
-	// GitHub CLI style: minimal output with confirmation
-	fmt.Printf("✓ Completed #%d: %s\n", todoID, todo.Title)
+	accountID, _ := f.AccountID()
+	undo.RecordWithHint(undo.Action{
+		Kind:        undo.KindTodoComplete,
+		AccountID:   accountID,
+		ProjectID:   projectID,
+		ID:          todoID,
+		Description: fmt.Sprintf("Completed todo #%d", todoID),
+	})
 
 	return nil
 }
+
+// completer is the subset of todo operations needed to complete a todo
+type completer interface {
+	GetTodo(ctx context.Context, projectID string, todoID int64) (*api.Todo, error)
+	CompleteTodo(ctx context.Context, projectID string, todoID int64) error
+}
+
+// commenter posts a comment on a recording
+type commenter interface {
+	CreateComment(ctx context.Context, projectID string, recordingID int64, req api.CommentCreateRequest) (*api.Comment, error)
+}
+
+type completeResult struct {
+	title            string
+	alreadyCompleted bool
+	commentErr       error // the comment failed after the todo was completed
+}
+
+// completeTodo completes a todo and then, when comment (rich text) is set,
+// posts it on the todo. A failed comment doesn't undo the completion; it is
+// returned in the result for the caller to warn about.
+func completeTodo(ctx context.Context, ops completer, comments commenter, projectID string, todoID int64, comment string) (*completeResult, error) {
+	// Get the todo first to display its title
+	todo, err := ops.GetTodo(ctx, projectID, todoID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch todo: %w", err)
+	}
+
+	result := &completeResult{title: todo.Title}
+	if todo.Completed {
+		result.alreadyCompleted = true
+		return result, nil
+	}
+
+	if err := ops.CompleteTodo(ctx, projectID, todoID); err != nil {
+		return nil, fmt.Errorf("failed to complete todo: %w", err)
+	}
+
+	if comment != "" {
+		if _, err := comments.CreateComment(ctx, projectID, todoID, api.CommentCreateRequest{Content: comment}); err != nil {
+			result.commentErr = err
+		}
+	}
+
+	return result, nil
+}
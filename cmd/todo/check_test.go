@@ -0,0 +1,66 @@
+package todo
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/api/mock"
+)
+
+func TestCompleteTodo(t *testing.T) {
+	t.Run("completes without a comment", func(t *testing.T) {
+		client := mock.NewMockClient()
+		client.Todo = &api.Todo{ID: 1, Title: "Ship it"}
+
+		result, err := completeTodo(context.Background(), client, client, "10", 1, "")
+		require.NoError(t, err)
+		assert.Equal(t, "Ship it", result.title)
+		assert.Equal(t, []string{"GetTodo(10, 1)", "CompleteTodo(10, 1)"}, client.Calls)
+	})
+
+	t.Run("completes then comments", func(t *testing.T) {
+		client := mock.NewMockClient()
+		client.Todo = &api.Todo{ID: 1, Title: "Ship it"}
+
+		result, err := completeTodo(context.Background(), client, client, "10", 1, "<div>done via deploy #42</div>")
+		require.NoError(t, err)
+		assert.NoError(t, result.commentErr)
+		assert.Equal(t, []string{"GetTodo(10, 1)", "CompleteTodo(10, 1)", "CreateComment(10, 1)"}, client.Calls)
+	})
+
+	t.Run("a failed comment is only reported", func(t *testing.T) {
+		client := mock.NewMockClient()
+		client.Todo = &api.Todo{ID: 1, Title: "Ship it"}
+		client.CreateCommentError = errors.New("boom")
+
+		result, err := completeTodo(context.Background(), client, client, "10", 1, "<div>done</div>")
+		require.NoError(t, err)
+		assert.EqualError(t, result.commentErr, "boom")
+		assert.Contains(t, client.Calls, "CompleteTodo(10, 1)")
+	})
+
+	t.Run("no comment when completing fails", func(t *testing.T) {
+		client := mock.NewMockClient()
+		client.Todo = &api.Todo{ID: 1, Title: "Ship it"}
+		client.CompleteTodoError = errors.New("boom")
+
+		_, err := completeTodo(context.Background(), client, client, "10", 1, "<div>done</div>")
+		assert.EqualError(t, err, "failed to complete todo: boom")
+		assert.NotContains(t, client.Calls, "CreateComment(10, 1)")
+	})
+
+	t.Run("already completed todo is left alone", func(t *testing.T) {
+		client := mock.NewMockClient()
+		client.Todo = &api.Todo{ID: 1, Title: "Ship it", Completed: true}
+
+		result, err := completeTodo(context.Background(), client, client, "10", 1, "<div>done</div>")
+		require.NoError(t, err)
+		assert.True(t, result.alreadyCompleted)
+		assert.Equal(t, []string{"GetTodo(10, 1)"}, client.Calls)
+	})
+}
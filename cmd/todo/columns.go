@@ -0,0 +1,93 @@
+package todo
+
+import (
+	"fmt"
+
+	"github.com/needmore/bc4/internal/api"
+)
+
+// todoColumnSeparator matches the gap the TTY table printer puts between
+// columns
+const todoColumnSeparator = 3
+
+// todoColumn describes a column of the TTY todo table. Columns with a zero
+// dropOrder are always shown; the others are dropped, highest first, when the
+// table won't fit the terminal.
+type todoColumn struct {
+	header    string
+	minWidth  int
+	dropOrder int
+}
+
+// todoTableColumns returns the TTY columns for a todo table, sized for the
+// given todos
+func todoTableColumns(todos []api.Todo, withGroups bool) []todoColumn {
+	idWidth := len("ID")
+	for _, todo := range todos {
+		if w := len(fmt.Sprintf("%d", todo.ID)); w > idWidth {
+			idWidth = w
+		}
+	}
+
+	columns := []todoColumn{
+		{header: "ID", minWidth: idWidth},
+		{header: "", minWidth: 1},
+		{header: "TODO", minWidth: 20},
+	}
+	if withGroups {
+		columns = append(columns, todoColumn{header: "GROUP", minWidth: 10, dropOrder: 1})
+	}
+	return append(columns,
+		todoColumn{header: "ASSIGNEE", minWidth: 10, dropOrder: 2},
+		todoColumn{header: "DUE", minWidth: 6, dropOrder: 3},
+	)
+}
+
+// fitTodoColumns drops optional columns (DUE, then ASSIGNEE, then GROUP)
+// until the minimum widths of the rest fit within width
+func fitTodoColumns(columns []todoColumn, width int) []todoColumn {
+	fitted := append([]todoColumn(nil), columns...)
+	for todoColumnsWidth(fitted) > width {
+		drop := -1
+		for i, col := range fitted {
+			if col.dropOrder > 0 && (drop < 0 || col.dropOrder > fitted[drop].dropOrder) {
+				drop = i
+			}
+		}
+		if drop < 0 {
+			break
+		}
+		fitted = append(fitted[:drop], fitted[drop+1:]...)
+	}
+	return fitted
+}
+
+func todoColumnsWidth(columns []todoColumn) int {
+	if len(columns) == 0 {
+		return 0
+	}
+	total := (len(columns) - 1) * todoColumnSeparator
+	for _, col := range columns {
+		total += col.minWidth
+	}
+	return total
+}
+
+// todoColumnSet reports which optional columns are visible
+type todoColumnSet map[string]bool
+
+func newTodoColumnSet(columns []todoColumn) todoColumnSet {
+	set := make(todoColumnSet, len(columns))
+	for _, col := range columns {
+		set[col.header] = true
+	}
+	return set
+}
+
+func todoColumnHeaders(columns []todoColumn) []string {
+	headers := make([]string, len(columns))
+	for i, col := range columns {
+		headers[i] = col.header
+	}
+	return headers
+}
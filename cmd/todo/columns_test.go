@@ -0,0 +1,35 @@
+package todo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/needmore/bc4/internal/api"
+)
+
+func TestFitTodoColumns(t *testing.T) {
+	todos := []api.Todo{{ID: 12345}, {ID: 7}}
+
+	tests := []struct {
+		name       string
+		width      int
+		withGroups bool
+		expected   []string
+	}{
+		{"wide terminal keeps everything", 120, true, []string{"ID", "", "TODO", "GROUP", "ASSIGNEE", "DUE"}},
+		{"exact fit keeps everything", 67, true, []string{"ID", "", "TODO", "GROUP", "ASSIGNEE", "DUE"}},
+		{"drops due first", 66, true, []string{"ID", "", "TODO", "GROUP", "ASSIGNEE"}},
+		{"then assignee", 50, true, []string{"ID", "", "TODO", "GROUP"}},
+		{"then group", 40, true, []string{"ID", "", "TODO"}},
+		{"never drops required columns", 10, true, []string{"ID", "", "TODO"}},
+		{"without groups", 50, false, []string{"ID", "", "TODO", "ASSIGNEE"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			columns := fitTodoColumns(todoTableColumns(todos, tt.withGroups), tt.width)
+			assert.Equal(t, tt.expected, todoColumnHeaders(columns))
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package todo
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/mattn/go-runewidth"
+
+	"github.com/needmore/bc4/internal/api"
+	coretableprinter "github.com/needmore/bc4/internal/tableprinter"
+	"github.com/needmore/bc4/internal/ui/tableprinter"
+)
+
+// minCompactTitleWidth is the narrowest a --compact title is trimmed to;
+// the assignee and due date give way first
+const minCompactTitleWidth = 20
+
+// compactTodoLine is a todo's --compact line split into its parts, so each
+// part can be trimmed and styled separately
+type compactTodoLine struct {
+	status string // "✓ " or "[x] "
+	id     string // "#123 "
+	title  string
+	meta   string // " · Assignee · due Jun 1"
+}
+
+// newCompactTodoLine builds the line for todo. On a terminal it uses the
+// table's status glyphs and is trimmed to width; otherwise it is plain text.
+func newCompactTodoLine(todo api.Todo, tty bool, width int, dates todoDates) compactTodoLine {
+	line := compactTodoLine{id: fmt.Sprintf("#%d ", todo.ID)}
+
+	switch {
+	case tty:
+		line.status = tableprinter.StatusSymbol(todo.Completed) + " "
+	case todo.Completed:
+		line.status = "[x] "
+	default:
+		line.status = "[ ] "
+	}
+
+	line.title = todo.Content
+	if line.title == "" {
+		line.title = todo.Title
+	}
+
+	var meta []string
+	if len(todo.Assignees) > 0 {
+		names := make([]string, 0, len(todo.Assignees))
+		for _, a := range todo.Assignees {
+			names = append(names, a.Name)
+		}
+		meta = append(meta, strings.Join(names, ", "))
+	}
+	if due := dates.due(todo); due != "" {
+		meta = append(meta, "due "+due)
+	}
+	if len(meta) > 0 {
+		line.meta = " · " + strings.Join(meta, " · ")
+	}
+
+	if tty && width > 0 {
+		line.fit(width)
+	}
+	return line
+}
+
+// fit trims the title, then the assignee and due date, so the line is no
+// wider than width
+func (l *compactTodoLine) fit(width int) {
+	fixed := runewidth.StringWidth(l.status) + runewidth.StringWidth(l.id)
+	titleWidth := width - fixed - runewidth.StringWidth(l.meta)
+	if titleWidth < minCompactTitleWidth {
+		titleWidth = minCompactTitleWidth
+	}
+	l.title = coretableprinter.Truncate(titleWidth, l.title)
+
+	if l.meta != "" {
+		metaWidth := width - fixed - runewidth.StringWidth(l.title)
+		if metaWidth <= len(" · ") {
+			l.meta = ""
+		} else {
+			l.meta = coretableprinter.Truncate(metaWidth, l.meta)
+		}
+	}
+}
+
+// writeCompactTodos writes one line per todo for --compact. On a terminal,
+// completed todos are muted and highlighted titles keep their style.
+func writeCompactTodos(w io.Writer, rows []todoRow, tty bool, width int, hl *todoHighlighter, dates todoDates) error {
+	cs := coretableprinter.NewColorScheme()
+	for _, row := range rows {
+		line := newCompactTodoLine(row.todo, tty, width, dates)
+
+		text := line.status + line.id + line.title + line.meta
+		if tty {
+			switch {
+			case row.todo.Completed:
+				text = cs.Muted(text)
+			case hl.matches(row.todo):
+				text = cs.Gray(line.status) + line.id + hl.style.Render(line.title) + cs.Muted(line.meta)
+			default:
+				text = cs.Gray(line.status) + line.id + line.title + cs.Muted(line.meta)
+			}
+		}
+
+		if _, err := fmt.Fprintln(w, text); err != nil {
+			return err
+		}
+	}
+	return nil
+}
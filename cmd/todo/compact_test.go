@@ -0,0 +1,50 @@
+package todo
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mattn/go-runewidth"
+	"github.com/needmore/bc4/internal/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// compactDates pins "now" so the short due dates don't gain a year
+var compactDates = todoDates{now: time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)}
+
+func TestWriteCompactTodos_Plain(t *testing.T) {
+	due := "2026-06-01"
+	rows := []todoRow{
+		{todo: api.Todo{ID: 123, Title: "Ship release", Assignees: []api.Person{{Name: "Jane Doe"}}, DueOn: &due}},
+		{todo: api.Todo{ID: 124, Title: "Write notes", Completed: true}},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, writeCompactTodos(&buf, rows, false, 0, nil, compactDates))
+	assert.Equal(t, "[ ] #123 Ship release · Jane Doe · due Jun 1\n[x] #124 Write notes\n", buf.String())
+}
+
+func TestNewCompactTodoLine_FitsWidth(t *testing.T) {
+	due := "2026-06-01"
+	todo := api.Todo{
+		ID:        123,
+		Title:     "リリースの準備をしてチェックリストを全部確認する long title that keeps going",
+		Assignees: []api.Person{{Name: "Jane Doe"}},
+		DueOn:     &due,
+	}
+
+	line := newCompactTodoLine(todo, true, 60, compactDates)
+	text := line.status + line.id + line.title + line.meta
+	assert.LessOrEqual(t, runewidth.StringWidth(text), 60)
+	assert.True(t, strings.HasPrefix(text, "○ #123 "))
+	assert.True(t, strings.HasSuffix(text, " · Jane Doe · due Jun 1"), "the title is trimmed before the assignee and due date")
+	assert.Contains(t, line.title, "...")
+
+	// On a very narrow terminal the title keeps a readable minimum
+	line = newCompactTodoLine(todo, true, 30, compactDates)
+	assert.InDelta(t, minCompactTitleWidth, runewidth.StringWidth(line.title), 1, "wide characters can leave one column spare")
+	assert.LessOrEqual(t, runewidth.StringWidth(line.status+line.id+line.title+line.meta), 30)
+}
@@ -0,0 +1,94 @@
+package todo
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/utils"
+)
+
+// parseCompletedSince parses a --since-completed value: a relative window
+// like "24h", "7d", or "2w", or a YYYY-MM-DD date
+func parseCompletedSince(value string, now time.Time) (time.Time, error) {
+	value = strings.TrimSpace(strings.ToLower(value))
+
+	if span, ok := utils.ParseSpan(value); ok {
+		return span.Before(now), nil
+	}
+
+	if t, err := time.ParseInLocation("2006-01-02", value, now.Location()); err == nil {
+		return t, nil
+	}
+
+	return time.Time{}, fmt.Errorf("invalid --since-completed %q: use a window like 24h, 7d, or 2w, or a date (YYYY-MM-DD)", value)
+}
+
+// completedAt returns when a todo was completed, if known
+func completedAt(todo api.Todo) (time.Time, bool) {
+	if !todo.Completed || todo.CompletedAt == nil {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339, *todo.CompletedAt)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// completedSince returns the todos completed at or after since, most recently
+// completed first. Todos without a completion time are left out.
+func completedSince(todos []api.Todo, since time.Time) []api.Todo {
+	type completedTodo struct {
+		todo api.Todo
+		at   time.Time
+	}
+
+	var matched []completedTodo
+	for _, todo := range todos {
+		if at, ok := completedAt(todo); ok && !at.Before(since) {
+			matched = append(matched, completedTodo{todo: todo, at: at})
+		}
+	}
+
+	sort.SliceStable(matched, func(i, j int) bool {
+		return matched[i].at.After(matched[j].at)
+	})
+
+	result := make([]api.Todo, len(matched))
+	for i, m := range matched {
+		result[i] = m.todo
+	}
+	return result
+}
+
+// completionSummary describes who completed a todo and when, e.g.
+// "by Jane Doe on June 13, 2024 at 4:30 PM". It's empty when neither is known.
+func completionSummary(todo api.Todo) string {
+	var parts []string
+	if todo.Completer != nil && todo.Completer.Name != "" {
+		parts = append(parts, "by "+todo.Completer.Name)
+	}
+	if at, ok := completedAt(todo); ok {
+		parts = append(parts, "on "+at.Local().Format("January 2, 2006 at 3:04 PM"))
+	}
+	return strings.Join(parts, " ")
+}
+
+// groupCompletion reports a group's completion as "done/total" and whether
+// every todo in it is completed. The API's CompletedRatio is preferred; the
+// fetched todos are counted when it's missing or malformed.
+func groupCompletion(group api.TodoGroup, todos []api.Todo) (string, bool) {
+	var done, total int
+	if _, err := fmt.Sscanf(group.CompletedRatio, "%d/%d", &done, &total); err != nil {
+		done, total = 0, len(todos)
+		for _, todo := range todos {
+			if todo.Completed {
+				done++
+			}
+		}
+	}
+	return fmt.Sprintf("%d/%d", done, total), total > 0 && done == total
+}
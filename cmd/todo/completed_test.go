@@ -0,0 +1,90 @@
+package todo
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/needmore/bc4/internal/api"
+)
+
+func TestParseCompletedSince(t *testing.T) {
+	now := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		input string
+		want  time.Time
+	}{
+		{"24h", now.Add(-24 * time.Hour)},
+		{"7d", now.AddDate(0, 0, -7)},
+		{"2W", now.AddDate(0, 0, -14)},
+		{"2024-06-01", time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		got, err := parseCompletedSince(tt.input, now)
+		require.NoError(t, err, tt.input)
+		assert.Equal(t, tt.want, got, tt.input)
+	}
+
+	_, err := parseCompletedSince("last week", now)
+	assert.Error(t, err)
+}
+
+func TestCompletedSince(t *testing.T) {
+	var todos []api.Todo
+	require.NoError(t, json.Unmarshal([]byte(`[
+		{"id": 1, "title": "Old", "completed": true, "completed_at": "2024-05-01T10:00:00Z"},
+		{"id": 2, "title": "Tuesday", "completed": true, "completed_at": "2024-06-11T09:00:00Z"},
+		{"id": 3, "title": "Open", "completed": false},
+		{"id": 4, "title": "Thursday", "completed": true, "completed_at": "2024-06-13T16:30:00Z"},
+		{"id": 5, "title": "No timestamp", "completed": true}
+	]`), &todos))
+	require.NotNil(t, todos[1].CompletedAt)
+
+	since := time.Date(2024, 6, 8, 0, 0, 0, 0, time.UTC)
+	got := completedSince(todos, since)
+
+	require.Len(t, got, 2)
+	assert.Equal(t, int64(4), got[0].ID)
+	assert.Equal(t, int64(2), got[1].ID)
+}
+
+func TestCompletionSummary(t *testing.T) {
+	completedAt := "2024-06-13T16:30:00Z"
+	todo := api.Todo{Completed: true, CompletedAt: &completedAt, Completer: &api.Person{Name: "Jane Doe"}}
+
+	summary := completionSummary(todo)
+	assert.Contains(t, summary, "by Jane Doe on ")
+	assert.Contains(t, summary, "2024")
+
+	assert.Empty(t, completionSummary(api.Todo{}))
+}
+
+func TestGroupCompletion(t *testing.T) {
+	done := []api.Todo{{Completed: true}, {Completed: true}}
+	mixed := []api.Todo{{Completed: true}, {Completed: false}}
+
+	tests := []struct {
+		name      string
+		group     api.TodoGroup
+		todos     []api.Todo
+		ratio     string
+		completed bool
+	}{
+		{"ratio complete", api.TodoGroup{CompletedRatio: "8/8"}, nil, "8/8", true},
+		{"ratio partial", api.TodoGroup{CompletedRatio: "3/8"}, done, "3/8", false},
+		{"empty group", api.TodoGroup{CompletedRatio: "0/0"}, nil, "0/0", false},
+		{"counted when ratio missing", api.TodoGroup{}, done, "2/2", true},
+		{"counted partial", api.TodoGroup{}, mixed, "1/2", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ratio, completed := groupCompletion(tt.group, tt.todos)
+			assert.Equal(t, tt.ratio, ratio)
+			assert.Equal(t, tt.completed, completed)
+		})
+	}
+}
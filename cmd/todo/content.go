@@ -0,0 +1,129 @@
+package todo
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/markdown"
+	"github.com/needmore/bc4/internal/ui"
+)
+
+// todoContentOptions controls how todo bodies appear in JSON output
+type todoContentOptions struct {
+	omit   bool
+	format markdown.ContentFormat
+	// projectPeople, when set, adds a stale_assignees array listing the
+	// assignees who aren't among these project people
+	projectPeople map[int64]bool
+	// sla, when set, adds an age_days field with how long ago the todo was
+	// created
+	sla *todoSLA
+	// fields, when set, limits each todo to these JSON fields
+	fields []string
+}
+
+// todoJSONFieldNames lists the fields --json can select, including the ones
+// other flags add
+func todoJSONFieldNames() []string {
+	return append(ui.JSONFieldNames(api.Todo{}), "stale_assignees", "age_days")
+}
+
+// addTodoContentFlags registers --no-content and --content-as
+func addTodoContentFlags(cmd *cobra.Command, noContent *bool, contentAs *string) {
+	cmd.Flags().BoolVar(noContent, "no-content", false, "Omit content and description from JSON output")
+	cmd.Flags().StringVar(contentAs, "content-as", "", "Render content and description in JSON output as html, markdown, or text")
+}
+
+func parseTodoContentOptions(noContent bool, contentAs string) (todoContentOptions, error) {
+	if noContent && contentAs != "" {
+		return todoContentOptions{}, fmt.Errorf("--no-content and --content-as cannot be used together")
+	}
+	format, err := markdown.ParseContentFormat(contentAs)
+	if err != nil {
+		return todoContentOptions{}, fmt.Errorf("invalid --content-as: %w", err)
+	}
+	return todoContentOptions{omit: noContent, format: format}, nil
+}
+
+// shapeTodoJSON returns the value to encode for todo, with its body fields
+// converted or removed according to opts
+func shapeTodoJSON(todo api.Todo, opts todoContentOptions) (interface{}, error) {
+	if opts.omit {
+		fields, err := todoJSONFields(todo)
+		if err != nil {
+			return nil, err
+		}
+		delete(fields, "content")
+		delete(fields, "description")
+		return withExtraFields(fields, todo, opts), nil
+	}
+
+	if opts.format != markdown.ContentHTML {
+		converter := markdown.NewConverter()
+		var err error
+		if todo.Content, err = markdown.ConvertContent(converter, todo.Content, opts.format); err != nil {
+			return nil, fmt.Errorf("failed to convert content of todo #%d: %w", todo.ID, err)
+		}
+		if todo.Description, err = markdown.ConvertContent(converter, todo.Description, opts.format); err != nil {
+			return nil, fmt.Errorf("failed to convert description of todo #%d: %w", todo.ID, err)
+		}
+	}
+
+	if opts.projectPeople == nil && opts.sla == nil {
+		return todo, nil
+	}
+	fields, err := todoJSONFields(todo)
+	if err != nil {
+		return nil, err
+	}
+	return withExtraFields(fields, todo, opts), nil
+}
+
+// todoJSONFields returns todo's JSON encoding as a map, for adding or
+// removing fields
+func todoJSONFields(todo api.Todo) (map[string]interface{}, error) {
+	data, err := json.Marshal(todo)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+// withExtraFields adds stale_assignees and age_days to fields when opts
+// asks for them
+func withExtraFields(fields map[string]interface{}, todo api.Todo, opts todoContentOptions) map[string]interface{} {
+	if opts.projectPeople != nil {
+		fields["stale_assignees"] = staleAssignees(todo, opts.projectPeople)
+	}
+	if opts.sla != nil {
+		if days, ok := opts.sla.ageDays(todo); ok {
+			fields["age_days"] = days
+		}
+	}
+	return fields
+}
+
+// shapeTodosJSON applies shapeTodoJSON to each todo
+func shapeTodosJSON(todos []api.Todo, opts todoContentOptions) ([]interface{}, error) {
+	shaped := make([]interface{}, len(todos))
+	for i, todo := range todos {
+		value, err := shapeTodoJSON(todo, opts)
+		if err != nil {
+			return nil, err
+		}
+		if opts.fields != nil {
+			if value, err = ui.FilterJSONFields(value, opts.fields); err != nil {
+				return nil, err
+			}
+		}
+		shaped[i] = value
+	}
+	return shaped, nil
+}
@@ -0,0 +1,71 @@
+package todo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/markdown"
+	"github.com/needmore/bc4/internal/ui"
+)
+
+func TestParseTodoContentOptions(t *testing.T) {
+	opts, err := parseTodoContentOptions(false, "markdown")
+	require.NoError(t, err)
+	assert.Equal(t, markdown.ContentMarkdown, opts.format)
+
+	_, err = parseTodoContentOptions(true, "text")
+	assert.Error(t, err)
+
+	_, err = parseTodoContentOptions(false, "rtf")
+	assert.Error(t, err)
+}
+
+func TestShapeTodoJSON(t *testing.T) {
+	todo := api.Todo{ID: 1, Title: "Ship", Content: "Ship", Description: "<div>Tag <em>v2</em></div>"}
+
+	t.Run("no-content drops body fields", func(t *testing.T) {
+		value, err := shapeTodoJSON(todo, todoContentOptions{omit: true})
+		require.NoError(t, err)
+		fields := value.(map[string]interface{})
+		assert.NotContains(t, fields, "content")
+		assert.NotContains(t, fields, "description")
+		assert.Equal(t, "Ship", fields["title"])
+	})
+
+	t.Run("content-as text", func(t *testing.T) {
+		value, err := shapeTodoJSON(todo, todoContentOptions{format: markdown.ContentText})
+		require.NoError(t, err)
+		assert.Equal(t, "Tag v2", value.(api.Todo).Description)
+	})
+
+	t.Run("html is unchanged", func(t *testing.T) {
+		value, err := shapeTodoJSON(todo, todoContentOptions{format: markdown.ContentHTML})
+		require.NoError(t, err)
+		assert.Equal(t, todo, value)
+	})
+
+	t.Run("stale assignees", func(t *testing.T) {
+		assigned := todo
+		assigned.Assignees = []api.Person{{ID: 1, Name: "Ann"}, {ID: 2, Name: "Gone"}}
+		value, err := shapeTodoJSON(assigned, todoContentOptions{projectPeople: map[int64]bool{1: true}})
+		require.NoError(t, err)
+		fields := value.(map[string]interface{})
+		assert.Equal(t, []api.Person{{ID: 2, Name: "Gone"}}, fields["stale_assignees"])
+		assert.Equal(t, "Ship", fields["title"])
+	})
+}
+
+func TestShapeTodosJSON_Fields(t *testing.T) {
+	todos := []api.Todo{{ID: 1, Title: "Ship", Content: "Ship"}}
+	opts := todoContentOptions{format: markdown.ContentHTML, fields: []string{"id", "title"}}
+
+	shaped, err := shapeTodosJSON(todos, opts)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{map[string]interface{}{"id": float64(1), "title": "Ship"}}, shaped)
+
+	_, err = ui.ParseJSONFields("id,nope", todoJSONFieldNames())
+	assert.ErrorContains(t, err, "unknown field(s): nope")
+}
@@ -2,18 +2,17 @@ package todo
 
 import (
 	"fmt"
-	"strings"
 
 	"github.com/needmore/bc4/internal/api"
 	"github.com/needmore/bc4/internal/factory"
-	"github.com/needmore/bc4/internal/parser"
 	"github.com/needmore/bc4/internal/utils"
 	"github.com/spf13/cobra"
 )
 
 type createGroupOptions struct {
-	list  string
-	color string
+	list      string
+	color     string
+	listMatch string
 }
 
 func newCreateGroupCmd(f *factory.Factory) *cobra.Command {
@@ -49,12 +48,18 @@ Available colors:
 	}
 
 	cmd.Flags().StringVarP(&opts.list, "list", "l", "", "Todo list ID, name, or URL (defaults to selected list)")
+	addListMatchFlag(cmd, &opts.listMatch)
 	cmd.Flags().StringVarP(&opts.color, "color", "c", "", "Color for the group (white, red, orange, yellow, green, blue, aqua, purple, gray, pink, brown)")
 
 	return cmd
 }
 
 func runCreateGroup(f *factory.Factory, opts *createGroupOptions, args []string) error {
+	policy, err := parseMatchPolicy(opts.listMatch)
+	if err != nil {
+		return err
+	}
+
 	// Get name from args or prompt
 	var name string
 	if len(args) > 0 {
@@ -98,36 +103,11 @@ func runCreateGroup(f *factory.Factory, opts *createGroupOptions, args []string)
 	// Resolve todo list ID
 	var todoListID int64
 	if opts.list != "" {
-		// Check if it's a URL
-		if parser.IsBasecampURL(opts.list) {
-			parsed, err := parser.ParseBasecampURL(opts.list)
-			if err != nil {
-				return fmt.Errorf("invalid Basecamp URL: %w", err)
-			}
-			if parsed.ResourceType != parser.ResourceTypeTodoList {
-				return fmt.Errorf("URL is not a todo list URL: %s", opts.list)
-			}
-			todoListID = parsed.ResourceID
-		} else {
-			// User specified a list - try to find it
-			todoLists, err := todoOps.GetTodoLists(f.Context(), projectID, todoSet.ID)
-			if err != nil {
-				return fmt.Errorf("failed to fetch todo lists: %w", err)
-			}
-
-			// Try to match by ID or name
-			for _, list := range todoLists {
-				if fmt.Sprintf("%d", list.ID) == opts.list ||
-					strings.EqualFold(list.Title, opts.list) ||
-					strings.EqualFold(list.Name, opts.list) {
-					todoListID = list.ID
-					break
-				}
-			}
-
-			if todoListID == 0 {
-				return fmt.Errorf("todo list not found: %s", opts.list)
-			}
+		todoListID, err = resolveTodoList(opts.list, policy, func() ([]api.TodoList, error) {
+			return todoOps.GetTodoLists(f.Context(), projectID, todoSet.ID)
+		})
+		if err != nil {
+			return err
 		}
 	} else {
 		// Use default todo list from config
@@ -0,0 +1,161 @@
+package todo
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/needmore/bc4/internal/api"
+)
+
+// defaultCSVColumns is the column set used when --columns is not specified
+var defaultCSVColumns = []string{"id", "group", "status", "title", "assignee", "due"}
+
+// todoCSVColumn describes a single selectable CSV column
+type todoCSVColumn struct {
+	header string
+	value  func(row todoRow) string
+}
+
+// todoCSVColumns maps column names accepted by --columns to their definitions
+var todoCSVColumns = map[string]todoCSVColumn{
+	"id":       {header: "ID", value: func(r todoRow) string { return strconv.FormatInt(r.todo.ID, 10) }},
+	"group":    {header: "Group", value: func(r todoRow) string { return r.group }},
+	"status":   {header: "Status", value: func(r todoRow) string { return todoStatusMarker(r.todo) }},
+	"title":    {header: "Todo", value: func(r todoRow) string { return todoPlainTitle(r.todo) }},
+	"assignee": {header: "Assignee", value: func(r todoRow) string { return todoAssigneeNames(r.todo) }},
+	"due":      {header: "Due", value: func(r todoRow) string { return derefString(r.todo.DueOn) }},
+	"starts":   {header: "Starts", value: func(r todoRow) string { return derefString(r.todo.StartsOn) }},
+	"created":  {header: "Created", value: func(r todoRow) string { return r.todo.CreatedAt }},
+	"updated":  {header: "Updated", value: func(r todoRow) string { return r.todo.UpdatedAt }},
+}
+
+// todoRow pairs a todo with the title of the group it belongs to (if any)
+type todoRow struct {
+	group string
+	todo  api.Todo
+}
+
+// parseCSVColumns parses a comma-separated column list, returning the
+// default columns when spec is empty
+func parseCSVColumns(spec string) ([]string, error) {
+	if strings.TrimSpace(spec) == "" {
+		return defaultCSVColumns, nil
+	}
+
+	var columns []string
+	for _, name := range strings.Split(spec, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		if _, ok := todoCSVColumns[name]; !ok {
+			return nil, fmt.Errorf("unknown column %q (valid columns: %s)", name, strings.Join(validCSVColumns(), ", "))
+		}
+		columns = append(columns, name)
+	}
+
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("no columns specified")
+	}
+	return columns, nil
+}
+
+// validCSVColumns returns the names accepted by --columns in a stable order
+func validCSVColumns() []string {
+	return []string{"id", "group", "status", "title", "assignee", "due", "starts", "created", "updated"}
+}
+
+// writeTodosCSV writes todo rows as RFC 4180 CSV with the given columns
+func writeTodosCSV(w io.Writer, rows []todoRow, columns []string) error {
+	writer := csv.NewWriter(w)
+
+	headers := make([]string, len(columns))
+	for i, name := range columns {
+		headers[i] = todoCSVColumns[name].header
+	}
+	if err := writer.Write(headers); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+
+	for _, row := range rows {
+		record := make([]string, len(columns))
+		for i, name := range columns {
+			record[i] = todoCSVColumns[name].value(row)
+		}
+		if err := writer.Write(record); err != nil {
+			return fmt.Errorf("failed to write CSV record: %w", err)
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// collectTodoRows flattens todos into rows, in group order when groups are
+// present, skipping completed todos unless showAll is set
+func collectTodoRows(groups []api.TodoGroup, groupedTodos map[string][]api.Todo, showAll bool) []todoRow {
+	var rows []todoRow
+	add := func(group string, todos []api.Todo) {
+		for _, todo := range todos {
+			if showAll || !todo.Completed {
+				rows = append(rows, todoRow{group: group, todo: todo})
+			}
+		}
+	}
+
+	if len(groups) > 0 {
+		for _, group := range groups {
+			add(group.Title, groupedTodos[fmt.Sprintf("%d", group.ID)])
+		}
+		return rows
+	}
+
+	add("", groupedTodos[""])
+	return rows
+}
+
+// labelTodoGroups fills in the group of rows whose todos were taken out of
+// their groups, from todoGroups (todo ID to group title)
+func labelTodoGroups(rows []todoRow, todoGroups map[int64]string) []todoRow {
+	for i, row := range rows {
+		if row.group == "" {
+			rows[i].group = todoGroups[row.todo.ID]
+		}
+	}
+	return rows
+}
+
+// todoStatusMarker returns the checkbox-style status used in plain output
+func todoStatusMarker(todo api.Todo) string {
+	if todo.Completed {
+		return "[x]"
+	}
+	return "[ ]"
+}
+
+// todoPlainTitle returns the plain-text title, falling back to content
+func todoPlainTitle(todo api.Todo) string {
+	if todo.Title != "" {
+		return todo.Title
+	}
+	return todo.Content
+}
+
+// todoAssigneeNames joins assignee names with commas
+func todoAssigneeNames(todo api.Todo) string {
+	names := make([]string, 0, len(todo.Assignees))
+	for _, a := range todo.Assignees {
+		names = append(names, a.Name)
+	}
+	return strings.Join(names, ", ")
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,93 @@
+package todo
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/needmore/bc4/internal/api"
+)
+
+func TestParseCSVColumns(t *testing.T) {
+	t.Run("default columns", func(t *testing.T) {
+		cols, err := parseCSVColumns("")
+		require.NoError(t, err)
+		assert.Equal(t, defaultCSVColumns, cols)
+	})
+
+	t.Run("custom columns normalized", func(t *testing.T) {
+		cols, err := parseCSVColumns(" ID, Title ,due")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"id", "title", "due"}, cols)
+	})
+
+	t.Run("unknown column lists valid set", func(t *testing.T) {
+		_, err := parseCSVColumns("id,priority")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "priority")
+		assert.Contains(t, err.Error(), "assignee")
+	})
+}
+
+func TestWriteTodosCSV_Quoting(t *testing.T) {
+	due := "2025-01-15"
+	rows := []todoRow{
+		{todo: api.Todo{ID: 1, Title: "Buy milk, eggs", DueOn: &due}},
+		{todo: api.Todo{ID: 2, Title: "Line one\nline two", Completed: true}},
+		{todo: api.Todo{ID: 3, Title: `Say "hi"`}},
+	}
+
+	var buf bytes.Buffer
+	err := writeTodosCSV(&buf, rows, []string{"id", "status", "title", "due"})
+	require.NoError(t, err)
+
+	expected := "ID,Status,Todo,Due\n" +
+		"1,[ ],\"Buy milk, eggs\",2025-01-15\n" +
+		"2,[x],\"Line one\nline two\",\n" +
+		"3,[ ],\"Say \"\"hi\"\"\",\n"
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestCollectTodoRows_SameLayoutWithAndWithoutGroups(t *testing.T) {
+	open := api.Todo{ID: 1, Title: "Open"}
+	done := api.Todo{ID: 2, Title: "Done", Completed: true}
+
+	t.Run("flat list", func(t *testing.T) {
+		rows := collectTodoRows(nil, map[string][]api.Todo{"": {open, done}}, false)
+		require.Len(t, rows, 1)
+		assert.Equal(t, "", rows[0].group)
+
+		var buf bytes.Buffer
+		require.NoError(t, writeTodosCSV(&buf, rows, defaultCSVColumns))
+		assert.Equal(t, "ID,Group,Status,Todo,Assignee,Due\n1,,[ ],Open,,\n", buf.String())
+	})
+
+	t.Run("grouped list keeps group order", func(t *testing.T) {
+		groups := []api.TodoGroup{{ID: 20, Title: "Second"}, {ID: 10, Title: "First"}}
+		grouped := map[string][]api.Todo{"10": {open}, "20": {done}}
+
+		rows := collectTodoRows(groups, grouped, true)
+		require.Len(t, rows, 2)
+		assert.Equal(t, "Second", rows[0].group)
+		assert.Equal(t, "First", rows[1].group)
+	})
+}
+
+func TestLabelTodoGroups(t *testing.T) {
+	due := func(s string) *string { return &s }
+	todos := []api.Todo{
+		{ID: 1, Title: "Later", DueOn: due("2026-05-02")},
+		{ID: 2, Title: "Sooner", DueOn: due("2026-05-01")},
+	}
+	sortTodosByDue(todos)
+
+	rows := labelTodoGroups(collectTodoRows(nil, map[string][]api.Todo{"": todos}, false), map[int64]string{1: "Backend", 2: "Frontend"})
+	require.Len(t, rows, 2)
+	assert.Equal(t, todoRow{group: "Frontend", todo: todos[0]}, rows[0])
+	assert.Equal(t, todoRow{group: "Backend", todo: todos[1]}, rows[1])
+
+	rows = labelTodoGroups(collectTodoRows(nil, map[string][]api.Todo{"": todos}, false), nil)
+	assert.Empty(t, rows[0].group, "without sorting out of groups there's nothing to label")
+}
@@ -0,0 +1,66 @@
+package todo
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/utils"
+)
+
+// dueAnchor is the reference date relative --due values are counted from
+type dueAnchor string
+
+const (
+	dueAnchorToday       dueAnchor = "today"
+	dueAnchorListCreated dueAnchor = "list-created"
+	dueAnchorListUpdated dueAnchor = "list-updated"
+)
+
+func parseDueAnchor(s string) (dueAnchor, error) {
+	switch anchor := dueAnchor(strings.ToLower(strings.TrimSpace(s))); anchor {
+	case "", dueAnchorToday:
+		return dueAnchorToday, nil
+	case dueAnchorListCreated, dueAnchorListUpdated:
+		return anchor, nil
+	default:
+		return "", fmt.Errorf("invalid --due-anchor %q: must be today, list-created, or list-updated", s)
+	}
+}
+
+// resolveDueDate turns a --due value into a YYYY-MM-DD date. Relative values
+// are counted from the anchor; fetchList is only called for list anchors.
+func resolveDueDate(due string, anchor dueAnchor, fetchList func() (*api.TodoList, error), now time.Time) (string, error) {
+	if anchor == dueAnchorToday {
+		date, err := utils.ParseDateRelativeTo(due, now)
+		if err != nil {
+			return "", fmt.Errorf("invalid --due: %w", err)
+		}
+		return date, nil
+	}
+
+	if _, err := time.Parse(utils.DateLayout, strings.TrimSpace(due)); err == nil {
+		return "", fmt.Errorf("--due-anchor %s only applies to relative --due values such as +5d", anchor)
+	}
+
+	list, err := fetchList()
+	if err != nil {
+		return "", fmt.Errorf("failed to get todo list for --due-anchor: %w", err)
+	}
+
+	stamp := list.CreatedAt
+	if anchor == dueAnchorListUpdated {
+		stamp = list.UpdatedAt
+	}
+	base, err := time.Parse(time.RFC3339, stamp)
+	if err != nil {
+		return "", fmt.Errorf("todo list %q has no usable %s date", list.Title, anchor)
+	}
+
+	date, err := utils.ParseDateRelativeTo(due, base.In(now.Location()))
+	if err != nil {
+		return "", fmt.Errorf("invalid --due: %w", err)
+	}
+	return date, nil
+}
@@ -0,0 +1,76 @@
+package todo
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/needmore/bc4/internal/api"
+)
+
+func TestResolveDueDate(t *testing.T) {
+	now := time.Date(2025, 6, 16, 15, 0, 0, 0, time.UTC)
+	list := &api.TodoList{
+		Title:     "Sprint 12",
+		CreatedAt: "2025-06-02T09:00:00Z",
+		UpdatedAt: "2025-06-10T17:30:00Z",
+	}
+	fetched := 0
+	fetchList := func() (*api.TodoList, error) {
+		fetched++
+		return list, nil
+	}
+
+	tests := []struct {
+		name   string
+		due    string
+		anchor dueAnchor
+		want   string
+	}{
+		{"today anchor", "+5d", dueAnchorToday, "2025-06-21"},
+		{"absolute date", "2025-07-01", dueAnchorToday, "2025-07-01"},
+		{"list created", "+5d", dueAnchorListCreated, "2025-06-07"},
+		{"list updated in weeks", "+1w", dueAnchorListUpdated, "2025-06-17"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveDueDate(tt.due, tt.anchor, fetchList, now)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+	assert.Equal(t, 2, fetched, "the list is only fetched for list anchors")
+
+	t.Run("absolute date with list anchor", func(t *testing.T) {
+		_, err := resolveDueDate("2025-07-01", dueAnchorListCreated, fetchList, now)
+		assert.ErrorContains(t, err, "only applies to relative")
+	})
+
+	t.Run("invalid due", func(t *testing.T) {
+		_, err := resolveDueDate("someday", dueAnchorListCreated, fetchList, now)
+		assert.ErrorContains(t, err, "invalid --due")
+	})
+
+	t.Run("list fetch fails", func(t *testing.T) {
+		_, err := resolveDueDate("+1d", dueAnchorListCreated, func() (*api.TodoList, error) {
+			return nil, errors.New("boom")
+		}, now)
+		assert.ErrorContains(t, err, "boom")
+	})
+}
+
+func TestParseDueAnchor(t *testing.T) {
+	anchor, err := parseDueAnchor("")
+	require.NoError(t, err)
+	assert.Equal(t, dueAnchorToday, anchor)
+
+	anchor, err = parseDueAnchor("List-Created")
+	require.NoError(t, err)
+	assert.Equal(t, dueAnchorListCreated, anchor)
+
+	_, err = parseDueAnchor("sprint-start")
+	assert.Error(t, err)
+}
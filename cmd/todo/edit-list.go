@@ -3,7 +3,6 @@ package todo
 import (
 	"fmt"
 	"strconv"
-	"strings"
 
 	"github.com/needmore/bc4/internal/api"
 	"github.com/needmore/bc4/internal/cmdutil"
@@ -17,6 +16,7 @@ type editListOptions struct {
 	name             string
 	description      string
 	clearDescription bool
+	listMatch        string
 }
 
 func newEditListCmd(f *factory.Factory) *cobra.Command {
@@ -57,6 +57,7 @@ The name field is required by the API, so if only updating description, the curr
 	cmd.Flags().StringVarP(&opts.name, "name", "n", "", "New name for the todo list")
 	cmd.Flags().StringVarP(&opts.description, "description", "d", "", "New description for the todo list (supports markdown)")
 	cmd.Flags().BoolVar(&opts.clearDescription, "clear-description", false, "Clear the description")
+	addListMatchFlag(cmd, &opts.listMatch)
 
 	return cmd
 }
@@ -72,10 +73,14 @@ func runEditList(f *factory.Factory, opts *editListOptions, args []string) error
 		return fmt.Errorf("cannot use both --description and --clear-description")
 	}
 
+	policy, err := parseMatchPolicy(opts.listMatch)
+	if err != nil {
+		return err
+	}
+
 	// Parse list ID (could be numeric ID, name, or URL)
 	listArg := args[0]
 	var todoListID int64
-	var err error
 
 	// Try to parse as URL first
 	if parser.IsBasecampURL(listArg) {
@@ -118,26 +123,12 @@ func runEditList(f *factory.Factory, opts *editListOptions, args []string) error
 			return fmt.Errorf("failed to get todo set: %w", err)
 		}
 
-		todoLists, err := todoOps.GetTodoLists(f.Context(), projectID, todoSet.ID)
+		todoListID, err = resolveTodoList(listArg, policy, func() ([]api.TodoList, error) {
+			return todoOps.GetTodoLists(f.Context(), projectID, todoSet.ID)
+		})
 		if err != nil {
-			return fmt.Errorf("failed to fetch todo lists: %w", err)
+			return err
 		}
-
-		searchTerm := strings.ToLower(listArg)
-		var matches []api.TodoList
-		for _, list := range todoLists {
-			if strings.Contains(strings.ToLower(list.Title), searchTerm) {
-				matches = append(matches, list)
-			}
-		}
-
-		if len(matches) == 0 {
-			return fmt.Errorf("no todo list found matching '%s'", listArg)
-		} else if len(matches) > 1 {
-			return fmt.Errorf("multiple todo lists match '%s'. Please be more specific or use the list ID", listArg)
-		}
-
-		todoListID = matches[0].ID
 	}
 
 	// Fetch the current todo list to get existing values
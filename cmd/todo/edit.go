@@ -15,20 +15,24 @@ import (
 	"github.com/needmore/bc4/internal/markdown"
 	"github.com/needmore/bc4/internal/mentions"
 	"github.com/needmore/bc4/internal/parser"
+	"github.com/needmore/bc4/internal/ui"
 	"github.com/needmore/bc4/internal/utils"
 	"github.com/spf13/cobra"
 )
 
 type editOptions struct {
-	title       string
-	description string
-	due         string
-	startsOn    string
-	assign      []string
-	unassign    []string
-	file        string
-	clearDue    bool
-	attach      []string
+	title        string
+	description  string
+	due          string
+	startsOn     string
+	assign       []string
+	unassign     []string
+	assignees    []string
+	assigneeMode string
+	file         string
+	clearDue     bool
+	attach       []string
+	interactive  bool
 }
 
 func newEditCmd(f *factory.Factory) *cobra.Command {
@@ -43,7 +47,13 @@ You can specify the todo using either:
 - A numeric ID (e.g., "12345")
 - A Basecamp URL (e.g., "https://3.basecamp.com/1234567/buckets/89012345/todos/12345")
 
-All fields are optional - only specified fields will be updated.
+All fields are optional - only specified fields will be updated, and the
+todo's other fields are kept as they are. --content is another name for
+--title, since Basecamp calls a todo's title its content.
+
+With no changes given on a terminal, or with --interactive, the todo is
+edited step by step: its title, description, due date, and assignees, then a
+confirmation before anything is saved.
 
 Use --attach to add images or files to the todo description. Attachments are
 appended to the existing description. Multiple files can be attached by using
@@ -51,6 +61,9 @@ the flag multiple times.`,
 		Example: `  # Edit todo title
   bc4 todo edit 12345 --title "Updated title"
 
+  # Edit the todo step by step
+  bc4 todo edit 12345
+
   # Edit todo description with markdown
   bc4 todo edit 12345 --description "New description with **bold** text"
 
@@ -66,6 +79,13 @@ the flag multiple times.`,
   # Remove someone from the todo
   bc4 todo edit 12345 --unassign user@example.com
 
+  # Set exactly who is assigned, replacing everyone else
+  bc4 todo edit 12345 --assignee jane@example.com --assignee @john
+
+  # Add or remove people without disturbing other assignees
+  bc4 todo edit 12345 --assignee @jane --assignee-mode add
+  bc4 todo edit 12345 --assignee @jane --assignee-mode remove
+
   # Update from a markdown file
   bc4 todo edit 12345 --file updated-todo.md
 
@@ -84,14 +104,19 @@ the flag multiple times.`,
 	}
 
 	cmd.Flags().StringVarP(&opts.title, "title", "t", "", "New title for the todo")
+	cmd.Flags().StringVar(&opts.title, "content", "", "New content (title) for the todo, the same as --title")
 	cmd.Flags().StringVarP(&opts.description, "description", "d", "", "New description for the todo")
-	cmd.Flags().StringVar(&opts.due, "due", "", "Due date (YYYY-MM-DD)")
+	cmd.Flags().StringVar(&opts.due, "due", "", "Due date (YYYY-MM-DD, today, tomorrow, a weekday, or +Nd/+Nw)")
 	cmd.Flags().StringVar(&opts.startsOn, "starts-on", "", "Start date (YYYY-MM-DD)")
 	cmd.Flags().StringSliceVar(&opts.assign, "assign", nil, "Add assignees (by email or name)")
 	cmd.Flags().StringSliceVar(&opts.unassign, "unassign", nil, "Remove assignees (by email or name)")
+	cmd.Flags().StringSliceVar(&opts.assignees, "assignee", nil, "Assignees to apply with --assignee-mode (by email or name)")
+	cmd.Flags().StringVar(&opts.assigneeMode, "assignee-mode", utils.AssigneeModeReplace, "How --assignee is applied: replace, add, or remove")
 	cmd.Flags().StringVarP(&opts.file, "file", "f", "", "Read new content from a markdown file")
 	cmd.Flags().BoolVar(&opts.clearDue, "clear-due", false, "Clear the due date")
 	cmd.Flags().StringSliceVar(&opts.attach, "attach", nil, "Attach file(s) to the todo (can be used multiple times)")
+	cmd.Flags().BoolVar(&opts.interactive, "interactive", false, "Edit the todo step by step (default on a terminal when no changes are given)")
+	cmd.MarkFlagsMutuallyExclusive("title", "content")
 
 	return cmd
 }
@@ -167,17 +192,31 @@ func runEdit(f *factory.Factory, opts *editOptions, args []string) error {
 		}
 	}
 
+	if err := utils.ValidateAssigneeMode(opts.assigneeMode); err != nil {
+		return err
+	}
+	if len(opts.assignees) > 0 && (len(opts.assign) > 0 || len(opts.unassign) > 0) {
+		return fmt.Errorf("--assignee cannot be combined with --assign or --unassign")
+	}
+
 	// Check if any changes were requested
 	hasChanges := opts.title != "" || opts.description != "" || opts.due != "" ||
 		opts.startsOn != "" || len(opts.assign) > 0 || len(opts.unassign) > 0 ||
-		opts.clearDue || len(opts.attach) > 0
+		len(opts.assignees) > 0 || opts.clearDue || len(opts.attach) > 0
 
+	if opts.interactive && hasChanges {
+		return fmt.Errorf("--interactive cannot be combined with changes given as flags, a file, or stdin")
+	}
+	if opts.interactive || (!hasChanges && ui.IsTerminal(os.Stdin) && ui.IsTerminal(os.Stdout)) {
+		return runEditInteractive(f, client, resolvedProjectID, currentTodo)
+	}
 	if !hasChanges {
-		return fmt.Errorf("no changes specified. Use --title, --description, --due, --assign, --unassign, --attach, or --file to specify changes")
+		return fmt.Errorf("no changes specified. Use --title, --description, --due, --assign, --unassign, --assignee, --attach, or --file to specify changes")
 	}
 
-	// Build update request
-	req := api.TodoUpdateRequest{}
+	// Start from the todo as it is, so fields that aren't being changed are
+	// kept
+	req := todoUpdateBase(currentTodo)
 
 	// Create markdown converter
 	converter := markdown.NewConverter()
@@ -192,7 +231,7 @@ func runEdit(f *factory.Factory, opts *editOptions, args []string) error {
 		if err != nil {
 			return fmt.Errorf("failed to resolve mentions: %w", err)
 		}
-		req.Content = richTitle
+		req.Content = &richTitle
 	}
 
 	// Handle description update
@@ -205,16 +244,13 @@ func runEdit(f *factory.Factory, opts *editOptions, args []string) error {
 		if err != nil {
 			return fmt.Errorf("failed to resolve mentions: %w", err)
 		}
-		req.Description = richDescription
+		req.Description = &richDescription
 	}
 
 	// Handle attachments - append to existing or new description
 	if len(opts.attach) > 0 {
 		// Start with the description we already have, or the current todo's description
-		baseDescription := req.Description
-		if baseDescription == "" {
-			baseDescription = currentTodo.Description
-		}
+		baseDescription := *req.Description
 
 		for _, attachPath := range opts.attach {
 			fileData, err := os.ReadFile(attachPath)
@@ -229,7 +265,7 @@ func runEdit(f *factory.Factory, opts *editOptions, args []string) error {
 			tag := attachments.BuildTag(upload.AttachableSGID)
 			baseDescription += tag
 		}
-		req.Description = baseDescription
+		req.Description = &baseDescription
 	}
 
 	// Handle due date
@@ -237,44 +273,49 @@ func runEdit(f *factory.Factory, opts *editOptions, args []string) error {
 		emptyDate := ""
 		req.DueOn = &emptyDate
 	} else if opts.due != "" {
-		req.DueOn = &opts.due
+		due, err := utils.ParseDate(opts.due)
+		if err != nil {
+			return fmt.Errorf("invalid --due: %w", err)
+		}
+		req.DueOn = &due
 	}
 
 	// Handle start date
 	if opts.startsOn != "" {
-		req.StartsOn = &opts.startsOn
+		startsOn, err := utils.ParseDate(opts.startsOn)
+		if err != nil {
+			return fmt.Errorf("invalid --starts-on: %w", err)
+		}
+		req.StartsOn = &startsOn
 	}
 
 	// Handle assignee changes
-	if len(opts.assign) > 0 || len(opts.unassign) > 0 {
+	if len(opts.assign) > 0 || len(opts.unassign) > 0 || len(opts.assignees) > 0 {
 		// Create user resolver
 		userResolver := utils.NewUserResolver(client.Client, resolvedProjectID)
 
 		// Start with existing assignees
-		currentAssigneeIDs := make([]int64, 0)
+		currentAssigneeIDs := make([]int64, 0, len(currentTodo.Assignees))
 		for _, assignee := range currentTodo.Assignees {
 			currentAssigneeIDs = append(currentAssigneeIDs, assignee.ID)
 		}
 
+		// Apply --assignee using the requested mode
+		if len(opts.assignees) > 0 {
+			ids, err := userResolver.ResolveUsers(f.Context(), opts.assignees)
+			if err != nil {
+				return fmt.Errorf("failed to resolve assignees: %w", err)
+			}
+			currentAssigneeIDs = utils.ApplyAssigneeMode(currentAssigneeIDs, ids, opts.assigneeMode)
+		}
+
 		// Add new assignees
 		if len(opts.assign) > 0 {
 			newAssigneeIDs, err := userResolver.ResolveUsers(f.Context(), opts.assign)
 			if err != nil {
 				return fmt.Errorf("failed to resolve assignees to add: %w", err)
 			}
-			// Merge without duplicates
-			for _, newID := range newAssigneeIDs {
-				found := false
-				for _, existingID := range currentAssigneeIDs {
-					if existingID == newID {
-						found = true
-						break
-					}
-				}
-				if !found {
-					currentAssigneeIDs = append(currentAssigneeIDs, newID)
-				}
-			}
+			currentAssigneeIDs = utils.ApplyAssigneeMode(currentAssigneeIDs, newAssigneeIDs, utils.AssigneeModeAdd)
 		}
 
 		// Remove assignees
@@ -283,21 +324,7 @@ func runEdit(f *factory.Factory, opts *editOptions, args []string) error {
 			if err != nil {
 				return fmt.Errorf("failed to resolve assignees to remove: %w", err)
 			}
-			// Filter out removed assignees
-			filteredIDs := make([]int64, 0)
-			for _, existingID := range currentAssigneeIDs {
-				shouldRemove := false
-				for _, removeID := range removeIDs {
-					if existingID == removeID {
-						shouldRemove = true
-						break
-					}
-				}
-				if !shouldRemove {
-					filteredIDs = append(filteredIDs, existingID)
-				}
-			}
-			currentAssigneeIDs = filteredIDs
+			currentAssigneeIDs = utils.ApplyAssigneeMode(currentAssigneeIDs, removeIDs, utils.AssigneeModeRemove)
 		}
 
 		req.AssigneeIDs = currentAssigneeIDs
@@ -314,3 +341,24 @@ func runEdit(f *factory.Factory, opts *editOptions, args []string) error {
 
 	return nil
 }
+
+// todoUpdateBase returns an update request carrying the todo's current
+// content, description, dates, and assignees, so that changing one field
+// doesn't clear the others
+func todoUpdateBase(todo *api.Todo) api.TodoUpdateRequest {
+	content := todo.Content
+	if content == "" {
+		content = todo.Title
+	}
+	description := todo.Description
+	req := api.TodoUpdateRequest{
+		Content:     &content,
+		Description: &description,
+		DueOn:       todo.DueOn,
+		StartsOn:    todo.StartsOn,
+	}
+	for _, assignee := range todo.Assignees {
+		req.AssigneeIDs = append(req.AssigneeIDs, assignee.ID)
+	}
+	return req
+}
@@ -0,0 +1,487 @@
+package todo
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/bubbles/textarea"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/markdown"
+	"github.com/needmore/bc4/internal/mentions"
+	"github.com/needmore/bc4/internal/utils"
+)
+
+type todoEditStep int
+
+const (
+	todoEditStepTitle todoEditStep = iota
+	todoEditStepDescription
+	todoEditStepDue
+	todoEditStepAssignees
+	todoEditStepConfirm
+	todoEditStepUpdating
+	todoEditStepDone
+)
+
+// todoEdits are the values chosen in the interactive editor
+type todoEdits struct {
+	title       string
+	description string // Markdown
+	due         string // YYYY-MM-DD, or "" for no due date
+	assigneeIDs []int64
+}
+
+// todoEditsFrom returns the todo's current values, as the editor starts
+// with them
+func todoEditsFrom(todo *api.Todo, converter markdown.Converter) todoEdits {
+	edits := todoEdits{title: todo.Title}
+	if todo.Description != "" {
+		if md, err := converter.RichTextToMarkdown(todo.Description); err == nil {
+			edits.description = strings.TrimSpace(md)
+		} else {
+			edits.description = markdown.PlainText(todo.Description)
+		}
+	}
+	if todo.DueOn != nil {
+		edits.due = *todo.DueOn
+	}
+	for _, assignee := range todo.Assignees {
+		edits.assigneeIDs = append(edits.assigneeIDs, assignee.ID)
+	}
+	return edits
+}
+
+// request builds the update for edits made to todo, starting from its
+// current values. The title and description are only converted from
+// Markdown, with toRichText, when they were changed, so untouched rich text
+// is sent back as it was.
+func (e todoEdits) request(todo *api.Todo, original todoEdits, toRichText func(string) (string, error)) (api.TodoUpdateRequest, error) {
+	req := todoUpdateBase(todo)
+	if e.title != original.title {
+		rich, err := toRichText(e.title)
+		if err != nil {
+			return req, fmt.Errorf("failed to convert title: %w", err)
+		}
+		req.Content = &rich
+	}
+	if e.description != original.description {
+		// An emptied description is sent as "" so that it is cleared
+		rich := ""
+		if e.description != "" {
+			var err error
+			if rich, err = toRichText(e.description); err != nil {
+				return req, fmt.Errorf("failed to convert description: %w", err)
+			}
+		}
+		req.Description = &rich
+	}
+	if e.due != original.due {
+		due := e.due
+		req.DueOn = &due
+	}
+	req.AssigneeIDs = e.assigneeIDs
+	return req, nil
+}
+
+type todoEditPeopleMsg struct {
+	people []api.Person
+	err    error
+}
+
+type todoUpdatedMsg struct {
+	todo *api.Todo
+	err  error
+}
+
+// todoEditModel edits a todo step by step, like 'bc4 card edit'
+type todoEditModel struct {
+	factory   *factory.Factory
+	client    *api.Client
+	projectID string
+	todo      *api.Todo
+
+	step       todoEditStep
+	original   todoEdits
+	edits      todoEdits
+	people     []api.Person
+	peopleList list.Model
+
+	titleInput      textinput.Model
+	descriptionArea textarea.Model
+	dueInput        textinput.Model
+	dueErr          string
+	spinner         spinner.Model
+	updatedTodo     *api.Todo
+	err             error
+}
+
+func newTodoEditModel(f *factory.Factory, client *api.Client, projectID string, todo *api.Todo) todoEditModel {
+	original := todoEditsFrom(todo, markdown.NewConverter())
+	m := todoEditModel{
+		factory:         f,
+		client:          client,
+		projectID:       projectID,
+		todo:            todo,
+		original:        original,
+		edits:           original,
+		titleInput:      textinput.New(),
+		descriptionArea: textarea.New(),
+		dueInput:        textinput.New(),
+		spinner:         spinner.New(),
+	}
+	m.edits.assigneeIDs = slices.Clone(original.assigneeIDs)
+
+	m.titleInput.Placeholder = "Todo title..."
+	m.titleInput.CharLimit = 500
+	m.titleInput.SetValue(original.title)
+	m.titleInput.Focus()
+
+	m.descriptionArea.Placeholder = "Description (Markdown supported)..."
+	m.descriptionArea.CharLimit = 10000
+	m.descriptionArea.SetValue(original.description)
+
+	m.dueInput.Placeholder = "YYYY-MM-DD, tomorrow, friday, +3d... (empty for none)"
+	m.dueInput.SetValue(original.due)
+
+	m.peopleList = list.New([]list.Item{}, list.NewDefaultDelegate(), 0, 0)
+	m.peopleList.Title = "Select Assignees"
+	m.peopleList.SetShowStatusBar(false)
+	m.peopleList.SetFilteringEnabled(true)
+	return m
+}
+
+func (m todoEditModel) Init() tea.Cmd {
+	return tea.Batch(textinput.Blink, m.spinner.Tick, m.loadPeople())
+}
+
+func (m todoEditModel) loadPeople() tea.Cmd {
+	return func() tea.Msg {
+		people, err := m.client.GetProjectPeople(m.factory.Context(), m.projectID)
+		return todoEditPeopleMsg{people: people, err: err}
+	}
+}
+
+func (m todoEditModel) updateTodo() tea.Cmd {
+	return func() tea.Msg {
+		converter := markdown.NewConverter()
+		toRichText := func(md string) (string, error) {
+			rich, err := converter.MarkdownToRichText(md)
+			if err != nil {
+				return "", err
+			}
+			return mentions.Resolve(m.factory.Context(), rich, m.client, m.projectID)
+		}
+
+		req, err := m.edits.request(m.todo, m.original, toRichText)
+		if err != nil {
+			return todoUpdatedMsg{err: err}
+		}
+		todo, err := m.client.UpdateTodo(m.factory.Context(), m.projectID, m.todo.ID, req)
+		return todoUpdatedMsg{todo: todo, err: err}
+	}
+}
+
+func (m todoEditModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+	var cmds []tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.peopleList.SetSize(msg.Width, msg.Height-10)
+		m.descriptionArea.SetWidth(msg.Width - 4)
+		m.descriptionArea.SetHeight(msg.Height - 10)
+
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c":
+			return m, tea.Quit
+		case "esc":
+			if m.step != todoEditStepDescription {
+				return m, tea.Quit
+			}
+		}
+
+	case todoEditPeopleMsg:
+		// Without people the assignees are left as they are
+		if msg.err == nil {
+			m.people = msg.people
+		}
+
+	case todoUpdatedMsg:
+		if msg.err != nil {
+			m.err = msg.err
+			return m, tea.Quit
+		}
+		m.updatedTodo = msg.todo
+		m.step = todoEditStepDone
+		return m, tea.Quit
+
+	case spinner.TickMsg:
+		if m.step == todoEditStepUpdating {
+			m.spinner, cmd = m.spinner.Update(msg)
+			cmds = append(cmds, cmd)
+		}
+	}
+
+	switch m.step {
+	case todoEditStepTitle:
+		if key, ok := msg.(tea.KeyMsg); ok && key.String() == "enter" {
+			if title := strings.TrimSpace(m.titleInput.Value()); title != "" {
+				m.edits.title = title
+				m.step = todoEditStepDescription
+				m.titleInput.Blur()
+				cmds = append(cmds, m.descriptionArea.Focus())
+				return m, tea.Batch(cmds...)
+			}
+		}
+		m.titleInput, cmd = m.titleInput.Update(msg)
+		cmds = append(cmds, cmd)
+
+	case todoEditStepDescription:
+		if key, ok := msg.(tea.KeyMsg); ok {
+			switch key.String() {
+			case "ctrl+d":
+				m.edits.description = strings.TrimSpace(m.descriptionArea.Value())
+				fallthrough
+			case "esc":
+				// Esc keeps the current description
+				m.step = todoEditStepDue
+				m.descriptionArea.Blur()
+				cmds = append(cmds, m.dueInput.Focus())
+				return m, tea.Batch(cmds...)
+			}
+		}
+		m.descriptionArea, cmd = m.descriptionArea.Update(msg)
+		cmds = append(cmds, cmd)
+
+	case todoEditStepDue:
+		if key, ok := msg.(tea.KeyMsg); ok && key.String() == "enter" {
+			value := strings.TrimSpace(m.dueInput.Value())
+			due := ""
+			if value != "" {
+				parsed, err := utils.ParseDate(value)
+				if err != nil {
+					m.dueErr = err.Error()
+					return m, nil
+				}
+				due = parsed
+			}
+			m.edits.due = due
+			m.dueErr = ""
+			m.dueInput.Blur()
+			m.step = todoEditStepConfirm
+			if len(m.people) > 0 {
+				m.peopleList.SetItems(todoEditPeopleItems(m.people, m.edits.assigneeIDs))
+				m.step = todoEditStepAssignees
+			}
+			return m, tea.Batch(cmds...)
+		}
+		m.dueInput, cmd = m.dueInput.Update(msg)
+		cmds = append(cmds, cmd)
+
+	case todoEditStepAssignees:
+		if key, ok := msg.(tea.KeyMsg); ok {
+			switch key.String() {
+			case "enter":
+				m.step = todoEditStepConfirm
+				return m, tea.Batch(cmds...)
+			case " ", "space":
+				if item, ok := m.peopleList.SelectedItem().(todoPersonItem); ok {
+					m.edits.assigneeIDs = toggleID(m.edits.assigneeIDs, item.person.ID)
+					index := m.peopleList.Index()
+					item.selected = !item.selected
+					cmds = append(cmds, m.peopleList.SetItem(index, item))
+				}
+				return m, tea.Batch(cmds...)
+			}
+		}
+		m.peopleList, cmd = m.peopleList.Update(msg)
+		cmds = append(cmds, cmd)
+
+	case todoEditStepConfirm:
+		if key, ok := msg.(tea.KeyMsg); ok {
+			switch key.String() {
+			case "y", "Y":
+				m.step = todoEditStepUpdating
+				cmds = append(cmds, m.spinner.Tick, m.updateTodo())
+			case "n", "N":
+				return m, tea.Quit
+			}
+		}
+	}
+
+	return m, tea.Batch(cmds...)
+}
+
+func (m todoEditModel) View() string {
+	if m.err != nil {
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("1")).Render(fmt.Sprintf("Error: %v", m.err))
+	}
+	if m.step == todoEditStepDone && m.updatedTodo != nil {
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("2")).Render(fmt.Sprintf("Todo updated: #%d", m.updatedTodo.ID))
+	}
+
+	title := lipgloss.NewStyle().Bold(true).Underline(true).MarginBottom(1)
+	var content string
+
+	switch m.step {
+	case todoEditStepTitle:
+		content = title.Render("Edit Todo Title") + "\n\n"
+		content += m.titleInput.View()
+		content += "\n\nPress Enter to continue"
+
+	case todoEditStepDescription:
+		content = title.Render("Edit Description") + "\n\n"
+		content += m.descriptionArea.View()
+		content += "\n\nPress Ctrl+D when done, Esc to keep the current description"
+
+	case todoEditStepDue:
+		content = title.Render("Edit Due Date") + "\n\n"
+		content += m.dueInput.View()
+		if m.dueErr != "" {
+			content += "\n\n" + lipgloss.NewStyle().Foreground(lipgloss.Color("1")).Render(m.dueErr)
+		}
+		content += "\n\nPress Enter to continue"
+
+	case todoEditStepAssignees:
+		content = title.Render("Edit Assignees") + "\n\n"
+		content += "Press Space to toggle selection, Enter when done\n\n"
+		if names := m.assigneeNames(); len(names) > 0 {
+			content += "Selected: " + strings.Join(names, ", ") + "\n\n"
+		}
+		content += m.peopleList.View()
+
+	case todoEditStepConfirm:
+		content = title.Render("Confirm Changes") + "\n\n"
+		content += m.summary()
+		content += "\nUpdate todo? (y/n)"
+
+	case todoEditStepUpdating:
+		content = m.spinner.View() + " Updating todo..."
+	}
+
+	return content
+}
+
+// summary lists what changed, for the confirmation step
+func (m todoEditModel) summary() string {
+	var b strings.Builder
+	if m.edits.title != m.original.title {
+		fmt.Fprintf(&b, "Title: %s → %s\n", m.original.title, m.edits.title)
+	} else {
+		fmt.Fprintf(&b, "Title: %s (unchanged)\n", m.edits.title)
+	}
+
+	if m.edits.description != m.original.description {
+		b.WriteString("Description: Modified\n")
+	} else {
+		b.WriteString("Description: Unchanged\n")
+	}
+
+	switch {
+	case m.edits.due == m.original.due:
+		b.WriteString("Due: Unchanged\n")
+	case m.edits.due == "":
+		b.WriteString("Due: Cleared\n")
+	default:
+		fmt.Fprintf(&b, "Due: %s\n", m.edits.due)
+	}
+
+	if sameIDs(m.edits.assigneeIDs, m.original.assigneeIDs) {
+		b.WriteString("Assignees: Unchanged\n")
+	} else if names := m.assigneeNames(); len(names) > 0 {
+		fmt.Fprintf(&b, "Assignees: %s\n", strings.Join(names, ", "))
+	} else {
+		b.WriteString("Assignees: None\n")
+	}
+	return b.String()
+}
+
+// assigneeNames returns the names of the selected assignees
+func (m todoEditModel) assigneeNames() []string {
+	var names []string
+	for _, id := range m.edits.assigneeIDs {
+		for _, p := range m.people {
+			if p.ID == id {
+				names = append(names, p.Name)
+				break
+			}
+		}
+	}
+	return names
+}
+
+// todoPersonItem is a person in the assignee list, marked when selected
+type todoPersonItem struct {
+	person   api.Person
+	selected bool
+}
+
+func (i todoPersonItem) Title() string {
+	prefix := "  "
+	if i.selected {
+		prefix = "✓ "
+	}
+	return prefix + i.person.Name
+}
+
+func (i todoPersonItem) Description() string { return i.person.EmailAddress }
+func (i todoPersonItem) FilterValue() string { return i.person.Name + " " + i.person.EmailAddress }
+
+// todoEditPeopleItems returns the assignee list items, with the selected
+// people marked
+func todoEditPeopleItems(people []api.Person, selected []int64) []list.Item {
+	items := make([]list.Item, len(people))
+	for i, person := range people {
+		items[i] = todoPersonItem{person: person, selected: slices.Contains(selected, person.ID)}
+	}
+	return items
+}
+
+// toggleID adds id to ids, or removes it when it is already there
+func toggleID(ids []int64, id int64) []int64 {
+	if i := slices.Index(ids, id); i >= 0 {
+		return slices.Delete(ids, i, i+1)
+	}
+	return append(ids, id)
+}
+
+// sameIDs reports whether a and b hold the same IDs in any order
+func sameIDs(a, b []int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, id := range a {
+		if !slices.Contains(b, id) {
+			return false
+		}
+	}
+	return true
+}
+
+// runEditInteractive edits todo step by step and saves it after confirmation
+func runEditInteractive(f *factory.Factory, client *api.ModularClient, projectID string, todo *api.Todo) error {
+	model := newTodoEditModel(f, client.Client, projectID, todo)
+	finalModel, err := tea.NewProgram(model, tea.WithAltScreen()).Run()
+	if err != nil {
+		return fmt.Errorf("error running editor: %w", err)
+	}
+
+	if m, ok := finalModel.(todoEditModel); ok {
+		if m.err != nil {
+			return fmt.Errorf("failed to update todo: %w", m.err)
+		}
+		if m.updatedTodo != nil {
+			fmt.Printf("Updated #%d\n", m.updatedTodo.ID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+package todo
+
+import (
+	"encoding/json"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/markdown"
+)
+
+func editableTodo() *api.Todo {
+	due := "2026-04-01"
+	starts := "2026-03-25"
+	return &api.Todo{
+		ID:          42,
+		Title:       "Ship release",
+		Content:     "Ship release",
+		Description: "<div>Check <strong>staging</strong></div>",
+		DueOn:       &due,
+		StartsOn:    &starts,
+		Assignees:   []api.Person{{ID: 1, Name: "Jane"}, {ID: 2, Name: "John"}},
+	}
+}
+
+func TestTodoUpdateBase_PreservesFields(t *testing.T) {
+	todo := editableTodo()
+
+	req := todoUpdateBase(todo)
+	require.NotNil(t, req.Content)
+	assert.Equal(t, "Ship release", *req.Content)
+	require.NotNil(t, req.Description)
+	assert.Equal(t, todo.Description, *req.Description)
+	require.NotNil(t, req.DueOn)
+	assert.Equal(t, "2026-04-01", *req.DueOn)
+	require.NotNil(t, req.StartsOn)
+	assert.Equal(t, "2026-03-25", *req.StartsOn)
+	assert.Equal(t, []int64{1, 2}, req.AssigneeIDs)
+
+	// Older responses may only have the title
+	req = todoUpdateBase(&api.Todo{Title: "Plain"})
+	assert.Equal(t, "Plain", *req.Content)
+}
+
+func TestTodoEdits_Request(t *testing.T) {
+	todo := editableTodo()
+	original := todoEditsFrom(todo, markdown.NewConverter())
+	assert.Equal(t, "Ship release", original.title)
+	assert.Equal(t, "2026-04-01", original.due)
+	assert.Contains(t, original.description, "**staging**")
+
+	converted := 0
+	toRichText := func(md string) (string, error) {
+		converted++
+		return "<div>" + md + "</div>", nil
+	}
+
+	t.Run("unchanged keeps the rich text", func(t *testing.T) {
+		converted = 0
+		req, err := original.request(todo, original, toRichText)
+		require.NoError(t, err)
+		assert.Zero(t, converted)
+		assert.Equal(t, todo.Description, *req.Description)
+		assert.Equal(t, "2026-04-01", *req.DueOn)
+		assert.Equal(t, []int64{1, 2}, req.AssigneeIDs)
+	})
+
+	t.Run("changed fields", func(t *testing.T) {
+		converted = 0
+		edits := original
+		edits.title = "Ship it"
+		edits.due = ""
+		edits.assigneeIDs = []int64{2}
+
+		req, err := edits.request(todo, original, toRichText)
+		require.NoError(t, err)
+		assert.Equal(t, 1, converted, "only the changed title is converted")
+		assert.Equal(t, "<div>Ship it</div>", *req.Content)
+		assert.Equal(t, todo.Description, *req.Description)
+		require.NotNil(t, req.DueOn)
+		assert.Equal(t, "", *req.DueOn, "an emptied due date clears it")
+		assert.Equal(t, "2026-03-25", *req.StartsOn)
+		assert.Equal(t, []int64{2}, req.AssigneeIDs)
+	})
+
+	t.Run("cleared description is sent empty", func(t *testing.T) {
+		converted = 0
+		edits := original
+		edits.description = ""
+
+		req, err := edits.request(todo, original, toRichText)
+		require.NoError(t, err)
+		assert.Zero(t, converted)
+		require.NotNil(t, req.Description)
+		assert.Equal(t, "", *req.Description)
+
+		body, err := json.Marshal(req)
+		require.NoError(t, err)
+		assert.Contains(t, string(body), `"description":""`)
+	})
+}
+
+func TestTodoEditModel_Steps(t *testing.T) {
+	todo := editableTodo()
+	m := newTodoEditModel(nil, nil, "1", todo)
+
+	key := func(model todoEditModel, k tea.KeyMsg) todoEditModel {
+		next, _ := model.Update(k)
+		return next.(todoEditModel)
+	}
+
+	m = key(m, tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Equal(t, todoEditStepDescription, m.step)
+
+	m = key(m, tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Equal(t, todoEditStepDue, m.step, "esc keeps the description")
+	assert.Equal(t, m.original.description, m.edits.description)
+
+	m.dueInput.SetValue("not a date")
+	m = key(m, tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Equal(t, todoEditStepDue, m.step)
+	assert.NotEmpty(t, m.dueErr)
+
+	m.dueInput.SetValue("2026-05-01")
+	m = key(m, tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Equal(t, todoEditStepConfirm, m.step, "without people the assignee step is skipped")
+	assert.Equal(t, "2026-05-01", m.edits.due)
+	assert.Contains(t, m.summary(), "Due: 2026-05-01")
+	assert.Contains(t, m.summary(), "Title: Ship release (unchanged)")
+}
+
+func TestToggleID(t *testing.T) {
+	ids := toggleID([]int64{1, 2}, 3)
+	assert.Equal(t, []int64{1, 2, 3}, ids)
+	ids = toggleID(ids, 1)
+	assert.Equal(t, []int64{2, 3}, ids)
+	assert.True(t, sameIDs([]int64{3, 2}, ids))
+	assert.False(t, sameIDs([]int64{2}, ids))
+}
@@ -0,0 +1,84 @@
+package todo
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/attachments"
+	"github.com/needmore/bc4/internal/download"
+)
+
+// maxTodoDirTitle caps the title part of per-todo directory names
+const maxTodoDirTitle = 50
+
+// exportTodoAttachments downloads the attachments of every todo in rows into
+// dir, optionally one subdirectory per todo. Todos without attachments are
+// skipped quietly; one summary is printed for the whole export.
+func exportTodoAttachments(ctx context.Context, uploadOps api.UploadOperations, bucketID string, rows []todoRow, dir string, subdirs bool) (*download.Result, error) {
+	var withAttachments []api.Todo
+	for _, row := range rows {
+		if len(attachments.ParseAttachments(row.todo.Description)) > 0 {
+			withAttachments = append(withAttachments, row.todo)
+		}
+	}
+	if len(withAttachments) == 0 {
+		fmt.Printf("No attachments found in %d todos\n", len(rows))
+		return &download.Result{}, nil
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create export directory: %w", err)
+	}
+
+	total := &download.Result{}
+	if subdirs {
+		for _, todo := range withAttachments {
+			todoDir := filepath.Join(dir, todoDirName(todo))
+			if err := os.MkdirAll(todoDir, 0755); err != nil {
+				return total, fmt.Errorf("failed to create directory for todo #%d: %w", todo.ID, err)
+			}
+			fmt.Printf("Todo #%d: %s\n", todo.ID, todoPlainTitle(todo))
+			result, err := download.DownloadFromSources(ctx, uploadOps, bucketID, []download.AttachmentSource{
+				{Label: todoPlainTitle(todo), Content: todo.Description},
+			}, download.Options{OutputDir: todoDir, NoSummary: true})
+			if result == nil {
+				return total, fmt.Errorf("failed to download attachments for todo #%d: %w", todo.ID, err)
+			}
+			// Partial failures are counted in the result and reported once at the end
+			total.Add(result)
+		}
+	} else {
+		// One run across all todos so clashing filenames are numbered
+		sources := make([]download.AttachmentSource, len(withAttachments))
+		for i, todo := range withAttachments {
+			sources[i] = download.AttachmentSource{Label: todoPlainTitle(todo), Content: todo.Description}
+		}
+		result, err := download.DownloadFromSources(ctx, uploadOps, bucketID, sources, download.Options{OutputDir: dir, NoSummary: true})
+		if result == nil {
+			return total, fmt.Errorf("failed to download attachments: %w", err)
+		}
+		// Partial failures are counted in the result and reported once at the end
+		total.Add(result)
+	}
+
+	download.PrintSummary(total)
+	fmt.Printf("Exported from %d of %d todos to %s\n", len(withAttachments), len(rows), dir)
+	if total.Failed > 0 {
+		return total, fmt.Errorf("some attachments failed to download")
+	}
+	return total, nil
+}
+
+// todoDirName returns a filesystem-safe directory name for a todo, e.g.
+// "12345-Update homepage"
+func todoDirName(todo api.Todo) string {
+	title := strings.NewReplacer("/", "-", "\\", "-").Replace(todoPlainTitle(todo))
+	if runes := []rune(title); len(runes) > maxTodoDirTitle {
+		title = strings.TrimSpace(string(runes[:maxTodoDirTitle]))
+	}
+	return download.SanitizeFilename(fmt.Sprintf("%d-%s", todo.ID, title))
+}
@@ -0,0 +1,74 @@
+package todo
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/needmore/bc4/internal/api"
+)
+
+// fakeUploadOps serves uploads by ID and writes downloads to disk
+type fakeUploadOps struct {
+	uploads map[int64]*api.Upload
+}
+
+func (f *fakeUploadOps) GetUpload(_ context.Context, _ string, uploadID int64) (*api.Upload, error) {
+	if u, ok := f.uploads[uploadID]; ok {
+		return u, nil
+	}
+	return nil, fmt.Errorf("upload %d not found", uploadID)
+}
+
+func (f *fakeUploadOps) DownloadAttachment(_ context.Context, _ string, destPath string) error {
+	return os.WriteFile(destPath, []byte("data"), 0644)
+}
+
+func attachmentHTML(uploadID int64, filename string) string {
+	return fmt.Sprintf(`<bc-attachment sgid="s" content-type="image/png" filename="%s" url="https://3.basecamp.com/1/uploads/%d/download/%s"></bc-attachment>`,
+		filename, uploadID, filename)
+}
+
+func TestExportTodoAttachments(t *testing.T) {
+	ops := &fakeUploadOps{uploads: map[int64]*api.Upload{
+		1: {Filename: "mock.png"},
+		2: {Filename: "mock.png"},
+	}}
+	rows := []todoRow{
+		{todo: api.Todo{ID: 10, Title: "Design/review", Description: attachmentHTML(1, "mock.png")}},
+		{todo: api.Todo{ID: 11, Title: "No files", Description: "<p>plain</p>"}},
+		{todo: api.Todo{ID: 12, Title: "Build", Description: attachmentHTML(2, "mock.png")}},
+	}
+
+	t.Run("flat export numbers clashing names", func(t *testing.T) {
+		dir := t.TempDir()
+		result, err := exportTodoAttachments(context.Background(), ops, "1", rows, dir, false)
+		require.NoError(t, err)
+		assert.Equal(t, 2, result.Successful)
+		assert.FileExists(t, filepath.Join(dir, "mock.png"))
+		assert.FileExists(t, filepath.Join(dir, "mock_1.png"))
+	})
+
+	t.Run("subdirs per todo", func(t *testing.T) {
+		dir := t.TempDir()
+		result, err := exportTodoAttachments(context.Background(), ops, "1", rows, dir, true)
+		require.NoError(t, err)
+		assert.Equal(t, 2, result.Successful)
+		assert.FileExists(t, filepath.Join(dir, "10-Design-review", "mock.png"))
+		assert.FileExists(t, filepath.Join(dir, "12-Build", "mock.png"))
+		assert.NoDirExists(t, filepath.Join(dir, "11-No files"))
+	})
+
+	t.Run("nothing to export", func(t *testing.T) {
+		dir := filepath.Join(t.TempDir(), "out")
+		result, err := exportTodoAttachments(context.Background(), ops, "1", rows[1:2], dir, false)
+		require.NoError(t, err)
+		assert.Zero(t, result.Total)
+		assert.NoDirExists(t, dir)
+	})
+}
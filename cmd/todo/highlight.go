@@ -0,0 +1,73 @@
+package todo
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/ui/tableprinter"
+)
+
+// defaultHighlightColor is the --highlight-color used when none is given
+const defaultHighlightColor = "214"
+
+// highlightColorPattern matches an ANSI 256 color number or a hex color
+var highlightColorPattern = regexp.MustCompile(`^([0-9]{1,3}|#[0-9a-fA-F]{3}|#[0-9a-fA-F]{6})$`)
+
+// todoHighlighter renders todos whose title or content contains any of its
+// patterns in a bold, colored style. A nil highlighter highlights nothing.
+type todoHighlighter struct {
+	patterns []string // lower-cased
+	style    lipgloss.Style
+}
+
+// newTodoHighlighter builds a highlighter for comma-separated patterns,
+// matched case-insensitively. It returns nil when there are no patterns.
+func newTodoHighlighter(patterns []string, color string) (*todoHighlighter, error) {
+	if !highlightColorPattern.MatchString(color) {
+		return nil, fmt.Errorf("invalid --highlight-color %q: use a color number (0-255) or hex color like #ff8800", color)
+	}
+
+	var lowered []string
+	for _, p := range patterns {
+		if p = strings.ToLower(strings.TrimSpace(p)); p != "" {
+			lowered = append(lowered, p)
+		}
+	}
+	if len(lowered) == 0 {
+		return nil, nil
+	}
+
+	return &todoHighlighter{
+		patterns: lowered,
+		style:    lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(color)),
+	}, nil
+}
+
+// matches reports whether the todo's title or content contains a pattern
+func (h *todoHighlighter) matches(todo api.Todo) bool {
+	if h == nil {
+		return false
+	}
+	title := strings.ToLower(todo.Title)
+	content := strings.ToLower(todo.Content)
+	for _, p := range h.patterns {
+		if strings.Contains(title, p) || strings.Contains(content, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// addTodoField adds the todo's title to the table, highlighted when it
+// matches and the table is going to a terminal
+func (h *todoHighlighter) addTodoField(table *tableprinter.TablePrinter, title string, todo api.Todo) {
+	if table.IsTTY() && h.matches(todo) {
+		table.AddField(title, func(s string) string { return h.style.Render(s) })
+		return
+	}
+	table.AddTodoField(title, todo.Completed)
+}
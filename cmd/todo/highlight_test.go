@@ -0,0 +1,56 @@
+package todo
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/ui/tableprinter"
+)
+
+func TestTodoHighlighter(t *testing.T) {
+	t.Run("matches title or content ignoring case", func(t *testing.T) {
+		hl, err := newTodoHighlighter([]string{"Blocked", " urgent "}, defaultHighlightColor)
+		require.NoError(t, err)
+		require.NotNil(t, hl)
+
+		assert.True(t, hl.matches(api.Todo{Title: "BLOCKED on review"}))
+		assert.True(t, hl.matches(api.Todo{Title: "Fix login", Content: "Urgent: customers affected"}))
+		assert.False(t, hl.matches(api.Todo{Title: "Write docs"}))
+	})
+
+	t.Run("no patterns means no highlighter", func(t *testing.T) {
+		hl, err := newTodoHighlighter([]string{"", " "}, defaultHighlightColor)
+		require.NoError(t, err)
+		assert.Nil(t, hl)
+		assert.False(t, hl.matches(api.Todo{Title: "anything"}))
+	})
+
+	t.Run("validates the color", func(t *testing.T) {
+		for _, color := range []string{"214", "#f80", "#ff8800"} {
+			_, err := newTodoHighlighter([]string{"x"}, color)
+			assert.NoError(t, err, color)
+		}
+		for _, color := range []string{"orange", "#ff88", "1234", ""} {
+			_, err := newTodoHighlighter([]string{"x"}, color)
+			assert.Error(t, err, color)
+		}
+	})
+
+	t.Run("non-TTY output is unchanged", func(t *testing.T) {
+		hl, err := newTodoHighlighter([]string{"blocked"}, defaultHighlightColor)
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		table := tableprinter.NewWithOptions(&buf, false, 80)
+		table.AddHeader("TODO")
+		hl.addTodoField(table, "Blocked on review", api.Todo{Title: "Blocked on review"})
+		table.EndRow()
+		require.NoError(t, table.Render())
+		assert.Contains(t, buf.String(), "Blocked on review")
+		assert.NotContains(t, buf.String(), "\x1b[")
+	})
+}
@@ -1,22 +1,27 @@
 package todo
 
 import (
-	"encoding/csv"
+	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
-	"strconv"
+	"sort"
 	"strings"
 	"time"
 
+	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/glamour"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/spf13/cobra"
 
 	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/cmdutil"
 	"github.com/needmore/bc4/internal/factory"
 	"github.com/needmore/bc4/internal/ui"
 	"github.com/needmore/bc4/internal/ui/tableprinter"
+	"github.com/needmore/bc4/internal/utils"
 )
 
 func newListCmd(f *factory.Factory) *cobra.Command {
@@ -24,9 +29,41 @@ func newListCmd(f *factory.Factory) *cobra.Command {
 	var projectID string
 	var formatStr string
 	var jsonFields string
+	var jsonLines bool
 	var webView bool
+	var printIDsOnly bool
+	var clipboard bool
 	var showAll bool
 	var grouped bool
+	var columns string
+	var watch bool
+	var interval time.Duration
+	var status string
+	var assignees []string
+	var filter utils.ItemFilter
+	var dueBefore string
+	var dueAfter string
+	var sortBy string
+	var listMatch string
+	var sinceCompleted string
+	var noContent bool
+	var contentAs string
+	var exportDir string
+	var exportSubdirs bool
+	var collapseCompleted bool
+	var assigneeUnknown bool
+	var viewName string
+	var saveViewName string
+	var nested bool
+	var byAssignee bool
+	var highlightPatterns []string
+	var highlightColor string
+	var slaValue string
+	var slaOnly bool
+	var check cmdutil.ResultCheck
+	var compact bool
+	var dateFormat string
+	var relativeDates bool
 
 	cmd := &cobra.Command{
 		Use:   "list [list-id|name]",
@@ -35,9 +72,189 @@ func newListCmd(f *factory.Factory) *cobra.Command {
 
 For todo lists that are organized into groups/sections, use --grouped to display
 them with clear section headers, or leave it off to show all todos in a flat table
-with a GROUP column for easy scanning.`,
+with a GROUP column for easy scanning.
+
+Use --format csv for spreadsheet-friendly output. CSV always includes a Group
+column (empty for lists without groups) so the layout is the same for every
+list. Choose which columns to include with --columns.
+
+Use --format markdown for a checklist document to share outside Basecamp:
+the list title and completion, then - [ ] / - [x] items under their group
+headings with due dates inline. Completed todos are included with --all.
+
+Use --format jsonl (or --json-lines) to write one todo per line as
+newline-delimited JSON, without the enclosing list wrapper.
+
+Use --watch for a live, full-screen view of the list that refreshes every
+--interval (30s by default) and briefly highlights newly completed todos.
+Press q or Ctrl+C to exit. When output is not a terminal, --watch prints the
+list once.
+
+Use --status archived to review archived todos (and find archived lists by
+name), or --status all to show active and archived todos together.
+
+For triage, narrow the list with --assignee, --assigned, --unassigned,
+--has-due, and --no-due. Filters combine, and the summary counts reflect the
+filtered todos. --due-before and --due-after take a date (YYYY-MM-DD, today, a
+weekday, or an offset such as 7d or +2w from today) and keep todos due on or
+before/after it; todos without a due date are left out. --assignee takes a
+person ID, a name or part of one, or an email; add --all to include the
+person's completed todos. When nobody's todos match, a note on stderr says so
+and nothing is written to stdout.
+
+Use --assignee-unknown to find todos still assigned to people who are no
+longer on the project. Those assignees are marked "(not in project)", and
+JSON output lists them in a stale_assignees array on each todo.
+
+JSON output includes each todo's content and description as rich text HTML.
+Use --no-content to leave them out, or --content-as markdown|text to convert
+them.
+
+Use --since-completed for a "what got done" report: only todos completed
+within the window are shown, most recently completed first.
+
+With --grouped on a terminal, --collapse-completed-groups shows each group
+whose todos are all completed as a one-line summary, which keeps --all
+readable on long-running lists.
+
+Use --export-attachments <dir> to download the attachments of every todo in
+the list (after filters) into dir instead of listing them. Add --subdirs to
+put each todo's files in its own directory.
+
+Use --group-by-assignee for a workload view: todos are listed under a heading
+per assignee with their open and total counts, busiest first, regardless of
+the list's own groups. A todo with several assignees appears under each of
+them, and todos without one are listed under "Unassigned". With --format json
+the todos are nested by assignee.
+
+Use --include-parent-todo (or --nested) to show todos whose parent is another
+todo indented under that todo. Basecamp normally nests todos only in lists and
+groups, which --grouped already shows, so on most lists this changes nothing.
+
+Use --highlight to make todos stand out while still listing every todo: on a
+terminal, todos whose title or content contains any of the comma-separated
+patterns (ignoring case) are shown bold in --highlight-color, a color number
+(0-255) or hex color. Other output formats are unaffected.
+
+Use --sla for support-style triage: an AGE column shows how long each todo
+has been open, and incomplete todos older than the threshold (e.g. 12h, 3d,
+2w) have their age in red. Add --sla-only to list just those todos. With
+--format json each todo gets an age_days field.
+
+Use --compact on big lists or small terminals to show each todo on a single
+line, "○ #123 Title · Assignee · due Jun 1", trimmed to the terminal width
+instead of in a table. Completed todos are muted. When output is not a
+terminal the lines are plain text with [ ] and [x] for the status.
+
+Use --date-format to choose how due dates are shown: short ("Jan 2", with
+the year when it isn't this year), iso ("2006-01-02"), relative ("in 3 days",
+"2 days ago"), or any Go time layout. --relative-dates is the same as
+--date-format relative. Set a default with preferences.date_format in
+'bc4 config edit'. CSV, JSON, and markdown output are unaffected.
+
+For monitoring, --fail-if-any exits with status 3 when any todos are listed
+after filters, and --fail-if-empty when none are. The output is unchanged,
+so a cron job or Nagios-style check can alert on, say, overdue todos while
+still showing them.
+
+Use --clipboard to copy the list as a Markdown checklist (the same as
+--format markdown) to the system clipboard, ready to paste into chat. When no
+clipboard tool is found (pbcopy, wl-copy, xclip, xsel, or clip.exe), the
+checklist is printed instead.
+
+Use --print-ids-only to print just the IDs of the listed todos (after
+filters), one per line, for piping into other commands. Nothing is printed
+when no todos match.
+
+Use --save-view <name> to remember the list and flags you ran with, and
+--view <name> to run them again. Flags given alongside --view override the
+saved ones.`,
+		Example: `  # Export open todos as CSV
+  bc4 todo list "Sprint Tasks" --format csv
+
+  # Export selected columns, including completed todos
+  bc4 todo list "Sprint Tasks" --format csv --columns id,title,due --all
+
+  # Share the list as a markdown checklist, including completed todos
+  bc4 todo list "Sprint Tasks" --format markdown --all
+
+  # Copy the checklist to the clipboard for pasting into chat
+  bc4 todo list "Sprint Tasks" --clipboard
+
+  # Complete every unassigned todo without a due date
+  bc4 todo list "Inbox" --unassigned --no-due --print-ids-only | xargs -n1 bc4 todo check
+
+  # Keep a live view of the list open, refreshing every minute
+  bc4 todo list "Sprint Tasks" --watch --interval 1m
+
+  # Save a triage view, then replay it
+  bc4 todo list "Inbox" --all --unassigned --save-view triage
+  bc4 todo list --view triage
+
+  # Review archived todos in a list
+  bc4 todo list "Sprint Tasks" --status archived
+
+  # Open todos nobody has picked up yet
+  bc4 todo list "Sprint Tasks" --unassigned
+
+  # One line per todo for a quick scan
+  bc4 todo list "Sprint Tasks" --compact
+
+  # Show due dates as "in 3 days" or "2 days ago"
+  bc4 todo list "Sprint Tasks" --relative-dates
+
+  # Make blocked and urgent todos stand out
+  bc4 todo list "Sprint Tasks" --highlight "blocked,urgent"
+
+  # Jane's todos that have a due date
+  bc4 todo list "Sprint Tasks" --assignee jane@example.com --has-due
+
+  # What's due in the next week, soonest first
+  bc4 todo list "Sprint Tasks" --due-before 7d --sort due
+
+  # Support todos that have been open more than 3 days
+  bc4 todo list "Support" --sla 3d --sla-only
+
+  # Alert from cron when anything in the list is overdue
+  bc4 todo list "Support" --overdue --fail-if-any
+
+  # Who has the most open todos in the list
+  bc4 todo list "Sprint Tasks" --group-by-assignee
+
+  # What got done this week
+  bc4 todo list "Sprint Tasks" --since-completed 7d
+
+  # Download every attachment in the list, one directory per todo
+  bc4 todo list "Sprint Tasks" --all --export-attachments ./files --subdirs`,
 		Args: cobra.MaximumNArgs(1),
-		RunE: func(cmd *cobra.Command, args []string) error {
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			// --fail-if-any and --fail-if-empty only change the exit status,
+			// once the list has been output
+			matched := -1
+			defer func() {
+				if err == nil && matched >= 0 {
+					err = check.Evaluate(matched, "todos")
+				}
+			}()
+
+			// Replay a saved view, then save the resulting flag set if asked
+			if viewName != "" || saveViewName != "" {
+				cfg, err := f.Config()
+				if err != nil {
+					return err
+				}
+				if viewName != "" {
+					if args, err = applyView(cmd, cfg, viewName, args); err != nil {
+						return err
+					}
+				}
+				if saveViewName != "" {
+					if err := saveView(cmd, saveViewName, args); err != nil {
+						return err
+					}
+				}
+			}
+
 			// Apply account override if specified
 			if accountID != "" {
 				f = f.WithAccount(accountID)
@@ -48,6 +265,155 @@ with a GROUP column for easy scanning.`,
 				f = f.WithProject(projectID)
 			}
 
+			// Parse output format; markdown is specific to this command
+			markdownOutput := isMarkdownFormat(formatStr)
+			if markdownOutput {
+				formatStr = string(ui.OutputFormatTable)
+			}
+			format, err := ui.ParseOutputFormat(formatStr)
+			if err != nil {
+				return err
+			}
+			if jsonLines {
+				format = ui.OutputFormatJSONL
+			}
+
+			// The clipboard gets the Markdown checklist, ready to paste
+			if clipboard {
+				if format != ui.OutputFormatTable || jsonFields != "" || webView {
+					return fmt.Errorf("--clipboard copies the list as Markdown and cannot be used with --web or another output format")
+				}
+				markdownOutput = true
+			}
+
+			if status != api.StatusActive && status != api.StatusArchived && status != todoStatusAll {
+				return fmt.Errorf("invalid --status %q: must be active, archived, or all", status)
+			}
+
+			if dueBefore != "" {
+				if filter.DueBefore, err = parseDueBound(dueBefore); err != nil {
+					return fmt.Errorf("invalid --due-before: %w", err)
+				}
+			}
+			if dueAfter != "" {
+				if filter.DueAfter, err = parseDueBound(dueAfter); err != nil {
+					return fmt.Errorf("invalid --due-after: %w", err)
+				}
+			}
+			if err := filter.Validate(); err != nil {
+				return err
+			}
+			if err := validateTodoSort(sortBy); err != nil {
+				return err
+			}
+			if sortBy != "" && (watch || sinceCompleted != "") {
+				return fmt.Errorf("--sort cannot be used with --watch or --since-completed")
+			}
+			if err := check.Validate(); err != nil {
+				return err
+			}
+			if check.Enabled() && (watch || webView) {
+				return fmt.Errorf("--fail-if-any and --fail-if-empty cannot be used with --watch or --web")
+			}
+			policy, err := parseMatchPolicy(listMatch)
+			if err != nil {
+				return err
+			}
+			if filter.Unassigned && len(assignees) > 0 {
+				return fmt.Errorf("--unassigned and --assignee cannot be used together")
+			}
+			if assigneeUnknown && filter.Unassigned {
+				return fmt.Errorf("--assignee-unknown and --unassigned cannot be used together")
+			}
+			if assigneeUnknown && watch {
+				return fmt.Errorf("--assignee-unknown cannot be used with --watch")
+			}
+
+			contentOpts, err := parseTodoContentOptions(noContent, contentAs)
+			if err != nil {
+				return err
+			}
+			if contentOpts.fields, err = ui.ParseJSONFields(jsonFields, todoJSONFieldNames()); err != nil {
+				return fmt.Errorf("invalid --json: %w", err)
+			}
+
+			var completedAfter time.Time
+			if sinceCompleted != "" {
+				if watch {
+					return fmt.Errorf("--since-completed cannot be used with --watch")
+				}
+				if completedAfter, err = parseCompletedSince(sinceCompleted, time.Now()); err != nil {
+					return err
+				}
+				// Completed todos are only fetched with --all
+				showAll = true
+			}
+
+			if exportSubdirs && exportDir == "" {
+				return fmt.Errorf("--subdirs requires --export-attachments")
+			}
+			if exportDir != "" && (watch || format != ui.OutputFormatTable || markdownOutput || jsonFields != "") {
+				return fmt.Errorf("--export-attachments cannot be combined with --watch or another output format")
+			}
+
+			if nested && (watch || format != ui.OutputFormatTable || markdownOutput || jsonFields != "") {
+				return fmt.Errorf("--include-parent-todo can only be used with table output")
+			}
+
+			if printIDsOnly && (watch || webView || byAssignee || exportDir != "" || format != ui.OutputFormatTable || markdownOutput || jsonFields != "") {
+				return fmt.Errorf("--print-ids-only cannot be combined with --watch, --web, --group-by-assignee, --export-attachments, or another output format")
+			}
+
+			if byAssignee {
+				if watch || grouped || markdownOutput || (format != ui.OutputFormatTable && format != ui.OutputFormatJSON) {
+					return fmt.Errorf("--group-by-assignee can only be used with table or json output, without --grouped or --watch")
+				}
+				if exportDir != "" {
+					return fmt.Errorf("--group-by-assignee cannot be used with --export-attachments")
+				}
+			}
+
+			if watch {
+				if status != api.StatusActive {
+					return fmt.Errorf("--watch can only be used with --status active")
+				}
+				if format != ui.OutputFormatTable || markdownOutput {
+					return fmt.Errorf("--watch can only be used with table output")
+				}
+				if interval < time.Second {
+					return fmt.Errorf("--interval must be at least 1s")
+				}
+			}
+
+			if compact && (watch || grouped || byAssignee || nested || slaValue != "" || format != ui.OutputFormatTable || markdownOutput || jsonFields != "") {
+				return fmt.Errorf("--compact can only be used with table output, without --watch, --grouped, --group-by-assignee, --include-parent-todo, or --sla")
+			}
+
+			var sla *todoSLA
+			if slaValue != "" {
+				if watch || grouped || byAssignee {
+					return fmt.Errorf("--sla cannot be used with --watch, --grouped, or --group-by-assignee")
+				}
+				if sla, err = newTodoSLA(slaValue, time.Now()); err != nil {
+					return err
+				}
+				contentOpts.sla = sla
+			} else if slaOnly {
+				return fmt.Errorf("--sla-only requires --sla")
+			}
+
+			highlighter, err := newTodoHighlighter(highlightPatterns, highlightColor)
+			if err != nil {
+				return err
+			}
+
+			var csvColumns []string
+			if format == ui.OutputFormatCSV {
+				if csvColumns, err = parseCSVColumns(columns); err != nil {
+					return err
+				}
+			}
+
 			// Get API client from factory
 			client, err := f.ApiClient()
 			if err != nil {
@@ -61,6 +427,11 @@ with a GROUP column for easy scanning.`,
 				return err
 			}
 
+			dates, err := newTodoDates(dateFormat, relativeDates, cfg.Preferences.DateFormat)
+			if err != nil {
+				return err
+			}
+
 			// Get resolved account ID
 			resolvedAccountID, err := f.AccountID()
 			if err != nil {
@@ -73,53 +444,23 @@ with a GROUP column for easy scanning.`,
 				return err
 			}
 
-			// Get todo set for the project
-			todoSet, err := todoOps.GetProjectTodoSet(f.Context(), resolvedProjectID)
-			if err != nil {
-				return fmt.Errorf("failed to get project todo set: %w", err)
-			}
+			// Project people are fetched at most once per run and shared by
+			// everything below that needs them
+			people := utils.NewUserResolver(client.Client, resolvedProjectID)
 
-			// Determine which todo list to view
-			var todoListID int64
-			if len(args) == 0 {
-				// No argument - use default todo list if set
-				defaultTodoListID := ""
-				if cfg.Accounts != nil && cfg.Accounts[resolvedAccountID].ProjectDefaults != nil {
-					if projDefaults, ok := cfg.Accounts[resolvedAccountID].ProjectDefaults[resolvedProjectID]; ok {
-						defaultTodoListID = projDefaults.DefaultTodoList
-					}
-				}
-				if defaultTodoListID == "" {
-					return fmt.Errorf("no todo list specified and no default set. Use 'todo select' to set a default")
+			// Resolve --assignee people before fetching
+			if len(assignees) > 0 {
+				if filter.AssigneeIDs, err = utils.ResolvePersonIDs(f.Context(), people, assignees); err != nil {
+					return fmt.Errorf("failed to resolve assignee: %w", err)
 				}
-				todoListID, _ = strconv.ParseInt(defaultTodoListID, 10, 64)
-			} else {
-				// Try to parse as ID first
-				if id, err := strconv.ParseInt(args[0], 10, 64); err == nil {
-					todoListID = id
-				} else {
-					// Try to find by name
-					todoLists, err := todoOps.GetTodoLists(f.Context(), resolvedProjectID, todoSet.ID)
-					if err != nil {
-						return fmt.Errorf("failed to fetch todo lists: %w", err)
-					}
-
-					searchTerm := strings.ToLower(args[0])
-					var matches []api.TodoList
-					for _, list := range todoLists {
-						if strings.Contains(strings.ToLower(list.Title), searchTerm) {
-							matches = append(matches, list)
-						}
-					}
-
-					if len(matches) == 0 {
-						return fmt.Errorf("no todo list found matching '%s'", args[0])
-					} else if len(matches) > 1 {
-						return fmt.Errorf("multiple todo lists match '%s'. Please be more specific", args[0])
-					}
+			}
 
-					todoListID = matches[0].ID
-				}
+			// Determine which todo list to view
+			todoListID, err := resolveListOrDefault(f.Context(), todoOps, cfg, resolvedAccountID, resolvedProjectID, args, policy, func(todoSetID int64) ([]api.TodoList, error) {
+				return fetchTodoListsByStatus(f.Context(), todoOps, resolvedProjectID, todoSetID, status)
+			})
+			if err != nil {
+				return err
 			}
 
 			// Get the todo list
@@ -137,81 +478,411 @@ with a GROUP column for easy scanning.`,
 				return nil
 			}
 
-			// Get todos in the list
-			var todos []api.Todo
-			if showAll {
-				todos, err = todoOps.GetAllTodos(f.Context(), resolvedProjectID, todoListID)
-			} else {
-				todos, err = todoOps.GetTodos(f.Context(), resolvedProjectID, todoListID)
+			// Live view - falls through to a one-shot render when not a TTY
+			if watch && ui.IsTerminal(os.Stdout) {
+				model := newTodoWatchModel(f.Context(), todoOps, resolvedProjectID, todoList, showAll, interval)
+				model.filter = filter
+				model.dates = dates
+				if _, err := tea.NewProgram(model, tea.WithAltScreen()).Run(); err != nil {
+					return fmt.Errorf("error running watch view: %w", err)
+				}
+				return nil
 			}
-			if err != nil {
-				return fmt.Errorf("failed to fetch todos: %w", err)
+
+			// Get todos in the list, falling back to groups when there are no direct todos
+			todos, groups, groupedTodos, err := fetchListTodos(f.Context(), todoOps, resolvedProjectID, todoList, showAll, status)
+			var partialErr *api.PartialError
+			if errors.As(err, &partialErr) {
+				// Show the open todos rather than failing outright
+				fmt.Fprintf(os.Stderr, "Warning: %v\n", partialErr)
+			} else if err != nil {
+				return err
+			}
+
+			// Apply filters before output so counts match what's shown
+			if !filter.IsZero() {
+				todos = filterTodos(todos, filter)
+				for groupID, groupTodos := range groupedTodos {
+					groupedTodos[groupID] = filterTodos(groupTodos, filter)
+				}
+			}
+
+			// Keep only todos open past the --sla threshold
+			if slaOnly {
+				todos = filterSLABreached(todos, sla)
+				for groupID, groupTodos := range groupedTodos {
+					groupedTodos[groupID] = filterSLABreached(groupTodos, sla)
+				}
 			}
 
-			// Check if this todo list has groups instead of direct todos
-			var groups []api.TodoGroup
-			var groupedTodos map[string][]api.Todo
+			// Keep todos assigned to someone who is no longer on the project
+			if assigneeUnknown {
+				index, err := people.Index(f.Context())
+				if err != nil {
+					return err
+				}
+				current := index.IDs()
+				todos = filterStaleAssigned(todos, current)
+				for groupID, groupTodos := range groupedTodos {
+					groupedTodos[groupID] = filterStaleAssigned(groupTodos, current)
+				}
+				contentOpts.projectPeople = current
+
+				// JSON lists them in stale_assignees; everywhere else the
+				// names are marked
+				if format != ui.OutputFormatJSON && format != ui.OutputFormatJSONL && jsonFields == "" {
+					todos = markStaleAssignees(todos, current)
+					for groupID, groupTodos := range groupedTodos {
+						groupedTodos[groupID] = markStaleAssignees(groupTodos, current)
+					}
+				}
+			}
 
-			if len(todos) == 0 && todoList.GroupsURL != "" {
-				// Try fetching groups
-				groups, err = todoOps.GetTodoGroups(f.Context(), resolvedProjectID, todoListID)
-				if err == nil && len(groups) > 0 {
-					// Fetch todos for each group
-					groupedTodos = make(map[string][]api.Todo)
+			// Completion reports are a single list ordered by completion time
+			if !completedAfter.IsZero() {
+				for _, group := range groups {
+					todos = append(todos, groupedTodos[fmt.Sprintf("%d", group.ID)]...)
+				}
+				todos = completedSince(todos, completedAfter)
+				groups, groupedTodos = nil, nil
+			}
+
+			// --sort due orders within each group with --grouped, and across
+			// the whole list otherwise. Sorting across the list takes todos out
+			// of their groups, so todoGroups remembers each one's group title.
+			var todoGroups map[int64]string
+			if sortBy == todoSortDue {
+				if grouped && len(groups) > 0 {
+					for groupID, groupTodos := range groupedTodos {
+						sortTodosByDue(groupTodos)
+						groupedTodos[groupID] = groupTodos
+					}
+				} else if len(groups) > 0 {
+					todoGroups = make(map[int64]string)
 					for _, group := range groups {
-						var groupTodos []api.Todo
-						if showAll {
-							groupTodos, err = todoOps.GetAllTodos(f.Context(), resolvedProjectID, group.ID)
-						} else {
-							groupTodos, err = todoOps.GetTodos(f.Context(), resolvedProjectID, group.ID)
-						}
-						if err == nil {
-							groupedTodos[fmt.Sprintf("%d", group.ID)] = groupTodos
+						groupTodos := groupedTodos[fmt.Sprintf("%d", group.ID)]
+						for _, todo := range groupTodos {
+							todoGroups[todo.ID] = group.Title
 						}
+						todos = append(todos, groupTodos...)
 					}
+					sortTodosByDue(todos)
+					groups, groupedTodos = nil, nil
+				} else {
+					sortTodosByDue(todos)
 				}
 			}
 
-			// Parse output format
-			format, err := ui.ParseOutputFormat(formatStr)
-			if err != nil {
+			if check.Enabled() {
+				matched = countListedTodos(todos, groups, groupedTodos, showAll)
+			}
+
+			// Bulk attachment export replaces the listing
+			if exportDir != "" {
+				if len(groups) == 0 {
+					groupedTodos = map[string][]api.Todo{"": todos}
+				}
+				rows := collectTodoRows(groups, groupedTodos, showAll)
+				_, err := exportTodoAttachments(f.Context(), client.Uploads(), resolvedProjectID, rows, exportDir, exportSubdirs)
 				return err
 			}
 
+			// Bare IDs, one per line, for piping into other commands
+			if printIDsOnly {
+				if len(groups) == 0 {
+					groupedTodos = map[string][]api.Todo{"": todos}
+				}
+				return ui.WriteIDs(os.Stdout, todoRowIDs(collectTodoRows(groups, groupedTodos, showAll)))
+			}
+
+			// Re-bucket every todo by assignee, ignoring the list's own groups
+			if byAssignee {
+				for _, group := range groups {
+					todos = append(todos, groupedTodos[fmt.Sprintf("%d", group.ID)]...)
+				}
+				buckets := bucketTodosByAssignee(todos)
+				if format == ui.OutputFormatJSON || jsonFields != "" {
+					return outputTodosByAssigneeJSON(todoList, buckets, contentOpts)
+				}
+				return displayTodosByAssignee(todoList, buckets, showAll, highlighter, dates)
+			}
+
+			// Handle JSON Lines output - one todo per line, in display order
+			if format == ui.OutputFormatJSONL {
+				if len(groups) == 0 {
+					groupedTodos = map[string][]api.Todo{"": todos}
+				}
+				rows := collectTodoRows(groups, groupedTodos, showAll)
+				lines := make([]api.Todo, 0, len(rows))
+				for _, row := range rows {
+					lines = append(lines, row.todo)
+				}
+				shaped, err := shapeTodosJSON(lines, contentOpts)
+				if err != nil {
+					return err
+				}
+				return ui.WriteJSONLines(os.Stdout, shaped, nil)
+			}
+
 			// Handle JSON output
 			if format == ui.OutputFormatJSON || jsonFields != "" {
 				if len(groups) > 0 {
-					return outputTodoListWithGroupsJSON(todoList, groups, groupedTodos, jsonFields)
+					return outputTodoListWithGroupsJSON(todoList, groups, groupedTodos, contentOpts)
+				}
+				return outputTodoListJSON(todoList, todos, contentOpts)
+			}
+
+			// Handle CSV output - same layout with or without groups
+			if format == ui.OutputFormatCSV {
+				if len(groups) == 0 {
+					groupedTodos = map[string][]api.Todo{"": todos}
+				}
+				rows := labelTodoGroups(collectTodoRows(groups, groupedTodos, showAll), todoGroups)
+				return writeTodosCSV(os.Stdout, rows, csvColumns)
+			}
+
+			// Handle markdown output - a checklist for sharing, paged on a terminal
+			if markdownOutput {
+				if len(groups) == 0 {
+					groupedTodos = map[string][]api.Todo{"": todos}
+				}
+				var buf bytes.Buffer
+				if err := writeTodosMarkdown(&buf, todoList, groups, groupedTodos, showAll, time.Now()); err != nil {
+					return err
+				}
+				if clipboard {
+					return copyTodosToClipboard(os.Stdout, os.Stderr, buf.String(), todoList.Title, ui.CopyToClipboard)
+				}
+				return utils.ShowInPager(buf.String(), &utils.PagerOptions{Pager: cfg.Preferences.Pager})
+			}
+
+			// Say plainly when the person has nothing here; on stderr, so
+			// scripts reading the table get no rows rather than a message
+			if len(assignees) > 0 && countListedTodos(todos, groups, groupedTodos, showAll) == 0 {
+				fmt.Fprintln(os.Stderr, noAssignedTodosMessage(todoList.Title, assignees, showAll))
+				return nil
+			}
+
+			// One line per todo instead of a table
+			if compact {
+				if len(groups) == 0 {
+					groupedTodos = map[string][]api.Todo{"": todos}
+				}
+				rows := labelTodoGroups(collectTodoRows(groups, groupedTodos, showAll), todoGroups)
+				return writeCompactTodos(os.Stdout, rows, ui.IsTerminal(os.Stdout), ui.GetTerminalWidth(), highlighter, dates)
+			}
+
+			// Show sub-todos indented under their parent todo
+			if nested {
+				todos = nestTodos(todos)
+				for groupID, groupTodos := range groupedTodos {
+					groupedTodos[groupID] = nestTodos(groupTodos)
 				}
-				return outputTodoListJSON(todoList, todos, jsonFields)
 			}
 
 			// Display todo list in terminal - GitHub CLI style
 			if len(groups) > 0 {
 				if grouped {
 					// Show groups separately with headers between them
-					return displayTodoListWithGroups(todoList, groups, groupedTodos, ui.OutputFormatTable, showAll)
+					return displayTodoListWithGroups(todoList, groups, groupedTodos, showAll, collapseCompleted, highlighter, dates)
 				} else {
 					// Show all todos in single table with GROUP column
-					return displayTodoListGitHubStyle(todoList, groups, groupedTodos, showAll)
+					return displayTodoListGitHubStyle(todoList, groups, groupedTodos, nil, showAll, highlighter, sla, dates)
 				}
 			}
-			return displayTodoListGitHubStyle(todoList, nil, map[string][]api.Todo{"": todos}, showAll)
+			return displayTodoListGitHubStyle(todoList, nil, map[string][]api.Todo{"": todos}, todoGroups, showAll, highlighter, sla, dates)
 		},
 	}
 
 	cmd.Flags().StringVarP(&accountID, "account", "a", "", "Specify account ID (overrides default)")
 	cmd.Flags().StringVarP(&projectID, "project", "p", "", "Specify project ID (overrides default)")
-	cmd.Flags().StringVarP(&formatStr, "format", "f", "table", "Output format: table, json, or csv")
-	cmd.Flags().StringVar(&jsonFields, "json", "", "Output JSON with specified fields")
+	cmd.Flags().StringVarP(&formatStr, "format", "f", "table", "Output format: table, json, jsonl, csv, or markdown")
+	cmd.Flags().StringVar(&jsonFields, "json", "", "Output JSON with only these todo fields (comma-separated, e.g. id,title,due_on)")
+	cmd.Flags().BoolVar(&jsonLines, "json-lines", false, "Output one todo per line as JSON (same as --format jsonl)")
 	cmd.Flags().BoolVarP(&webView, "web", "w", false, "Open in web browser")
+	cmd.Flags().BoolVar(&printIDsOnly, "print-ids-only", false, "Print only the IDs of the listed todos, one per line")
+	cmd.Flags().BoolVar(&clipboard, "clipboard", false, "Copy the list to the clipboard as Markdown instead of printing it")
+	cmd.Flags().BoolVar(&clipboard, "output-clipboard", false, "Same as --clipboard")
 	cmd.Flags().BoolVarP(&showAll, "all", "A", false, "Show all todos including completed ones")
 	cmd.Flags().BoolVar(&grouped, "grouped", false, "Show todo groups/sections separately with headers (for organized todo lists)")
+	cmd.Flags().StringVar(&viewName, "view", "", "Apply the flags and list saved as this view")
+	cmd.Flags().StringVar(&saveViewName, "save-view", "", "Save this command's list and flags as a named view")
+	cmd.Flags().BoolVar(&collapseCompleted, "collapse-completed-groups", false, "With --grouped, show fully completed groups as a single summary line")
+	cmd.Flags().BoolVar(&byAssignee, "group-by-assignee", false, "Show todos under a heading per assignee, busiest first")
+	cmd.Flags().BoolVar(&nested, "include-parent-todo", false, "Show sub-todos indented under their parent todo")
+	cmd.Flags().BoolVar(&nested, "nested", false, "Same as --include-parent-todo")
+	cmd.Flags().StringSliceVar(&highlightPatterns, "highlight", nil, "Emphasize todos whose title or content contains any of these patterns (comma-separated)")
+	cmd.Flags().StringVar(&highlightColor, "highlight-color", defaultHighlightColor, "Color for --highlight: a color number (0-255) or hex color")
+	cmd.Flags().StringVar(&slaValue, "sla", "", "Show each todo's age and flag open todos older than this (e.g. 12h, 3d, 2w)")
+	cmd.Flags().BoolVar(&compact, "compact", false, "Show each todo on a single line instead of in a table")
+	cmd.Flags().BoolVar(&slaOnly, "sla-only", false, "With --sla, only show todos open past the threshold")
+	cmd.Flags().StringVar(&dateFormat, "date-format", "", "How to show due dates: short, iso, relative, or a Go time layout")
+	cmd.Flags().BoolVar(&relativeDates, "relative-dates", false, "Show due dates relative to today (same as --date-format relative)")
+	check.AddFlags(cmd, "todos")
+	cmd.Flags().BoolVar(&watch, "watch", false, "Keep the list open and refresh it automatically")
+	cmdutil.IntervalVarP(cmd.Flags(), &interval, "interval", "", 30*time.Second, "Refresh interval for --watch (e.g., 15s, 1m, or 30 for seconds)")
+	cmd.Flags().StringSliceVar(&assignees, "assignee", nil, "Only show todos assigned to this person (ID, name, or email)")
+	cmd.Flags().BoolVar(&filter.Assigned, "assigned", false, "Only show todos with at least one assignee")
+	cmd.Flags().BoolVar(&filter.Unassigned, "unassigned", false, "Only show todos with no assignees")
+	cmd.Flags().BoolVar(&assigneeUnknown, "assignee-unknown", false, "Only show todos assigned to someone no longer on the project")
+	cmd.Flags().BoolVar(&filter.Overdue, "overdue", false, "Only show todos past their due date")
+	cmd.Flags().StringVar(&dueBefore, "due-before", "", "Only show todos due on or before this date (YYYY-MM-DD, today, 7d, ...)")
+	cmd.Flags().StringVar(&dueAfter, "due-after", "", "Only show todos due on or after this date (YYYY-MM-DD, today, 7d, ...)")
+	cmd.Flags().StringVar(&sortBy, "sort", "", "Sort todos by: due (earliest first, undated last)")
+	cmd.Flags().BoolVar(&filter.HasDue, "has-due", false, "Only show todos with a due date")
+	cmd.Flags().BoolVar(&filter.NoDue, "no-due", false, "Only show todos without a due date")
+	cmd.Flags().StringVar(&status, "status", api.StatusActive, "Which todos to show: active, archived, or all")
+	addTodoContentFlags(cmd, &noContent, &contentAs)
+	cmd.Flags().StringVar(&sinceCompleted, "since-completed", "", "Only show todos completed within this window (e.g. 24h, 7d, 2w, or YYYY-MM-DD)")
+	addListMatchFlag(cmd, &listMatch)
+	cmd.Flags().StringVar(&exportDir, "export-attachments", "", "Download the attachments of every listed todo into this directory")
+	cmd.Flags().BoolVar(&exportSubdirs, "subdirs", false, "With --export-attachments, put each todo's files in its own directory")
+	cmd.Flags().StringVar(&columns, "columns", "", "Columns for CSV output (comma-separated: id, group, status, title, assignee, due, starts, created, updated)")
 
 	return cmd
 }
 
-func outputTodoListJSON(todoList *api.TodoList, todos []api.Todo, _ string) error {
+// todoStatusAll selects both active and archived todos for --status
+const todoStatusAll = "all"
+
+// fetchTodoListsByStatus fetches the todo lists with the given --status value
+func fetchTodoListsByStatus(ctx context.Context, todoOps api.TodoOperations, projectID string, todoSetID int64, status string) ([]api.TodoList, error) {
+	if status != todoStatusAll {
+		return todoOps.GetTodoListsWithStatus(ctx, projectID, todoSetID, status)
+	}
+
+	lists, err := todoOps.GetTodoLists(ctx, projectID, todoSetID)
+	if err != nil {
+		return nil, err
+	}
+	archived, err := todoOps.GetTodoListsWithStatus(ctx, projectID, todoSetID, api.StatusArchived)
+	if err != nil {
+		return nil, err
+	}
+	return append(lists, archived...), nil
+}
+
+// todoFetcher returns the function used to fetch the todos in a list for the
+// given --all and --status values
+func todoFetcher(todoOps api.TodoOperations, showAll bool, status string) func(context.Context, string, int64) ([]api.Todo, error) {
+	active := todoOps.GetTodos
+	if showAll {
+		active = todoOps.GetAllTodos
+	}
+	archived := func(ctx context.Context, projectID string, todoListID int64) ([]api.Todo, error) {
+		return todoOps.GetTodosWithStatus(ctx, projectID, todoListID, api.StatusArchived)
+	}
+
+	switch status {
+	case api.StatusArchived:
+		return archived
+	case todoStatusAll:
+		return func(ctx context.Context, projectID string, todoListID int64) ([]api.Todo, error) {
+			todos, err := active(ctx, projectID, todoListID)
+			var partialErr *api.PartialError
+			if err != nil && !errors.As(err, &partialErr) {
+				return nil, err
+			}
+			archivedTodos, archivedErr := archived(ctx, projectID, todoListID)
+			if archivedErr != nil {
+				return nil, archivedErr
+			}
+			return append(todos, archivedTodos...), err
+		}
+	default:
+		return active
+	}
+}
+
+// fetchListTodos fetches the todos in a todo list. Lists organized into groups
+// have no direct todos, so in that case the groups and their todos (keyed by
+// group ID) are returned instead. If completed todos could not be loaded, the
+// open todos are still returned along with an *api.PartialError.
+func fetchListTodos(ctx context.Context, todoOps api.TodoOperations, projectID string, todoList *api.TodoList, showAll bool, status string) ([]api.Todo, []api.TodoGroup, map[string][]api.Todo, error) {
+	fetch := todoFetcher(todoOps, showAll, status)
+
+	var partialErr *api.PartialError
+
+	todos, err := fetch(ctx, projectID, todoList.ID)
+	if err != nil && !errors.As(err, &partialErr) {
+		return nil, nil, nil, fmt.Errorf("failed to fetch todos: %w", err)
+	}
+
+	if len(todos) > 0 || todoList.GroupsURL == "" {
+		return todos, nil, nil, partialResult(partialErr)
+	}
+
+	// Try fetching groups
+	groups, err := todoOps.GetTodoGroups(ctx, projectID, todoList.ID)
+	if err != nil || len(groups) == 0 {
+		return todos, nil, nil, partialResult(partialErr)
+	}
+
+	// Fetch todos for each group
+	groupedTodos := make(map[string][]api.Todo)
+	for _, group := range groups {
+		groupTodos, err := fetch(ctx, projectID, group.ID)
+		var groupPartialErr *api.PartialError
+		if err == nil || errors.As(err, &groupPartialErr) {
+			groupedTodos[fmt.Sprintf("%d", group.ID)] = groupTodos
+		}
+		if groupPartialErr != nil && partialErr == nil {
+			partialErr = groupPartialErr
+		}
+	}
+
+	return todos, groups, groupedTodos, partialResult(partialErr)
+}
+
+// todoSortDue orders todos by due date for --sort
+const todoSortDue = "due"
+
+// validateTodoSort checks a --sort value
+func validateTodoSort(sortBy string) error {
+	if sortBy == "" || sortBy == todoSortDue {
+		return nil
+	}
+	return fmt.Errorf("invalid --sort %q: must be due", sortBy)
+}
+
+// sortTodosByDue sorts todos earliest due first, with undated todos last
+func sortTodosByDue(todos []api.Todo) {
+	utils.SortItemsByDue(todos, func(todo api.Todo) *string { return todo.DueOn })
+}
+
+// parseDueBound parses a --due-before or --due-after date. Besides what
+// utils.ParseDate accepts, a bare offset such as 7d counts from today, the
+// same as +7d.
+func parseDueBound(value string) (string, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if span, ok := utils.ParseSpan(value); ok && span.Unit != 'h' {
+		value = "+" + value
+	}
+	return utils.ParseDate(value)
+}
+
+// filterTodos returns the todos matching filter
+func filterTodos(todos []api.Todo, filter utils.ItemFilter) []api.Todo {
+	return utils.FilterItems(todos, func(todo api.Todo) bool {
+		return filter.Matches(todo.Assignees, todo.DueOn)
+	})
+}
+
+// partialResult converts a possibly nil *api.PartialError to an error,
+// avoiding a non-nil error interface holding a nil pointer.
+func partialResult(err *api.PartialError) error {
+	if err == nil {
+		return nil
+	}
+	return err
+}
+
+func outputTodoListJSON(todoList *api.TodoList, todos []api.Todo, contentOpts todoContentOptions) error {
+	shaped, err := shapeTodosJSON(todos, contentOpts)
+	if err != nil {
+		return err
+	}
+
 	// Combine todo list and todos data
 	data := map[string]interface{}{
 		"id":          todoList.ID,
@@ -220,12 +891,9 @@ func outputTodoListJSON(todoList *api.TodoList, todos []api.Todo, _ string) erro
 		"created_at":  todoList.CreatedAt,
 		"updated_at":  todoList.UpdatedAt,
 		"completed":   fmt.Sprintf("%d/%d", countCompleted(todos), len(todos)),
-		"todos":       todos,
+		"todos":       shaped,
 	}
 
-	// TODO: If specific fields requested, filter the output
-	// Currently, all fields are returned regardless of the fields parameter
-
 	encoder := json.NewEncoder(os.Stdout)
 	encoder.SetIndent("", "  ")
 	return encoder.Encode(data)
@@ -241,7 +909,7 @@ func countCompleted(todos []api.Todo) int {
 	return count
 }
 
-func displayTodoListWithGroups(todoList *api.TodoList, groups []api.TodoGroup, groupedTodos map[string][]api.Todo, format ui.OutputFormat, showAll bool) error {
+func displayTodoListWithGroups(todoList *api.TodoList, groups []api.TodoGroup, groupedTodos map[string][]api.Todo, showAll, collapseCompleted bool, hl *todoHighlighter, dates todoDates) error {
 	// First, count total todos before any filtering
 	totalTodos := 0
 	completedTodos := 0
@@ -270,9 +938,9 @@ func displayTodoListWithGroups(todoList *api.TodoList, groups []api.TodoGroup, g
 	}
 
 	// Terminal display with nice formatting
-	if !ui.IsTerminal(os.Stdout) || format == ui.OutputFormatCSV {
-		// Non-TTY or CSV format - simple output
-		return displayTodoListWithGroupsSimple(todoList, groups, groupedTodos, format)
+	if !ui.IsTerminal(os.Stdout) {
+		// Non-TTY - simple output
+		return displayTodoListWithGroupsSimple(todoList, groups, groupedTodos)
 	}
 
 	// Pretty terminal display
@@ -309,7 +977,7 @@ func displayTodoListWithGroups(todoList *api.TodoList, groups []api.TodoGroup, g
 
 	if todoList.CreatedAt != "" {
 		if createdTime, err := time.Parse(time.RFC3339, todoList.CreatedAt); err == nil {
-			meta += fmt.Sprintf(" • Created %s", createdTime.Format("Jan 2, 2006"))
+			meta += fmt.Sprintf(" • Created %s", dates.date(createdTime.Local()))
 		}
 	}
 	fmt.Println(metaStyle.Render(meta))
@@ -342,6 +1010,14 @@ func displayTodoListWithGroups(todoList *api.TodoList, groups []api.TodoGroup, g
 			fmt.Println()
 		}
 
+		// Fully completed groups shrink to a single summary line
+		if collapseCompleted {
+			if ratio, done := groupCompletion(group, groupedTodos[fmt.Sprintf("%d", group.ID)]); done {
+				fmt.Println(groupTitleStyle.Render(group.Title) + metaStyle.Render(" — "+ratio+" completed (collapsed)"))
+				continue
+			}
+		}
+
 		// Display group title with completion ratio
 		groupTitle := group.Title
 		if group.CompletedRatio != "" {
@@ -351,81 +1027,210 @@ func displayTodoListWithGroups(todoList *api.TodoList, groups []api.TodoGroup, g
 
 		// Get todos for this group
 		if todos, ok := groupedTodos[fmt.Sprintf("%d", group.ID)]; ok && len(todos) > 0 {
-			// Create GitHub CLI-style table for this group
-			table := tableprinter.New(os.Stdout)
+			_ = renderTodoSectionTable(todos, hl, dates)
+		} else {
+			fmt.Println(metaStyle.Render("  No todos in this group"))
+		}
+	}
+
+	return nil
+}
+
+// renderTodoSectionTable writes one section's todos as a table, used under
+// the group and assignee headers
+func renderTodoSectionTable(todos []api.Todo, hl *todoHighlighter, dates todoDates) error {
+	table := tableprinter.New(os.Stdout)
+
+	// Add headers dynamically based on TTY mode
+	if table.IsTTY() {
+		table.AddHeader("", "TODO", "ASSIGNEE", "DUE")
+	} else {
+		table.AddHeader("STATUS", "TODO", "ASSIGNEE", "STATE", "DUE")
+	}
+
+	cs := table.GetColorScheme()
 
-			// Add headers dynamically based on TTY mode
-			if table.IsTTY() {
-				table.AddHeader("", "TODO", "ASSIGNEE", "DUE")
+	for _, todo := range todos {
+		// Status column - symbol for TTY, text for non-TTY
+		if table.IsTTY() {
+			table.AddStatusField(todo.Completed)
+		} else {
+			if todo.Completed {
+				table.AddField("completed")
 			} else {
-				table.AddHeader("STATUS", "TODO", "ASSIGNEE", "STATE", "DUE")
+				table.AddField("incomplete")
 			}
+		}
 
-			cs := table.GetColorScheme()
+		// Todo title with completion styling
+		title := todo.Content
+		if title == "" {
+			title = todo.Title
+		}
+		hl.addTodoField(table, title, todo)
+
+		// Get assignees
+		assignee := ""
+		if len(todo.Assignees) > 0 {
+			names := []string{}
+			for _, a := range todo.Assignees {
+				names = append(names, a.Name)
+			}
+			assignee = strings.Join(names, ", ")
+		}
+		table.AddField(assignee, cs.Muted)
 
-			// Add todos from this group
-			for _, todo := range todos {
-				// Status column - symbol for TTY, text for non-TTY
-				if table.IsTTY() {
-					table.AddStatusField(todo.Completed)
-				} else {
-					if todo.Completed {
-						table.AddField("completed")
-					} else {
-						table.AddField("incomplete")
-					}
-				}
+		// Add STATE column only for non-TTY
+		if !table.IsTTY() {
+			if todo.Completed {
+				table.AddField("completed")
+			} else {
+				table.AddField("incomplete")
+			}
+		}
 
-				// Todo title with completion styling
-				title := todo.Content
-				if title == "" {
-					title = todo.Title
-				}
-				table.AddTodoField(title, todo.Completed)
+		// Due date
+		table.AddField(dates.due(todo), cs.Muted)
 
-				// Get assignees
-				assignee := ""
-				if len(todo.Assignees) > 0 {
-					names := []string{}
-					for _, a := range todo.Assignees {
-						names = append(names, a.Name)
-					}
-					assignee = strings.Join(names, ", ")
-				}
-				table.AddField(assignee, cs.Muted)
+		table.EndRow()
+	}
 
-				// Add STATE column only for non-TTY
-				if !table.IsTTY() {
-					if todo.Completed {
-						table.AddField("completed")
-					} else {
-						table.AddField("incomplete")
-					}
-				}
+	return table.Render()
+}
 
-				// Due date
-				due := ""
-				if todo.DueOn != nil && *todo.DueOn != "" {
-					if dueTime, err := time.Parse("2006-01-02", *todo.DueOn); err == nil {
-						due = dueTime.Format("Jan 2")
-					}
-				}
-				table.AddField(due, cs.Muted)
+// assigneeBucket is one assignee's todos for --group-by-assignee. Unassigned
+// todos are collected in a bucket with a zero PersonID.
+type assigneeBucket struct {
+	PersonID int64
+	Name     string
+	Open     int
+	Todos    []api.Todo
+}
+
+// bucketTodosByAssignee groups todos under each of their assignees, sorted by
+// open todos (most first) then name, with the unassigned bucket last
+func bucketTodosByAssignee(todos []api.Todo) []assigneeBucket {
+	index := make(map[int64]int)
+	var buckets []assigneeBucket
+	add := func(personID int64, name string, todo api.Todo) {
+		i, ok := index[personID]
+		if !ok {
+			i = len(buckets)
+			index[personID] = i
+			buckets = append(buckets, assigneeBucket{PersonID: personID, Name: name})
+		}
+		buckets[i].Todos = append(buckets[i].Todos, todo)
+		if !todo.Completed {
+			buckets[i].Open++
+		}
+	}
+
+	for _, todo := range todos {
+		if len(todo.Assignees) == 0 {
+			add(0, "Unassigned", todo)
+			continue
+		}
+		for _, person := range todo.Assignees {
+			add(person.ID, person.Name, todo)
+		}
+	}
+
+	sort.SliceStable(buckets, func(i, j int) bool {
+		a, b := buckets[i], buckets[j]
+		if (a.PersonID == 0) != (b.PersonID == 0) {
+			return b.PersonID == 0
+		}
+		if a.Open != b.Open {
+			return a.Open > b.Open
+		}
+		return strings.ToLower(a.Name) < strings.ToLower(b.Name)
+	})
+	return buckets
+}
 
-				table.EndRow()
+// displayTodosByAssignee writes each assignee's todos under a heading with
+// their open and total counts
+func displayTodosByAssignee(todoList *api.TodoList, buckets []assigneeBucket, showAll bool, hl *todoHighlighter, dates todoDates) error {
+	visible := func(todos []api.Todo) []api.Todo {
+		if showAll {
+			return todos
+		}
+		var open []api.Todo
+		for _, todo := range todos {
+			if !todo.Completed {
+				open = append(open, todo)
 			}
+		}
+		return open
+	}
 
-			_ = table.Render()
-		} else {
-			fmt.Println(metaStyle.Render("  No todos in this group"))
+	if !ui.IsTerminal(os.Stdout) {
+		fmt.Printf("Todo List: %s\n", todoList.Title)
+		fmt.Printf("ID: %d\n\n", todoList.ID)
+		fmt.Println("Assignee\tStatus\tTodo\tDue")
+		for _, bucket := range buckets {
+			for _, todo := range visible(bucket.Todos) {
+				fmt.Printf("%s\t%s\t%s\t%s\n", bucket.Name, todoStatusMarker(todo), todo.Title, derefString(todo.DueOn))
+			}
 		}
+		return nil
 	}
 
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("99"))
+	metaStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("241"))
+	headerStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("75"))
+
+	fmt.Println(titleStyle.Render(todoList.Title))
+	fmt.Println(metaStyle.Render(fmt.Sprintf("%d assignees", len(buckets))))
+	if len(buckets) == 0 {
+		fmt.Println()
+		fmt.Println(metaStyle.Render("No todos in this list"))
+		return nil
+	}
+
+	for _, bucket := range buckets {
+		fmt.Println()
+		counts := fmt.Sprintf("(%d open / %d total)", bucket.Open, len(bucket.Todos))
+		fmt.Println(headerStyle.Render(bucket.Name) + " " + metaStyle.Render(counts))
+		if todos := visible(bucket.Todos); len(todos) > 0 {
+			_ = renderTodoSectionTable(todos, hl, dates)
+		} else {
+			fmt.Println(metaStyle.Render("  No open todos"))
+		}
+	}
 	return nil
 }
 
-func displayTodoListWithGroupsSimple(todoList *api.TodoList, groups []api.TodoGroup, groupedTodos map[string][]api.Todo, format ui.OutputFormat) error {
-	// Simple output for non-TTY and CSV format
+// outputTodosByAssigneeJSON writes the list with its todos nested by assignee
+func outputTodosByAssigneeJSON(todoList *api.TodoList, buckets []assigneeBucket, contentOpts todoContentOptions) error {
+	assignees := make([]map[string]interface{}, len(buckets))
+	for i, bucket := range buckets {
+		todos, err := shapeTodosJSON(bucket.Todos, contentOpts)
+		if err != nil {
+			return err
+		}
+		var personID interface{}
+		if bucket.PersonID != 0 {
+			personID = bucket.PersonID
+		}
+		assignees[i] = map[string]interface{}{
+			"id":    personID,
+			"name":  bucket.Name,
+			"open":  bucket.Open,
+			"total": len(bucket.Todos),
+			"todos": todos,
+		}
+	}
+
+	return ui.WriteJSON(os.Stdout, map[string]interface{}{
+		"id":        todoList.ID,
+		"title":     todoList.Title,
+		"assignees": assignees,
+	})
+}
+
+func displayTodoListWithGroupsSimple(todoList *api.TodoList, groups []api.TodoGroup, groupedTodos map[string][]api.Todo) error {
+	// Simple output for non-TTY
 	fmt.Printf("Todo List: %s\n", todoList.Title)
 	fmt.Printf("ID: %d\n", todoList.ID)
 
@@ -443,56 +1248,12 @@ func displayTodoListWithGroupsSimple(todoList *api.TodoList, groups []api.TodoGr
 	}
 	fmt.Printf("Progress: %d/%d completed\n\n", totalCompleted, totalTodos)
 
-	// Output groups and todos in the requested format
-	if format == ui.OutputFormatCSV {
-		// Use proper CSV writer for CSV format
-		writer := csv.NewWriter(os.Stdout)
-		defer writer.Flush()
-
-		// Write header
-		if err := writer.Write([]string{"Group", "Status", "Todo", "Due"}); err != nil {
-			return fmt.Errorf("failed to write CSV header: %w", err)
-		}
-
-		// Write data rows
-		for _, group := range groups {
-			if todos, ok := groupedTodos[fmt.Sprintf("%d", group.ID)]; ok {
-				for _, todo := range todos {
-					status := "[ ]"
-					if todo.Completed {
-						status = "[x]"
-					}
-
-					due := ""
-					if todo.DueOn != nil {
-						due = *todo.DueOn
-					}
-
-					record := []string{group.Title, status, todo.Title, due}
-					if err := writer.Write(record); err != nil {
-						return fmt.Errorf("failed to write CSV record: %w", err)
-					}
-				}
-			}
-		}
-	} else {
-		// Tab-separated output for non-TTY (backwards compatibility)
-		fmt.Println("Group\tStatus\tTodo\tDue")
-		for _, group := range groups {
-			if todos, ok := groupedTodos[fmt.Sprintf("%d", group.ID)]; ok {
-				for _, todo := range todos {
-					status := "[ ]"
-					if todo.Completed {
-						status = "[x]"
-					}
-
-					due := ""
-					if todo.DueOn != nil {
-						due = *todo.DueOn
-					}
-
-					fmt.Printf("%s\t%s\t%s\t%s\n", group.Title, status, todo.Title, due)
-				}
+	// Tab-separated output for non-TTY (backwards compatibility)
+	fmt.Println("Group\tStatus\tTodo\tDue")
+	for _, group := range groups {
+		if todos, ok := groupedTodos[fmt.Sprintf("%d", group.ID)]; ok {
+			for _, todo := range todos {
+				fmt.Printf("%s\t%s\t%s\t%s\n", group.Title, todoStatusMarker(todo), todo.Title, derefString(todo.DueOn))
 			}
 		}
 	}
@@ -500,11 +1261,14 @@ func displayTodoListWithGroupsSimple(todoList *api.TodoList, groups []api.TodoGr
 	return nil
 }
 
-func outputTodoListWithGroupsJSON(todoList *api.TodoList, groups []api.TodoGroup, groupedTodos map[string][]api.Todo, _ string) error {
+func outputTodoListWithGroupsJSON(todoList *api.TodoList, groups []api.TodoGroup, groupedTodos map[string][]api.Todo, contentOpts todoContentOptions) error {
 	// Combine todo list, groups, and todos data
 	groupData := make([]map[string]interface{}, len(groups))
 	for i, group := range groups {
-		todos := groupedTodos[fmt.Sprintf("%d", group.ID)]
+		todos, err := shapeTodosJSON(groupedTodos[fmt.Sprintf("%d", group.ID)], contentOpts)
+		if err != nil {
+			return err
+		}
 		groupData[i] = map[string]interface{}{
 			"id":              group.ID,
 			"title":           group.Title,
@@ -523,192 +1287,203 @@ func outputTodoListWithGroupsJSON(todoList *api.TodoList, groups []api.TodoGroup
 		"groups":      groupData,
 	}
 
-	// TODO: If specific fields requested, filter the output
-	// Currently, all fields are returned regardless of the fields parameter
-
 	encoder := json.NewEncoder(os.Stdout)
 	encoder.SetIndent("", "  ")
 	return encoder.Encode(data)
 }
 
-func displayTodoListGitHubStyle(todoList *api.TodoList, groups []api.TodoGroup, groupedTodos map[string][]api.Todo, showAll bool) error {
+func displayTodoListGitHubStyle(todoList *api.TodoList, groups []api.TodoGroup, groupedTodos map[string][]api.Todo, todoGroups map[int64]string, showAll bool, hl *todoHighlighter, sla *todoSLA, dates todoDates) error {
 	// First, count total todos before any filtering
 	totalTodos := 0
-	completedTodos := 0
 	for _, todos := range groupedTodos {
-		for _, todo := range todos {
-			totalTodos++
-			if todo.Completed {
-				completedTodos++
-			}
-		}
+		totalTodos += len(todos)
 	}
 
-	// Filter todos if not showing all
-	filteredGroupedTodos := make(map[string][]api.Todo)
-	displayedTodos := 0
-	allTodos := []api.Todo{}
-
-	for groupID, todos := range groupedTodos {
-		var filteredTodos []api.Todo
-		for _, todo := range todos {
-			if showAll || !todo.Completed {
-				filteredTodos = append(filteredTodos, todo)
-				displayedTodos++
-				allTodos = append(allTodos, todo)
-			}
-		}
-		filteredGroupedTodos[groupID] = filteredTodos
+	// Rows in display order; todos sorted out of their groups get their
+	// group back from todoGroups
+	rows := labelTodoGroups(collectTodoRows(groups, groupedTodos, showAll), todoGroups)
+	withGroups := len(groups) > 0 || len(todoGroups) > 0
+	allTodos := make([]api.Todo, len(rows))
+	for i, row := range rows {
+		allTodos[i] = row.todo
 	}
-	groupedTodos = filteredGroupedTodos
 
 	// Display GitHub CLI style summary line
 	if showAll {
-		fmt.Printf("Showing %d of %d todos in %s\n\n", displayedTodos, totalTodos, todoList.Title)
+		fmt.Printf("Showing %d of %d todos in %s\n\n", len(rows), totalTodos, todoList.Title)
 	} else {
-		fmt.Printf("Showing %d of %d open todos in %s\n\n", displayedTodos, totalTodos, todoList.Title)
+		fmt.Printf("Showing %d of %d open todos in %s\n\n", len(rows), totalTodos, todoList.Title)
 	}
 
 	// Create GitHub CLI-style table
 	table := tableprinter.New(os.Stdout)
 
 	// Add headers dynamically based on TTY mode and groups
+	var show todoColumnSet
 	if table.IsTTY() {
-		if len(groups) > 0 {
-			table.AddHeader("ID", "", "TODO", "GROUP", "ASSIGNEE", "DUE")
-		} else {
-			table.AddHeader("ID", "", "TODO", "ASSIGNEE", "DUE")
+		// Drop lower-priority columns that won't fit the terminal
+		columns := todoTableColumns(allTodos, withGroups)
+		if sla != nil {
+			columns = append(columns, todoColumn{header: "AGE", minWidth: 4})
 		}
+		columns = fitTodoColumns(columns, ui.GetTerminalWidth())
+		show = newTodoColumnSet(columns)
+		table.AddHeader(todoColumnHeaders(columns)...)
 	} else {
+		// Non-TTY output always includes every column
+		show = todoColumnSet{"GROUP": withGroups, "ASSIGNEE": true, "DUE": true, "AGE": sla != nil}
 		// Add STATE column for non-TTY mode (machine readable)
-		if len(groups) > 0 {
-			table.AddHeader("ID", "STATUS", "TODO", "GROUP", "ASSIGNEE", "STATE", "DUE")
-		} else {
-			table.AddHeader("ID", "STATUS", "TODO", "ASSIGNEE", "STATE", "DUE")
+		headers := []string{"ID", "STATUS", "TODO", "ASSIGNEE", "STATE", "DUE"}
+		if withGroups {
+			headers = []string{"ID", "STATUS", "TODO", "GROUP", "ASSIGNEE", "STATE", "DUE"}
 		}
+		if sla != nil {
+			headers = append(headers, "AGE")
+		}
+		table.AddHeader(headers...)
 	}
 
 	cs := table.GetColorScheme()
 
 	// Add all todos to single table
-	if len(groups) > 0 {
-		// With groups
-		for _, group := range groups {
-			if todos, ok := groupedTodos[fmt.Sprintf("%d", group.ID)]; ok {
-				for _, todo := range todos {
-					// ID column
-					table.AddField(fmt.Sprintf("%d", todo.ID))
-
-					// Status column - symbol for TTY, text for non-TTY
-					if table.IsTTY() {
-						table.AddStatusField(todo.Completed)
-					} else {
-						if todo.Completed {
-							table.AddField("completed")
-						} else {
-							table.AddField("incomplete")
-						}
-					}
+	for _, row := range rows {
+		todo := row.todo
 
-					// Todo title with completion styling
-					title := todo.Content
-					if title == "" {
-						title = todo.Title
-					}
-					table.AddTodoField(title, todo.Completed)
+		// ID column
+		table.AddField(fmt.Sprintf("%d", todo.ID))
 
-					// Group name with cyan color (like GitHub CLI branch names)
-					table.AddField(group.Title, cs.Cyan)
+		// Status column - symbol for TTY, text for non-TTY
+		if table.IsTTY() {
+			table.AddStatusField(todo.Completed)
+		} else {
+			if todo.Completed {
+				table.AddField("completed")
+			} else {
+				table.AddField("incomplete")
+			}
+		}
 
-					// Get assignees
-					assignee := ""
-					if len(todo.Assignees) > 0 {
-						names := []string{}
-						for _, a := range todo.Assignees {
-							names = append(names, a.Name)
-						}
-						assignee = strings.Join(names, ", ")
-					}
-					table.AddField(assignee, cs.Muted)
-
-					// Add STATE column only for non-TTY
-					if !table.IsTTY() {
-						if todo.Completed {
-							table.AddField("completed")
-						} else {
-							table.AddField("incomplete")
-						}
-					}
+		// Todo title with completion styling
+		title := todo.Content
+		if title == "" {
+			title = todo.Title
+		}
+		hl.addTodoField(table, title, todo)
 
-					// Due date
-					due := ""
-					if todo.DueOn != nil && *todo.DueOn != "" {
-						if dueTime, err := time.Parse("2006-01-02", *todo.DueOn); err == nil {
-							due = dueTime.Format("Jan 2")
-						}
-					}
-					table.AddField(due, cs.Muted)
+		// Group name with cyan color (like GitHub CLI branch names)
+		if show["GROUP"] {
+			table.AddField(row.group, cs.Cyan)
+		}
 
-					table.EndRow()
-				}
+		// Get assignees
+		assignee := ""
+		if len(todo.Assignees) > 0 {
+			names := []string{}
+			for _, a := range todo.Assignees {
+				names = append(names, a.Name)
 			}
+			assignee = strings.Join(names, ", ")
 		}
-	} else {
-		// Without groups
-		for _, todo := range allTodos {
-			// ID column
-			table.AddField(fmt.Sprintf("%d", todo.ID))
-
-			// Status column - symbol for TTY, text for non-TTY
-			if table.IsTTY() {
-				table.AddStatusField(todo.Completed)
+		if show["ASSIGNEE"] {
+			table.AddField(assignee, cs.Muted)
+		}
+
+		// Add STATE column only for non-TTY
+		if !table.IsTTY() {
+			if todo.Completed {
+				table.AddField("completed")
 			} else {
-				if todo.Completed {
-					table.AddField("completed")
-				} else {
-					table.AddField("incomplete")
-				}
+				table.AddField("incomplete")
 			}
+		}
 
-			// Todo title with completion styling
-			title := todo.Content
-			if title == "" {
-				title = todo.Title
-			}
-			table.AddTodoField(title, todo.Completed)
+		// Due date
+		if show["DUE"] {
+			table.AddField(dates.due(todo), cs.Muted)
+		}
 
-			// Get assignees
-			assignee := ""
-			if len(todo.Assignees) > 0 {
-				names := []string{}
-				for _, a := range todo.Assignees {
-					names = append(names, a.Name)
-				}
-				assignee = strings.Join(names, ", ")
-			}
-			table.AddField(assignee, cs.Muted)
+		// Age against the --sla threshold
+		if show["AGE"] {
+			sla.addAgeField(table, todo)
+		}
 
-			// Add STATE column only for non-TTY
-			if !table.IsTTY() {
-				if todo.Completed {
-					table.AddField("completed")
-				} else {
-					table.AddField("incomplete")
-				}
-			}
+		table.EndRow()
+	}
 
-			// Due date
-			due := ""
-			if todo.DueOn != nil && *todo.DueOn != "" {
-				if dueTime, err := time.Parse("2006-01-02", *todo.DueOn); err == nil {
-					due = dueTime.Format("Jan 2")
-				}
-			}
-			table.AddField(due, cs.Muted)
+	return table.Render()
+}
+
+// todoDates formats the dates shown in todo listings in the --date-format
+type todoDates struct {
+	format ui.DateFormat
+	// now is what relative dates are relative to; zero means the current time
+	now time.Time
+}
 
-			table.EndRow()
+// newTodoDates resolves the date format from --date-format or
+// --relative-dates, falling back to the configured default
+func newTodoDates(flag string, relative bool, preference string) (todoDates, error) {
+	if relative {
+		if flag != "" {
+			return todoDates{}, fmt.Errorf("--relative-dates and --date-format cannot be used together")
 		}
+		flag = ui.DateFormatRelative
 	}
+	if flag == "" {
+		flag = preference
+	}
+	format, err := ui.ParseDateFormat(flag)
+	if err != nil {
+		return todoDates{}, err
+	}
+	return todoDates{format: format}, nil
+}
 
-	return table.Render()
+// date formats t in the chosen format
+func (d todoDates) date(t time.Time) string {
+	now := d.now
+	if now.IsZero() {
+		now = time.Now()
+	}
+	return d.format.Format(now, t)
+}
+
+// due returns the todo's due date, or "" when it has none
+func (d todoDates) due(todo api.Todo) string {
+	if todo.DueOn == nil || *todo.DueOn == "" {
+		return ""
+	}
+	dueTime, err := time.Parse("2006-01-02", *todo.DueOn)
+	if err != nil {
+		return ""
+	}
+	return d.date(dueTime)
+}
+
+// noAssignedTodosMessage explains an --assignee listing with no todos,
+// naming the people as they were given
+func noAssignedTodosMessage(list string, assignees []string, showAll bool) string {
+	who := strings.Join(assignees, " or ")
+	if showAll {
+		return fmt.Sprintf("No todos assigned to %s in %s", who, list)
+	}
+	return fmt.Sprintf("No open todos assigned to %s in %s (use --all to include completed ones)", who, list)
+}
+
+// countListedTodos returns how many todos the listing shows: every todo in
+// the list or its groups, leaving out completed ones unless showAll is set
+func countListedTodos(todos []api.Todo, groups []api.TodoGroup, groupedTodos map[string][]api.Todo, showAll bool) int {
+	if len(groups) == 0 {
+		groupedTodos = map[string][]api.Todo{"": todos}
+	}
+	return len(collectTodoRows(groups, groupedTodos, showAll))
+}
+
+// todoRowIDs returns the IDs of the todos in rows, in order
+func todoRowIDs(rows []todoRow) []int64 {
+	ids := make([]int64, len(rows))
+	for i, row := range rows {
+		ids[i] = row.todo.ID
+	}
+	return ids
 }
@@ -0,0 +1,245 @@
+package todo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/utils"
+)
+
+// partialTodoOps returns open todos but fails to load completed ones
+type partialTodoOps struct {
+	api.TodoOperations
+	open     []api.Todo
+	archived []api.Todo
+}
+
+func (p partialTodoOps) GetTodosWithStatus(ctx context.Context, projectID string, todoListID int64, status string) ([]api.Todo, error) {
+	if status != api.StatusArchived {
+		return nil, errors.New("unexpected status " + status)
+	}
+	return p.archived, nil
+}
+
+func (p partialTodoOps) GetTodos(ctx context.Context, projectID string, todoListID int64) ([]api.Todo, error) {
+	return p.open, nil
+}
+
+func (p partialTodoOps) GetAllTodos(ctx context.Context, projectID string, todoListID int64) ([]api.Todo, error) {
+	return p.open, &api.PartialError{Missing: "completed todos", Err: errors.New("server error")}
+}
+
+func TestFetchListTodos_KeepsOpenTodosWhenCompletedFetchFails(t *testing.T) {
+	ops := partialTodoOps{open: []api.Todo{{ID: 1, Title: "Open"}, {ID: 2, Title: "Also open"}}}
+
+	todos, groups, _, err := fetchListTodos(context.Background(), ops, "1", &api.TodoList{ID: 10}, true, api.StatusActive)
+
+	var partialErr *api.PartialError
+	require.True(t, errors.As(err, &partialErr), "expected a partial error, got %v", err)
+	assert.Empty(t, groups)
+	assert.Len(t, todos, 2, "open todos should still be returned")
+
+	rows := collectTodoRows(nil, map[string][]api.Todo{"": todos}, true)
+	assert.Len(t, rows, 2, "open todos should still be displayed")
+}
+
+func TestFetchListTodos_NoErrorWithoutCompleted(t *testing.T) {
+	ops := partialTodoOps{open: []api.Todo{{ID: 1, Title: "Open"}}}
+
+	todos, _, _, err := fetchListTodos(context.Background(), ops, "1", &api.TodoList{ID: 10}, false, api.StatusActive)
+	require.NoError(t, err)
+	assert.Len(t, todos, 1)
+}
+
+func TestFetchListTodos_Status(t *testing.T) {
+	ops := partialTodoOps{
+		open:     []api.Todo{{ID: 1, Title: "Open"}},
+		archived: []api.Todo{{ID: 2, Title: "Archived"}},
+	}
+
+	todos, _, _, err := fetchListTodos(context.Background(), ops, "1", &api.TodoList{ID: 10}, false, api.StatusArchived)
+	require.NoError(t, err)
+	require.Len(t, todos, 1)
+	assert.Equal(t, int64(2), todos[0].ID)
+
+	todos, _, _, err = fetchListTodos(context.Background(), ops, "1", &api.TodoList{ID: 10}, false, todoStatusAll)
+	require.NoError(t, err)
+	assert.Len(t, todos, 2, "--status all should include active and archived todos")
+}
+
+func TestFilterTodos_QuickFilters(t *testing.T) {
+	due := "2024-06-10"
+	todos := []api.Todo{
+		{ID: 1, Title: "Assigned with due", Assignees: []api.Person{{ID: 5}}, DueOn: &due},
+		{ID: 2, Title: "Assigned no due", Assignees: []api.Person{{ID: 6}}},
+		{ID: 3, Title: "Unassigned with due", DueOn: &due},
+		{ID: 4, Title: "Unassigned no due"},
+	}
+
+	ids := func(todos []api.Todo) []int64 {
+		var out []int64
+		for _, todo := range todos {
+			out = append(out, todo.ID)
+		}
+		return out
+	}
+
+	assert.Equal(t, []int64{3, 4}, ids(filterTodos(todos, utils.ItemFilter{Unassigned: true})))
+	assert.Equal(t, []int64{1, 2}, ids(filterTodos(todos, utils.ItemFilter{Assigned: true})))
+	assert.Equal(t, []int64{1, 3}, ids(filterTodos(todos, utils.ItemFilter{HasDue: true})))
+	assert.Equal(t, []int64{4}, ids(filterTodos(todos, utils.ItemFilter{Unassigned: true, NoDue: true})))
+	assert.Equal(t, []int64{2}, ids(filterTodos(todos, utils.ItemFilter{AssigneeIDs: []int64{6}, NoDue: true})))
+}
+
+func TestStaleAssignees(t *testing.T) {
+	current := utils.NewPeopleIndex([]api.Person{{ID: 1, Name: "Ann"}}).IDs()
+	todos := []api.Todo{
+		{ID: 1, Assignees: []api.Person{{ID: 1, Name: "Ann"}}},
+		{ID: 2, Assignees: []api.Person{{ID: 1, Name: "Ann"}, {ID: 9, Name: "Gone"}}},
+		{ID: 3},
+	}
+
+	stale := filterStaleAssigned(todos, current)
+	require.Len(t, stale, 1)
+	assert.Equal(t, int64(2), stale[0].ID)
+
+	marked := markStaleAssignees(stale, current)
+	assert.Equal(t, "Ann", marked[0].Assignees[0].Name)
+	assert.Equal(t, "Gone (not in project)", marked[0].Assignees[1].Name)
+	assert.Equal(t, "Gone", stale[0].Assignees[1].Name, "marking leaves the originals alone")
+}
+
+func TestBucketTodosByAssignee(t *testing.T) {
+	alice := api.Person{ID: 1, Name: "Alice"}
+	bob := api.Person{ID: 2, Name: "Bob"}
+	todos := []api.Todo{
+		{ID: 10, Title: "Shared", Assignees: []api.Person{alice, bob}},
+		{ID: 11, Title: "Bob's", Assignees: []api.Person{bob}},
+		{ID: 12, Title: "Nobody's"},
+		{ID: 13, Title: "Done", Completed: true, Assignees: []api.Person{alice}},
+		{ID: 14, Title: "Also nobody's"},
+	}
+
+	buckets := bucketTodosByAssignee(todos)
+	require.Len(t, buckets, 3)
+
+	assert.Equal(t, "Bob", buckets[0].Name)
+	assert.Equal(t, 2, buckets[0].Open)
+	assert.Len(t, buckets[0].Todos, 2)
+
+	assert.Equal(t, "Alice", buckets[1].Name)
+	assert.Equal(t, 1, buckets[1].Open)
+	assert.Len(t, buckets[1].Todos, 2)
+
+	// Unassigned sorts last even with more open todos
+	assert.Equal(t, "Unassigned", buckets[2].Name)
+	assert.Equal(t, int64(0), buckets[2].PersonID)
+	assert.Equal(t, 2, buckets[2].Open)
+
+	assert.Empty(t, bucketTodosByAssignee(nil))
+}
+
+func TestTodoRowIDs(t *testing.T) {
+	groups := []api.TodoGroup{{ID: 10, Title: "Later"}}
+	grouped := map[string][]api.Todo{
+		"10": {{ID: 3}, {ID: 4, Completed: true}, {ID: 5}},
+	}
+
+	assert.Equal(t, []int64{3, 5}, todoRowIDs(collectTodoRows(groups, grouped, false)))
+	assert.Empty(t, todoRowIDs(collectTodoRows(nil, map[string][]api.Todo{"": nil}, false)))
+}
+
+func TestCountListedTodos(t *testing.T) {
+	open := api.Todo{ID: 1}
+	done := api.Todo{ID: 2, Completed: true}
+
+	assert.Equal(t, 1, countListedTodos([]api.Todo{open, done}, nil, nil, false))
+	assert.Equal(t, 2, countListedTodos([]api.Todo{open, done}, nil, nil, true))
+	assert.Equal(t, 0, countListedTodos(nil, nil, nil, false))
+
+	groups := []api.TodoGroup{{ID: 10}, {ID: 20}}
+	grouped := map[string][]api.Todo{"10": {open}, "20": {open, done}}
+	assert.Equal(t, 2, countListedTodos(nil, groups, grouped, false))
+	assert.Equal(t, 3, countListedTodos(nil, groups, grouped, true))
+}
+
+func TestParseDueBound(t *testing.T) {
+	today := time.Now().Format("2006-01-02")
+	got, err := parseDueBound("today")
+	require.NoError(t, err)
+	assert.Equal(t, today, got)
+
+	week := time.Now().AddDate(0, 0, 7).Format("2006-01-02")
+	for _, value := range []string{"7d", "+7d", " 1W "} {
+		got, err := parseDueBound(value)
+		require.NoError(t, err, value)
+		assert.Equal(t, week, got, value)
+	}
+
+	got, err = parseDueBound("2026-04-01")
+	require.NoError(t, err)
+	assert.Equal(t, "2026-04-01", got)
+
+	_, err = parseDueBound("soon")
+	assert.Error(t, err)
+}
+
+func TestSortTodosByDue(t *testing.T) {
+	early, late := "2026-03-01", "2026-04-01"
+	todos := []api.Todo{{ID: 1}, {ID: 2, DueOn: &late}, {ID: 3, DueOn: &early}, {ID: 4}}
+	sortTodosByDue(todos)
+
+	var ids []int64
+	for _, todo := range todos {
+		ids = append(ids, todo.ID)
+	}
+	assert.Equal(t, []int64{3, 2, 1, 4}, ids, "undated todos go last, in their original order")
+
+	assert.NoError(t, validateTodoSort("due"))
+	assert.NoError(t, validateTodoSort(""))
+	assert.EqualError(t, validateTodoSort("title"), `invalid --sort "title": must be due`)
+}
+
+func TestNoAssignedTodosMessage(t *testing.T) {
+	assert.Equal(t, "No open todos assigned to jane@example.com in Sprint (use --all to include completed ones)",
+		noAssignedTodosMessage("Sprint", []string{"jane@example.com"}, false))
+	assert.Equal(t, "No todos assigned to Jane or 42 in Sprint",
+		noAssignedTodosMessage("Sprint", []string{"Jane", "42"}, true))
+}
+
+func TestTodoDates(t *testing.T) {
+	due := "2027-01-02"
+	todo := api.Todo{DueOn: &due}
+	now := time.Date(2026, 12, 30, 9, 0, 0, 0, time.UTC)
+
+	dates, err := newTodoDates("", false, "")
+	require.NoError(t, err)
+	dates.now = now
+	assert.Equal(t, "Jan 2, 2027", dates.due(todo), "a due date next year shows the year")
+
+	dates, err = newTodoDates("", true, "iso")
+	require.NoError(t, err)
+	dates.now = now
+	assert.Equal(t, "in 3 days", dates.due(todo), "--relative-dates overrides the configured default")
+
+	dates, err = newTodoDates("iso", false, "relative")
+	require.NoError(t, err)
+	assert.Equal(t, "2027-01-02", dates.due(todo), "--date-format overrides the configured default")
+
+	dates, err = newTodoDates("", false, "relative")
+	require.NoError(t, err)
+	dates.now = now
+	assert.Equal(t, "in 3 days", dates.due(todo), "the configured default applies without flags")
+	assert.Equal(t, "", dates.due(api.Todo{}))
+
+	_, err = newTodoDates("iso", true, "")
+	assert.Error(t, err)
+	_, err = newTodoDates("someday", false, "")
+	assert.Error(t, err)
+}
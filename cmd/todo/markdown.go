@@ -0,0 +1,87 @@
+package todo
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/ui"
+	"github.com/needmore/bc4/internal/utils"
+)
+
+// isMarkdownFormat reports whether a --format value asks for markdown, which
+// todo list handles itself rather than through ui.ParseOutputFormat
+func isMarkdownFormat(format string) bool {
+	switch strings.ToLower(format) {
+	case "markdown", "md":
+		return true
+	}
+	return false
+}
+
+// writeTodosMarkdown writes the list as a markdown checklist: the list title
+// and completion ratio, then the todos under their group headings
+func writeTodosMarkdown(w io.Writer, todoList *api.TodoList, groups []api.TodoGroup, groupedTodos map[string][]api.Todo, showAll bool, now time.Time) error {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# %s\n", todoList.Title)
+	if todoList.CompletedRatio != "" {
+		fmt.Fprintf(&b, "\n_%s completed_\n", todoList.CompletedRatio)
+	}
+
+	rows := collectTodoRows(groups, groupedTodos, showAll)
+	if len(rows) == 0 {
+		b.WriteString("\nNo todos.\n")
+	}
+
+	// Start a new block (with a heading for groups) whenever the group changes
+	for i, row := range rows {
+		if i == 0 || row.group != rows[i-1].group {
+			if row.group != "" {
+				fmt.Fprintf(&b, "\n## %s\n", row.group)
+			}
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "- %s %s", todoStatusMarker(row.todo), todoPlainTitle(row.todo))
+		if due := markdownDue(row.todo.DueOn, now); due != "" {
+			fmt.Fprintf(&b, " (due %s)", due)
+		}
+		b.WriteString("\n")
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// markdownDue formats a due date like "Jun 1", adding the year when it isn't
+// the current one
+func markdownDue(dueOn *string, now time.Time) string {
+	if dueOn == nil || *dueOn == "" {
+		return ""
+	}
+	due, err := time.Parse(utils.DateLayout, *dueOn)
+	if err != nil {
+		return *dueOn
+	}
+	return ui.DateFormat{}.Format(now, due)
+}
+
+// copyTodosToClipboard copies the Markdown checklist with copyText, confirming
+// on stderr. Without a clipboard tool the checklist goes to stdout instead,
+// so it isn't lost.
+func copyTodosToClipboard(stdout, stderr io.Writer, checklist, title string, copyText func(string) error) error {
+	err := copyText(checklist)
+	if errors.Is(err, ui.ErrClipboardUnavailable) {
+		fmt.Fprintf(stderr, "Warning: %v; printing the list instead\n", err)
+		_, err = io.WriteString(stdout, checklist)
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(stderr, "✓ Copied %s to the clipboard as Markdown\n", title)
+	return nil
+}
@@ -0,0 +1,91 @@
+package todo
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/ui"
+)
+
+func TestWriteTodosMarkdown(t *testing.T) {
+	now := time.Date(2025, 5, 20, 12, 0, 0, 0, time.UTC)
+	due := "2025-06-01"
+	nextYear := "2026-01-15"
+	list := &api.TodoList{Title: "Launch", CompletedRatio: "1/3"}
+
+	t.Run("grouped with completed todos", func(t *testing.T) {
+		groups := []api.TodoGroup{{ID: 1, Title: "Design"}, {ID: 2, Title: "Build"}}
+		groupedTodos := map[string][]api.Todo{
+			"1": {{Title: "Mockups", Completed: true}, {Title: "Review", DueOn: &due}},
+			"2": {{Title: "Ship it", DueOn: &nextYear}},
+		}
+
+		var buf bytes.Buffer
+		require.NoError(t, writeTodosMarkdown(&buf, list, groups, groupedTodos, true, now))
+		assert.Equal(t, `# Launch
+
+_1/3 completed_
+
+## Design
+
+- [x] Mockups
+- [ ] Review (due Jun 1)
+
+## Build
+
+- [ ] Ship it (due Jan 15, 2026)
+`, buf.String())
+	})
+
+	t.Run("flat without completed todos", func(t *testing.T) {
+		groupedTodos := map[string][]api.Todo{
+			"": {{Title: "Mockups", Completed: true}, {Title: "Review"}},
+		}
+
+		var buf bytes.Buffer
+		require.NoError(t, writeTodosMarkdown(&buf, list, nil, groupedTodos, false, now))
+		assert.Equal(t, "# Launch\n\n_1/3 completed_\n\n- [ ] Review\n", buf.String())
+	})
+}
+
+func TestCopyTodosToClipboard(t *testing.T) {
+	checklist := "## Sprint\n\n- [ ] Ship it\n"
+
+	t.Run("copied", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		var copied string
+		err := copyTodosToClipboard(&stdout, &stderr, checklist, "Sprint", func(text string) error {
+			copied = text
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, checklist, copied)
+		assert.Empty(t, stdout.String())
+		assert.Contains(t, stderr.String(), "Copied Sprint to the clipboard")
+	})
+
+	t.Run("no clipboard tool prints instead", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		err := copyTodosToClipboard(&stdout, &stderr, checklist, "Sprint", func(string) error {
+			return ui.ErrClipboardUnavailable
+		})
+		require.NoError(t, err)
+		assert.Equal(t, checklist, stdout.String())
+		assert.Contains(t, stderr.String(), "clipboard unavailable")
+	})
+
+	t.Run("copy failure", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		err := copyTodosToClipboard(&stdout, &stderr, checklist, "Sprint", func(string) error {
+			return errors.New("xclip: can't open display")
+		})
+		assert.EqualError(t, err, "xclip: can't open display")
+		assert.Empty(t, stdout.String())
+	})
+}
@@ -1,19 +1,29 @@
 package todo
 
 import (
+	"errors"
 	"fmt"
+	"os"
 	"strconv"
 
+	"github.com/charmbracelet/huh"
+	"github.com/needmore/bc4/internal/api"
 	"github.com/needmore/bc4/internal/cmdutil"
 	"github.com/needmore/bc4/internal/factory"
 	"github.com/needmore/bc4/internal/parser"
+	"github.com/needmore/bc4/internal/ui"
+	"github.com/needmore/bc4/internal/undo"
 	"github.com/spf13/cobra"
 )
 
 type moveOptions struct {
-	position int
-	top      bool
-	bottom   bool
+	position  int
+	top       bool
+	bottom    bool
+	toList    string
+	toProject string
+	listMatch string
+	yes       bool
 }
 
 func newMoveCmd(f *factory.Factory) *cobra.Command {
@@ -21,14 +31,22 @@ func newMoveCmd(f *factory.Factory) *cobra.Command {
 
 	cmd := &cobra.Command{
 		Use:   "move <todo-id|url>",
-		Short: "Move a todo to a different position within its list",
-		Long: `Move a todo to a different position within its todo list.
+		Short: "Move a todo to a different position or list",
+		Long: `Move a todo to a different position within its todo list, or into
+another list with --to-list.
 
 You can specify the todo using either:
 - A numeric ID (e.g., "12345")
 - A Basecamp URL (e.g., "https://3.basecamp.com/1234567/buckets/89012345/todos/12345")
 
-Position is 1-based (1 = first item in the list).`,
+Position is 1-based (1 = first item in the list). When moving to another list
+the todo goes to the top unless --position or --bottom is given.
+
+Moving a todo to another list asks for confirmation on a terminal; use --yes
+to skip the prompt (required when not running interactively). --to-project
+picks the destination list's project, but the Basecamp API can't move todos
+between projects, so cross-project moves are checked and then refused rather
+than copied.`,
 		Example: `  # Move todo to specific position
   bc4 todo move 12345 --position 1      # Move to top (first position)
   bc4 todo move 12345 --position 3      # Move to 3rd position
@@ -38,7 +56,10 @@ Position is 1-based (1 = first item in the list).`,
   bc4 todo move 12345 --bottom          # Move to bottom of list
 
   # Move using a URL
-  bc4 todo move https://3.basecamp.com/.../todos/12345 --position 1`,
+  bc4 todo move https://3.basecamp.com/.../todos/12345 --position 1
+
+  # Move into another list
+  bc4 todo move 12345 --to-list "Next Sprint" --yes`,
 		Args: cmdutil.ExactArgs(1, "todo-id"),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runMove(f, opts, args)
@@ -48,6 +69,10 @@ Position is 1-based (1 = first item in the list).`,
 	cmd.Flags().IntVar(&opts.position, "position", 0, "Move to specific position (1-based)")
 	cmd.Flags().BoolVar(&opts.top, "top", false, "Move to top of list (position 1)")
 	cmd.Flags().BoolVar(&opts.bottom, "bottom", false, "Move to bottom of list")
+	cmd.Flags().StringVar(&opts.toList, "to-list", "", "Move into this todo list (ID, name, or URL)")
+	cmd.Flags().StringVar(&opts.toProject, "to-project", "", "Project of the --to-list list (ID, name, or URL)")
+	addListMatchFlag(cmd, &opts.listMatch)
+	cmd.Flags().BoolVarP(&opts.yes, "yes", "y", false, "Skip the confirmation prompt for --to-list moves")
 
 	return cmd
 }
@@ -72,6 +97,10 @@ func runMove(f *factory.Factory, opts *moveOptions, args []string) error {
 		}
 	}
 
+	if opts.toList != "" || opts.toProject != "" {
+		return runMoveToList(f, opts, todoID)
+	}
+
 	// Validate position options
 	optionCount := 0
 	if opts.position > 0 {
@@ -145,3 +174,116 @@ func runMove(f *factory.Factory, opts *moveOptions, args []string) error {
 
 	return nil
 }
+
+// runMoveToList moves a todo into another list, confirming first
+func runMoveToList(f *factory.Factory, opts *moveOptions, todoID int64) error {
+	if opts.toList == "" {
+		return fmt.Errorf("--to-project requires --to-list")
+	}
+	if opts.top && opts.bottom || (opts.top || opts.bottom) && opts.position > 0 {
+		return fmt.Errorf("only one of --position, --top, or --bottom can be specified")
+	}
+	policy, err := parseMatchPolicy(opts.listMatch)
+	if err != nil {
+		return err
+	}
+
+	client, err := f.ApiClient()
+	if err != nil {
+		return err
+	}
+	todoOps := client.Todos()
+
+	projectID, err := f.ProjectID()
+	if err != nil {
+		return err
+	}
+
+	destProjectID := projectID
+	if opts.toProject != "" {
+		destProjectID, err = resolveProject(opts.toProject, func() ([]api.Project, error) {
+			return client.Projects().GetProjects(f.Context())
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Make sure the destination list exists in the destination project
+	todoSet, err := todoOps.GetProjectTodoSet(f.Context(), destProjectID)
+	if err != nil {
+		return fmt.Errorf("failed to get todo set for project %s: %w", destProjectID, err)
+	}
+	destListID, err := resolveTodoList(opts.toList, policy, func() ([]api.TodoList, error) {
+		return todoOps.GetTodoLists(f.Context(), destProjectID, todoSet.ID)
+	})
+	if err != nil {
+		return err
+	}
+	destList, err := todoOps.GetTodoList(f.Context(), destProjectID, destListID)
+	if err != nil {
+		return fmt.Errorf("todo list %s not found in project %s: %w", opts.toList, destProjectID, err)
+	}
+
+	if destProjectID != projectID {
+		return fmt.Errorf("cannot move #%d to %q: %w", todoID, destList.Title, api.ErrCrossProjectMoveUnsupported)
+	}
+
+	todo, err := todoOps.GetTodo(f.Context(), projectID, todoID)
+	if err != nil {
+		return fmt.Errorf("failed to get todo: %w", err)
+	}
+	if todo.TodolistID == destListID {
+		fmt.Printf("#%d is already in %s\n", todoID, destList.Title)
+		return nil
+	}
+
+	if !opts.yes {
+		if !ui.IsTerminal(os.Stdout) || !ui.IsTerminal(os.Stdin) {
+			return fmt.Errorf("moving a todo to another list requires --yes when not running interactively")
+		}
+		var confirm bool
+		if err := huh.NewConfirm().
+			Title(fmt.Sprintf("Move \"%s\" to %s?", todo.Title, destList.Title)).
+			Affirmative("Move").
+			Negative("Cancel").
+			Value(&confirm).
+			Run(); err != nil {
+			return err
+		}
+		if !confirm {
+			fmt.Println("Canceled")
+			return nil
+		}
+	}
+
+	position := 1
+	switch {
+	case opts.position > 0:
+		position = opts.position
+	case opts.bottom:
+		todos, err := todoOps.GetAllTodos(f.Context(), projectID, destListID)
+		var partialErr *api.PartialError
+		if err != nil && !errors.As(err, &partialErr) {
+			return fmt.Errorf("failed to get todos in list: %w", err)
+		}
+		position = len(todos) + 1
+	}
+
+	if err := todoOps.MoveTodo(f.Context(), projectID, todoID, destProjectID, destListID, position); err != nil {
+		return err
+	}
+
+	fmt.Printf("Moved #%d to %s\n", todoID, destList.Title)
+
+	accountID, _ := f.AccountID()
+	undo.RecordWithHint(undo.Action{
+		Kind:             undo.KindTodoMove,
+		AccountID:        accountID,
+		ProjectID:        projectID,
+		ID:               todoID,
+		PreviousParentID: todo.TodolistID,
+		Description:      fmt.Sprintf("Moved todo #%d to %s", todoID, destList.Title),
+	})
+	return nil
+}
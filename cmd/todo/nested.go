@@ -0,0 +1,81 @@
+package todo
+
+import (
+	"strings"
+
+	"github.com/needmore/bc4/internal/api"
+)
+
+// subTodoMarker prefixes a sub-todo's title, after its indentation
+const subTodoMarker = "└ "
+
+// nestTodos orders todos so each sub-todo follows its parent todo, and
+// indents the sub-todos' titles by their depth. Basecamp usually nests todos
+// only under lists and groups, so todos whose parent isn't one of the given
+// todos stay at the top level in their original order.
+func nestTodos(todos []api.Todo) []api.Todo {
+	present := make(map[int64]bool, len(todos))
+	for _, todo := range todos {
+		present[todo.ID] = true
+	}
+
+	children := make(map[int64][]api.Todo)
+	var roots []api.Todo
+	for _, todo := range todos {
+		if parentID, ok := parentTodoID(todo); ok && present[parentID] && parentID != todo.ID {
+			children[parentID] = append(children[parentID], todo)
+			continue
+		}
+		roots = append(roots, todo)
+	}
+	if len(children) == 0 {
+		return todos
+	}
+
+	nested := make([]api.Todo, 0, len(todos))
+	visited := make(map[int64]bool, len(todos))
+	var walk func(todo api.Todo, depth int)
+	walk = func(todo api.Todo, depth int) {
+		if visited[todo.ID] {
+			return
+		}
+		visited[todo.ID] = true
+		nested = append(nested, indentTodo(todo, depth))
+		for _, child := range children[todo.ID] {
+			walk(child, depth+1)
+		}
+	}
+	for _, root := range roots {
+		walk(root, 0)
+	}
+
+	// Todos in a parent cycle have no root; keep them rather than drop them
+	for _, todo := range todos {
+		if !visited[todo.ID] {
+			walk(todo, 0)
+		}
+	}
+	return nested
+}
+
+// parentTodoID returns the ID of the todo's parent when that parent is
+// itself a todo
+func parentTodoID(todo api.Todo) (int64, bool) {
+	if todo.Parent == nil || todo.Parent.Type != "Todo" {
+		return 0, false
+	}
+	return todo.Parent.ID, true
+}
+
+// indentTodo returns a copy of todo with its title indented to depth
+func indentTodo(todo api.Todo, depth int) api.Todo {
+	if depth == 0 {
+		return todo
+	}
+	prefix := strings.Repeat("  ", depth-1) + subTodoMarker
+	todo.Title = prefix + todo.Title
+	if todo.Content != "" {
+		todo.Content = prefix + todo.Content
+	}
+	return todo
+}
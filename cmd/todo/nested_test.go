@@ -0,0 +1,54 @@
+package todo
+
+import (
+	"testing"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func nestedTitles(todos []api.Todo) []string {
+	titles := make([]string, len(todos))
+	for i, todo := range todos {
+		titles[i] = todo.Title
+	}
+	return titles
+}
+
+func TestNestTodos(t *testing.T) {
+	listParent := &api.Parent{ID: 1, Type: "Todolist"}
+
+	t.Run("list children stay flat", func(t *testing.T) {
+		todos := []api.Todo{
+			{ID: 10, Title: "First", Parent: listParent},
+			{ID: 11, Title: "Second", Parent: listParent},
+		}
+		assert.Equal(t, todos, nestTodos(todos))
+	})
+
+	t.Run("sub-todos follow their parent", func(t *testing.T) {
+		todos := []api.Todo{
+			{ID: 20, Title: "Grandchild", Parent: &api.Parent{ID: 12, Type: "Todo"}},
+			{ID: 10, Title: "Parent", Parent: listParent},
+			{ID: 11, Title: "Other", Parent: listParent},
+			{ID: 12, Title: "Child", Content: "Child", Parent: &api.Parent{ID: 10, Type: "Todo"}},
+		}
+		got := nestTodos(todos)
+		assert.Equal(t, []string{"Parent", "└ Child", "  └ Grandchild", "Other"}, nestedTitles(got))
+		assert.Equal(t, "└ Child", got[1].Content)
+		assert.Equal(t, "Child", todos[3].Title, "input todos are not modified")
+	})
+
+	t.Run("parent outside the list", func(t *testing.T) {
+		todos := []api.Todo{{ID: 10, Title: "Orphan", Parent: &api.Parent{ID: 99, Type: "Todo"}}}
+		assert.Equal(t, []string{"Orphan"}, nestedTitles(nestTodos(todos)))
+	})
+
+	t.Run("cycles are kept", func(t *testing.T) {
+		todos := []api.Todo{
+			{ID: 10, Title: "A", Parent: &api.Parent{ID: 11, Type: "Todo"}},
+			{ID: 11, Title: "B", Parent: &api.Parent{ID: 10, Type: "Todo"}},
+		}
+		assert.Equal(t, []string{"A", "└ B"}, nestedTitles(nestTodos(todos)))
+	})
+}
@@ -0,0 +1,49 @@
+package todo
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"strconv"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/parser"
+)
+
+// resolveNotifyCampfire resolves a --notify-campfire value (ID, name, or URL)
+// to a campfire ID
+func resolveNotifyCampfire(ctx context.Context, campfireOps api.CampfireOperations, projectID, ref string) (int64, error) {
+	if parser.IsBasecampURL(ref) {
+		parsed, err := parser.ParseBasecampURL(ref)
+		if err != nil {
+			return 0, fmt.Errorf("invalid Basecamp URL: %w", err)
+		}
+		if parsed.ResourceType != parser.ResourceTypeCampfire {
+			return 0, fmt.Errorf("URL is not a campfire URL: %s", ref)
+		}
+		return parsed.ResourceID, nil
+	}
+	if id, err := strconv.ParseInt(ref, 10, 64); err == nil {
+		return id, nil
+	}
+	cf, err := campfireOps.GetCampfireByName(ctx, projectID, ref)
+	if err != nil {
+		return 0, fmt.Errorf("campfire '%s' not found", ref)
+	}
+	return cf.ID, nil
+}
+
+// todoNotice formats the campfire line announcing a new todo
+func todoNotice(todo *api.Todo, webURL string) string {
+	return fmt.Sprintf(`New todo: <a href="%s">%s</a>`, html.EscapeString(webURL), html.EscapeString(todo.Title))
+}
+
+// notifyCampfire posts a line linking todo to the campfire
+func notifyCampfire(ctx context.Context, campfireOps api.CampfireOperations, accountID, projectID string, campfireID int64, todo *api.Todo) error {
+	webURL, err := parser.BuildWebURL(accountID, projectID, parser.ResourceTypeTodo, todo.ID)
+	if err != nil {
+		return err
+	}
+	_, err = campfireOps.PostCampfireLine(ctx, projectID, campfireID, todoNotice(todo, webURL), "text/html")
+	return err
+}
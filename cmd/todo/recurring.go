@@ -0,0 +1,60 @@
+package todo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/utils"
+)
+
+// recurringRequest checks the --recurring flags and builds the check-in
+// question to create in place of a todo. Flags that only make sense for a
+// todo are rejected rather than silently ignored.
+func recurringRequest(opts *addOptions, args []string) (api.QuestionCreateRequest, error) {
+	var req api.QuestionCreateRequest
+
+	if len(args) != 1 {
+		return req, fmt.Errorf("--recurring takes exactly one title, which becomes the check-in question")
+	}
+	switch {
+	case opts.list != "", opts.group != "":
+		return req, fmt.Errorf("--recurring cannot be used with --list or --group")
+	case opts.due != "", opts.dueAnchor != "":
+		return req, fmt.Errorf("--recurring cannot be used with --due")
+	case len(opts.assign) > 0, len(opts.attach) > 0, opts.description != "":
+		return req, fmt.Errorf("--recurring cannot be used with --assign, --attach, or --description")
+	case opts.file != "", opts.contentFromTodo != "", opts.notifyCampfire != "":
+		return req, fmt.Errorf("--recurring cannot be used with --file, --content-from-todo, or --notify-campfire")
+	}
+
+	timeOfDay := opts.recurringTime
+	if timeOfDay == "" {
+		timeOfDay = "09:00"
+	}
+	hour, minute, err := utils.ParseTimeOfDay(timeOfDay)
+	if err != nil {
+		return req, err
+	}
+
+	return utils.CheckinQuestion(todoContentTitle(args[0]), opts.recurring, opts.recurringDays, hour, minute)
+}
+
+// createRecurringQuestion creates req as a check-in question in the project,
+// explaining why when check-ins aren't enabled there
+func createRecurringQuestion(ctx context.Context, ops api.QuestionOperations, projectID string, req api.QuestionCreateRequest) (*api.Question, error) {
+	questionnaire, err := ops.GetProjectQuestionnaire(ctx, projectID)
+	if errors.Is(err, api.ErrNoQuestionnaire) {
+		return nil, fmt.Errorf("todos can't repeat; --recurring creates a check-in question instead, but Automatic Check-ins aren't enabled in this project")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get questionnaire: %w", err)
+	}
+
+	question, err := ops.CreateQuestion(ctx, projectID, questionnaire.ID, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create check-in question: %w", err)
+	}
+	return question, nil
+}
@@ -0,0 +1,306 @@
+package todo
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/config"
+	"github.com/needmore/bc4/internal/parser"
+	"github.com/needmore/bc4/internal/ui"
+	"github.com/needmore/bc4/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+// nameCandidate is a todo list or group that a name argument may refer to
+type nameCandidate struct {
+	id        int64
+	title     string
+	name      string
+	updatedAt string
+}
+
+// matchPolicy decides what happens when a name matches several lists or groups
+type matchPolicy string
+
+const (
+	// matchError asks on a terminal and fails otherwise
+	matchError matchPolicy = "error"
+	// matchFirst picks the first match in API order
+	matchFirst matchPolicy = "first"
+	// matchNewest picks the most recently updated match
+	matchNewest matchPolicy = "newest"
+)
+
+// parseMatchPolicy checks a --list-match value
+func parseMatchPolicy(s string) (matchPolicy, error) {
+	switch policy := matchPolicy(s); policy {
+	case matchError, matchFirst, matchNewest:
+		return policy, nil
+	}
+	return "", fmt.Errorf("invalid --list-match %q: must be first, newest, or error", s)
+}
+
+// addListMatchFlag registers the --list-match flag shared by the commands
+// that resolve todo lists by name
+func addListMatchFlag(cmd *cobra.Command, p *string) {
+	cmd.Flags().StringVar(p, "list-match", string(matchError),
+		"When a name matches several lists: first, newest (most recently updated), or error")
+}
+
+// resolveTodoList resolves a todo list ID, name, or URL to a list ID. Lists
+// are only fetched when a name needs to be matched.
+func resolveTodoList(arg string, policy matchPolicy, fetchLists func() ([]api.TodoList, error)) (int64, error) {
+	if id, ok, err := parseIDOrURL(arg, parser.ResourceTypeTodoList, "todo list"); ok || err != nil {
+		return id, err
+	}
+
+	lists, err := fetchLists()
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch todo lists: %w", err)
+	}
+	candidates := make([]nameCandidate, 0, len(lists))
+	for _, list := range lists {
+		candidates = append(candidates, nameCandidate{id: list.ID, title: list.Title, name: list.Name, updatedAt: list.UpdatedAt})
+	}
+	return resolveName(arg, "todo list", policy, candidates)
+}
+
+// resolveListOrDefault resolves the todo list given as the first of args,
+// or the project's default list (set with 'todo select') when there are no
+// args. The project's todo set and lists are only fetched when a name needs
+// to be matched.
+func resolveListOrDefault(ctx context.Context, todoOps api.TodoOperations, cfg *config.Config, accountID, projectID string, args []string, policy matchPolicy, fetchLists func(todoSetID int64) ([]api.TodoList, error)) (int64, error) {
+	if len(args) == 0 {
+		defaultTodoListID := ""
+		if cfg.Accounts != nil && cfg.Accounts[accountID].ProjectDefaults != nil {
+			if projDefaults, ok := cfg.Accounts[accountID].ProjectDefaults[projectID]; ok {
+				defaultTodoListID = projDefaults.DefaultTodoList
+			}
+		}
+		if defaultTodoListID == "" {
+			return 0, fmt.Errorf("no todo list specified and no default set. Use 'todo select' to set a default")
+		}
+		todoListID, err := strconv.ParseInt(defaultTodoListID, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid default todo list %q; use 'todo select' to set it again", defaultTodoListID)
+		}
+		return todoListID, nil
+	}
+
+	// Accepts an ID, URL, or name
+	return resolveTodoList(args[0], policy, func() ([]api.TodoList, error) {
+		todoSet, err := todoOps.GetProjectTodoSet(ctx, projectID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get project todo set: %w", err)
+		}
+		return fetchLists(todoSet.ID)
+	})
+}
+
+// resolveTodoGroup resolves a todo group ID, name, or URL to a group ID.
+// Groups are only fetched when a name needs to be matched.
+func resolveTodoGroup(arg string, policy matchPolicy, fetchGroups func() ([]api.TodoGroup, error)) (int64, error) {
+	if id, ok, err := parseIDOrURL(arg, parser.ResourceTypeTodoGroup, "todo group"); ok || err != nil {
+		return id, err
+	}
+
+	groups, err := fetchGroups()
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch todo groups: %w", err)
+	}
+	candidates := make([]nameCandidate, 0, len(groups))
+	for _, group := range groups {
+		candidates = append(candidates, nameCandidate{id: group.ID, title: group.Title, name: group.Name, updatedAt: group.UpdatedAt})
+	}
+	return resolveName(arg, "todo group", policy, candidates)
+}
+
+// resolveListGroup resolves a todo group ID, name, or URL within the todo
+// list listID. The API accepts any list-like ID as a group, so a group given
+// by ID or URL is checked against the list's groups; otherwise a typo could
+// post into an unrelated list.
+func resolveListGroup(arg string, listID int64, policy matchPolicy, fetchGroups func() ([]api.TodoGroup, error)) (int64, error) {
+	id, ok, err := parseIDOrURL(arg, parser.ResourceTypeTodoGroup, "todo group")
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		// Names are only matched against the list's own groups
+		return resolveTodoGroup(arg, policy, fetchGroups)
+	}
+
+	groups, err := fetchGroups()
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch todo groups: %w", err)
+	}
+	for _, group := range groups {
+		if group.ID == id {
+			return id, nil
+		}
+	}
+	return 0, fmt.Errorf("todo group %d is not in todo list %d", id, listID)
+}
+
+// resolveProject resolves a project ID, name, or URL to a project ID.
+// Projects are only fetched when a name needs to be matched.
+func resolveProject(arg string, fetchProjects func() ([]api.Project, error)) (string, error) {
+	if id, ok, err := parseIDOrURL(arg, parser.ResourceTypeProject, "project"); ok || err != nil {
+		return strconv.FormatInt(id, 10), err
+	}
+
+	projects, err := fetchProjects()
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch projects: %w", err)
+	}
+	candidates := make([]nameCandidate, 0, len(projects))
+	for _, project := range projects {
+		candidates = append(candidates, nameCandidate{id: project.ID, title: project.Name, updatedAt: project.UpdatedAt})
+	}
+	id, err := resolveName(arg, "project", matchError, candidates)
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(id, 10), nil
+}
+
+// parseIDOrURL handles the numeric ID and Basecamp URL forms of a list or
+// group argument. ok is false when arg should be matched by name instead.
+func parseIDOrURL(arg string, resourceType parser.ResourceType, kind string) (int64, bool, error) {
+	if parser.IsBasecampURL(arg) {
+		parsed, err := parser.ParseBasecampURL(arg)
+		if err != nil {
+			return 0, false, fmt.Errorf("invalid Basecamp URL: %w", err)
+		}
+		if parsed.ResourceType != resourceType {
+			return 0, false, fmt.Errorf("URL is not a %s URL: %s", kind, arg)
+		}
+		return parsed.ResourceID, true, nil
+	}
+	if id, err := strconv.ParseInt(arg, 10, 64); err == nil {
+		return id, true, nil
+	}
+	return 0, false, nil
+}
+
+// resolveName picks the candidate matching arg. Exact (case-insensitive)
+// matches win over partial title matches. When several candidates match, the
+// policy picks one, or with matchError the user picks one on a terminal and
+// it's an error otherwise.
+func resolveName(arg, kind string, policy matchPolicy, candidates []nameCandidate) (int64, error) {
+	var matches []nameCandidate
+	indexes, _ := utils.MatchIDOrName(candidates, arg, true, nil,
+		func(c nameCandidate) string { return c.title },
+		func(c nameCandidate) string { return c.name })
+	for _, i := range indexes {
+		matches = append(matches, candidates[i])
+	}
+	switch len(matches) {
+	case 0:
+		return 0, fmt.Errorf("%s not found: %s", kind, arg)
+	case 1:
+		return matches[0].id, nil
+	}
+
+	switch policy {
+	case matchFirst:
+		return matches[0].id, nil
+	case matchNewest:
+		return newestCandidate(matches).id, nil
+	}
+
+	if !ui.IsTerminal(os.Stdout) || !ui.IsTerminal(os.Stdin) {
+		return 0, fmt.Errorf("multiple %ss match '%s'. Please be more specific or use the %s ID", kind, arg, kind)
+	}
+
+	result, err := tea.NewProgram(newMatchPickerModel(fmt.Sprintf("Multiple %ss match '%s'", kind, arg), matches)).Run()
+	if err != nil {
+		return 0, fmt.Errorf("error running picker: %w", err)
+	}
+	picker := result.(matchPickerModel)
+	if picker.chosen == nil {
+		return 0, fmt.Errorf("no %s selected", kind)
+	}
+	return picker.chosen.id, nil
+}
+
+// newestCandidate returns the most recently updated candidate, preferring
+// the earlier one on ties or unparseable times
+func newestCandidate(candidates []nameCandidate) nameCandidate {
+	newest := candidates[0]
+	newestAt, _ := time.Parse(time.RFC3339, newest.updatedAt)
+	for _, c := range candidates[1:] {
+		if at, err := time.Parse(time.RFC3339, c.updatedAt); err == nil && at.After(newestAt) {
+			newest, newestAt = c, at
+		}
+	}
+	return newest
+}
+
+// matchPickerModel is a small inline picker for ambiguous names
+type matchPickerModel struct {
+	title   string
+	options []nameCandidate
+	cursor  int
+	chosen  *nameCandidate
+}
+
+func newMatchPickerModel(title string, options []nameCandidate) matchPickerModel {
+	return matchPickerModel{title: title, options: options}
+}
+
+func (m matchPickerModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m matchPickerModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch msg.String() {
+		case "ctrl+c", "q", "esc":
+			return m, tea.Quit
+		case "up", "k":
+			if m.cursor > 0 {
+				m.cursor--
+			}
+		case "down", "j":
+			if m.cursor < len(m.options)-1 {
+				m.cursor++
+			}
+		case "enter":
+			chosen := m.options[m.cursor]
+			m.chosen = &chosen
+			return m, tea.Quit
+		}
+	}
+	return m, nil
+}
+
+func (m matchPickerModel) View() string {
+	// Clear the picker once a choice is made
+	if m.chosen != nil {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString(titleStyle.Render(m.title))
+	b.WriteString("\n")
+	for i, option := range m.options {
+		line := fmt.Sprintf("%s (ID: %d)", option.title, option.id)
+		if i == m.cursor {
+			b.WriteString(selectedItemStyle.Render("> " + line))
+		} else {
+			b.WriteString(normalItemStyle.Render("  " + line))
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
+	b.WriteString(helpStyle.Render("↑/↓: Navigate • Enter: Select • Esc: Cancel"))
+	b.WriteString("\n")
+	return b.String()
+}
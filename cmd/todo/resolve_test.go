@@ -0,0 +1,172 @@
+package todo
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/api/mock"
+	"github.com/needmore/bc4/internal/config"
+)
+
+func TestResolveTodoList(t *testing.T) {
+	lists := []api.TodoList{{ID: 1, Title: "Sprint 1"}, {ID: 2, Title: "Sprint 2"}}
+	fetch := func() ([]api.TodoList, error) { return lists, nil }
+
+	id, err := resolveTodoList("Sprint 2", matchError, fetch)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), id)
+
+	id, err = resolveTodoList("42", matchError, func() ([]api.TodoList, error) {
+		return nil, errors.New("lists should not be fetched for an ID")
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), id)
+
+	_, err = resolveTodoList("Missing", matchError, fetch)
+	assert.EqualError(t, err, "todo list not found: Missing")
+
+	// Tests don't run on a terminal, so ambiguity is an error rather than a prompt
+	_, err = resolveTodoList("Sprint", matchError, fetch)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "multiple todo lists match 'Sprint'")
+}
+
+func TestResolveListOrDefault(t *testing.T) {
+	cfg := &config.Config{Accounts: map[string]config.AccountConfig{
+		"1": {ProjectDefaults: map[string]config.ProjectDefaults{"9": {DefaultTodoList: "77"}}},
+	}}
+	client := mock.NewMockClient()
+	client.TodoSet = &api.TodoSet{ID: 5}
+	var fetchedSet int64
+	fetch := func(todoSetID int64) ([]api.TodoList, error) {
+		fetchedSet = todoSetID
+		return []api.TodoList{{ID: 3, Title: "Sprint"}}, nil
+	}
+	ctx := context.Background()
+
+	id, err := resolveListOrDefault(ctx, client, cfg, "1", "9", nil, matchError, fetch)
+	require.NoError(t, err)
+	assert.Equal(t, int64(77), id)
+	assert.Empty(t, client.Calls, "the default list needs no lookups")
+
+	id, err = resolveListOrDefault(ctx, client, cfg, "1", "9", []string{"Sprint"}, matchError, fetch)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), id)
+	assert.Equal(t, int64(5), fetchedSet)
+
+	_, err = resolveListOrDefault(ctx, client, cfg, "1", "8", nil, matchError, fetch)
+	assert.ErrorContains(t, err, "no default set")
+}
+
+func TestResolveProject(t *testing.T) {
+	projects := []api.Project{{ID: 10, Name: "Website"}, {ID: 20, Name: "Mobile App"}}
+	fetch := func() ([]api.Project, error) { return projects, nil }
+
+	id, err := resolveProject("mobile app", fetch)
+	require.NoError(t, err)
+	assert.Equal(t, "20", id)
+
+	id, err = resolveProject("https://3.basecamp.com/1/projects/30", func() ([]api.Project, error) {
+		return nil, errors.New("projects should not be fetched for a URL")
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "30", id)
+
+	_, err = resolveProject("Intranet", fetch)
+	assert.EqualError(t, err, "project not found: Intranet")
+}
+
+func TestResolveTodoList_MatchPolicy(t *testing.T) {
+	lists := []api.TodoList{
+		{ID: 1, Title: "Sprint 1", UpdatedAt: "2025-01-02T10:00:00Z"},
+		{ID: 2, Title: "Sprint 2", UpdatedAt: "2025-03-01T10:00:00Z"},
+		{ID: 3, Title: "Sprint 3", UpdatedAt: "2025-02-01T10:00:00Z"},
+	}
+	fetch := func() ([]api.TodoList, error) { return lists, nil }
+
+	id, err := resolveTodoList("Sprint", matchFirst, fetch)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), id)
+
+	id, err = resolveTodoList("Sprint", matchNewest, fetch)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), id)
+
+	// Policies only apply to ambiguous names
+	id, err = resolveTodoList("Sprint 3", matchFirst, fetch)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), id)
+}
+
+func TestParseMatchPolicy(t *testing.T) {
+	for _, s := range []string{"first", "newest", "error"} {
+		policy, err := parseMatchPolicy(s)
+		require.NoError(t, err)
+		assert.Equal(t, matchPolicy(s), policy)
+	}
+
+	_, err := parseMatchPolicy("last")
+	assert.ErrorContains(t, err, "invalid --list-match")
+}
+
+func TestResolveTodoGroup_URL(t *testing.T) {
+	fetch := func() ([]api.TodoGroup, error) { return nil, errors.New("unexpected fetch") }
+
+	id, err := resolveTodoGroup("https://3.basecamp.com/1/buckets/2/todolists/3/groups/99", matchError, fetch)
+	require.NoError(t, err)
+	assert.Equal(t, int64(99), id)
+
+	_, err = resolveTodoGroup("https://3.basecamp.com/1/buckets/2/todosets/3/todolists/99", matchError, fetch)
+	assert.ErrorContains(t, err, "URL is not a todo group URL")
+}
+
+func TestResolveListGroup(t *testing.T) {
+	fetch := func() ([]api.TodoGroup, error) {
+		return []api.TodoGroup{{ID: 10, Title: "In Progress"}, {ID: 11, Title: "Done"}}, nil
+	}
+
+	id, err := resolveListGroup("11", 3, matchError, fetch)
+	require.NoError(t, err)
+	assert.Equal(t, int64(11), id)
+
+	id, err = resolveListGroup("https://3.basecamp.com/1/buckets/2/todolists/3/groups/10", 3, matchError, fetch)
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), id)
+
+	id, err = resolveListGroup("done", 3, matchError, fetch)
+	require.NoError(t, err)
+	assert.Equal(t, int64(11), id)
+
+	// A group from another list, or any other ID, is refused
+	_, err = resolveListGroup("99", 3, matchError, fetch)
+	assert.EqualError(t, err, "todo group 99 is not in todo list 3")
+
+	_, err = resolveListGroup("https://3.basecamp.com/1/buckets/2/todolists/4/groups/99", 3, matchError, fetch)
+	assert.EqualError(t, err, "todo group 99 is not in todo list 3")
+
+	_, err = resolveListGroup("11", 3, matchError, func() ([]api.TodoGroup, error) {
+		return nil, errors.New("boom")
+	})
+	assert.ErrorContains(t, err, "failed to fetch todo groups")
+}
+
+func TestMatchPickerModel(t *testing.T) {
+	m := newMatchPickerModel("Pick", []nameCandidate{{id: 1, title: "One"}, {id: 2, title: "Two"}})
+
+	model, _ := m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	model, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+
+	picker := model.(matchPickerModel)
+	require.NotNil(t, picker.chosen)
+	assert.Equal(t, int64(2), picker.chosen.id)
+
+	model, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Nil(t, model.(matchPickerModel).chosen, "cancelling should not choose anything")
+}
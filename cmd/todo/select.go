@@ -24,12 +24,18 @@ type todoListsLoadedMsg struct {
 	err       error
 }
 
+// defaultSavedMsg reports the result of saving the chosen default
+type defaultSavedMsg struct {
+	err error
+}
+
 type selectModel struct {
 	list      list.Model
 	todoLists []api.TodoList
 	spinner   spinner.Model
 	loading   bool
 	err       error
+	saveErr   error
 	width     int
 	height    int
 	projectID string
@@ -117,6 +123,10 @@ func (m selectModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.list.Styles.TitleBar = lipgloss.NewStyle()
 		return m, nil
 
+	case defaultSavedMsg:
+		m.saveErr = msg.err
+		return m, nil
+
 	case spinner.TickMsg:
 		var cmd tea.Cmd
 		m.spinner, cmd = m.spinner.Update(msg)
@@ -184,33 +194,32 @@ func (m *selectModel) loadTodoLists() tea.Cmd {
 
 func (m *selectModel) saveDefaultTodoList(todoList api.TodoList) tea.Cmd {
 	return func() tea.Msg {
-		cfg, err := m.factory.Config()
-		if err != nil {
-			return nil
-		}
-
 		// Get resolved account ID
 		resolvedAccountID, err := m.factory.AccountID()
 		if err != nil {
-			return nil
+			return defaultSavedMsg{err: err}
 		}
 
 		// Update config
-		if cfg.Accounts == nil {
-			cfg.Accounts = make(map[string]config.AccountConfig)
-		}
-
-		acc := cfg.Accounts[resolvedAccountID]
-		if acc.ProjectDefaults == nil {
-			acc.ProjectDefaults = make(map[string]config.ProjectDefaults)
-		}
+		err = config.Update(func(cfg *config.Config) error {
+			if cfg.Accounts == nil {
+				cfg.Accounts = make(map[string]config.AccountConfig)
+			}
 
-		projDefaults := acc.ProjectDefaults[m.projectID]
-		projDefaults.DefaultTodoList = strconv.FormatInt(todoList.ID, 10)
-		acc.ProjectDefaults[m.projectID] = projDefaults
-		cfg.Accounts[resolvedAccountID] = acc
+			acc := cfg.Accounts[resolvedAccountID]
+			if acc.ProjectDefaults == nil {
+				acc.ProjectDefaults = make(map[string]config.ProjectDefaults)
+			}
 
-		_ = config.Save(cfg)
+			projDefaults := acc.ProjectDefaults[m.projectID]
+			projDefaults.DefaultTodoList = strconv.FormatInt(todoList.ID, 10)
+			acc.ProjectDefaults[m.projectID] = projDefaults
+			cfg.Accounts[resolvedAccountID] = acc
+			return nil
+		})
+		if err != nil {
+			return defaultSavedMsg{err: fmt.Errorf("failed to save config: %w", err)}
+		}
 
 		fmt.Printf("\nDefault todo list set to: %s (ID: %d)\n", todoList.Title, todoList.ID)
 		return nil
@@ -330,9 +339,13 @@ func newSelectCmd(f *factory.Factory) *cobra.Command {
 			}
 
 			// Run the interactive selector
-			if _, err := tea.NewProgram(m, tea.WithAltScreen()).Run(); err != nil {
+			final, err := tea.NewProgram(m, tea.WithAltScreen()).Run()
+			if err != nil {
 				return fmt.Errorf("error running selector: %w", err)
 			}
+			if sm, ok := final.(selectModel); ok && sm.saveErr != nil {
+				return sm.saveErr
+			}
 
 			return nil
 		},
@@ -31,12 +31,6 @@ func newSetCmd(f *factory.Factory) *cobra.Command {
 				f = f.WithProject(projectID)
 			}
 
-			// Get config
-			cfg, err := f.Config()
-			if err != nil {
-				return err
-			}
-
 			// Get resolved account ID
 			resolvedAccountID, err := f.AccountID()
 			if err != nil {
@@ -50,22 +44,23 @@ func newSetCmd(f *factory.Factory) *cobra.Command {
 			}
 
 			// Update config
-			if cfg.Accounts == nil {
-				cfg.Accounts = make(map[string]config.AccountConfig)
-			}
-
-			acc := cfg.Accounts[resolvedAccountID]
-			if acc.ProjectDefaults == nil {
-				acc.ProjectDefaults = make(map[string]config.ProjectDefaults)
-			}
-
-			projDefaults := acc.ProjectDefaults[resolvedProjectID]
-			projDefaults.DefaultTodoList = todoListID
-			acc.ProjectDefaults[resolvedProjectID] = projDefaults
-			cfg.Accounts[resolvedAccountID] = acc
-
-			// Save config
-			if err := config.Save(cfg); err != nil {
+			err = config.Update(func(cfg *config.Config) error {
+				if cfg.Accounts == nil {
+					cfg.Accounts = make(map[string]config.AccountConfig)
+				}
+
+				acc := cfg.Accounts[resolvedAccountID]
+				if acc.ProjectDefaults == nil {
+					acc.ProjectDefaults = make(map[string]config.ProjectDefaults)
+				}
+
+				projDefaults := acc.ProjectDefaults[resolvedProjectID]
+				projDefaults.DefaultTodoList = todoListID
+				acc.ProjectDefaults[resolvedProjectID] = projDefaults
+				cfg.Accounts[resolvedAccountID] = acc
+				return nil
+			})
+			if err != nil {
 				return fmt.Errorf("failed to save config: %w", err)
 			}
 
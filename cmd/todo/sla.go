@@ -0,0 +1,103 @@
+package todo
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/ui/tableprinter"
+	"github.com/needmore/bc4/internal/utils"
+)
+
+// todoSLA flags incomplete todos that have been open longer than a threshold.
+// Each todo's age is worked out once and reused.
+type todoSLA struct {
+	threshold time.Duration
+	now       time.Time
+	ages      map[int64]time.Duration
+}
+
+// newTodoSLA parses an --sla threshold like 12h, 3d, or 2w
+func newTodoSLA(value string, now time.Time) (*todoSLA, error) {
+	value = strings.TrimSpace(strings.ToLower(value))
+
+	if span, ok := utils.ParseSpan(value); ok && span.Count > 0 {
+		return &todoSLA{
+			threshold: span.Duration(),
+			now:       now,
+			ages:      make(map[int64]time.Duration),
+		}, nil
+	}
+
+	return nil, fmt.Errorf("invalid --sla %q: use a threshold like 12h, 3d, or 2w", value)
+}
+
+// age returns how long ago the todo was created, and false when its
+// creation time can't be read
+func (s *todoSLA) age(todo api.Todo) (time.Duration, bool) {
+	if age, ok := s.ages[todo.ID]; ok {
+		return age, true
+	}
+	created, err := time.Parse(time.RFC3339, todo.CreatedAt)
+	if err != nil {
+		return 0, false
+	}
+	age := s.now.Sub(created)
+	s.ages[todo.ID] = age
+	return age, true
+}
+
+// ageDays returns the todo's age in whole days
+func (s *todoSLA) ageDays(todo api.Todo) (int, bool) {
+	age, ok := s.age(todo)
+	if !ok {
+		return 0, false
+	}
+	return int(age / (24 * time.Hour)), true
+}
+
+// breached reports whether the todo is still open and older than the
+// threshold. A nil SLA flags nothing.
+func (s *todoSLA) breached(todo api.Todo) bool {
+	if s == nil || todo.Completed {
+		return false
+	}
+	age, ok := s.age(todo)
+	return ok && age > s.threshold
+}
+
+// ageLabel formats the todo's age compactly, in hours under a day and days
+// after that
+func (s *todoSLA) ageLabel(todo api.Todo) string {
+	age, ok := s.age(todo)
+	if !ok {
+		return ""
+	}
+	if age < 24*time.Hour {
+		return fmt.Sprintf("%dh", int(age.Hours()))
+	}
+	return fmt.Sprintf("%dd", int(age/(24*time.Hour)))
+}
+
+// addAgeField adds the todo's age to the table, in red on a terminal when
+// it breaches the SLA
+func (s *todoSLA) addAgeField(table *tableprinter.TablePrinter, todo api.Todo) {
+	cs := table.GetColorScheme()
+	if table.IsTTY() && s.breached(todo) {
+		table.AddField(s.ageLabel(todo), cs.Red)
+		return
+	}
+	table.AddField(s.ageLabel(todo), cs.Muted)
+}
+
+// filterSLABreached keeps only the todos that breach the SLA
+func filterSLABreached(todos []api.Todo, sla *todoSLA) []api.Todo {
+	var kept []api.Todo
+	for _, todo := range todos {
+		if sla.breached(todo) {
+			kept = append(kept, todo)
+		}
+	}
+	return kept
+}
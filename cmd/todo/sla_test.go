@@ -0,0 +1,71 @@
+package todo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTodoSLA(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	for value, want := range map[string]time.Duration{
+		"12h": 12 * time.Hour,
+		"3d":  72 * time.Hour,
+		"2W":  14 * 24 * time.Hour,
+	} {
+		sla, err := newTodoSLA(value, now)
+		require.NoError(t, err, value)
+		assert.Equal(t, want, sla.threshold, value)
+	}
+
+	for _, value := range []string{"", "3", "0d", "-1d", "3m", "soon"} {
+		_, err := newTodoSLA(value, now)
+		assert.ErrorContains(t, err, "invalid --sla", value)
+	}
+}
+
+func TestTodoSLA(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	sla, err := newTodoSLA("3d", now)
+	require.NoError(t, err)
+
+	old := api.Todo{ID: 1, CreatedAt: "2026-03-05T12:00:00Z"}
+	recent := api.Todo{ID: 2, CreatedAt: "2026-03-10T07:00:00Z"}
+	oldDone := api.Todo{ID: 3, CreatedAt: "2026-03-01T12:00:00Z", Completed: true}
+	unknown := api.Todo{ID: 4, CreatedAt: "not a time"}
+
+	assert.True(t, sla.breached(old))
+	assert.False(t, sla.breached(recent))
+	assert.False(t, sla.breached(oldDone), "completed todos never breach")
+	assert.False(t, sla.breached(unknown))
+
+	assert.Equal(t, "5d", sla.ageLabel(old))
+	assert.Equal(t, "5h", sla.ageLabel(recent))
+	assert.Equal(t, "", sla.ageLabel(unknown))
+
+	days, ok := sla.ageDays(old)
+	assert.True(t, ok)
+	assert.Equal(t, 5, days)
+
+	kept := filterSLABreached([]api.Todo{old, recent, oldDone, unknown}, sla)
+	require.Len(t, kept, 1)
+	assert.Equal(t, int64(1), kept[0].ID)
+
+	var nilSLA *todoSLA
+	assert.False(t, nilSLA.breached(old))
+}
+
+func TestShapeTodoJSONAgeDays(t *testing.T) {
+	sla, err := newTodoSLA("3d", time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+
+	value, err := shapeTodoJSON(api.Todo{ID: 1, CreatedAt: "2026-03-05T12:00:00Z"}, todoContentOptions{sla: sla})
+	require.NoError(t, err)
+	fields, ok := value.(map[string]interface{})
+	require.True(t, ok)
+	assert.Equal(t, 5, fields["age_days"])
+}
@@ -0,0 +1,50 @@
+package todo
+
+import (
+	"github.com/needmore/bc4/internal/api"
+)
+
+// staleAssigneeSuffix marks assignees who are no longer on the project in
+// table output
+const staleAssigneeSuffix = " (not in project)"
+
+// staleAssignees returns the assignees of todo who aren't in current
+func staleAssignees(todo api.Todo, current map[int64]bool) []api.Person {
+	stale := []api.Person{}
+	for _, a := range todo.Assignees {
+		if !current[a.ID] {
+			stale = append(stale, a)
+		}
+	}
+	return stale
+}
+
+// filterStaleAssigned returns the todos with at least one assignee who isn't
+// in current
+func filterStaleAssigned(todos []api.Todo, current map[int64]bool) []api.Todo {
+	var kept []api.Todo
+	for _, todo := range todos {
+		if len(staleAssignees(todo, current)) > 0 {
+			kept = append(kept, todo)
+		}
+	}
+	return kept
+}
+
+// markStaleAssignees returns copies of todos whose stale assignees' names
+// carry staleAssigneeSuffix, so every table layout highlights them
+func markStaleAssignees(todos []api.Todo, current map[int64]bool) []api.Todo {
+	marked := make([]api.Todo, len(todos))
+	for i, todo := range todos {
+		assignees := make([]api.Person, len(todo.Assignees))
+		for j, a := range todo.Assignees {
+			if !current[a.ID] {
+				a.Name += staleAssigneeSuffix
+			}
+			assignees[j] = a
+		}
+		todo.Assignees = assignees
+		marked[i] = todo
+	}
+	return marked
+}
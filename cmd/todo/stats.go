@@ -0,0 +1,241 @@
+package todo
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/ui"
+	"github.com/needmore/bc4/internal/utils"
+)
+
+// maxTrendDays caps --trend so the sparkline stays readable
+const maxTrendDays = 365
+
+// sparkBlocks are the sparkline levels, lowest first
+var sparkBlocks = []rune("▁▂▃▄▅▆▇█")
+
+// trendBucket counts the todos completed on one day
+type trendBucket struct {
+	Date           string `json:"date"`
+	CompletedCount int    `json:"completed_count"`
+}
+
+// todoStats is the JSON shape of 'todo stats'
+type todoStats struct {
+	ListID        int64         `json:"list_id"`
+	List          string        `json:"list"`
+	RemainingOpen int           `json:"remaining_open"`
+	Completed     int           `json:"completed"`
+	TrendDays     int           `json:"trend_days"`
+	Trend         []trendBucket `json:"trend"`
+}
+
+func newStatsCmd(f *factory.Factory) *cobra.Command {
+	var accountID string
+	var projectID string
+	var formatStr string
+	var trend string
+	var listMatch string
+
+	cmd := &cobra.Command{
+		Use:   "stats [list-id|name]",
+		Short: "Show a todo list's progress and completion trend",
+		Long: `Show how many todos in a list are still open and how many were completed
+each day over a recent window, as a burndown-style snapshot.
+
+--trend sets the window in days or weeks (e.g. 14d, 4w; default 14d). On a
+terminal the daily completions are drawn as a sparkline; otherwise one
+"date count" line is printed per day. Use --format json for the daily
+buckets as {date, completed_count}.
+
+Only todos with a recorded completion time count towards the trend.`,
+		Example: `  bc4 todo stats "Sprint Tasks"
+  bc4 todo stats "Sprint Tasks" --trend 4w
+  bc4 todo stats 12345 --format json`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			f = f.ApplyOverrides(accountID, projectID)
+
+			format, err := ui.ResolveTableOrJSON(formatStr)
+			if err != nil {
+				return err
+			}
+			days, err := parseTrendWindow(trend)
+			if err != nil {
+				return err
+			}
+			policy, err := parseMatchPolicy(listMatch)
+			if err != nil {
+				return err
+			}
+
+			client, err := f.ApiClient()
+			if err != nil {
+				return err
+			}
+			todoOps := client.Todos()
+
+			cfg, err := f.Config()
+			if err != nil {
+				return err
+			}
+			resolvedAccountID, err := f.AccountID()
+			if err != nil {
+				return err
+			}
+			resolvedProjectID, err := f.ProjectID()
+			if err != nil {
+				return err
+			}
+
+			todoListID, err := resolveListOrDefault(f.Context(), todoOps, cfg, resolvedAccountID, resolvedProjectID, args, policy, func(todoSetID int64) ([]api.TodoList, error) {
+				return todoOps.GetTodoLists(f.Context(), resolvedProjectID, todoSetID)
+			})
+			if err != nil {
+				return err
+			}
+
+			todoList, err := todoOps.GetTodoList(f.Context(), resolvedProjectID, todoListID)
+			if err != nil {
+				return fmt.Errorf("failed to fetch todo list: %w", err)
+			}
+
+			// Completed todos are needed for the trend
+			todos, groups, groupedTodos, err := fetchListTodos(f.Context(), todoOps, resolvedProjectID, todoList, true, api.StatusActive)
+			var partialErr *api.PartialError
+			if errors.As(err, &partialErr) {
+				fmt.Fprintf(os.Stderr, "Warning: %v\n", partialErr)
+			} else if err != nil {
+				return err
+			}
+			for _, group := range groups {
+				todos = append(todos, groupedTodos[fmt.Sprintf("%d", group.ID)]...)
+			}
+
+			stats := buildTodoStats(todoList, todos, days, time.Now())
+			if format == ui.OutputFormatJSON {
+				return ui.WriteJSON(os.Stdout, stats)
+			}
+			return renderTodoStats(os.Stdout, stats, ui.IsTerminal(os.Stdout))
+		},
+	}
+
+	cmd.Flags().StringVarP(&accountID, "account", "a", "", "Specify account ID (overrides default)")
+	cmd.Flags().StringVarP(&projectID, "project", "p", "", "Specify project ID (overrides default)")
+	cmd.Flags().StringVarP(&formatStr, "format", "f", "table", "Output format: table or json")
+	cmd.Flags().StringVar(&trend, "trend", "14d", "Window of daily completions to show (e.g. 14d, 4w)")
+	addListMatchFlag(cmd, &listMatch)
+
+	return cmd
+}
+
+// parseTrendWindow parses a --trend value like "14d" or "4w" into days
+func parseTrendWindow(value string) (int, error) {
+	value = strings.TrimSpace(strings.ToLower(value))
+	if span, ok := utils.ParseSpan(value); ok && span.Unit != 'h' && span.Count > 0 {
+		if span.Days() > maxTrendDays {
+			return 0, fmt.Errorf("invalid --trend %q: at most %d days", value, maxTrendDays)
+		}
+		return span.Days(), nil
+	}
+	return 0, fmt.Errorf("invalid --trend %q: use a number of days or weeks, like 14d or 4w", value)
+}
+
+// buildTodoStats counts open todos and buckets completions per local day
+// over the days ending today
+func buildTodoStats(todoList *api.TodoList, todos []api.Todo, days int, now time.Time) todoStats {
+	stats := todoStats{
+		ListID:    todoList.ID,
+		List:      todoList.Title,
+		TrendDays: days,
+		Trend:     make([]trendBucket, days),
+	}
+
+	now = now.Local()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	first := today.AddDate(0, 0, -(days - 1))
+	index := make(map[string]int, days)
+	for i := range stats.Trend {
+		date := first.AddDate(0, 0, i).Format("2006-01-02")
+		stats.Trend[i].Date = date
+		index[date] = i
+	}
+
+	for _, todo := range todos {
+		if !todo.Completed {
+			stats.RemainingOpen++
+			continue
+		}
+		stats.Completed++
+		if at, ok := completedAt(todo); ok {
+			if i, ok := index[at.Local().Format("2006-01-02")]; ok {
+				stats.Trend[i].CompletedCount++
+			}
+		}
+	}
+	return stats
+}
+
+// renderTodoStats writes the summary and trend; a sparkline on a TTY,
+// one "date count" line per day otherwise
+func renderTodoStats(w io.Writer, stats todoStats, tty bool) error {
+	total := 0
+	peak := 0
+	for _, b := range stats.Trend {
+		total += b.CompletedCount
+		if b.CompletedCount > peak {
+			peak = b.CompletedCount
+		}
+	}
+
+	if !tty {
+		fmt.Fprintf(w, "list\t%s\nopen\t%d\ncompleted\t%d\n", stats.List, stats.RemainingOpen, stats.Completed)
+		for _, b := range stats.Trend {
+			fmt.Fprintf(w, "%s\t%d\n", b.Date, b.CompletedCount)
+		}
+		return nil
+	}
+
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("99"))
+	metaStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("241"))
+	sparkStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("42"))
+
+	fmt.Fprintln(w, titleStyle.Render(stats.List))
+	fmt.Fprintln(w, metaStyle.Render(fmt.Sprintf("%d open, %d completed", stats.RemainingOpen, stats.Completed)))
+	fmt.Fprintln(w)
+
+	if total == 0 {
+		fmt.Fprintf(w, "No completions in the last %d days\n", stats.TrendDays)
+		return nil
+	}
+
+	fmt.Fprintf(w, "Completed per day, last %d days (peak %d):\n", stats.TrendDays, peak)
+	fmt.Fprintln(w, sparkStyle.Render(sparkline(stats.Trend, peak)))
+	first, last := stats.Trend[0].Date, stats.Trend[len(stats.Trend)-1].Date
+	fmt.Fprintln(w, metaStyle.Render(fmt.Sprintf("%s → %s, %d completed", first, last, total)))
+	return nil
+}
+
+// sparkline draws one block per bucket, scaled to peak. Days with no
+// completions use a blank so the shape of the trend stands out.
+func sparkline(buckets []trendBucket, peak int) string {
+	var b strings.Builder
+	for _, bucket := range buckets {
+		if bucket.CompletedCount == 0 || peak == 0 {
+			b.WriteRune(' ')
+			continue
+		}
+		level := (bucket.CompletedCount*len(sparkBlocks) - 1) / peak
+		b.WriteRune(sparkBlocks[level])
+	}
+	return b.String()
+}
@@ -0,0 +1,66 @@
+package todo
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/needmore/bc4/internal/api"
+)
+
+func TestParseTrendWindow(t *testing.T) {
+	days, err := parseTrendWindow("14d")
+	require.NoError(t, err)
+	assert.Equal(t, 14, days)
+
+	days, err = parseTrendWindow("2W")
+	require.NoError(t, err)
+	assert.Equal(t, 14, days)
+
+	for _, bad := range []string{"", "0d", "14", "3m", "400d"} {
+		_, err := parseTrendWindow(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+func TestBuildTodoStats(t *testing.T) {
+	now := time.Date(2025, 5, 10, 15, 0, 0, 0, time.Local)
+	at := func(day int) *string {
+		s := time.Date(2025, 5, day, 12, 0, 0, 0, time.Local).Format(time.RFC3339)
+		return &s
+	}
+	todos := []api.Todo{
+		{ID: 1},
+		{ID: 2},
+		{ID: 3, Completed: true, CompletedAt: at(10)},
+		{ID: 4, Completed: true, CompletedAt: at(10)},
+		{ID: 5, Completed: true, CompletedAt: at(8)},
+		{ID: 6, Completed: true, CompletedAt: at(1)}, // outside the window
+		{ID: 7, Completed: true},                     // no completion time
+	}
+
+	stats := buildTodoStats(&api.TodoList{ID: 9, Title: "Sprint"}, todos, 3, now)
+	assert.Equal(t, 2, stats.RemainingOpen)
+	assert.Equal(t, 5, stats.Completed)
+	assert.Equal(t, []trendBucket{
+		{Date: "2025-05-08", CompletedCount: 1},
+		{Date: "2025-05-09", CompletedCount: 0},
+		{Date: "2025-05-10", CompletedCount: 2},
+	}, stats.Trend)
+
+	assert.Equal(t, "▄ █", sparkline(stats.Trend, 2))
+
+	var buf bytes.Buffer
+	require.NoError(t, renderTodoStats(&buf, stats, false))
+	assert.Equal(t, "list\tSprint\nopen\t2\ncompleted\t5\n2025-05-08\t1\n2025-05-09\t0\n2025-05-10\t2\n", buf.String())
+}
+
+func TestRenderTodoStats_NoCompletions(t *testing.T) {
+	stats := buildTodoStats(&api.TodoList{Title: "Empty"}, []api.Todo{{ID: 1}}, 7, time.Now())
+	var buf bytes.Buffer
+	require.NoError(t, renderTodoStats(&buf, stats, true))
+	assert.Contains(t, buf.String(), "No completions in the last 7 days")
+}
@@ -30,6 +30,7 @@ Examples:
 	// Add subcommands
 	cmd.AddCommand(newListsCmd(f))
 	cmd.AddCommand(newListCmd(f))
+	cmd.AddCommand(newStatsCmd(f))
 	cmd.AddCommand(newSelectCmd(f))
 	cmd.AddCommand(newSetCmd(f))
 	cmd.AddCommand(newViewCmd(f))
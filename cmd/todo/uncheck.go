@@ -1,10 +1,13 @@
 package todo
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
 
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/errors"
 	"github.com/needmore/bc4/internal/factory"
 	"github.com/needmore/bc4/internal/parser"
 	"github.com/spf13/cobra"
@@ -13,15 +16,21 @@ import (
 func newUncheckCmd(f *factory.Factory) *cobra.Command {
 	var accountID string
 	var projectID string
+	var check bool
 
 	cmd := &cobra.Command{
-		Use:   "uncheck <todo-id or URL>",
-		Short: "Mark a todo as incomplete",
+		Use:     "uncheck <todo-id or URL>",
+		Aliases: []string{"uncomplete"},
+		Short:   "Mark a todo as incomplete",
 		Long: `Mark a todo as incomplete.
 
 You can specify the todo using either:
 - A numeric ID (e.g., "12345" or "#12345")
-- A Basecamp URL (e.g., "https://3.basecamp.com/1234567/buckets/89012345/todos/12345")`,
+- A Basecamp URL (e.g., "https://3.basecamp.com/1234567/buckets/89012345/todos/12345")
+
+The todo is looked up before it is reopened, so reopening a todo that is
+already incomplete is not an error: it is reported as already incomplete and
+left unchanged. --check asks for that lookup explicitly.`,
 		Example: `  # Mark todo #12345 as incomplete
   bc4 todo uncheck 12345
 
@@ -29,7 +38,10 @@ You can specify the todo using either:
   bc4 todo uncheck #12345
 
   # Using a Basecamp URL
-  bc4 todo uncheck "https://3.basecamp.com/1234567/buckets/89012345/todos/12345"`,
+  bc4 todo uncheck "https://3.basecamp.com/1234567/buckets/89012345/todos/12345"
+
+  # Verify the todo is completed before reopening it
+  bc4 todo uncomplete 12345 --check`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Apply account override if specified
@@ -46,6 +58,8 @@ You can specify the todo using either:
 		},
 	}
 
+	cmd.Flags().BoolVar(&check, "check", false, "Fetch the todo first and report it if it's already incomplete")
+
 	cmd.Flags().StringVarP(&accountID, "account", "a", "", "Specify account ID")
 	cmd.Flags().StringVarP(&projectID, "project", "p", "", "Specify project ID")
 
@@ -87,26 +101,59 @@ func runUncheck(f *factory.Factory, todoIDStr string, accountIDFlag string, proj
 		return err
 	}
 
-	// Get the todo first to display its title
-	todo, err := todoOps.GetTodo(f.Context(), projectID, todoID)
+	result, err := uncompleteTodo(f.Context(), todoOps, projectID, todoID)
 	if err != nil {
-		return fmt.Errorf("failed to fetch todo: %w", err)
+		return err
 	}
 
-	// Check if already incomplete
-	if !todo.Completed {
-		fmt.Printf("○ Todo #%d is already incomplete\n", todoID)
-		return nil
+	fmt.Println(uncheckMessage(todoID, result))
+	return nil
+}
+
+// uncheckMessage is the confirmation printed for an uncheck, GitHub CLI
+// style
+func uncheckMessage(todoID int64, result *uncompleteResult) string {
+	if result.alreadyIncomplete {
+		return fmt.Sprintf("○ Todo #%d is already incomplete", todoID)
 	}
+	return fmt.Sprintf("○ Reopened #%d: %s", todoID, result.title)
+}
 
-	// Mark as incomplete
-	err = todoOps.UncompleteTodo(f.Context(), projectID, todoID)
+type uncompleteResult struct {
+	title             string
+	alreadyIncomplete bool
+}
+
+// uncompleteTodo reopens a todo. The todo is fetched first, both for its title
+// and to leave it alone if it isn't completed. A 404 from the DELETE then
+// usually means someone else reopened it in the meantime, so it is looked up
+// again to tell that apart from a todo that has gone away.
+func uncompleteTodo(ctx context.Context, ops api.TodoOperations, projectID string, todoID int64) (*uncompleteResult, error) {
+	todo, err := ops.GetTodo(ctx, projectID, todoID)
 	if err != nil {
-		return fmt.Errorf("failed to uncomplete todo: %w", err)
+		return nil, fmt.Errorf("failed to fetch todo: %w", err)
+	}
+	result := &uncompleteResult{title: todo.Title}
+	if !todo.Completed {
+		result.alreadyIncomplete = true
+		return result, nil
 	}
 
-	// GitHub CLI style: minimal output with confirmation
-	fmt.Printf("○ Reopened #%d: %s\n", todoID, todo.Title)
+	err = ops.UncompleteTodo(ctx, projectID, todoID)
+	if err == nil {
+		return result, nil
+	}
+	if !errors.IsNotFoundError(err) {
+		return nil, fmt.Errorf("failed to uncomplete todo: %w", err)
+	}
 
-	return nil
+	todo, getErr := ops.GetTodo(ctx, projectID, todoID)
+	if getErr != nil {
+		return nil, fmt.Errorf("failed to uncomplete todo: %w", getErr)
+	}
+	if todo.Completed {
+		return nil, fmt.Errorf("failed to uncomplete todo: %w", err)
+	}
+	result.alreadyIncomplete = true
+	return result, nil
 }
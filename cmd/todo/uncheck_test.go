@@ -0,0 +1,84 @@
+package todo
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/api/mock"
+	bcerrors "github.com/needmore/bc4/internal/errors"
+)
+
+// reopenedMeanwhileClient simulates another client reopening the todo between
+// the lookup and the DELETE
+type reopenedMeanwhileClient struct {
+	*mock.MockClient
+}
+
+func (c *reopenedMeanwhileClient) UncompleteTodo(ctx context.Context, projectID string, todoID int64) error {
+	_ = c.MockClient.UncompleteTodo(ctx, projectID, todoID)
+	c.Todo.Completed = false
+	return bcerrors.NewNotFoundError("completion", "", errors.New("not found"))
+}
+
+func TestUncompleteTodo(t *testing.T) {
+	t.Run("check reports an already incomplete todo", func(t *testing.T) {
+		client := mock.NewMockClient()
+		client.Todo = &api.Todo{ID: 1, Title: "Write docs"}
+
+		result, err := uncompleteTodo(context.Background(), client, "10", 1)
+		require.NoError(t, err)
+		assert.True(t, result.alreadyIncomplete)
+		assert.Equal(t, "Write docs", result.title)
+		assert.Equal(t, []string{"GetTodo(10, 1)"}, client.Calls)
+		assert.Equal(t, "○ Todo #1 is already incomplete", uncheckMessage(1, result))
+	})
+
+	t.Run("completed todo is reopened", func(t *testing.T) {
+		client := mock.NewMockClient()
+		client.Todo = &api.Todo{ID: 1, Title: "Write docs", Completed: true}
+
+		result, err := uncompleteTodo(context.Background(), client, "10", 1)
+		require.NoError(t, err)
+		assert.False(t, result.alreadyIncomplete)
+		assert.Equal(t, "Write docs", result.title)
+		assert.Equal(t, []string{"GetTodo(10, 1)", "UncompleteTodo(10, 1)"}, client.Calls)
+	})
+
+	t.Run("404 after someone else reopened it is treated as success", func(t *testing.T) {
+		client := &reopenedMeanwhileClient{MockClient: mock.NewMockClient()}
+		client.Todo = &api.Todo{ID: 1, Title: "Write docs", Completed: true}
+
+		result, err := uncompleteTodo(context.Background(), client, "10", 1)
+		require.NoError(t, err)
+		assert.True(t, result.alreadyIncomplete)
+		assert.Equal(t, []string{"GetTodo(10, 1)", "UncompleteTodo(10, 1)", "GetTodo(10, 1)"}, client.Calls)
+	})
+
+	t.Run("missing todo is reported", func(t *testing.T) {
+		client := mock.NewMockClient()
+		client.TodoError = bcerrors.NewNotFoundError("todo", "1", errors.New("not found"))
+
+		_, err := uncompleteTodo(context.Background(), client, "10", 1)
+		require.Error(t, err)
+		assert.True(t, bcerrors.IsNotFoundError(err))
+	})
+
+	t.Run("other errors are returned", func(t *testing.T) {
+		client := mock.NewMockClient()
+		client.Todo = &api.Todo{ID: 1, Title: "Write docs", Completed: true}
+		client.UncompleteTodoError = errors.New("boom")
+
+		_, err := uncompleteTodo(context.Background(), client, "10", 1)
+		assert.EqualError(t, err, "failed to uncomplete todo: boom")
+	})
+}
+
+func TestUncheckMessage(t *testing.T) {
+	assert.Equal(t, "○ Reopened #1: Write docs", uncheckMessage(1, &uncompleteResult{title: "Write docs"}))
+	assert.Equal(t, "○ Todo #1 is already incomplete", uncheckMessage(1, &uncompleteResult{title: "Write docs", alreadyIncomplete: true}))
+}
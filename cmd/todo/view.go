@@ -29,6 +29,8 @@ func newViewCmd(f *factory.Factory) *cobra.Command {
 	var webView bool
 	var noPager bool
 	var withComments bool
+	var noContent bool
+	var contentAs string
 
 	cmd := &cobra.Command{
 		Use:   "view <todo-id|url>",
@@ -37,7 +39,11 @@ func newViewCmd(f *factory.Factory) *cobra.Command {
 
 You can specify the todo using either:
 - A numeric ID (e.g., "12345")
-- A Basecamp URL (e.g., "https://3.basecamp.com/1234567/buckets/89012345/todos/12345")`,
+- A Basecamp URL (e.g., "https://3.basecamp.com/1234567/buckets/89012345/todos/12345")
+
+A breadcrumb above the title shows where the todo lives: its project, list,
+and group (for todos in a group). JSON output includes it as "breadcrumb",
+with the ID and name of each level.`,
 		Example: `bc4 todo view 12345
 bc4 todo view https://3.basecamp.com/.../todos/12345
 bc4 todo view 12345 --with-comments`,
@@ -103,9 +109,28 @@ bc4 todo view 12345 --with-comments`,
 				return nil
 			}
 
+			// Work out where the todo lives; the view is still shown if
+			// this fails
+			crumb, err := newBreadcrumbResolver(client, todoOps, resolvedProjectID).Resolve(f.Context(), todo)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: could not resolve todo list: %v\n", err)
+			}
+
 			// Handle JSON output
 			if formatStr == "json" {
-				var output interface{} = todo
+				contentOpts, err := parseTodoContentOptions(noContent, contentAs)
+				if err != nil {
+					return err
+				}
+				output, err := shapeTodoJSON(*todo, contentOpts)
+				if err != nil {
+					return err
+				}
+				if crumb != nil {
+					if output, err = withBreadcrumb(output, crumb); err != nil {
+						return err
+					}
+				}
 
 				// If specific fields requested, filter the output
 				if jsonFields != "" {
@@ -113,7 +138,7 @@ bc4 todo view 12345 --with-comments`,
 					filtered := make(map[string]interface{})
 
 					// Convert todo to map for field selection
-					todoJSON, _ := json.Marshal(todo)
+					todoJSON, _ := json.Marshal(output)
 					var todoMap map[string]interface{}
 					_ = json.Unmarshal(todoJSON, &todoMap)
 
@@ -191,8 +216,14 @@ bc4 todo view 12345 --with-comments`,
 			}
 			statusStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(statusColor))
 
+			// Show where the todo lives above its title
+			fmt.Fprintln(&buf)
+			if crumb != nil {
+				fmt.Fprintln(&buf, labelStyle.Render(crumb.String()+breadcrumbSeparator+todo.Title))
+			}
+
 			// Display todo content with status
-			fmt.Fprintf(&buf, "\n%s %s\n\n", statusStyle.Render(statusIcon), titleStyle.Render(todo.Content))
+			fmt.Fprintf(&buf, "%s %s\n\n", statusStyle.Render(statusIcon), titleStyle.Render(todo.Content))
 
 			// Show metadata
 			fmt.Fprintf(&buf, "%s %d\n", labelStyle.Render("ID:"), todo.ID)
@@ -201,6 +232,9 @@ bc4 todo view 12345 --with-comments`,
 				statusText = "completed"
 			}
 			fmt.Fprintf(&buf, "%s %s\n", labelStyle.Render("Status:"), statusText)
+			if completion := completionSummary(*todo); completion != "" {
+				fmt.Fprintf(&buf, "%s %s\n", labelStyle.Render("Completed:"), completion)
+			}
 
 			if todo.DueOn != nil && *todo.DueOn != "" {
 				// Parse and format due date
@@ -258,8 +292,14 @@ bc4 todo view 12345 --with-comments`,
 				}
 			}
 
-			// Show todo list ID
-			if todo.TodolistID > 0 {
+			// Show the list and group the todo is in
+			if crumb != nil && crumb.List != nil {
+				fmt.Fprintln(&buf)
+				fmt.Fprintf(&buf, "%s %s (#%d)\n", labelStyle.Render("Todo List:"), crumb.List.Name, crumb.List.ID)
+				if crumb.Group != nil {
+					fmt.Fprintf(&buf, "%s %s (#%d)\n", labelStyle.Render("Group:"), crumb.Group.Name, crumb.Group.ID)
+				}
+			} else if todo.TodolistID > 0 {
 				fmt.Fprintln(&buf)
 				fmt.Fprintf(&buf, "%s %d\n", labelStyle.Render("Todo List ID:"), todo.TodolistID)
 			}
@@ -297,6 +337,7 @@ bc4 todo view 12345 --with-comments`,
 	cmd.Flags().StringVarP(&accountID, "account", "a", "", "Specify account ID")
 	cmd.Flags().StringVarP(&projectID, "project", "p", "", "Specify project ID")
 	cmd.Flags().StringVarP(&formatStr, "format", "f", "", "Output format (json)")
+	addTodoContentFlags(cmd, &noContent, &contentAs)
 	cmd.Flags().StringVar(&jsonFields, "json-fields", "", "Comma-separated list of JSON fields to output")
 	cmd.Flags().BoolVarP(&webView, "web", "w", false, "Open in browser")
 	cmd.Flags().BoolVar(&noPager, "no-pager", false, "Disable pager for output")
@@ -0,0 +1,49 @@
+package todo
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/needmore/bc4/internal/cmdutil"
+	"github.com/needmore/bc4/internal/config"
+)
+
+// viewFlags are never stored in a saved view
+var viewFlags = []string{"view", "save-view"}
+
+// applyView replays the named saved view onto cmd. Flags given on the command
+// line take precedence, and the saved list is only used when args is empty.
+// It returns the args to run with.
+func applyView(cmd *cobra.Command, cfg *config.Config, name string, args []string) ([]string, error) {
+	view, err := cfg.LookupView(name, cmdutil.CommandName(cmd))
+	if err != nil {
+		return nil, err
+	}
+	if err := cmdutil.ApplyFlagValues(cmd, view); err != nil {
+		return nil, fmt.Errorf("failed to apply view %q: %w", name, err)
+	}
+	if len(args) == 0 && view[config.ViewArgKey] != "" {
+		args = []string{view[config.ViewArgKey]}
+	}
+	return args, nil
+}
+
+// saveView stores the flags set on cmd, and the list argument if any, as the
+// named view.
+func saveView(cmd *cobra.Command, name string, args []string) error {
+	values := cmdutil.ChangedFlags(cmd, viewFlags...)
+	if len(args) > 0 {
+		values[config.ViewArgKey] = args[0]
+	}
+	err := config.Update(func(cfg *config.Config) error {
+		cfg.SaveView(name, cmdutil.CommandName(cmd), values)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save view: %w", err)
+	}
+	fmt.Fprintf(os.Stderr, "Saved view %q; replay it with --view %s\n", name, name)
+	return nil
+}
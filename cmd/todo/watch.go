@@ -0,0 +1,251 @@
+package todo
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/ui/tableprinter"
+	"github.com/needmore/bc4/internal/utils"
+)
+
+// Messages for the todo watch view
+type todoWatchLoadedMsg struct {
+	rows      []todoRow
+	hasGroups bool
+	err       error
+}
+
+type todoWatchTickMsg struct{}
+
+// todoWatchModel renders a todo list full-screen and refreshes it on an interval
+type todoWatchModel struct {
+	ctx       context.Context
+	cancel    context.CancelFunc
+	todoOps   api.TodoOperations
+	projectID string
+	todoList  *api.TodoList
+	showAll   bool
+	interval  time.Duration
+	filter    utils.ItemFilter
+	dates     todoDates
+
+	rows          []todoRow
+	hasGroups     bool
+	completed     map[int64]bool // completion state as of the previous poll
+	justCompleted map[int64]bool // todos completed since the previous poll
+	loaded        bool
+	lastUpdated   time.Time
+	err           error
+	width         int
+}
+
+var justCompletedStyle = lipgloss.NewStyle().
+	Bold(true).
+	Foreground(lipgloss.Color("42"))
+
+func newTodoWatchModel(ctx context.Context, todoOps api.TodoOperations, projectID string, todoList *api.TodoList, showAll bool, interval time.Duration) todoWatchModel {
+	ctx, cancel := context.WithCancel(ctx)
+	return todoWatchModel{
+		ctx:       ctx,
+		cancel:    cancel,
+		todoOps:   todoOps,
+		projectID: projectID,
+		todoList:  todoList,
+		showAll:   showAll,
+		interval:  interval,
+	}
+}
+
+func (m todoWatchModel) Init() tea.Cmd {
+	return m.fetch()
+}
+
+func (m todoWatchModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		return m, nil
+
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q", "esc":
+			// Stop any in-flight request before exiting
+			m.cancel()
+			return m, tea.Quit
+		}
+
+	case todoWatchLoadedMsg:
+		if msg.err != nil {
+			// Keep showing the last good data and try again on the next tick
+			m.err = msg.err
+		} else {
+			m.applyRows(msg.rows, msg.hasGroups)
+			m.err = nil
+			m.lastUpdated = time.Now()
+		}
+		return m, m.tick()
+
+	case todoWatchTickMsg:
+		if m.ctx.Err() != nil {
+			return m, nil
+		}
+		return m, m.fetch()
+	}
+
+	return m, nil
+}
+
+// applyRows replaces the displayed rows and works out which todos were
+// completed since the previous poll so they can be highlighted.
+func (m *todoWatchModel) applyRows(rows []todoRow, hasGroups bool) {
+	completed := make(map[int64]bool, len(rows))
+	justCompleted := make(map[int64]bool)
+	for _, row := range rows {
+		completed[row.todo.ID] = row.todo.Completed
+		if m.loaded && row.todo.Completed {
+			if wasCompleted, seen := m.completed[row.todo.ID]; seen && !wasCompleted {
+				justCompleted[row.todo.ID] = true
+			}
+		}
+	}
+
+	m.rows = rows
+	m.hasGroups = hasGroups
+	m.completed = completed
+	m.justCompleted = justCompleted
+	m.loaded = true
+}
+
+func (m todoWatchModel) View() string {
+	var b strings.Builder
+
+	b.WriteString(titleStyle.Render(m.todoList.Title))
+	b.WriteString("\n")
+
+	if !m.loaded {
+		if m.err != nil {
+			fmt.Fprintf(&b, "Error: %v\n", m.err)
+		} else {
+			b.WriteString("Loading todos...\n")
+		}
+		return b.String()
+	}
+
+	total := len(m.rows)
+	done := 0
+	for _, row := range m.rows {
+		if row.todo.Completed {
+			done++
+		}
+	}
+	status := fmt.Sprintf("%d of %d completed • updated %s • refreshing every %v",
+		done, total, m.lastUpdated.Format("15:04:05"), m.interval)
+	if len(m.justCompleted) > 0 {
+		status += " • " + justCompletedStyle.Render(fmt.Sprintf("%d just completed", len(m.justCompleted)))
+	}
+	b.WriteString(helpStyle.Render(status))
+	b.WriteString("\n")
+	if m.err != nil {
+		fmt.Fprintf(&b, "Last refresh failed: %v\n", m.err)
+	}
+	b.WriteString("\n")
+
+	var table bytes.Buffer
+	if err := renderTodoWatchTable(&table, m.width, m.visibleRows(), m.hasGroups, m.justCompleted, m.dates); err != nil {
+		fmt.Fprintf(&b, "Error: %v\n", err)
+	}
+	b.WriteString(table.String())
+
+	b.WriteString("\n")
+	b.WriteString(helpStyle.Render("q: Quit"))
+	return b.String()
+}
+
+// visibleRows returns the rows to display. Completed todos are hidden unless
+// --all was given, except those completed since the previous poll.
+func (m todoWatchModel) visibleRows() []todoRow {
+	if m.showAll {
+		return m.rows
+	}
+	var rows []todoRow
+	for _, row := range m.rows {
+		if !row.todo.Completed || m.justCompleted[row.todo.ID] {
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
+
+func (m todoWatchModel) fetch() tea.Cmd {
+	ctx, todoOps, projectID, todoList, filter := m.ctx, m.todoOps, m.projectID, m.todoList, m.filter
+	return func() tea.Msg {
+		// Always fetch completed todos so completions can be detected
+		todos, groups, groupedTodos, err := fetchListTodos(ctx, todoOps, projectID, todoList, true, api.StatusActive)
+		if err != nil {
+			return todoWatchLoadedMsg{err: err}
+		}
+		if len(groups) == 0 {
+			groupedTodos = map[string][]api.Todo{"": todos}
+		}
+		for groupID, groupTodos := range groupedTodos {
+			groupedTodos[groupID] = filterTodos(groupTodos, filter)
+		}
+		return todoWatchLoadedMsg{
+			rows:      collectTodoRows(groups, groupedTodos, true),
+			hasGroups: len(groups) > 0,
+		}
+	}
+}
+
+func (m todoWatchModel) tick() tea.Cmd {
+	return tea.Tick(m.interval, func(time.Time) tea.Msg {
+		return todoWatchTickMsg{}
+	})
+}
+
+// renderTodoWatchTable renders the watch table, highlighting todos that were
+// completed since the previous poll.
+func renderTodoWatchTable(w io.Writer, width int, rows []todoRow, hasGroups bool, highlight map[int64]bool, dates todoDates) error {
+	if len(rows) == 0 {
+		_, err := fmt.Fprintln(w, "No open todos")
+		return err
+	}
+
+	table := tableprinter.NewWithOptions(w, true, width)
+	if hasGroups {
+		table.AddHeader("ID", "", "TODO", "GROUP", "ASSIGNEE", "DUE")
+	} else {
+		table.AddHeader("ID", "", "TODO", "ASSIGNEE", "DUE")
+	}
+
+	cs := table.GetColorScheme()
+	for _, row := range rows {
+		todo := row.todo
+		table.AddField(fmt.Sprintf("%d", todo.ID))
+		table.AddStatusField(todo.Completed)
+
+		if highlight[todo.ID] {
+			table.AddField(todoPlainTitle(todo), func(s string) string { return justCompletedStyle.Render(s) })
+		} else {
+			table.AddTodoField(todoPlainTitle(todo), todo.Completed)
+		}
+
+		if hasGroups {
+			table.AddField(row.group, cs.Cyan)
+		}
+		table.AddField(todoAssigneeNames(todo), cs.Muted)
+
+		table.AddField(dates.due(todo), cs.Muted)
+		table.EndRow()
+	}
+
+	return table.Render()
+}
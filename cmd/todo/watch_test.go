@@ -0,0 +1,53 @@
+package todo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/needmore/bc4/internal/api"
+)
+
+func TestTodoWatchModel_HighlightsNewlyCompleted(t *testing.T) {
+	m := newTodoWatchModel(context.Background(), nil, "1", &api.TodoList{ID: 10, Title: "Sprint"}, false, time.Minute)
+
+	m.applyRows([]todoRow{
+		{todo: api.Todo{ID: 1, Title: "Open"}},
+		{todo: api.Todo{ID: 2, Title: "About to finish"}},
+		{todo: api.Todo{ID: 3, Title: "Already done", Completed: true}},
+	}, false)
+	assert.Empty(t, m.justCompleted, "first poll has nothing to compare against")
+	assert.Len(t, m.visibleRows(), 2)
+
+	m.applyRows([]todoRow{
+		{todo: api.Todo{ID: 1, Title: "Open"}},
+		{todo: api.Todo{ID: 2, Title: "About to finish", Completed: true}},
+		{todo: api.Todo{ID: 3, Title: "Already done", Completed: true}},
+	}, false)
+	assert.Equal(t, map[int64]bool{2: true}, m.justCompleted)
+
+	visible := m.visibleRows()
+	if assert.Len(t, visible, 2) {
+		assert.Equal(t, int64(1), visible[0].todo.ID)
+		assert.Equal(t, int64(2), visible[1].todo.ID)
+	}
+
+	// The highlight lasts until the next poll
+	m.applyRows(m.rows, false)
+	assert.Empty(t, m.justCompleted)
+	assert.Len(t, m.visibleRows(), 1)
+}
+
+func TestTodoWatchModel_QuitCancelsPolling(t *testing.T) {
+	m := newTodoWatchModel(context.Background(), nil, "1", &api.TodoList{ID: 10}, false, time.Minute)
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")})
+	assert.NotNil(t, cmd)
+	assert.Error(t, updated.(todoWatchModel).ctx.Err())
+
+	_, cmd = updated.Update(todoWatchTickMsg{})
+	assert.Nil(t, cmd, "no further fetches after quitting")
+}
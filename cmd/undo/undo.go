@@ -0,0 +1,107 @@
+package undo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/undo"
+	"github.com/spf13/cobra"
+)
+
+// NewUndoCmd creates the undo command
+func NewUndoCmd(f *factory.Factory) *cobra.Command {
+	var dryRun bool
+
+	cmd := &cobra.Command{
+		Use:   "undo",
+		Short: "Reverse the last card or todo change",
+		Long: `Reverse the last change bc4 recorded as undoable.
+
+These changes can be undone:
+  - bc4 card move          moves the card back to its previous column
+  - bc4 todo move --to-list moves the todo back to the top of its previous list
+  - bc4 todo check         marks the todo incomplete again
+
+Only the most recent change is remembered, and it is forgotten once undone.`,
+		Example: `  bc4 card move 123 --column Done
+  bc4 undo
+
+  bc4 undo --dry-run   # Show what would be undone`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			action, err := undo.Last()
+			if errors.Is(err, undo.ErrNothingToUndo) {
+				return fmt.Errorf("nothing to undo")
+			}
+			if err != nil {
+				return err
+			}
+
+			if dryRun {
+				fmt.Printf("Would undo: %s\n", action.Description)
+				return nil
+			}
+
+			if action.AccountID != "" {
+				f = f.WithAccount(action.AccountID)
+			}
+			if action.ProjectID != "" {
+				f = f.WithProject(action.ProjectID)
+			}
+			client, err := f.ApiClient()
+			if err != nil {
+				return err
+			}
+
+			message, err := reverse(f.Context(), client.Cards(), client.Todos(), action)
+			if err != nil {
+				return err
+			}
+			if err := undo.Clear(); err != nil {
+				return err
+			}
+			fmt.Printf("✓ %s\n", message)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Show what would be undone without changing anything")
+
+	return cmd
+}
+
+// reverse applies the inverse of action and describes what it did
+func reverse(ctx context.Context, cards api.CardOperations, todos api.TodoOperations, action *undo.Action) (string, error) {
+	switch action.Kind {
+	case undo.KindCardMove:
+		var err error
+		if action.PreviousTableID != 0 {
+			err = cards.MoveCardToTable(ctx, action.ProjectID, action.ID, action.PreviousTableID, action.PreviousParentID)
+		} else {
+			err = cards.MoveCard(ctx, action.ProjectID, action.ID, action.PreviousParentID)
+		}
+		if err != nil {
+			return "", fmt.Errorf("failed to move card back: %w", err)
+		}
+		if action.PreviousParentName != "" {
+			return fmt.Sprintf("Moved card #%d back to '%s'", action.ID, action.PreviousParentName), nil
+		}
+		return fmt.Sprintf("Moved card #%d back to column %d", action.ID, action.PreviousParentID), nil
+
+	case undo.KindTodoMove:
+		if err := todos.MoveTodo(ctx, action.ProjectID, action.ID, action.ProjectID, action.PreviousParentID, 1); err != nil {
+			return "", fmt.Errorf("failed to move todo back: %w", err)
+		}
+		return fmt.Sprintf("Moved todo #%d back to list %d", action.ID, action.PreviousParentID), nil
+
+	case undo.KindTodoComplete:
+		if err := todos.UncompleteTodo(ctx, action.ProjectID, action.ID); err != nil {
+			return "", fmt.Errorf("failed to reopen todo: %w", err)
+		}
+		return fmt.Sprintf("Reopened todo #%d", action.ID), nil
+	}
+	return "", fmt.Errorf("don't know how to undo %q", action.Kind)
+}
@@ -0,0 +1,67 @@
+package undo
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/needmore/bc4/internal/api/mock"
+	"github.com/needmore/bc4/internal/undo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReverse(t *testing.T) {
+	tests := []struct {
+		name        string
+		action      undo.Action
+		wantCall    string
+		wantMessage string
+	}{
+		{
+			name:        "card move",
+			action:      undo.Action{Kind: undo.KindCardMove, ProjectID: "1", ID: 10, PreviousParentID: 20, PreviousParentName: "Doing"},
+			wantCall:    "MoveCard(1, 10, 20)",
+			wantMessage: "Moved card #10 back to 'Doing'",
+		},
+		{
+			name:        "card move across boards",
+			action:      undo.Action{Kind: undo.KindCardMove, ProjectID: "1", ID: 10, PreviousParentID: 20, PreviousTableID: 30},
+			wantCall:    "MoveCardToTable(1, 10, 30, 20)",
+			wantMessage: "Moved card #10 back to column 20",
+		},
+		{
+			name:        "todo move",
+			action:      undo.Action{Kind: undo.KindTodoMove, ProjectID: "1", ID: 11, PreviousParentID: 40},
+			wantCall:    "MoveTodo(1, 11, 1, 40, 1)",
+			wantMessage: "Moved todo #11 back to list 40",
+		},
+		{
+			name:        "todo complete",
+			action:      undo.Action{Kind: undo.KindTodoComplete, ProjectID: "1", ID: 12},
+			wantCall:    "UncompleteTodo(1, 12)",
+			wantMessage: "Reopened todo #12",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := mock.NewMockClient()
+			message, err := reverse(context.Background(), client, client, &tt.action)
+			require.NoError(t, err)
+			assert.Equal(t, []string{tt.wantCall}, client.Calls)
+			assert.Equal(t, tt.wantMessage, message)
+		})
+	}
+}
+
+func TestReverseErrors(t *testing.T) {
+	client := mock.NewMockClient()
+	client.UncompleteTodoError = errors.New("boom")
+	_, err := reverse(context.Background(), client, client, &undo.Action{Kind: undo.KindTodoComplete, ProjectID: "1", ID: 12})
+	assert.EqualError(t, err, "failed to reopen todo: boom")
+
+	client = mock.NewMockClient()
+	_, err = reverse(context.Background(), client, client, &undo.Action{Kind: "card_archive"})
+	assert.EqualError(t, err, `don't know how to undo "card_archive"`)
+}
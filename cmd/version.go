@@ -11,17 +11,29 @@ import (
 
 var (
 	versionDetailed bool
+	versionFormat   string
 )
 
 var versionCmd = &cobra.Command{
 	Use:   "version",
 	Short: "Display version information",
-	Long:  `Display the version of bc4 along with build information.`,
+	Long: `Display the version of bc4 along with build information.
+
+Use --format json for machine-readable output including the Go version, OS,
+and architecture, which is handy for bug reports. --verbose adds the Go
+version and platform to the human-readable form.`,
+	Example: `  bc4 version
+  bc4 version --verbose
+  bc4 version --format json`,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if versionFormat != "text" && versionFormat != "json" {
+			return fmt.Errorf("invalid --format %q: must be text or json", versionFormat)
+		}
+
 		info := version.Get()
 
 		// Check if JSON output is requested
-		if viper.GetBool("json") {
+		if versionFormat == "json" || viper.GetBool("json") {
 			jsonData, err := json.MarshalIndent(info, "", "  ")
 			if err != nil {
 				return fmt.Errorf("failed to marshal version info: %w", err)
@@ -31,9 +43,12 @@ var versionCmd = &cobra.Command{
 		}
 
 		// Check if detailed output is requested
-		if versionDetailed {
+		switch {
+		case versionDetailed:
 			fmt.Println(info.DetailedString())
-		} else {
+		case viper.GetBool("verbose"):
+			fmt.Println(info.VerboseString())
+		default:
 			fmt.Println(info.String())
 		}
 
@@ -43,4 +58,5 @@ var versionCmd = &cobra.Command{
 
 func init() {
 	versionCmd.Flags().BoolVarP(&versionDetailed, "detailed", "d", false, "Show detailed version information")
+	versionCmd.Flags().StringVarP(&versionFormat, "format", "f", "text", "Output format: text or json")
 }
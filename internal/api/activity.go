@@ -2,7 +2,9 @@ package api
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/url"
 	"sort"
 	"time"
@@ -12,13 +14,15 @@ import (
 
 // Event represents a Basecamp activity event
 type Event struct {
-	ID            int64     `json:"id"`
-	Action        string    `json:"action"`
-	CreatedAt     time.Time `json:"created_at"`
-	RecordingType string    `json:"recording_type"`
-	Recording     Recording `json:"recording"`
-	Creator       Person    `json:"creator"`
-	Bucket        Bucket    `json:"bucket"`
+	ID            int64          `json:"id"`
+	RecordingID   int64          `json:"recording_id,omitempty"`
+	Action        string         `json:"action"`
+	Details       map[string]any `json:"details,omitempty"`
+	CreatedAt     time.Time      `json:"created_at"`
+	RecordingType string         `json:"recording_type"`
+	Recording     Recording      `json:"recording"`
+	Creator       Person         `json:"creator"`
+	Bucket        Bucket         `json:"bucket"`
 }
 
 // Recording represents a Basecamp recording (generic content item)
@@ -34,6 +38,10 @@ type Recording struct {
 	Creator   Person    `json:"creator"`
 	Bucket    Bucket    `json:"bucket"`
 	Parent    *Parent   `json:"parent,omitempty"`
+
+	// Schedule entries (events) also carry when they start
+	StartsAt string `json:"starts_at,omitempty"`
+	AllDay   bool   `json:"all_day,omitempty"`
 }
 
 // Bucket represents a Basecamp bucket (project container)
@@ -56,16 +64,38 @@ type Parent struct {
 type ActivityListOptions struct {
 	Since          *time.Time // Filter events since this time
 	RecordingTypes []string   // Filter by recording types (todo, message, document, etc.)
-	PersonID       int64      // Filter by person ID (creator)
+	PersonIDs      []int64    // Filter by creator, matching any of these people
+	PersonID       int64      // Filter by a single creator; combined with PersonIDs
 	Limit          int        // Maximum number of events to return
+	MaxPages       int        // Maximum pages to fetch per recording type (0 = unlimited)
 }
 
+// creatorSet returns the people whose recordings are wanted, or nil when
+// recordings by anyone are
+func (o *ActivityListOptions) creatorSet() map[int64]bool {
+	if len(o.PersonIDs) == 0 && o.PersonID <= 0 {
+		return nil
+	}
+	set := make(map[int64]bool, len(o.PersonIDs)+1)
+	for _, id := range o.PersonIDs {
+		set[id] = true
+	}
+	if o.PersonID > 0 {
+		set[o.PersonID] = true
+	}
+	return set
+}
+
+// DefaultActivityMaxPages is the per-type page limit used by activity
+// listings, guarding against unbounded fetches on busy projects
+const DefaultActivityMaxPages = 50
+
 // ListEvents returns activity events for a recording
 func (c *Client) ListEvents(ctx context.Context, projectID string, recordingID int64) ([]Event, error) {
 	var events []Event
 	path := fmt.Sprintf("/buckets/%s/recordings/%d/events.json", projectID, recordingID)
 
-	pr := NewPaginatedRequest(c)
+	pr := NewPaginatedRequest(c).WithContext(ctx)
 	if err := pr.GetAll(path, &events); err != nil {
 		return nil, fmt.Errorf("failed to list events: %w", err)
 	}
@@ -73,10 +103,25 @@ func (c *Client) ListEvents(ctx context.Context, projectID string, recordingID i
 	return events, nil
 }
 
+// ListEventsChronological returns the events for any recording (card, todo,
+// message, ...) ordered oldest first, suitable for an audit trail
+func (c *Client) ListEventsChronological(ctx context.Context, projectID string, recordingID int64) ([]Event, error) {
+	events, err := c.ListEvents(ctx, projectID, recordingID)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].CreatedAt.Before(events[j].CreatedAt)
+	})
+	return events, nil
+}
+
 // ListRecordings returns all recordings (activity items) for a project.
 // Types are fetched in parallel using errgroup — if one type fails or the
 // context is cancelled, all in-flight fetches are aborted. When opts.Since
 // is set, pagination stops early once records older than the cutoff are encountered.
+// If opts.MaxPages stops any type before its last page, the recordings are
+// returned along with a *PartialError.
 func (c *Client) ListRecordings(ctx context.Context, projectID string, opts *ActivityListOptions) ([]Recording, error) {
 	// Default types to fetch if none specified
 	typesToFetch := []string{"Todo", "Message", "Document", "Comment"}
@@ -87,21 +132,25 @@ func (c *Client) ListRecordings(ctx context.Context, projectID string, opts *Act
 
 	// Extract options for per-type fetching
 	var since *time.Time
+	maxPages := 0
 	if opts != nil {
 		since = opts.Since
+		maxPages = opts.MaxPages
 	}
 
 	// Fetch all types in parallel; cancel siblings on first error
 	g, gctx := errgroup.WithContext(ctx)
 	results := make([][]Recording, len(typesToFetch))
+	truncated := make([]bool, len(typesToFetch))
 
 	for i, recordingType := range typesToFetch {
 		g.Go(func() error {
-			recs, err := c.listRecordingsByType(gctx, projectID, recordingType, since)
+			recs, more, err := c.listRecordingsByType(gctx, projectID, recordingType, since, maxPages)
 			if err != nil {
 				return fmt.Errorf("failed to list %s recordings: %w", recordingType, err)
 			}
 			results[i] = recs
+			truncated[i] = more
 			return nil
 		})
 	}
@@ -124,6 +173,15 @@ func (c *Client) ListRecordings(ctx context.Context, projectID string, opts *Act
 		allRecordings = filterRecordings(allRecordings, opts)
 	}
 
+	for _, more := range truncated {
+		if more {
+			return allRecordings, &PartialError{
+				Missing: "older activity",
+				Err:     fmt.Errorf("stopped after %d pages per type", maxPages),
+			}
+		}
+	}
+
 	return allRecordings, nil
 }
 
@@ -131,7 +189,8 @@ func (c *Client) ListRecordings(ctx context.Context, projectID string, opts *Act
 // When since is non-nil, pagination stops early once all items on a page
 // are older than the cutoff (data arrives sorted by updated_at desc).
 // The context is propagated to all HTTP requests for cancellation support.
-func (c *Client) listRecordingsByType(ctx context.Context, projectID string, recordingType string, since *time.Time) ([]Recording, error) {
+// It also reports whether maxPages (0 = unlimited) cut pagination short.
+func (c *Client) listRecordingsByType(ctx context.Context, projectID string, recordingType string, since *time.Time, maxPages int) ([]Recording, bool, error) {
 	var recordings []Recording
 
 	// Build query params
@@ -143,7 +202,7 @@ func (c *Client) listRecordingsByType(ctx context.Context, projectID string, rec
 
 	path := fmt.Sprintf("/projects/recordings.json?%s", params.Encode())
 
-	pr := NewPaginatedRequest(c).WithContext(ctx)
+	pr := NewPaginatedRequest(c).WithContext(ctx).WithMaxPages(maxPages)
 
 	// Early termination: stop paginating once the last item on a page
 	// is older than our since cutoff. Since results are sorted by
@@ -161,10 +220,10 @@ func (c *Client) listRecordingsByType(ctx context.Context, projectID string, rec
 	}
 
 	if err := pr.GetAll(path, &recordings); err != nil {
-		return nil, err
+		return nil, false, err
 	}
 
-	return recordings, nil
+	return recordings, pr.Truncated(), nil
 }
 
 // sortRecordings sorts recordings by updated_at in descending order.
@@ -182,6 +241,7 @@ func filterRecordings(recordings []Recording, opts *ActivityListOptions) []Recor
 		capacity = opts.Limit
 	}
 	filtered := make([]Recording, 0, capacity)
+	people := opts.creatorSet()
 
 	for _, r := range recordings {
 		// Filter by since time
@@ -190,7 +250,7 @@ func filterRecordings(recordings []Recording, opts *ActivityListOptions) []Recor
 		}
 
 		// Filter by person ID
-		if opts.PersonID > 0 && r.Creator.ID != opts.PersonID {
+		if len(people) > 0 && !people[r.Creator.ID] {
 			continue
 		}
 
@@ -216,3 +276,21 @@ func (c *Client) GetRecording(ctx context.Context, projectID string, recordingID
 
 	return &recording, nil
 }
+
+// GetRecordingRaw fetches a recording of any type and returns the response
+// body exactly as the API sent it
+func (c *Client) GetRecordingRaw(ctx context.Context, projectID string, recordingID int64) (json.RawMessage, error) {
+	path := fmt.Sprintf("/buckets/%s/recordings/%d.json", projectID, recordingID)
+	resp, err := c.doRequestContext(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recording: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read recording response: %w", err)
+	}
+
+	return json.RawMessage(body), nil
+}
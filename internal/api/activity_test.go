@@ -89,6 +89,19 @@ func TestFilterRecordings(t *testing.T) {
 		}
 	})
 
+	t.Run("filter by any of several people", func(t *testing.T) {
+		opts := &ActivityListOptions{PersonIDs: []int64{200, 300}}
+		result := filterRecordings(recs, opts)
+
+		assert.Len(t, result, 2)
+		assert.Equal(t, int64(2), result[0].ID)
+		assert.Equal(t, int64(4), result[1].ID)
+
+		// The single-person field still applies alongside the list
+		opts = &ActivityListOptions{PersonIDs: []int64{300}, PersonID: 100}
+		assert.Len(t, filterRecordings(recs, opts), 3)
+	})
+
 	t.Run("filter with limit", func(t *testing.T) {
 		opts := &ActivityListOptions{Limit: 2}
 		result := filterRecordings(recs, opts)
@@ -280,12 +293,114 @@ func TestListRecordingsByType_EarlyTermination(t *testing.T) {
 		httpClient: &http.Client{},
 	}
 
-	recs, err := client.listRecordingsByType(context.Background(), "1", "Todo", &cutoff)
+	recs, truncated, err := client.listRecordingsByType(context.Background(), "1", "Todo", &cutoff, 0)
 	require.NoError(t, err)
 
 	// Should have page 1 (2 items) + page 2 (2 items) but NOT page 3
 	// Page 1 last item (90min ago) is after cutoff (2h ago) → continue
 	// Page 2 last item (4h ago) is before cutoff → stop
 	assert.Len(t, recs, 4, "should fetch pages 1 and 2 but stop before page 3")
+	assert.False(t, truncated, "stopping at the since boundary is not truncation")
 	assert.Equal(t, 2, pageIndex, "should have fetched exactly 2 pages")
 }
+
+// newRecordingPagesServer serves the given pages of recordings, linking each
+// page to the next with a Link header
+func newRecordingPagesServer(t *testing.T, pages [][]Recording, served *int) *httptest.Server {
+	t.Helper()
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page := 1
+		if p := r.URL.Query().Get("page"); p != "" {
+			_, _ = fmt.Sscanf(p, "%d", &page)
+		}
+		*served++
+		if page > len(pages) {
+			_, _ = w.Write([]byte("[]"))
+			return
+		}
+		if page < len(pages) {
+			nextURL := fmt.Sprintf("%s/123456/projects/recordings.json?type=Todo&page=%d", srv.URL, page+1)
+			w.Header().Set("Link", fmt.Sprintf(`<%s>; rel="next"`, nextURL))
+		}
+		_ = json.NewEncoder(w).Encode(pages[page-1])
+	}))
+	return srv
+}
+
+func TestListRecordings_AllPages(t *testing.T) {
+	now := time.Now()
+	var pages [][]Recording
+	id := int64(0)
+	for p := 0; p < 5; p++ {
+		var page []Recording
+		for i := 0; i < 3; i++ {
+			id++
+			page = append(page, Recording{ID: id, UpdatedAt: now.Add(-time.Duration(id) * time.Hour)})
+		}
+		pages = append(pages, page)
+	}
+
+	t.Run("fetches every page when unlimited", func(t *testing.T) {
+		served := 0
+		srv := newRecordingPagesServer(t, pages, &served)
+		defer srv.Close()
+		client := &Client{accountID: "123456", baseURL: srv.URL, httpClient: &http.Client{}}
+
+		recs, err := client.ListRecordings(context.Background(), "1", &ActivityListOptions{
+			RecordingTypes: []string{"Todo"},
+		})
+		require.NoError(t, err)
+		assert.Len(t, recs, 15)
+		assert.Equal(t, 5, served)
+	})
+
+	t.Run("fetches the full since window", func(t *testing.T) {
+		served := 0
+		srv := newRecordingPagesServer(t, pages, &served)
+		defer srv.Close()
+		client := &Client{accountID: "123456", baseURL: srv.URL, httpClient: &http.Client{}}
+
+		// Records 1-10 are within the window; page 4 crosses the boundary
+		since := now.Add(-10*time.Hour - 30*time.Minute)
+		recs, err := client.ListRecordings(context.Background(), "1", &ActivityListOptions{
+			RecordingTypes: []string{"Todo"},
+			Since:          &since,
+			MaxPages:       4,
+		})
+		require.NoError(t, err, "reaching the since boundary on the last allowed page is not truncation")
+		assert.Len(t, recs, 10)
+		assert.Equal(t, 4, served)
+	})
+
+	t.Run("returns a partial error when max pages is hit", func(t *testing.T) {
+		served := 0
+		srv := newRecordingPagesServer(t, pages, &served)
+		defer srv.Close()
+		client := &Client{accountID: "123456", baseURL: srv.URL, httpClient: &http.Client{}}
+
+		recs, err := client.ListRecordings(context.Background(), "1", &ActivityListOptions{
+			RecordingTypes: []string{"Todo"},
+			MaxPages:       2,
+		})
+		var partial *PartialError
+		require.ErrorAs(t, err, &partial)
+		assert.Equal(t, "older activity", partial.Missing)
+		assert.Len(t, recs, 6)
+		assert.Equal(t, 2, served)
+	})
+
+	t.Run("exactly max pages is not truncated", func(t *testing.T) {
+		served := 0
+		srv := newRecordingPagesServer(t, pages, &served)
+		defer srv.Close()
+		client := &Client{accountID: "123456", baseURL: srv.URL, httpClient: &http.Client{}}
+
+		recs, err := client.ListRecordings(context.Background(), "1", &ActivityListOptions{
+			RecordingTypes: []string{"Todo"},
+			MaxPages:       5,
+		})
+		require.NoError(t, err)
+		assert.Len(t, recs, 15)
+	})
+}
@@ -0,0 +1,73 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Assignment is a todo or card assigned to the current user, as listed by
+// the my/assignments endpoint
+type Assignment struct {
+	ID        int64   `json:"id"`
+	Type      string  `json:"type"`
+	Content   string  `json:"content"`
+	DueOn     string  `json:"due_on,omitempty"`
+	Completed bool    `json:"completed"`
+	AppURL    string  `json:"app_url"`
+	Bucket    *Bucket `json:"bucket,omitempty"`
+}
+
+// IsCard reports whether the assignment is a card table card
+func (a Assignment) IsCard() bool {
+	return strings.Contains(strings.ToLower(a.Type), "card")
+}
+
+// MyAssignments holds the current user's assignments, split the same way
+// Basecamp's "My Assignments" screen splits them
+type MyAssignments struct {
+	Priorities    []Assignment `json:"priorities"`
+	NonPriorities []Assignment `json:"non_priorities"`
+}
+
+// All returns priorities followed by non-priorities
+func (m *MyAssignments) All() []Assignment {
+	all := make([]Assignment, 0, len(m.Priorities)+len(m.NonPriorities))
+	all = append(all, m.Priorities...)
+	return append(all, m.NonPriorities...)
+}
+
+// OpenCounts returns the number of open todos and open cards assigned to
+// the current user
+func (m *MyAssignments) OpenCounts() (todos, cards int) {
+	for _, a := range m.All() {
+		if a.Completed {
+			continue
+		}
+		if a.IsCard() {
+			cards++
+		} else {
+			todos++
+		}
+	}
+	return todos, cards
+}
+
+// GetMyAssignments returns the todos and cards assigned to the current user
+// across all projects in the account
+func (c *Client) GetMyAssignments(ctx context.Context) (*MyAssignments, error) {
+	var assignments MyAssignments
+
+	resp, err := c.doRequestContext(ctx, "GET", "/my/assignments.json", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch assignments: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := json.NewDecoder(resp.Body).Decode(&assignments); err != nil {
+		return nil, fmt.Errorf("failed to decode assignments: %w", err)
+	}
+
+	return &assignments, nil
+}
@@ -0,0 +1,40 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetMyAssignments_OpenCounts(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/123456/my/assignments.json" {
+			t.Errorf("unexpected request: %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{
+			"priorities": [{"id": 1, "type": "todo", "content": "Ship it"}],
+			"non_priorities": [
+				{"id": 2, "type": "todo", "content": "Done already", "completed": true},
+				{"id": 3, "type": "todo", "content": "Review"},
+				{"id": 4, "type": "kanban_card", "content": "Fix login"}
+			]
+		}`))
+	}))
+	defer srv.Close()
+
+	client := &Client{accountID: "123456", baseURL: srv.URL, httpClient: &http.Client{}}
+
+	assignments, err := client.GetMyAssignments(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, assignments.All(), 4)
+
+	todos, cards := assignments.OpenCounts()
+	assert.Equal(t, 2, todos)
+	assert.Equal(t, 1, cards)
+}
@@ -0,0 +1,108 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBaseURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		raw      string
+		want     string
+		insecure bool
+		wantErr  string
+	}{
+		{name: "https", raw: "https://proxy.example.com", want: "https://proxy.example.com"},
+		{name: "trailing slash", raw: "https://proxy.example.com/", want: "https://proxy.example.com"},
+		{name: "with path", raw: "https://gateway.example.com/basecamp/", want: "https://gateway.example.com/basecamp"},
+		{name: "http is insecure", raw: "http://localhost:8080", want: "http://localhost:8080", insecure: true},
+		{name: "no scheme", raw: "proxy.example.com", wantErr: "must start with https://"},
+		{name: "other scheme", raw: "ftp://proxy.example.com", wantErr: "must start with https://"},
+		{name: "no host", raw: "https://", wantErr: "missing host"},
+		{name: "query", raw: "https://proxy.example.com?x=1", wantErr: "query or fragment"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, insecure, err := ParseBaseURL(tt.raw)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.insecure, insecure)
+		})
+	}
+}
+
+func TestNewModularClientWithBaseURL(t *testing.T) {
+	t.Run("sends requests to the base URL", func(t *testing.T) {
+		var gotPath, gotAuth string
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			gotPath = r.URL.Path
+			gotAuth = r.Header.Get("Authorization")
+			_, _ = w.Write([]byte(`{"id": 42, "name": "Website"}`))
+		}))
+		defer srv.Close()
+
+		client := NewModularClientWithBaseURL("123", "token", srv.URL+"/basecamp")
+		project, err := client.GetProject(context.Background(), "42")
+		require.NoError(t, err)
+		assert.Equal(t, "Website", project.Name)
+		assert.Equal(t, "/basecamp/123/projects/42.json", gotPath)
+		assert.Equal(t, "Bearer token", gotAuth)
+	})
+
+	t.Run("empty base URL uses the default", func(t *testing.T) {
+		client := NewModularClientWithBaseURL("123", "token", "")
+		assert.Equal(t, defaultBaseURL, client.baseURL)
+	})
+}
+
+func TestPathFromURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		baseURL string
+		link    string
+		want    string
+	}{
+		{
+			name:    "basecamp link",
+			baseURL: defaultBaseURL,
+			link:    "https://3.basecampapi.com/123/buckets/1/todos.json?page=2",
+			want:    "/buckets/1/todos.json?page=2",
+		},
+		{
+			name:    "link rewritten by a proxy",
+			baseURL: "https://proxy.example.com",
+			link:    "https://proxy.example.com/123/buckets/1/todos.json?page=2",
+			want:    "/buckets/1/todos.json?page=2",
+		},
+		{
+			name:    "proxy with a path prefix",
+			baseURL: "https://gateway.example.com/basecamp",
+			link:    "https://gateway.example.com/basecamp/123/buckets/1/todos.json?page=2",
+			want:    "/buckets/1/todos.json?page=2",
+		},
+		{
+			name:    "basecamp link through a proxy",
+			baseURL: "https://proxy.example.com",
+			link:    "https://3.basecampapi.com/123/buckets/1/todos.json?page=2",
+			want:    "/buckets/1/todos.json?page=2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewModularClientWithBaseURL("123", "token", tt.baseURL)
+			assert.Equal(t, tt.want, client.pathFromURL(tt.link))
+		})
+	}
+}
@@ -73,29 +73,86 @@ func (c *Client) GetCampfire(ctx context.Context, projectID string, campfireID i
 	return &campfire, nil
 }
 
+// CampfireLineOptions narrows the lines returned by GetCampfireLinesWithOptions
+type CampfireLineOptions struct {
+	// Limit caps the number of lines returned (0 for no limit)
+	Limit int
+	// Before, when set, excludes lines created at or after this time
+	Before time.Time
+	// After, when set, excludes lines created at or before this time
+	After time.Time
+}
+
 // GetCampfireLines returns messages from a campfire
 func (c *Client) GetCampfireLines(ctx context.Context, projectID string, campfireID int64, limit int) ([]CampfireLine, error) {
+	return c.GetCampfireLinesWithOptions(ctx, projectID, campfireID, CampfireLineOptions{Limit: limit})
+}
+
+// GetCampfireLinesWithOptions returns messages from a campfire, newest first.
+// The lines endpoint has no date filters, so Before and After are applied
+// while paging, stopping once lines are older than After.
+func (c *Client) GetCampfireLinesWithOptions(ctx context.Context, projectID string, campfireID int64, opts CampfireLineOptions) ([]CampfireLine, error) {
 	var lines []CampfireLine
 	path := fmt.Sprintf("/buckets/%s/chats/%d/lines.json", projectID, campfireID)
 
-	// If limit is specified, just get one page with that limit
-	if limit > 0 {
-		path = fmt.Sprintf("%s?limit=%d", path, limit)
-		if err := c.Get(path, &lines); err != nil {
+	// Without date filters a limit fits in a single page
+	if opts.Before.IsZero() && opts.After.IsZero() {
+		if opts.Limit > 0 {
+			path = fmt.Sprintf("%s?limit=%d", path, opts.Limit)
+			if err := c.Get(path, &lines); err != nil {
+				return nil, fmt.Errorf("failed to get campfire lines: %w", err)
+			}
+			return lines, nil
+		}
+
+		// Otherwise, use paginated request to get all lines
+		pr := NewPaginatedRequest(c).WithContext(ctx)
+		if err := pr.GetAll(path, &lines); err != nil {
 			return nil, fmt.Errorf("failed to get campfire lines: %w", err)
 		}
 		return lines, nil
 	}
 
-	// Otherwise, use paginated request to get all lines
-	pr := NewPaginatedRequest(c)
+	matched := 0
+	pr := NewPaginatedRequest(c).WithContext(ctx).WithPageCheck(func(page any) bool {
+		pageLines, ok := page.([]CampfireLine)
+		if !ok || len(pageLines) == 0 {
+			return false
+		}
+		matched += len(filterCampfireLines(pageLines, opts))
+		if opts.Limit > 0 && matched >= opts.Limit {
+			return false
+		}
+		// Lines come newest first, so later pages are older still
+		last := pageLines[len(pageLines)-1]
+		return opts.After.IsZero() || last.CreatedAt.After(opts.After)
+	})
 	if err := pr.GetAll(path, &lines); err != nil {
 		return nil, fmt.Errorf("failed to get campfire lines: %w", err)
 	}
 
+	lines = filterCampfireLines(lines, opts)
+	if opts.Limit > 0 && len(lines) > opts.Limit {
+		lines = lines[:opts.Limit]
+	}
 	return lines, nil
 }
 
+// filterCampfireLines keeps the lines inside the Before/After window
+func filterCampfireLines(lines []CampfireLine, opts CampfireLineOptions) []CampfireLine {
+	var filtered []CampfireLine
+	for _, line := range lines {
+		if !opts.Before.IsZero() && !line.CreatedAt.Before(opts.Before) {
+			continue
+		}
+		if !opts.After.IsZero() && !line.CreatedAt.After(opts.After) {
+			continue
+		}
+		filtered = append(filtered, line)
+	}
+	return filtered
+}
+
 // PostCampfireLine posts a new message to a campfire
 func (c *Client) PostCampfireLine(ctx context.Context, projectID string, campfireID int64, content string, contentType string) (*CampfireLine, error) {
 	var line CampfireLine
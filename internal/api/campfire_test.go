@@ -0,0 +1,83 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCampfireLinesWithOptions(t *testing.T) {
+	base := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	// Three pages of two lines each, newest first, one hour apart
+	var pages [][]CampfireLine
+	for p := 0; p < 3; p++ {
+		var page []CampfireLine
+		for i := 0; i < 2; i++ {
+			id := int64(p*2 + i + 1)
+			page = append(page, CampfireLine{ID: id, CreatedAt: base.Add(-time.Duration(id) * time.Hour)})
+		}
+		pages = append(pages, page)
+	}
+
+	newServer := func(served *int) *httptest.Server {
+		var srv *httptest.Server
+		srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			page := 1
+			if p := r.URL.Query().Get("page"); p != "" {
+				_, _ = fmt.Sscanf(p, "%d", &page)
+			}
+			*served++
+			if page < len(pages) {
+				nextURL := fmt.Sprintf("%s/123456/buckets/1/chats/2/lines.json?page=%d", srv.URL, page+1)
+				w.Header().Set("Link", fmt.Sprintf(`<%s>; rel="next"`, nextURL))
+			}
+			_ = json.NewEncoder(w).Encode(pages[page-1])
+		}))
+		return srv
+	}
+
+	lineIDs := func(lines []CampfireLine) []int64 {
+		ids := make([]int64, len(lines))
+		for i, line := range lines {
+			ids[i] = line.ID
+		}
+		return ids
+	}
+
+	t.Run("window stops paging past after", func(t *testing.T) {
+		served := 0
+		srv := newServer(&served)
+		defer srv.Close()
+		client := &Client{accountID: "123456", baseURL: srv.URL, httpClient: &http.Client{}}
+
+		lines, err := client.GetCampfireLinesWithOptions(context.Background(), "1", 2, CampfireLineOptions{
+			Before: base.Add(-1 * time.Hour),
+			After:  base.Add(-4 * time.Hour),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []int64{2, 3}, lineIDs(lines))
+		assert.Equal(t, 2, served, "page 2 reaches the after cutoff")
+	})
+
+	t.Run("limit applies after filtering", func(t *testing.T) {
+		served := 0
+		srv := newServer(&served)
+		defer srv.Close()
+		client := &Client{accountID: "123456", baseURL: srv.URL, httpClient: &http.Client{}}
+
+		lines, err := client.GetCampfireLinesWithOptions(context.Background(), "1", 2, CampfireLineOptions{
+			Limit:  2,
+			Before: base.Add(-2 * time.Hour),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []int64{3, 4}, lineIDs(lines))
+		assert.Equal(t, 2, served)
+	})
+}
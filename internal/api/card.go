@@ -3,7 +3,9 @@ package api
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"time"
 )
 
@@ -51,6 +53,7 @@ type Card struct {
 	Content       string    `json:"content"`
 	Status        string    `json:"status"`
 	DueOn         *string   `json:"due_on,omitempty"`
+	StartsOn      *string   `json:"starts_on,omitempty"`
 	Assignees     []Person  `json:"assignees"`
 	Steps         []Step    `json:"steps"`
 	StepsCount    int       `json:"steps_count"`
@@ -78,18 +81,21 @@ type Step struct {
 
 // CardCreateRequest represents the payload for creating a new card
 type CardCreateRequest struct {
-	Title   string  `json:"title"`
-	Content string  `json:"content,omitempty"`
-	DueOn   *string `json:"due_on,omitempty"`
-	Notify  bool    `json:"notify,omitempty"`
+	Title    string  `json:"title"`
+	Content  string  `json:"content,omitempty"`
+	DueOn    *string `json:"due_on,omitempty"`
+	StartsOn *string `json:"starts_on,omitempty"`
+	Notify   bool    `json:"notify,omitempty"`
 }
 
-// CardUpdateRequest represents the payload for updating a card
+// CardUpdateRequest represents the payload for updating a card.
+// AssigneeIDs is always sent so that an empty slice unassigns everyone.
 type CardUpdateRequest struct {
 	Title       string  `json:"title,omitempty"`
 	Content     string  `json:"content,omitempty"`
 	DueOn       *string `json:"due_on,omitempty"`
-	AssigneeIDs []int64 `json:"assignee_ids,omitempty"`
+	StartsOn    *string `json:"starts_on,omitempty"`
+	AssigneeIDs []int64 `json:"assignee_ids"`
 }
 
 // CardMoveRequest represents the payload for moving a card
@@ -147,6 +153,9 @@ type StepPositionRequest struct {
 	Position int   `json:"position"` // zero-indexed
 }
 
+// ErrNoCardTables is returned for projects without a card table
+var ErrNoCardTables = errors.New("no card tables found for project")
+
 // GetAllProjectCardTables fetches all card tables for a project
 func (c *Client) GetAllProjectCardTables(ctx context.Context, projectID string) ([]*CardTable, error) {
 	// First get the project to find its card tables
@@ -191,7 +200,7 @@ func (c *Client) GetAllProjectCardTables(ctx context.Context, projectID string)
 	}
 
 	if len(cardTables) == 0 {
-		return nil, fmt.Errorf("no card tables found for project")
+		return nil, ErrNoCardTables
 	}
 
 	return cardTables, nil
@@ -204,7 +213,7 @@ func (c *Client) GetProjectCardTable(ctx context.Context, projectID string) (*Ca
 		return nil, err
 	}
 	if len(cardTables) == 0 {
-		return nil, fmt.Errorf("no card tables found for project")
+		return nil, ErrNoCardTables
 	}
 	return cardTables[0], nil
 }
@@ -229,8 +238,19 @@ func (c *Client) GetCardTable(ctx context.Context, projectID string, cardTableID
 
 // GetCardsInColumn fetches all cards in a specific column
 func (c *Client) GetCardsInColumn(ctx context.Context, projectID string, columnID int64) ([]Card, error) {
+	return c.GetCardsInColumnWithStatus(ctx, projectID, columnID, StatusActive)
+}
+
+// GetCardsInColumnWithStatus fetches the cards in a column that have the
+// given status (active or archived)
+func (c *Client) GetCardsInColumnWithStatus(ctx context.Context, projectID string, columnID int64, status string) ([]Card, error) {
+	query, err := statusQuery("cards", status, StatusArchived)
+	if err != nil {
+		return nil, err
+	}
+
 	var cards []Card
-	path := fmt.Sprintf("/buckets/%s/card_tables/lists/%d/cards.json", projectID, columnID)
+	path := fmt.Sprintf("/buckets/%s/card_tables/lists/%d/cards.json%s", projectID, columnID, query)
 
 	// Use paginated request to get all cards
 	pr := NewPaginatedRequest(c)
@@ -247,7 +267,7 @@ func (c *Client) GetOnHoldCardsInColumn(ctx context.Context, onHoldCardsURL stri
 		return nil, nil
 	}
 
-	path := extractPathFromURL(onHoldCardsURL)
+	path := c.pathFromURL(onHoldCardsURL)
 	if path == "" {
 		return nil, fmt.Errorf("failed to extract path from on-hold cards URL: %s", onHoldCardsURL)
 	}
@@ -278,6 +298,33 @@ func (c *Client) GetCard(ctx context.Context, projectID string, cardID int64) (*
 	return &card, nil
 }
 
+// GetCardEvents returns a card's event timeline (column moves, assignment
+// changes, completion), oldest first
+func (c *Client) GetCardEvents(ctx context.Context, projectID string, cardID int64) ([]Event, error) {
+	events, err := c.ListEventsChronological(ctx, projectID, cardID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch card events: %w", err)
+	}
+	return events, nil
+}
+
+// GetCardRaw fetches a card and returns the response body exactly as the API sent it
+func (c *Client) GetCardRaw(ctx context.Context, projectID string, cardID int64) (json.RawMessage, error) {
+	path := fmt.Sprintf("/buckets/%s/card_tables/cards/%d.json", projectID, cardID)
+	resp, err := c.doRequest("GET", path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch card: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read card response: %w", err)
+	}
+
+	return json.RawMessage(body), nil
+}
+
 // CreateCard creates a new card in a column
 func (c *Client) CreateCard(ctx context.Context, projectID string, columnID int64, req CardCreateRequest) (*Card, error) {
 	var card Card
@@ -314,6 +361,29 @@ func (c *Client) MoveCard(ctx context.Context, projectID string, cardID int64, c
 	return nil
 }
 
+// MoveCardToTable moves a card to a column on another card table in the same
+// project. The column is checked against the target table first, since the
+// move endpoint itself accepts any column ID.
+func (c *Client) MoveCardToTable(ctx context.Context, projectID string, cardID int64, cardTableID int64, columnID int64) error {
+	cardTable, err := c.GetCardTable(ctx, projectID, cardTableID)
+	if err != nil {
+		return err
+	}
+
+	found := false
+	for _, column := range cardTable.Lists {
+		if column.ID == columnID || (column.OnHold.ID != 0 && column.OnHold.ID == columnID) {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return fmt.Errorf("column %d does not belong to card table '%s'", columnID, cardTable.Title)
+	}
+
+	return c.MoveCard(ctx, projectID, cardID, columnID)
+}
+
 // ArchiveCard archives a card
 func (c *Client) ArchiveCard(ctx context.Context, projectID string, cardID int64) error {
 	// Cards are archived by moving them to the archive state
@@ -326,6 +396,17 @@ func (c *Client) ArchiveCard(ctx context.Context, projectID string, cardID int64
 	return nil
 }
 
+// UnarchiveCard restores an archived card to its column
+func (c *Client) UnarchiveCard(ctx context.Context, projectID string, cardID int64) error {
+	path := fmt.Sprintf("/buckets/%s/recordings/%d/status/active.json", projectID, cardID)
+
+	if err := c.Put(path, nil, nil); err != nil {
+		return fmt.Errorf("failed to unarchive card: %w", err)
+	}
+
+	return nil
+}
+
 // CreateColumn creates a new column in a card table
 func (c *Client) CreateColumn(ctx context.Context, projectID string, cardTableID int64, req ColumnCreateRequest) (*Column, error) {
 	var column Column
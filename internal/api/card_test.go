@@ -0,0 +1,221 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateCard_SendsDates(t *testing.T) {
+	var body map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/123456/buckets/1/card_tables/lists/42/cards.json", r.URL.Path)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id": 99, "title": "Card"}`))
+	}))
+	defer srv.Close()
+
+	client := &Client{
+		accountID:  "123456",
+		baseURL:    srv.URL,
+		httpClient: &http.Client{},
+	}
+
+	due := "2025-01-20"
+	start := "2025-01-15"
+	card, err := client.CreateCard(context.Background(), "1", 42, CardCreateRequest{
+		Title:    "Card",
+		DueOn:    &due,
+		StartsOn: &start,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(99), card.ID)
+	assert.Equal(t, "2025-01-20", body["due_on"])
+	assert.Equal(t, "2025-01-15", body["starts_on"])
+}
+
+func TestUpdateCard_SendsDates(t *testing.T) {
+	var body map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		_, _ = w.Write([]byte(`{"id": 99, "title": "Card"}`))
+	}))
+	defer srv.Close()
+
+	client := &Client{
+		accountID:  "123456",
+		baseURL:    srv.URL,
+		httpClient: &http.Client{},
+	}
+
+	start := "2025-01-15"
+	_, err := client.UpdateCard(context.Background(), "1", 99, CardUpdateRequest{
+		Title:    "Card",
+		StartsOn: &start,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "2025-01-15", body["starts_on"])
+	_, hasDue := body["due_on"]
+	assert.False(t, hasDue, "unset due date should be omitted")
+}
+
+func TestUpdateCard_SendsEmptyAssignees(t *testing.T) {
+	var raw []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var err error
+		raw, err = io.ReadAll(r.Body)
+		require.NoError(t, err)
+		_, _ = w.Write([]byte(`{"id": 99, "title": "Card"}`))
+	}))
+	defer srv.Close()
+
+	client := &Client{
+		accountID:  "123456",
+		baseURL:    srv.URL,
+		httpClient: &http.Client{},
+	}
+
+	// Removing the last assignee leaves an empty, non-nil set
+	_, err := client.UpdateCard(context.Background(), "1", 99, CardUpdateRequest{
+		Title:       "Card",
+		AssigneeIDs: []int64{},
+	})
+	require.NoError(t, err)
+
+	assert.Contains(t, string(raw), `"assignee_ids":[]`)
+}
+
+func TestGetCardRaw_ReturnsBodyUnchanged(t *testing.T) {
+	payload := `{"id": 99, "title": "Card", "unknown_field": {"nested": [1, 2]}}`
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/123456/buckets/1/card_tables/cards/99.json", r.URL.Path)
+		_, _ = w.Write([]byte(payload))
+	}))
+	defer srv.Close()
+
+	client := &Client{
+		accountID:  "123456",
+		baseURL:    srv.URL,
+		httpClient: &http.Client{},
+	}
+
+	raw, err := client.GetCardRaw(context.Background(), "1", 99)
+	require.NoError(t, err)
+	assert.Equal(t, payload, string(raw))
+}
+
+func TestGetCardsInColumnWithStatus_SendsStatusParam(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/123456/buckets/1/card_tables/lists/42/cards.json", r.URL.Path)
+		assert.Equal(t, "archived", r.URL.Query().Get("status"))
+		_, _ = w.Write([]byte(`[{"id": 99, "title": "Old card", "status": "archived"}]`))
+	}))
+	defer srv.Close()
+
+	client := &Client{accountID: "123456", baseURL: srv.URL, httpClient: &http.Client{}}
+
+	cards, err := client.GetCardsInColumnWithStatus(context.Background(), "1", 42, StatusArchived)
+	require.NoError(t, err)
+	require.Len(t, cards, 1)
+	assert.Equal(t, "Old card", cards[0].Title)
+}
+
+func TestGetCardsInColumn_ActiveByDefault(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.URL.RawQuery)
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer srv.Close()
+
+	client := &Client{accountID: "123456", baseURL: srv.URL, httpClient: &http.Client{}}
+
+	_, err := client.GetCardsInColumn(context.Background(), "1", 42)
+	require.NoError(t, err)
+}
+
+func TestUnarchiveCard(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/123456/buckets/1/recordings/99/status/active.json", r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	client := &Client{accountID: "123456", baseURL: srv.URL, httpClient: &http.Client{}}
+
+	require.NoError(t, client.UnarchiveCard(context.Background(), "1", 99))
+}
+
+func TestMoveCardToTable(t *testing.T) {
+	var moved []int64
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/123456/buckets/1/card_tables/200.json":
+			_, _ = w.Write([]byte(`{"id": 200, "title": "Marketing Board", "lists": [
+				{"id": 4, "title": "Backlog", "on_hold": {"id": 40, "enabled": true}},
+				{"id": 5, "title": "Published"}
+			]}`))
+		case "/123456/buckets/1/card_tables/cards/99/moves.json":
+			assert.Equal(t, http.MethodPost, r.Method)
+			var req CardMoveRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			moved = append(moved, req.ColumnID)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	client := &Client{
+		accountID:  "123456",
+		baseURL:    srv.URL,
+		httpClient: &http.Client{},
+	}
+
+	require.NoError(t, client.MoveCardToTable(context.Background(), "1", 99, 200, 5))
+	require.NoError(t, client.MoveCardToTable(context.Background(), "1", 99, 200, 40))
+	assert.Equal(t, []int64{5, 40}, moved)
+
+	// A column from another board is rejected before anything moves
+	err := client.MoveCardToTable(context.Background(), "1", 99, 200, 2)
+	assert.ErrorContains(t, err, "column 2 does not belong to card table 'Marketing Board'")
+	assert.Len(t, moved, 2)
+}
+
+func TestGetCardEvents_OldestFirst(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/123456/buckets/1/recordings/42/events.json", r.URL.Path)
+		_, _ = w.Write([]byte(`[
+			{"id": 3, "action": "completed", "created_at": "2025-01-03T10:00:00Z", "creator": {"name": "Ann"}},
+			{"id": 2, "action": "assignment_changed", "details": {"added_assignee_ids": [7]}, "created_at": "2025-01-02T10:00:00Z"},
+			{"id": 1, "action": "created", "created_at": "2025-01-01T10:00:00Z"}
+		]`))
+	}))
+	defer srv.Close()
+
+	client := &Client{
+		accountID:  "123456",
+		baseURL:    srv.URL,
+		httpClient: &http.Client{},
+	}
+
+	events, err := client.GetCardEvents(context.Background(), "1", 42)
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+	assert.Equal(t, []int64{1, 2, 3}, []int64{events[0].ID, events[1].ID, events[2].ID})
+	assert.Equal(t, []any{float64(7)}, events[1].Details["added_assignee_ids"])
+	assert.Equal(t, "Ann", events[2].Creator.Name)
+}
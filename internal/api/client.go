@@ -3,9 +3,11 @@ package api
 import (
 	"context"
 	"encoding/json"
+	stderrors "errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 	"time"
 
@@ -44,6 +46,27 @@ func NewClientWithRetryConfig(accountID, accessToken string, retryConfig RetryCo
 	}
 }
 
+// ParseBaseURL checks that raw is an absolute http or https URL that can be
+// used in place of the Basecamp API URL, such as a proxy or a recording
+// server, and returns it without a trailing slash. insecure is true for
+// plain http URLs, which send the access token unencrypted.
+func ParseBaseURL(raw string) (baseURL string, insecure bool, err error) {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return "", false, fmt.Errorf("invalid API base URL %q: %w", raw, err)
+	}
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return "", false, fmt.Errorf("invalid API base URL %q: must start with https://", raw)
+	}
+	if u.Host == "" {
+		return "", false, fmt.Errorf("invalid API base URL %q: missing host", raw)
+	}
+	if u.RawQuery != "" || u.Fragment != "" {
+		return "", false, fmt.Errorf("invalid API base URL %q: must not have a query or fragment", raw)
+	}
+	return strings.TrimRight(u.String(), "/"), u.Scheme == "http", nil
+}
+
 func (c *Client) getBaseURL() string {
 	return fmt.Sprintf("%s/%s", c.baseURL, c.accountID)
 }
@@ -210,7 +233,7 @@ func (c *Client) GetProject(ctx context.Context, projectID string) (*Project, er
 	var project Project
 
 	path := fmt.Sprintf("/projects/%s.json", projectID)
-	resp, err := c.doRequest("GET", path, nil)
+	resp, err := c.doRequestContext(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch project: %w", err)
 	}
@@ -330,6 +353,9 @@ type TodoList struct {
 	TodosCount     int    `json:"todos_count"`
 	TodosURL       string `json:"todos_url"`
 	GroupsURL      string `json:"groups_url"`
+
+	// Parent is the list a group belongs to; it is the todo set for lists
+	Parent *Parent `json:"parent,omitempty"`
 }
 
 // TodoGroup represents a group of todos within a todo list
@@ -376,11 +402,49 @@ type Todo struct {
 	CreatedAt   string   `json:"created_at"`
 	UpdatedAt   string   `json:"updated_at"`
 	Completed   bool     `json:"completed"`
+	CompletedAt *string  `json:"completed_at,omitempty"`
+	Completer   *Person  `json:"completer,omitempty"`
 	DueOn       *string  `json:"due_on"`
 	StartsOn    *string  `json:"starts_on"`
 	TodolistID  int64    `json:"todolist_id"`
 	Creator     *Person  `json:"creator"`
 	Assignees   []Person `json:"assignees"`
+
+	// Parent is the recording the todo belongs to, usually its list or group
+	Parent *Parent `json:"parent,omitempty"`
+
+	// Completion is the raw completion record Basecamp includes on completed
+	// todos; CompletedAt and Completer are filled in from it when decoding
+	Completion *TodoCompletion `json:"completion,omitempty"`
+}
+
+// TodoCompletion records when and by whom a todo was completed
+type TodoCompletion struct {
+	CreatedAt string  `json:"created_at"`
+	Creator   *Person `json:"creator"`
+}
+
+// UnmarshalJSON decodes a todo, lifting the completion time and completer
+// out of the nested completion record when they aren't set directly
+func (t *Todo) UnmarshalJSON(data []byte) error {
+	type todoAlias Todo
+	var alias todoAlias
+	if err := json.Unmarshal(data, &alias); err != nil {
+		return err
+	}
+	*t = Todo(alias)
+
+	if t.Completion != nil {
+		if t.CompletedAt == nil && t.Completion.CreatedAt != "" {
+			completedAt := t.Completion.CreatedAt
+			t.CompletedAt = &completedAt
+		}
+		if t.Completer == nil {
+			t.Completer = t.Completion.Creator
+		}
+	}
+
+	return nil
 }
 
 // GetProjectTodoSet fetches the todo set for a project
@@ -425,13 +489,49 @@ func (c *Client) GetProjectTodoSet(ctx context.Context, projectID string) (*Todo
 		}
 	}
 
-	return nil, fmt.Errorf("todo set not found for project")
+	return nil, ErrNoTodoSet
+}
+
+// ErrNoTodoSet is returned by GetProjectTodoSet for projects whose To-dos
+// tool is turned off
+var ErrNoTodoSet = stderrors.New("todo set not found for project")
+
+// Recording statuses that can be requested from list endpoints
+const (
+	StatusActive   = "active"
+	StatusArchived = "archived"
+)
+
+// statusQuery returns the query string selecting status on an endpoint that
+// supports the given statuses. Active is the API default, so it adds nothing.
+func statusQuery(endpoint, status string, supported ...string) (string, error) {
+	if status == "" || status == StatusActive {
+		return "", nil
+	}
+	for _, s := range supported {
+		if s == status {
+			return "?status=" + status, nil
+		}
+	}
+	return "", fmt.Errorf("status %q is not supported for %s (supported: %s, %s)",
+		status, endpoint, StatusActive, strings.Join(supported, ", "))
 }
 
 // GetTodoLists fetches all todo lists in a todo set
 func (c *Client) GetTodoLists(ctx context.Context, projectID string, todoSetID int64) ([]TodoList, error) {
+	return c.GetTodoListsWithStatus(ctx, projectID, todoSetID, StatusActive)
+}
+
+// GetTodoListsWithStatus fetches the todo lists in a todo set that have the
+// given status (active or archived)
+func (c *Client) GetTodoListsWithStatus(ctx context.Context, projectID string, todoSetID int64, status string) ([]TodoList, error) {
+	query, err := statusQuery("todo lists", status, StatusArchived)
+	if err != nil {
+		return nil, err
+	}
+
 	var todoLists []TodoList
-	path := fmt.Sprintf("/buckets/%s/todosets/%d/todolists.json", projectID, todoSetID)
+	path := fmt.Sprintf("/buckets/%s/todosets/%d/todolists.json%s", projectID, todoSetID, query)
 
 	// Use paginated request to get all todo lists
 	pr := NewPaginatedRequest(c)
@@ -462,8 +562,19 @@ func (c *Client) GetTodoList(ctx context.Context, projectID string, todoListID i
 
 // GetTodos fetches all todos in a todo list
 func (c *Client) GetTodos(ctx context.Context, projectID string, todoListID int64) ([]Todo, error) {
+	return c.GetTodosWithStatus(ctx, projectID, todoListID, StatusActive)
+}
+
+// GetTodosWithStatus fetches the todos in a todo list that have the given
+// status (active or archived)
+func (c *Client) GetTodosWithStatus(ctx context.Context, projectID string, todoListID int64, status string) ([]Todo, error) {
+	query, err := statusQuery("todos", status, StatusArchived)
+	if err != nil {
+		return nil, err
+	}
+
 	var todos []Todo
-	path := fmt.Sprintf("/buckets/%s/todolists/%d/todos.json", projectID, todoListID)
+	path := fmt.Sprintf("/buckets/%s/todolists/%d/todos.json%s", projectID, todoListID, query)
 
 	// Use paginated request to get all todos
 	pr := NewPaginatedRequest(c)
@@ -474,7 +585,25 @@ func (c *Client) GetTodos(ctx context.Context, projectID string, todoListID int6
 	return todos, nil
 }
 
-// GetAllTodos fetches all todos in a todo list including completed ones
+// PartialError is returned alongside partial results when part of a
+// multi-request fetch fails. The results that were fetched are still valid.
+type PartialError struct {
+	// Missing describes what could not be fetched, e.g. "completed todos"
+	Missing string
+	Err     error
+}
+
+func (e *PartialError) Error() string {
+	return fmt.Sprintf("%s unavailable: %v", e.Missing, e.Err)
+}
+
+func (e *PartialError) Unwrap() error {
+	return e.Err
+}
+
+// GetAllTodos fetches all todos in a todo list including completed ones.
+// If only the completed todos fail to load, the incomplete todos are returned
+// together with a *PartialError.
 func (c *Client) GetAllTodos(ctx context.Context, projectID string, todoListID int64) ([]Todo, error) {
 	var allTodos []Todo
 
@@ -492,8 +621,8 @@ func (c *Client) GetAllTodos(ctx context.Context, projectID string, todoListID i
 	// Use paginated request to get all completed todos
 	pr := NewPaginatedRequest(c)
 	if err := pr.GetAll(path, &completedTodos); err != nil {
-		// If we can't get completed todos, just return the incomplete ones
-		return allTodos, err
+		// If we can't get completed todos, still return the incomplete ones
+		return allTodos, &PartialError{Missing: "completed todos", Err: err}
 	}
 
 	// Mark them as completed (in case the API doesn't set this)
@@ -528,13 +657,15 @@ type TodoCreateRequest struct {
 	AssigneeIDs []int64 `json:"assignee_ids,omitempty"`
 }
 
-// TodoUpdateRequest represents the payload for updating an existing todo
+// TodoUpdateRequest represents the payload for updating an existing todo.
+// Nil fields are left out; a Description pointing at "" clears it.
+// AssigneeIDs is always sent so that an empty slice unassigns everyone.
 type TodoUpdateRequest struct {
-	Content                 string  `json:"content,omitempty"`
-	Description             string  `json:"description,omitempty"`
+	Content                 *string `json:"content,omitempty"`
+	Description             *string `json:"description,omitempty"`
 	DueOn                   *string `json:"due_on,omitempty"`
 	StartsOn                *string `json:"starts_on,omitempty"`
-	AssigneeIDs             []int64 `json:"assignee_ids,omitempty"`
+	AssigneeIDs             []int64 `json:"assignee_ids"`
 	CompletionSubscriberIDs []int64 `json:"completion_subscriber_ids,omitempty"`
 }
 
@@ -674,6 +805,37 @@ func (c *Client) RepositionTodo(ctx context.Context, projectID string, todoID in
 	return nil
 }
 
+// ErrCrossProjectMoveUnsupported is returned when a todo would have to move
+// between projects, which the Basecamp API has no endpoint for
+var ErrCrossProjectMoveUnsupported = stderrors.New("moving todos between projects is not supported by the Basecamp API")
+
+// TodoMoveRequest represents the payload for moving a todo to another list
+type TodoMoveRequest struct {
+	Position int   `json:"position"`
+	ParentID int64 `json:"parent_id"`
+}
+
+// MoveTodo moves a todo into another todo list (or group) at the given
+// position. The destination must be in the same project; moves to another
+// project fail with ErrCrossProjectMoveUnsupported.
+func (c *Client) MoveTodo(ctx context.Context, projectID string, todoID int64, destProjectID string, destListID int64, position int) error {
+	if destProjectID != "" && destProjectID != projectID {
+		return ErrCrossProjectMoveUnsupported
+	}
+
+	req := TodoMoveRequest{
+		Position: position,
+		ParentID: destListID,
+	}
+
+	path := fmt.Sprintf("/buckets/%s/todos/%d/position.json", projectID, todoID)
+	if err := c.Put(path, req, nil); err != nil {
+		return fmt.Errorf("failed to move todo: %w", err)
+	}
+
+	return nil
+}
+
 // GetTodo fetches a single todo by ID
 func (c *Client) GetTodo(ctx context.Context, projectID string, todoID int64) (*Todo, error) {
 	var todo Todo
@@ -0,0 +1,46 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateComment_OnMessage(t *testing.T) {
+	var req CommentCreateRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Messages are recordings, so comments go through the generic endpoint
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/123456/buckets/1/recordings/34567890/comments.json", r.URL.Path)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id": 99, "content": "<div>Thanks</div>", "parent": {"id": 34567890, "type": "Message"}}`))
+	}))
+	defer srv.Close()
+
+	client := &Client{accountID: "123456", baseURL: srv.URL, httpClient: &http.Client{}}
+
+	comment, err := client.CreateComment(context.Background(), "1", 34567890, CommentCreateRequest{Content: "<div>Thanks</div>"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(99), comment.ID)
+	assert.Equal(t, "<div>Thanks</div>", req.Content)
+}
+
+func TestListComments_OnMessage(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/123456/buckets/1/recordings/34567890/comments.json", r.URL.Path)
+		_, _ = w.Write([]byte(`[{"id": 99}, {"id": 100}]`))
+	}))
+	defer srv.Close()
+
+	client := &Client{accountID: "123456", baseURL: srv.URL, httpClient: &http.Client{}}
+
+	comments, err := client.ListComments(context.Background(), "1", 34567890)
+	require.NoError(t, err)
+	assert.Len(t, comments, 2)
+}
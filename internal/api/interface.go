@@ -1,6 +1,9 @@
 package api
 
-import "context"
+import (
+	"context"
+	"encoding/json"
+)
 
 // APIClient defines the interface for interacting with the Basecamp API
 type APIClient interface {
@@ -23,6 +26,7 @@ type APIClient interface {
 	CreateTodoGroup(ctx context.Context, projectID string, todoListID int64, req TodoGroupCreateRequest) (*TodoGroup, error)
 	RepositionTodoGroup(ctx context.Context, projectID string, groupID int64, position int) error
 	RepositionTodo(ctx context.Context, projectID string, todoID int64, position int) error
+	MoveTodo(ctx context.Context, projectID string, todoID int64, destProjectID string, destListID int64, position int) error
 	CompleteTodo(ctx context.Context, projectID string, todoID int64) error
 	UncompleteTodo(ctx context.Context, projectID string, todoID int64) error
 
@@ -31,19 +35,28 @@ type APIClient interface {
 	GetCampfire(ctx context.Context, projectID string, campfireID int64) (*Campfire, error)
 	GetCampfireByName(ctx context.Context, projectID string, name string) (*Campfire, error)
 	GetCampfireLines(ctx context.Context, projectID string, campfireID int64, limit int) ([]CampfireLine, error)
+	GetCampfireLinesWithOptions(ctx context.Context, projectID string, campfireID int64, opts CampfireLineOptions) ([]CampfireLine, error)
 	PostCampfireLine(ctx context.Context, projectID string, campfireID int64, content string, contentType string) (*CampfireLine, error)
 	DeleteCampfireLine(ctx context.Context, projectID string, campfireID int64, lineID int64) error
 
+	// Message board methods
+	GetMessageBoard(ctx context.Context, projectID string) (*MessageBoard, error)
+	ListMessageBoards(ctx context.Context, projectID string) ([]MessageBoard, error)
+
 	// Card table methods
 	GetAllProjectCardTables(ctx context.Context, projectID string) ([]*CardTable, error)
 	GetProjectCardTable(ctx context.Context, projectID string) (*CardTable, error)
 	GetCardTable(ctx context.Context, projectID string, cardTableID int64) (*CardTable, error)
 	GetCardsInColumn(ctx context.Context, projectID string, columnID int64) ([]Card, error)
+	GetCardsInColumnWithStatus(ctx context.Context, projectID string, columnID int64, status string) ([]Card, error)
 	GetCard(ctx context.Context, projectID string, cardID int64) (*Card, error)
+	GetCardEvents(ctx context.Context, projectID string, cardID int64) ([]Event, error)
 	CreateCard(ctx context.Context, projectID string, columnID int64, req CardCreateRequest) (*Card, error)
 	UpdateCard(ctx context.Context, projectID string, cardID int64, req CardUpdateRequest) (*Card, error)
 	MoveCard(ctx context.Context, projectID string, cardID int64, columnID int64) error
+	MoveCardToTable(ctx context.Context, projectID string, cardID int64, cardTableID int64, columnID int64) error
 	ArchiveCard(ctx context.Context, projectID string, cardID int64) error
+	UnarchiveCard(ctx context.Context, projectID string, cardID int64) error
 
 	// Card step methods
 	CreateStep(ctx context.Context, projectID string, cardID int64, req StepCreateRequest) (*Step, error)
@@ -62,8 +75,16 @@ type APIClient interface {
 
 	// Activity methods
 	ListEvents(ctx context.Context, projectID string, recordingID int64) ([]Event, error)
+	ListEventsChronological(ctx context.Context, projectID string, recordingID int64) ([]Event, error)
 	ListRecordings(ctx context.Context, projectID string, opts *ActivityListOptions) ([]Recording, error)
 	GetRecording(ctx context.Context, projectID string, recordingID int64) (*Recording, error)
+	GetRecordingRaw(ctx context.Context, projectID string, recordingID int64) (json.RawMessage, error)
+
+	// Subscription methods
+	GetSubscription(ctx context.Context, projectID string, recordingID int64) (*Subscription, error)
+	GetSubscribers(ctx context.Context, projectID string, recordingID int64) ([]Person, error)
+	Subscribe(ctx context.Context, projectID string, recordingID int64) (*Subscription, error)
+	Unsubscribe(ctx context.Context, projectID string, recordingID int64) error
 
 	// Schedule methods
 	GetProjectSchedule(ctx context.Context, projectID string) (*Schedule, error)
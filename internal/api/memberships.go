@@ -0,0 +1,115 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// ProjectMembership is a project a person belongs to, along with their
+// person record as listed on that project
+type ProjectMembership struct {
+	Project Project `json:"project"`
+	Person  Person  `json:"person"`
+}
+
+// GetMyProjectMemberships returns the projects the current user is a member
+// of. Project people are fetched concurrently; if some projects fail to load,
+// the memberships that were found are returned along with a *PartialError.
+func (c *Client) GetMyProjectMemberships(ctx context.Context) ([]ProjectMembership, error) {
+	me, err := c.GetMyProfile(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.GetProjectMemberships(ctx, me.ID)
+}
+
+// GetProjectMemberships returns the projects in the account that the given
+// person is a member of, fetched the same way as GetMyProjectMemberships
+func (c *Client) GetProjectMemberships(ctx context.Context, personID int64) ([]ProjectMembership, error) {
+	projects, err := c.GetProjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	people := make([][]Person, len(projects))
+	errs := runBounded(ctx, len(projects), maxConcurrentRequests, func(ctx context.Context, i int) error {
+		var err error
+		people[i], err = c.GetProjectPeople(ctx, strconv.FormatInt(projects[i].ID, 10))
+		return err
+	})
+
+	var memberships []ProjectMembership
+	var firstErr error
+	failed := 0
+	for i, project := range projects {
+		if errs[i] != nil {
+			failed++
+			if firstErr == nil {
+				firstErr = fmt.Errorf("%s: %w", project.Name, errs[i])
+			}
+			continue
+		}
+		for _, person := range people[i] {
+			if person.ID == personID {
+				memberships = append(memberships, ProjectMembership{Project: project, Person: person})
+				break
+			}
+		}
+	}
+
+	if failed > 0 {
+		return memberships, &PartialError{
+			Missing: fmt.Sprintf("people for %d of %d projects", failed, len(projects)),
+			Err:     firstErr,
+		}
+	}
+	return memberships, nil
+}
+
+// GetOpenTodoCounts returns, for each of the given projects, how many open
+// todos are assigned to the person, keyed by project ID. The counts come from
+// a single request to the assigned todos report; projects with nothing
+// assigned count as zero.
+func (c *Client) GetOpenTodoCounts(ctx context.Context, projects []Project, personID int64) (map[int64]int, error) {
+	todos, err := c.GetAssignedTodos(ctx, personID)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[int64]int, len(projects))
+	for _, project := range projects {
+		counts[project.ID] = 0
+	}
+	for _, todo := range todos {
+		if todo.Completed || todo.IsCard() || todo.Bucket == nil {
+			continue
+		}
+		if _, ok := counts[todo.Bucket.ID]; ok {
+			counts[todo.Bucket.ID]++
+		}
+	}
+	return counts, nil
+}
+
+// GetAssignedTodos returns the todos assigned to the person across all
+// projects in the account
+// GET /reports/todos/assigned/{id}.json
+func (c *Client) GetAssignedTodos(ctx context.Context, personID int64) ([]Assignment, error) {
+	var report struct {
+		Todos []Assignment `json:"todos"`
+	}
+
+	resp, err := c.doRequestContext(ctx, "GET", fmt.Sprintf("/reports/todos/assigned/%d.json", personID), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch assigned todos: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := json.NewDecoder(resp.Body).Decode(&report); err != nil {
+		return nil, fmt.Errorf("failed to decode assigned todos: %w", err)
+	}
+
+	return report.Todos, nil
+}
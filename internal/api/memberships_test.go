@@ -0,0 +1,111 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetMyProjectMemberships_ReportsPartialFailures(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/123456/my/profile.json":
+			_, _ = w.Write([]byte(`{"id": 7, "name": "Me"}`))
+		case "/123456/projects.json":
+			_, _ = w.Write([]byte(`[{"id": 1, "name": "Alpha"}, {"id": 2, "name": "Beta"}, {"id": 3, "name": "Gamma"}]`))
+		case "/123456/projects/1/people.json":
+			_, _ = w.Write([]byte(`[{"id": 7, "name": "Me", "title": "Designer", "admin": true}, {"id": 8, "name": "Other"}]`))
+		case "/123456/projects/2/people.json":
+			_, _ = w.Write([]byte(`[{"id": 8, "name": "Other"}]`))
+		case "/123456/projects/3/people.json":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			t.Errorf("unexpected request: %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	client := &Client{accountID: "123456", baseURL: srv.URL, httpClient: &http.Client{}}
+
+	memberships, err := client.GetMyProjectMemberships(context.Background())
+
+	var partialErr *PartialError
+	require.True(t, errors.As(err, &partialErr), "expected a *PartialError, got %v", err)
+	assert.Equal(t, "people for 1 of 3 projects", partialErr.Missing)
+
+	require.Len(t, memberships, 1)
+	assert.Equal(t, "Alpha", memberships[0].Project.Name)
+	assert.Equal(t, "Designer", memberships[0].Person.Title)
+	assert.True(t, memberships[0].Person.Admin)
+}
+
+func TestRunBounded_LimitsConcurrency(t *testing.T) {
+	var running, peak int32
+	release := make(chan struct{})
+
+	done := make(chan []error)
+	go func() {
+		done <- runBounded(context.Background(), 10, 3, func(ctx context.Context, i int) error {
+			n := atomic.AddInt32(&running, 1)
+			for {
+				p := atomic.LoadInt32(&peak)
+				if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+					break
+				}
+			}
+			<-release
+			atomic.AddInt32(&running, -1)
+			if i == 4 {
+				return errors.New("boom")
+			}
+			return nil
+		})
+	}()
+
+	close(release)
+	errs := <-done
+
+	assert.LessOrEqual(t, atomic.LoadInt32(&peak), int32(3))
+	require.Len(t, errs, 10)
+	for i, err := range errs {
+		if i == 4 {
+			assert.EqualError(t, err, "boom")
+		} else {
+			assert.NoError(t, err, "index %d", i)
+		}
+	}
+}
+
+func TestGetOpenTodoCounts_UsesAssignedTodosReport(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/123456/reports/todos/assigned/7.json" {
+			t.Errorf("unexpected request: %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{
+			"person": {"id": 7, "name": "Jane"},
+			"todos": [
+				{"id": 1, "type": "Todo", "bucket": {"id": 1, "name": "Alpha"}},
+				{"id": 2, "type": "Todo", "bucket": {"id": 1, "name": "Alpha"}},
+				{"id": 3, "type": "Todo", "completed": true, "bucket": {"id": 1, "name": "Alpha"}},
+				{"id": 4, "type": "Kanban::Card", "bucket": {"id": 1, "name": "Alpha"}},
+				{"id": 5, "type": "Todo", "bucket": {"id": 9, "name": "Elsewhere"}}
+			]
+		}`))
+	}))
+	defer srv.Close()
+
+	client := &Client{accountID: "123456", baseURL: srv.URL, httpClient: &http.Client{}}
+
+	counts, err := client.GetOpenTodoCounts(context.Background(), []Project{{ID: 1, Name: "Alpha"}, {ID: 2, Name: "Beta"}}, 7)
+	require.NoError(t, err)
+	assert.Equal(t, map[int64]int{1: 2, 2: 0}, counts)
+}
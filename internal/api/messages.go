@@ -85,9 +85,30 @@ type MessageUpdateRequest struct {
 	CategoryID *int64 `json:"category_id,omitempty"`
 }
 
-// GetMessageBoard returns the message board for a project
+// GetMessageBoard returns the message board for a project. Projects with
+// several message boards get the first one in the dock.
 func (c *Client) GetMessageBoard(ctx context.Context, projectID string) (*MessageBoard, error) {
-	// First get the project to find its message board
+	boards, err := c.ListMessageBoards(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if len(boards) == 0 {
+		return nil, fmt.Errorf("message board not found for project")
+	}
+
+	// Get the full message board details
+	var board MessageBoard
+	boardPath := fmt.Sprintf("/buckets/%s/message_boards/%d.json", projectID, boards[0].ID)
+	if err := c.Get(boardPath, &board); err != nil {
+		return nil, fmt.Errorf("failed to get message board: %w", err)
+	}
+	return &board, nil
+}
+
+// ListMessageBoards returns the message boards in a project's dock, in dock
+// order. Only the ID, title, name, and URL are filled in.
+func (c *Client) ListMessageBoards(ctx context.Context, projectID string) ([]MessageBoard, error) {
+	// First get the project to find its message boards
 	project, err := c.GetProject(ctx, projectID)
 	if err != nil {
 		return nil, err
@@ -109,20 +130,15 @@ func (c *Client) GetMessageBoard(ctx context.Context, projectID string) (*Messag
 		return nil, fmt.Errorf("failed to fetch project tools: %w", err)
 	}
 
-	// Find the message board in the dock
+	// Collect the message boards in the dock
+	var boards []MessageBoard
 	for _, tool := range projectData.Dock {
 		if tool.Name == "message_board" {
-			// Get the full message board details
-			var board MessageBoard
-			boardPath := fmt.Sprintf("/buckets/%s/message_boards/%d.json", projectID, tool.ID)
-			if err := c.Get(boardPath, &board); err != nil {
-				return nil, fmt.Errorf("failed to get message board: %w", err)
-			}
-			return &board, nil
+			boards = append(boards, MessageBoard{ID: tool.ID, Title: tool.Title, Name: tool.Name, URL: tool.URL})
 		}
 	}
 
-	return nil, fmt.Errorf("message board not found for project")
+	return boards, nil
 }
 
 // ListMessages returns all messages on a message board
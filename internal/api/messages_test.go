@@ -384,3 +384,39 @@ func TestUpdateMessage(t *testing.T) {
 		})
 	}
 }
+
+func TestListMessageBoards(t *testing.T) {
+	requestCount := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestCount++
+		if r.URL.Path != "/123456/projects/1.json" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if requestCount == 1 {
+			_, _ = w.Write([]byte(`{"id": 1, "name": "Test Project"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"dock": [
+			{"id": 10, "title": "Announcements", "name": "message_board", "url": "https://3.basecamp.com/123456/buckets/1/message_boards/10"},
+			{"id": 20, "title": "To-dos", "name": "todoset"},
+			{"id": 30, "title": "Engineering", "name": "message_board", "url": "https://3.basecamp.com/123456/buckets/1/message_boards/30"}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := &Client{
+		accountID:  "123456",
+		baseURL:    server.URL,
+		httpClient: &http.Client{},
+	}
+
+	boards, err := client.ListMessageBoards(context.Background(), "1")
+	assert.NoError(t, err)
+	if assert.Len(t, boards, 2) {
+		assert.Equal(t, int64(10), boards[0].ID)
+		assert.Equal(t, "Announcements", boards[0].Title)
+		assert.Equal(t, int64(30), boards[1].ID)
+		assert.Equal(t, "Engineering", boards[1].Title)
+	}
+}
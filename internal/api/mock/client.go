@@ -2,6 +2,7 @@ package mock
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 
@@ -15,6 +16,9 @@ type MockClient struct {
 	ProjectsError error
 	Project       *api.Project
 	ProjectError  error
+	// ProjectWriteError is returned by the methods that create, change,
+	// or delete a project
+	ProjectWriteError error
 
 	// Todos
 	TodoSet             *api.TodoSet
@@ -39,6 +43,7 @@ type MockClient struct {
 	UpdateTodoListError error
 	CompleteTodoError   error
 	UncompleteTodoError error
+	MoveTodoError       error
 
 	// Campfires
 	Campfires               []api.Campfire
@@ -51,19 +56,26 @@ type MockClient struct {
 	PostCampfireLineError   error
 	DeleteCampfireLineError error
 
+	// Message boards
+	MessageBoard       *api.MessageBoard
+	MessageBoardError  error
+	MessageBoards      []api.MessageBoard
+	MessageBoardsError error
+
 	// Cards
-	CardTable        *api.CardTable
-	CardTableError   error
-	Cards            []api.Card
-	CardsError       error
-	Card             *api.Card
-	CardError        error
-	CreatedCard      *api.Card
-	CreateCardError  error
-	UpdatedCard      *api.Card
-	UpdateCardError  error
-	MoveCardError    error
-	ArchiveCardError error
+	CardTable          *api.CardTable
+	CardTableError     error
+	Cards              []api.Card
+	CardsError         error
+	Card               *api.Card
+	CardError          error
+	CreatedCard        *api.Card
+	CreateCardError    error
+	UpdatedCard        *api.Card
+	UpdateCardError    error
+	MoveCardError      error
+	ArchiveCardError   error
+	UnarchiveCardError error
 
 	// Steps
 	CreatedStep            *api.Step
@@ -90,6 +102,65 @@ type MockClient struct {
 	Recording       *api.Recording
 	RecordingError  error
 
+	// Check-ins
+	Questionnaire       *api.Questionnaire
+	QuestionnaireError  error
+	CreatedQuestion     *api.Question
+	CreateQuestionError error
+	Questions           []api.Question
+	QuestionsError      error
+	Question            *api.Question
+	QuestionError       error
+	PauseQuestionError  error
+	UpdatedSettings     *api.QuestionNotificationSettings
+	UpdateSettingsError error
+	SettingsRequest     *api.NotificationSettingsUpdateRequest
+	UpdatedQuestion     *api.Question
+	UpdateQuestionError error
+	Answers             []api.QuestionAnswer
+	AnswersError        error
+	Answer              *api.QuestionAnswer
+	AnswerError         error
+	Answerers           []api.Person
+	AnswerersError      error
+	CreatedAnswer       *api.QuestionAnswer
+	CreateAnswerError   error
+	UpdatedAnswer       *api.QuestionAnswer
+	UpdateAnswerError   error
+	Reminders           []api.QuestionReminder
+	RemindersError      error
+
+	// Documents
+	Vault               *api.Vault
+	VaultError          error
+	CreatedDocument     *api.Document
+	CreateDocumentError error
+	Documents           []api.Document
+	DocumentsError      error
+	Document            *api.Document
+	DocumentError       error
+	UpdatedDocument     *api.Document
+	UpdateDocumentError error
+	DeleteDocumentError error
+
+	// Comments
+	Comments           []api.Comment
+	Comment            *api.Comment
+	CommentError       error
+	CreatedComment     *api.Comment
+	CreateCommentError error
+	UpdatedComment     *api.Comment
+	UpdateCommentError error
+	TrashCommentError  error
+
+	// Subscriptions
+	Subscription      *api.Subscription
+	SubscriptionError error
+	Subscribers       []api.Person
+	SubscribersError  error
+	SubscribeError    error
+	UnsubscribeError  error
+
 	// Timesheets
 	TimesheetEntries []api.TimesheetEntry
 	TimesheetError   error
@@ -130,6 +201,51 @@ func (m *MockClient) GetProject(ctx context.Context, projectID string) (*api.Pro
 	return m.Project, nil
 }
 
+// CreateProject mock implementation
+func (m *MockClient) CreateProject(ctx context.Context, req api.ProjectCreateRequest) (*api.Project, error) {
+	m.Calls = append(m.Calls, fmt.Sprintf("CreateProject(%s)", req.Name))
+	if m.ProjectWriteError != nil {
+		return nil, m.ProjectWriteError
+	}
+	return m.Project, nil
+}
+
+// UpdateProject mock implementation
+func (m *MockClient) UpdateProject(ctx context.Context, projectID string, req api.ProjectUpdateRequest) (*api.Project, error) {
+	m.Calls = append(m.Calls, fmt.Sprintf("UpdateProject(%s)", projectID))
+	if m.ProjectWriteError != nil {
+		return nil, m.ProjectWriteError
+	}
+	return m.Project, nil
+}
+
+// DeleteProject mock implementation
+func (m *MockClient) DeleteProject(ctx context.Context, projectID string) error {
+	m.Calls = append(m.Calls, fmt.Sprintf("DeleteProject(%s)", projectID))
+	return m.ProjectWriteError
+}
+
+// ArchiveProject mock implementation
+func (m *MockClient) ArchiveProject(ctx context.Context, projectID string) error {
+	m.Calls = append(m.Calls, fmt.Sprintf("ArchiveProject(%s)", projectID))
+	return m.ProjectWriteError
+}
+
+// UnarchiveProject mock implementation
+func (m *MockClient) UnarchiveProject(ctx context.Context, projectID string) error {
+	m.Calls = append(m.Calls, fmt.Sprintf("UnarchiveProject(%s)", projectID))
+	return m.ProjectWriteError
+}
+
+// CopyProject mock implementation
+func (m *MockClient) CopyProject(ctx context.Context, sourceProjectID string, name string, description string) (*api.Project, error) {
+	m.Calls = append(m.Calls, fmt.Sprintf("CopyProject(%s, %s)", sourceProjectID, name))
+	if m.ProjectWriteError != nil {
+		return nil, m.ProjectWriteError
+	}
+	return m.Project, nil
+}
+
 // GetProjectTodoSet mock implementation
 func (m *MockClient) GetProjectTodoSet(ctx context.Context, projectID string) (*api.TodoSet, error) {
 	m.Calls = append(m.Calls, fmt.Sprintf("GetProjectTodoSet(%s)", projectID))
@@ -148,6 +264,15 @@ func (m *MockClient) GetTodoLists(ctx context.Context, projectID string, todoSet
 	return m.TodoLists, nil
 }
 
+// GetTodoListsWithStatus mock implementation
+func (m *MockClient) GetTodoListsWithStatus(ctx context.Context, projectID string, todoSetID int64, status string) ([]api.TodoList, error) {
+	m.Calls = append(m.Calls, fmt.Sprintf("GetTodoListsWithStatus(%s, %d, %s)", projectID, todoSetID, status))
+	if m.TodoListsError != nil {
+		return nil, m.TodoListsError
+	}
+	return m.TodoLists, nil
+}
+
 // GetTodoList mock implementation
 func (m *MockClient) GetTodoList(ctx context.Context, projectID string, todoListID int64) (*api.TodoList, error) {
 	m.Calls = append(m.Calls, fmt.Sprintf("GetTodoList(%s, %d)", projectID, todoListID))
@@ -166,6 +291,15 @@ func (m *MockClient) GetTodos(ctx context.Context, projectID string, todoListID
 	return m.Todos, nil
 }
 
+// GetTodosWithStatus mock implementation
+func (m *MockClient) GetTodosWithStatus(ctx context.Context, projectID string, todoListID int64, status string) ([]api.Todo, error) {
+	m.Calls = append(m.Calls, fmt.Sprintf("GetTodosWithStatus(%s, %d, %s)", projectID, todoListID, status))
+	if m.TodosError != nil {
+		return nil, m.TodosError
+	}
+	return m.Todos, nil
+}
+
 // GetAllTodos mock implementation
 func (m *MockClient) GetAllTodos(ctx context.Context, projectID string, todoListID int64) ([]api.Todo, error) {
 	m.Calls = append(m.Calls, fmt.Sprintf("GetAllTodos(%s, %d)", projectID, todoListID))
@@ -247,6 +381,12 @@ func (m *MockClient) RepositionTodo(ctx context.Context, projectID string, todoI
 	return nil
 }
 
+// MoveTodo mock implementation
+func (m *MockClient) MoveTodo(ctx context.Context, projectID string, todoID int64, destProjectID string, destListID int64, position int) error {
+	m.Calls = append(m.Calls, fmt.Sprintf("MoveTodo(%s, %d, %s, %d, %d)", projectID, todoID, destProjectID, destListID, position))
+	return m.MoveTodoError
+}
+
 // CompleteTodo mock implementation
 func (m *MockClient) CompleteTodo(ctx context.Context, projectID string, todoID int64) error {
 	m.Calls = append(m.Calls, fmt.Sprintf("CompleteTodo(%s, %d)", projectID, todoID))
@@ -295,6 +435,15 @@ func (m *MockClient) GetCampfireLines(ctx context.Context, projectID string, cam
 	return m.CampfireLines, nil
 }
 
+// GetCampfireLinesWithOptions mock implementation
+func (m *MockClient) GetCampfireLinesWithOptions(ctx context.Context, projectID string, campfireID int64, opts api.CampfireLineOptions) ([]api.CampfireLine, error) {
+	m.Calls = append(m.Calls, fmt.Sprintf("GetCampfireLinesWithOptions(%s, %d, %d)", projectID, campfireID, opts.Limit))
+	if m.CampfireLinesError != nil {
+		return nil, m.CampfireLinesError
+	}
+	return m.CampfireLines, nil
+}
+
 // PostCampfireLine mock implementation
 func (m *MockClient) PostCampfireLine(ctx context.Context, projectID string, campfireID int64, content string, contentType string) (*api.CampfireLine, error) {
 	m.Calls = append(m.Calls, fmt.Sprintf("PostCampfireLine(%s, %d, %s, %s)", projectID, campfireID, content, contentType))
@@ -310,6 +459,24 @@ func (m *MockClient) DeleteCampfireLine(ctx context.Context, projectID string, c
 	return m.DeleteCampfireLineError
 }
 
+// GetMessageBoard mock implementation
+func (m *MockClient) GetMessageBoard(ctx context.Context, projectID string) (*api.MessageBoard, error) {
+	m.Calls = append(m.Calls, fmt.Sprintf("GetMessageBoard(%s)", projectID))
+	if m.MessageBoardError != nil {
+		return nil, m.MessageBoardError
+	}
+	return m.MessageBoard, nil
+}
+
+// ListMessageBoards mock implementation
+func (m *MockClient) ListMessageBoards(ctx context.Context, projectID string) ([]api.MessageBoard, error) {
+	m.Calls = append(m.Calls, fmt.Sprintf("ListMessageBoards(%s)", projectID))
+	if m.MessageBoardsError != nil {
+		return nil, m.MessageBoardsError
+	}
+	return m.MessageBoards, nil
+}
+
 // GetAllProjectCardTables mock implementation
 func (m *MockClient) GetAllProjectCardTables(ctx context.Context, projectID string) ([]*api.CardTable, error) {
 	m.Calls = append(m.Calls, fmt.Sprintf("GetAllProjectCardTables(%s)", projectID))
@@ -349,6 +516,15 @@ func (m *MockClient) GetCardsInColumn(ctx context.Context, projectID string, col
 	return m.Cards, nil
 }
 
+// GetCardsInColumnWithStatus mock implementation
+func (m *MockClient) GetCardsInColumnWithStatus(ctx context.Context, projectID string, columnID int64, status string) ([]api.Card, error) {
+	m.Calls = append(m.Calls, fmt.Sprintf("GetCardsInColumnWithStatus(%s, %d, %s)", projectID, columnID, status))
+	if m.CardsError != nil {
+		return nil, m.CardsError
+	}
+	return m.Cards, nil
+}
+
 // GetCard mock implementation
 func (m *MockClient) GetCard(ctx context.Context, projectID string, cardID int64) (*api.Card, error) {
 	m.Calls = append(m.Calls, fmt.Sprintf("GetCard(%s, %d)", projectID, cardID))
@@ -358,6 +534,33 @@ func (m *MockClient) GetCard(ctx context.Context, projectID string, cardID int64
 	return m.Card, nil
 }
 
+// GetCardEvents mock implementation
+func (m *MockClient) GetCardEvents(ctx context.Context, projectID string, cardID int64) ([]api.Event, error) {
+	m.Calls = append(m.Calls, fmt.Sprintf("GetCardEvents(%s, %d)", projectID, cardID))
+	if m.EventsError != nil {
+		return nil, m.EventsError
+	}
+	return m.Events, nil
+}
+
+// GetOnHoldCardsInColumn mock implementation
+func (m *MockClient) GetOnHoldCardsInColumn(ctx context.Context, onHoldCardsURL string) ([]api.Card, error) {
+	m.Calls = append(m.Calls, fmt.Sprintf("GetOnHoldCardsInColumn(%s)", onHoldCardsURL))
+	if m.CardsError != nil {
+		return nil, m.CardsError
+	}
+	return m.Cards, nil
+}
+
+// GetCardRaw mock implementation
+func (m *MockClient) GetCardRaw(ctx context.Context, projectID string, cardID int64) (json.RawMessage, error) {
+	m.Calls = append(m.Calls, fmt.Sprintf("GetCardRaw(%s, %d)", projectID, cardID))
+	if m.CardError != nil {
+		return nil, m.CardError
+	}
+	return json.Marshal(m.Card)
+}
+
 // CreateCard mock implementation
 func (m *MockClient) CreateCard(ctx context.Context, projectID string, columnID int64, req api.CardCreateRequest) (*api.Card, error) {
 	m.Calls = append(m.Calls, fmt.Sprintf("CreateCard(%s, %d, %+v)", projectID, columnID, req))
@@ -382,12 +585,24 @@ func (m *MockClient) MoveCard(ctx context.Context, projectID string, cardID int6
 	return m.MoveCardError
 }
 
+// MoveCardToTable mock implementation
+func (m *MockClient) MoveCardToTable(ctx context.Context, projectID string, cardID int64, cardTableID int64, columnID int64) error {
+	m.Calls = append(m.Calls, fmt.Sprintf("MoveCardToTable(%s, %d, %d, %d)", projectID, cardID, cardTableID, columnID))
+	return m.MoveCardError
+}
+
 // ArchiveCard mock implementation
 func (m *MockClient) ArchiveCard(ctx context.Context, projectID string, cardID int64) error {
 	m.Calls = append(m.Calls, fmt.Sprintf("ArchiveCard(%s, %d)", projectID, cardID))
 	return m.ArchiveCardError
 }
 
+// UnarchiveCard mock implementation
+func (m *MockClient) UnarchiveCard(ctx context.Context, projectID string, cardID int64) error {
+	m.Calls = append(m.Calls, fmt.Sprintf("UnarchiveCard(%s, %d)", projectID, cardID))
+	return m.UnarchiveCardError
+}
+
 // CreateStep mock implementation
 func (m *MockClient) CreateStep(ctx context.Context, projectID string, cardID int64, req api.StepCreateRequest) (*api.Step, error) {
 	m.Calls = append(m.Calls, fmt.Sprintf("CreateStep(%s, %d, %+v)", projectID, cardID, req))
@@ -490,6 +705,15 @@ func (m *MockClient) ListEvents(ctx context.Context, projectID string, recording
 	return m.Events, nil
 }
 
+// ListEventsChronological mock implementation
+func (m *MockClient) ListEventsChronological(ctx context.Context, projectID string, recordingID int64) ([]api.Event, error) {
+	m.Calls = append(m.Calls, fmt.Sprintf("ListEventsChronological(%s, %d)", projectID, recordingID))
+	if m.EventsError != nil {
+		return nil, m.EventsError
+	}
+	return m.Events, nil
+}
+
 // ListRecordings mock implementation
 func (m *MockClient) ListRecordings(ctx context.Context, projectID string, opts *api.ActivityListOptions) ([]api.Recording, error) {
 	m.Calls = append(m.Calls, fmt.Sprintf("ListRecordings(%s, %+v)", projectID, opts))
@@ -508,6 +732,252 @@ func (m *MockClient) GetRecording(ctx context.Context, projectID string, recordi
 	return m.Recording, nil
 }
 
+// GetRecordingRaw mock implementation
+func (m *MockClient) GetRecordingRaw(ctx context.Context, projectID string, recordingID int64) (json.RawMessage, error) {
+	m.Calls = append(m.Calls, fmt.Sprintf("GetRecordingRaw(%s, %d)", projectID, recordingID))
+	if m.RecordingError != nil {
+		return nil, m.RecordingError
+	}
+	return json.Marshal(m.Recording)
+}
+
+// GetProjectQuestionnaire mock implementation
+func (m *MockClient) GetProjectQuestionnaire(ctx context.Context, projectID string) (*api.Questionnaire, error) {
+	m.Calls = append(m.Calls, fmt.Sprintf("GetProjectQuestionnaire(%s)", projectID))
+	if m.QuestionnaireError != nil {
+		return nil, m.QuestionnaireError
+	}
+	if m.Questionnaire != nil {
+		return m.Questionnaire, nil
+	}
+	return &api.Questionnaire{ID: 1}, nil
+}
+
+// CreateQuestion mock implementation
+func (m *MockClient) CreateQuestion(ctx context.Context, projectID string, questionnaireID int64, req api.QuestionCreateRequest) (*api.Question, error) {
+	m.Calls = append(m.Calls, fmt.Sprintf("CreateQuestion(%s, %d)", projectID, questionnaireID))
+	if m.CreateQuestionError != nil {
+		return nil, m.CreateQuestionError
+	}
+	if m.CreatedQuestion != nil {
+		return m.CreatedQuestion, nil
+	}
+	return &api.Question{
+		ID:    1,
+		Title: req.Title,
+		Schedule: &api.QuestionSchedule{
+			Frequency: req.Schedule,
+			Days:      req.Days,
+			Hour:      req.Hour,
+			Minute:    req.Minute,
+		},
+	}, nil
+}
+
+// ListQuestions mock implementation
+func (m *MockClient) ListQuestions(ctx context.Context, projectID string, questionnaireID int64) ([]api.Question, error) {
+	m.Calls = append(m.Calls, fmt.Sprintf("ListQuestions(%s, %d)", projectID, questionnaireID))
+	if m.QuestionsError != nil {
+		return nil, m.QuestionsError
+	}
+	return m.Questions, nil
+}
+
+// GetQuestion mock implementation
+func (m *MockClient) GetQuestion(ctx context.Context, projectID string, questionID int64) (*api.Question, error) {
+	m.Calls = append(m.Calls, fmt.Sprintf("GetQuestion(%s, %d)", projectID, questionID))
+	if m.QuestionError != nil {
+		return nil, m.QuestionError
+	}
+	return m.Question, nil
+}
+
+// PauseQuestion mock implementation. On success it marks Question as paused,
+// so a following GetQuestion sees the change.
+func (m *MockClient) PauseQuestion(ctx context.Context, projectID string, questionID int64) error {
+	m.Calls = append(m.Calls, fmt.Sprintf("PauseQuestion(%s, %d)", projectID, questionID))
+	if m.PauseQuestionError != nil {
+		return m.PauseQuestionError
+	}
+	if m.Question != nil {
+		m.Question.Paused = true
+	}
+	return nil
+}
+
+// ResumeQuestion mock implementation. On success it marks Question as no
+// longer paused.
+func (m *MockClient) ResumeQuestion(ctx context.Context, projectID string, questionID int64) error {
+	m.Calls = append(m.Calls, fmt.Sprintf("ResumeQuestion(%s, %d)", projectID, questionID))
+	if m.PauseQuestionError != nil {
+		return m.PauseQuestionError
+	}
+	if m.Question != nil {
+		m.Question.Paused = false
+	}
+	return nil
+}
+
+// UpdateNotificationSettings mock implementation
+func (m *MockClient) UpdateNotificationSettings(ctx context.Context, projectID string, questionID int64, req api.NotificationSettingsUpdateRequest) (*api.QuestionNotificationSettings, error) {
+	m.Calls = append(m.Calls, fmt.Sprintf("UpdateNotificationSettings(%s, %d)", projectID, questionID))
+	m.SettingsRequest = &req
+	if m.UpdateSettingsError != nil {
+		return nil, m.UpdateSettingsError
+	}
+	return m.UpdatedSettings, nil
+}
+
+// UpdateQuestion mock implementation
+func (m *MockClient) UpdateQuestion(ctx context.Context, projectID string, questionID int64, req api.QuestionUpdateRequest) (*api.Question, error) {
+	m.Calls = append(m.Calls, fmt.Sprintf("UpdateQuestion(%s, %d)", projectID, questionID))
+	if m.UpdateQuestionError != nil {
+		return nil, m.UpdateQuestionError
+	}
+	return m.UpdatedQuestion, nil
+}
+
+// ListAnswers mock implementation
+func (m *MockClient) ListAnswers(ctx context.Context, projectID string, questionID int64, opts *api.AnswerListOptions) ([]api.QuestionAnswer, error) {
+	m.Calls = append(m.Calls, fmt.Sprintf("ListAnswers(%s, %d)", projectID, questionID))
+	if m.AnswersError != nil {
+		return nil, m.AnswersError
+	}
+	return m.Answers, nil
+}
+
+// ListAnswerers mock implementation
+func (m *MockClient) ListAnswerers(ctx context.Context, projectID string, questionID int64) ([]api.Person, error) {
+	m.Calls = append(m.Calls, fmt.Sprintf("ListAnswerers(%s, %d)", projectID, questionID))
+	if m.AnswerersError != nil {
+		return nil, m.AnswerersError
+	}
+	return m.Answerers, nil
+}
+
+// GetAnswersByPerson mock implementation
+func (m *MockClient) GetAnswersByPerson(ctx context.Context, projectID string, questionID int64, personID int64) ([]api.QuestionAnswer, error) {
+	m.Calls = append(m.Calls, fmt.Sprintf("GetAnswersByPerson(%s, %d, %d)", projectID, questionID, personID))
+	if m.AnswersError != nil {
+		return nil, m.AnswersError
+	}
+	return m.Answers, nil
+}
+
+// GetAnswer mock implementation
+func (m *MockClient) GetAnswer(ctx context.Context, projectID string, answerID int64) (*api.QuestionAnswer, error) {
+	m.Calls = append(m.Calls, fmt.Sprintf("GetAnswer(%s, %d)", projectID, answerID))
+	if m.AnswerError != nil {
+		return nil, m.AnswerError
+	}
+	return m.Answer, nil
+}
+
+// CreateAnswer mock implementation
+func (m *MockClient) CreateAnswer(ctx context.Context, projectID string, questionID int64, req api.AnswerCreateRequest) (*api.QuestionAnswer, error) {
+	m.Calls = append(m.Calls, fmt.Sprintf("CreateAnswer(%s, %d)", projectID, questionID))
+	if m.CreateAnswerError != nil {
+		return nil, m.CreateAnswerError
+	}
+	return m.CreatedAnswer, nil
+}
+
+// UpdateAnswer mock implementation
+func (m *MockClient) UpdateAnswer(ctx context.Context, projectID string, answerID int64, req api.AnswerUpdateRequest) (*api.QuestionAnswer, error) {
+	m.Calls = append(m.Calls, fmt.Sprintf("UpdateAnswer(%s, %d)", projectID, answerID))
+	if m.UpdateAnswerError != nil {
+		return nil, m.UpdateAnswerError
+	}
+	return m.UpdatedAnswer, nil
+}
+
+// ListMyReminders mock implementation
+func (m *MockClient) ListMyReminders(ctx context.Context) ([]api.QuestionReminder, error) {
+	m.Calls = append(m.Calls, "ListMyReminders()")
+	if m.RemindersError != nil {
+		return nil, m.RemindersError
+	}
+	return m.Reminders, nil
+}
+
+// CreateComment mock implementation
+func (m *MockClient) CreateComment(ctx context.Context, projectID string, recordingID int64, req api.CommentCreateRequest) (*api.Comment, error) {
+	m.Calls = append(m.Calls, fmt.Sprintf("CreateComment(%s, %d)", projectID, recordingID))
+	if m.CreateCommentError != nil {
+		return nil, m.CreateCommentError
+	}
+	if m.CreatedComment != nil {
+		return m.CreatedComment, nil
+	}
+	return &api.Comment{ID: 1, Content: req.Content}, nil
+}
+
+// ListComments mock implementation
+func (m *MockClient) ListComments(ctx context.Context, projectID string, recordingID int64) ([]api.Comment, error) {
+	m.Calls = append(m.Calls, fmt.Sprintf("ListComments(%s, %d)", projectID, recordingID))
+	if m.CommentError != nil {
+		return nil, m.CommentError
+	}
+	return m.Comments, nil
+}
+
+// GetComment mock implementation
+func (m *MockClient) GetComment(ctx context.Context, projectID string, commentID int64) (*api.Comment, error) {
+	m.Calls = append(m.Calls, fmt.Sprintf("GetComment(%s, %d)", projectID, commentID))
+	if m.CommentError != nil {
+		return nil, m.CommentError
+	}
+	return m.Comment, nil
+}
+
+// UpdateComment mock implementation
+func (m *MockClient) UpdateComment(ctx context.Context, projectID string, commentID int64, req api.CommentUpdateRequest) (*api.Comment, error) {
+	m.Calls = append(m.Calls, fmt.Sprintf("UpdateComment(%s, %d)", projectID, commentID))
+	if m.UpdateCommentError != nil {
+		return nil, m.UpdateCommentError
+	}
+	return m.UpdatedComment, nil
+}
+
+// TrashComment mock implementation
+func (m *MockClient) TrashComment(ctx context.Context, projectID string, commentID int64) error {
+	m.Calls = append(m.Calls, fmt.Sprintf("TrashComment(%s, %d)", projectID, commentID))
+	return m.TrashCommentError
+}
+
+// GetSubscription mock implementation
+func (m *MockClient) GetSubscription(ctx context.Context, projectID string, recordingID int64) (*api.Subscription, error) {
+	m.Calls = append(m.Calls, fmt.Sprintf("GetSubscription(%s, %d)", projectID, recordingID))
+	if m.SubscriptionError != nil {
+		return nil, m.SubscriptionError
+	}
+	return m.Subscription, nil
+}
+
+// Subscribe mock implementation
+func (m *MockClient) Subscribe(ctx context.Context, projectID string, recordingID int64) (*api.Subscription, error) {
+	m.Calls = append(m.Calls, fmt.Sprintf("Subscribe(%s, %d)", projectID, recordingID))
+	if m.SubscribeError != nil {
+		return nil, m.SubscribeError
+	}
+	return m.Subscription, nil
+}
+
+// Unsubscribe mock implementation
+func (m *MockClient) Unsubscribe(ctx context.Context, projectID string, recordingID int64) error {
+	m.Calls = append(m.Calls, fmt.Sprintf("Unsubscribe(%s, %d)", projectID, recordingID))
+	return m.UnsubscribeError
+}
+
+// GetSubscribers mock implementation
+func (m *MockClient) GetSubscribers(ctx context.Context, projectID string, recordingID int64) ([]api.Person, error) {
+	m.Calls = append(m.Calls, fmt.Sprintf("GetSubscribers(%s, %d)", projectID, recordingID))
+	if m.SubscribersError != nil {
+		return nil, m.SubscribersError
+	}
+	return m.Subscribers, nil
+}
+
 // GetProjectSchedule mock implementation
 func (m *MockClient) GetProjectSchedule(ctx context.Context, projectID string) (*api.Schedule, error) {
 	m.Calls = append(m.Calls, fmt.Sprintf("GetProjectSchedule(%s)", projectID))
@@ -604,5 +1074,66 @@ func (m *MockClient) Search(ctx context.Context, opts api.SearchOptions) ([]api.
 	return m.SearchResults, nil
 }
 
-// Ensure MockClient implements APIClient interface
-var _ api.APIClient = (*MockClient)(nil)
+// GetVault mock implementation
+func (m *MockClient) GetVault(ctx context.Context, projectID string) (*api.Vault, error) {
+	m.Calls = append(m.Calls, fmt.Sprintf("GetVault(%s)", projectID))
+	if m.VaultError != nil {
+		return nil, m.VaultError
+	}
+	return m.Vault, nil
+}
+
+// CreateDocument mock implementation
+func (m *MockClient) CreateDocument(ctx context.Context, projectID string, vaultID int64, req api.DocumentCreateRequest) (*api.Document, error) {
+	m.Calls = append(m.Calls, fmt.Sprintf("CreateDocument(%s, %d, %+v)", projectID, vaultID, req))
+	if m.CreateDocumentError != nil {
+		return nil, m.CreateDocumentError
+	}
+	return m.CreatedDocument, nil
+}
+
+// ListDocuments mock implementation
+func (m *MockClient) ListDocuments(ctx context.Context, projectID string, vaultID int64) ([]api.Document, error) {
+	m.Calls = append(m.Calls, fmt.Sprintf("ListDocuments(%s, %d)", projectID, vaultID))
+	if m.DocumentsError != nil {
+		return nil, m.DocumentsError
+	}
+	return m.Documents, nil
+}
+
+// GetDocument mock implementation
+func (m *MockClient) GetDocument(ctx context.Context, projectID string, documentID int64) (*api.Document, error) {
+	m.Calls = append(m.Calls, fmt.Sprintf("GetDocument(%s, %d)", projectID, documentID))
+	if m.DocumentError != nil {
+		return nil, m.DocumentError
+	}
+	return m.Document, nil
+}
+
+// UpdateDocument mock implementation
+func (m *MockClient) UpdateDocument(ctx context.Context, projectID string, documentID int64, req api.DocumentUpdateRequest) (*api.Document, error) {
+	m.Calls = append(m.Calls, fmt.Sprintf("UpdateDocument(%s, %d)", projectID, documentID))
+	if m.UpdateDocumentError != nil {
+		return nil, m.UpdateDocumentError
+	}
+	return m.UpdatedDocument, nil
+}
+
+// DeleteDocument mock implementation
+func (m *MockClient) DeleteDocument(ctx context.Context, projectID string, documentID int64) error {
+	m.Calls = append(m.Calls, fmt.Sprintf("DeleteDocument(%s, %d)", projectID, documentID))
+	return m.DeleteDocumentError
+}
+
+// Ensure MockClient implements APIClient and the operations interfaces
+// commands are tested through
+var (
+	_ api.APIClient          = (*MockClient)(nil)
+	_ api.ProjectOperations  = (*MockClient)(nil)
+	_ api.TodoOperations     = (*MockClient)(nil)
+	_ api.CardOperations     = (*MockClient)(nil)
+	_ api.StepOperations     = (*MockClient)(nil)
+	_ api.CommentOperations  = (*MockClient)(nil)
+	_ api.QuestionOperations = (*MockClient)(nil)
+	_ api.DocumentOperations = (*MockClient)(nil)
+)
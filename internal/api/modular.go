@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"encoding/json"
 )
 
 // ProjectOperations defines project-specific operations
@@ -20,8 +21,10 @@ type ProjectOperations interface {
 type TodoOperations interface {
 	GetProjectTodoSet(ctx context.Context, projectID string) (*TodoSet, error)
 	GetTodoLists(ctx context.Context, projectID string, todoSetID int64) ([]TodoList, error)
+	GetTodoListsWithStatus(ctx context.Context, projectID string, todoSetID int64, status string) ([]TodoList, error)
 	GetTodoList(ctx context.Context, projectID string, todoListID int64) (*TodoList, error)
 	GetTodos(ctx context.Context, projectID string, todoListID int64) ([]Todo, error)
+	GetTodosWithStatus(ctx context.Context, projectID string, todoListID int64, status string) ([]Todo, error)
 	GetAllTodos(ctx context.Context, projectID string, todoListID int64) ([]Todo, error)
 	GetTodo(ctx context.Context, projectID string, todoID int64) (*Todo, error)
 	GetTodoGroups(ctx context.Context, projectID string, todoListID int64) ([]TodoGroup, error)
@@ -32,6 +35,7 @@ type TodoOperations interface {
 	CreateTodoGroup(ctx context.Context, projectID string, todoListID int64, req TodoGroupCreateRequest) (*TodoGroup, error)
 	RepositionTodoGroup(ctx context.Context, projectID string, groupID int64, position int) error
 	RepositionTodo(ctx context.Context, projectID string, todoID int64, position int) error
+	MoveTodo(ctx context.Context, projectID string, todoID int64, destProjectID string, destListID int64, position int) error
 	CompleteTodo(ctx context.Context, projectID string, todoID int64) error
 	UncompleteTodo(ctx context.Context, projectID string, todoID int64) error
 }
@@ -42,6 +46,7 @@ type CampfireOperations interface {
 	GetCampfire(ctx context.Context, projectID string, campfireID int64) (*Campfire, error)
 	GetCampfireByName(ctx context.Context, projectID string, name string) (*Campfire, error)
 	GetCampfireLines(ctx context.Context, projectID string, campfireID int64, limit int) ([]CampfireLine, error)
+	GetCampfireLinesWithOptions(ctx context.Context, projectID string, campfireID int64, opts CampfireLineOptions) ([]CampfireLine, error)
 	PostCampfireLine(ctx context.Context, projectID string, campfireID int64, content string, contentType string) (*CampfireLine, error)
 	DeleteCampfireLine(ctx context.Context, projectID string, campfireID int64, lineID int64) error
 }
@@ -52,12 +57,17 @@ type CardOperations interface {
 	GetProjectCardTable(ctx context.Context, projectID string) (*CardTable, error)
 	GetCardTable(ctx context.Context, projectID string, cardTableID int64) (*CardTable, error)
 	GetCardsInColumn(ctx context.Context, projectID string, columnID int64) ([]Card, error)
+	GetCardsInColumnWithStatus(ctx context.Context, projectID string, columnID int64, status string) ([]Card, error)
 	GetOnHoldCardsInColumn(ctx context.Context, onHoldCardsURL string) ([]Card, error)
 	GetCard(ctx context.Context, projectID string, cardID int64) (*Card, error)
+	GetCardEvents(ctx context.Context, projectID string, cardID int64) ([]Event, error)
+	GetCardRaw(ctx context.Context, projectID string, cardID int64) (json.RawMessage, error)
 	CreateCard(ctx context.Context, projectID string, columnID int64, req CardCreateRequest) (*Card, error)
 	UpdateCard(ctx context.Context, projectID string, cardID int64, req CardUpdateRequest) (*Card, error)
 	MoveCard(ctx context.Context, projectID string, cardID int64, columnID int64) error
+	MoveCardToTable(ctx context.Context, projectID string, cardID int64, cardTableID int64, columnID int64) error
 	ArchiveCard(ctx context.Context, projectID string, cardID int64) error
+	UnarchiveCard(ctx context.Context, projectID string, cardID int64) error
 }
 
 // StepOperations defines card step-specific operations
@@ -112,8 +122,14 @@ type CommentOperations interface {
 // ActivityOperations defines activity-specific operations
 type ActivityOperations interface {
 	ListEvents(ctx context.Context, projectID string, recordingID int64) ([]Event, error)
+	ListEventsChronological(ctx context.Context, projectID string, recordingID int64) ([]Event, error)
 	ListRecordings(ctx context.Context, projectID string, opts *ActivityListOptions) ([]Recording, error)
 	GetRecording(ctx context.Context, projectID string, recordingID int64) (*Recording, error)
+	GetRecordingRaw(ctx context.Context, projectID string, recordingID int64) (json.RawMessage, error)
+	GetSubscription(ctx context.Context, projectID string, recordingID int64) (*Subscription, error)
+	GetSubscribers(ctx context.Context, projectID string, recordingID int64) ([]Person, error)
+	Subscribe(ctx context.Context, projectID string, recordingID int64) (*Subscription, error)
+	Unsubscribe(ctx context.Context, projectID string, recordingID int64) error
 }
 
 // ScheduleOperations defines schedule-specific operations
@@ -142,6 +158,17 @@ func NewModularClient(accountID, accessToken string) *ModularClient {
 	}
 }
 
+// NewModularClientWithBaseURL creates a modular client that sends requests
+// to baseURL instead of the Basecamp API. An empty baseURL uses the default.
+// baseURL should already be checked with ParseBaseURL.
+func NewModularClientWithBaseURL(accountID, accessToken, baseURL string) *ModularClient {
+	client := NewModularClient(accountID, accessToken)
+	if baseURL != "" {
+		client.baseURL = baseURL
+	}
+	return client
+}
+
 // Projects returns the project operations interface
 func (c *ModularClient) Projects() ProjectOperations {
 	return c.Client
@@ -233,6 +260,16 @@ type TimesheetOperations interface {
 	GetRecordingTimesheet(ctx context.Context, projectID string, recordingID int64) ([]TimesheetEntry, error)
 }
 
+// DocumentOperations defines document and vault operations
+type DocumentOperations interface {
+	GetVault(ctx context.Context, projectID string) (*Vault, error)
+	ListDocuments(ctx context.Context, projectID string, vaultID int64) ([]Document, error)
+	GetDocument(ctx context.Context, projectID string, documentID int64) (*Document, error)
+	CreateDocument(ctx context.Context, projectID string, vaultID int64, req DocumentCreateRequest) (*Document, error)
+	UpdateDocument(ctx context.Context, projectID string, documentID int64, req DocumentUpdateRequest) (*Document, error)
+	DeleteDocument(ctx context.Context, projectID string, documentID int64) error
+}
+
 // Questions returns the question operations interface
 func (c *ModularClient) Questions() QuestionOperations {
 	return c.Client
@@ -243,6 +280,11 @@ func (c *ModularClient) Timesheets() TimesheetOperations {
 	return c.Client
 }
 
+// Documents returns the document operations interface
+func (c *ModularClient) Documents() DocumentOperations {
+	return c.Client
+}
+
 // Example of how to extend with new operations without modifying existing code:
 //
 // type MessageOperations interface {
@@ -17,6 +17,7 @@ type PaginatedRequest struct {
 	ctx         context.Context     // nil = context.Background()
 	maxPages    int                 // 0 = no limit
 	pageCheck   func(page any) bool // called after each page; return false to stop pagination
+	truncated   bool                // set when maxPages stopped pagination with pages remaining
 }
 
 // NewPaginatedRequest creates a new paginated request handler
@@ -33,6 +34,12 @@ func (pr *PaginatedRequest) WithMaxPages(n int) *PaginatedRequest {
 	return pr
 }
 
+// Truncated reports whether the last GetAll stopped at the max pages limit
+// while more pages were available
+func (pr *PaginatedRequest) Truncated() bool {
+	return pr.truncated
+}
+
 // WithContext sets the context for all HTTP requests made during pagination.
 // When the context is cancelled, in-flight requests are aborted.
 func (pr *PaginatedRequest) WithContext(ctx context.Context) *PaginatedRequest {
@@ -69,6 +76,7 @@ func (pr *PaginatedRequest) GetAll(path string, result any) error {
 	currentPath := path
 	totalFetched := 0
 	pageCount := 0
+	pr.truncated = false
 
 	for currentPath != "" {
 		// Check for context cancellation before making a request
@@ -107,11 +115,6 @@ func (pr *PaginatedRequest) GetAll(path string, result any) error {
 			break
 		}
 
-		// Check max pages limit
-		if pr.maxPages > 0 && pageCount >= pr.maxPages {
-			break
-		}
-
 		// Check page callback — return false to stop
 		if pr.pageCheck != nil && !pr.pageCheck(pageSlice.Interface()) {
 			break
@@ -125,10 +128,16 @@ func (pr *PaginatedRequest) GetAll(path string, result any) error {
 			nextURL := parseNextLinkURL(linkHeader)
 			if nextURL != "" {
 				// Convert absolute URL to relative path for our client
-				currentPath = extractPathFromURL(nextURL)
+				currentPath = pr.client.pathFromURL(nextURL)
 			}
 		}
 
+		// Check max pages limit
+		if pr.maxPages > 0 && pageCount >= pr.maxPages {
+			pr.truncated = currentPath != ""
+			break
+		}
+
 		// Small delay between requests to be respectful
 		if currentPath != "" {
 			time.Sleep(100 * time.Millisecond)
@@ -297,6 +306,26 @@ func parseLinkHeaderEntries(linkHeader string) []LinkEntry {
 	return entries
 }
 
+// pathFromURL converts an absolute API URL, such as a next page link, to a
+// path relative to the client's account URL. Besides Basecamp's own URLs,
+// this handles URLs on a custom base URL, e.g. links rewritten by a proxy.
+func (c *Client) pathFromURL(absoluteURL string) string {
+	base, err := url.Parse(c.getBaseURL())
+	if err != nil {
+		return extractPathFromURL(absoluteURL)
+	}
+	u, err := url.Parse(absoluteURL)
+	if err != nil || u.Host == "" || !strings.EqualFold(u.Host, base.Host) || !strings.HasPrefix(u.Path, base.Path+"/") {
+		return extractPathFromURL(absoluteURL)
+	}
+
+	path := strings.TrimPrefix(u.Path, base.Path)
+	if u.RawQuery != "" {
+		path += "?" + u.RawQuery
+	}
+	return path
+}
+
 // extractPathFromURL converts an absolute Basecamp API URL to a relative path
 // Example: https://3.basecampapi.com/999999999/buckets/123/todos.json?page=2 -> /buckets/123/todos.json?page=2
 func extractPathFromURL(absoluteURL string) string {
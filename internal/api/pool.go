@@ -0,0 +1,34 @@
+package api
+
+import (
+	"context"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// maxConcurrentRequests bounds fan-out requests such as per-project fetches
+// so large accounts don't trip the API rate limit
+const maxConcurrentRequests = 4
+
+// runBounded calls fn for each index in [0, n), running at most limit calls at
+// once. Unlike errgroup.WithContext, a failure doesn't cancel the other calls:
+// the error for each index is returned so callers can report partial results.
+func runBounded(ctx context.Context, n, limit int, fn func(ctx context.Context, i int) error) []error {
+	errs := make([]error, n)
+
+	var g errgroup.Group
+	g.SetLimit(limit)
+	for i := 0; i < n; i++ {
+		g.Go(func() error {
+			if err := ctx.Err(); err != nil {
+				errs[i] = err
+				return nil
+			}
+			errs[i] = fn(ctx, i)
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	return errs
+}
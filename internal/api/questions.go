@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/url"
 	"time"
@@ -175,9 +176,13 @@ func (c *Client) GetProjectQuestionnaire(ctx context.Context, projectID string)
 		}
 	}
 
-	return nil, fmt.Errorf("questionnaire (check-ins) not found for project")
+	return nil, ErrNoQuestionnaire
 }
 
+// ErrNoQuestionnaire is returned by GetProjectQuestionnaire for projects
+// whose Automatic Check-ins tool is turned off
+var ErrNoQuestionnaire = errors.New("questionnaire (check-ins) not found for project")
+
 // ListQuestions fetches all questions in a questionnaire
 func (c *Client) ListQuestions(ctx context.Context, projectID string, questionnaireID int64) ([]Question, error) {
 	var questions []Question
@@ -76,6 +76,10 @@ func (rt *RetryableTransport) RoundTrip(req *http.Request) (*http.Response, erro
 
 		// If we got an error (network error), retry if we haven't exhausted attempts
 		if lastErr != nil {
+			// A cancelled or timed out request won't succeed on retry
+			if req.Context().Err() != nil {
+				return nil, lastErr
+			}
 			if attempt == rt.Config.MaxRetries {
 				break
 			}
@@ -0,0 +1,63 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/needmore/bc4/internal/errors"
+)
+
+// Subscription is the subscription state of a recording: who is notified
+// about its changes and whether the current user is one of them
+type Subscription struct {
+	Subscribed  bool     `json:"subscribed"`
+	Count       int      `json:"count"`
+	URL         string   `json:"url"`
+	Subscribers []Person `json:"subscribers"`
+}
+
+// subscriptionPath is the subscription endpoint of a recording of any type
+func subscriptionPath(projectID string, recordingID int64) string {
+	return fmt.Sprintf("/buckets/%s/recordings/%d/subscription.json", projectID, recordingID)
+}
+
+// GetSubscription returns the subscription state of a recording of any
+// type - a card, todo, message, or document
+func (c *Client) GetSubscription(ctx context.Context, projectID string, recordingID int64) (*Subscription, error) {
+	var subscription Subscription
+	if err := c.Get(subscriptionPath(projectID, recordingID), &subscription); err != nil {
+		return nil, fmt.Errorf("failed to get subscription: %w", err)
+	}
+	return &subscription, nil
+}
+
+// GetSubscribers returns the people subscribed to a recording of any type
+func (c *Client) GetSubscribers(ctx context.Context, projectID string, recordingID int64) ([]Person, error) {
+	var subscription Subscription
+	if err := c.Get(subscriptionPath(projectID, recordingID), &subscription); err != nil {
+		return nil, fmt.Errorf("failed to get subscribers: %w", err)
+	}
+	return subscription.Subscribers, nil
+}
+
+// Subscribe subscribes the current user to a recording and returns the
+// resulting subscription. Subscribing twice is harmless.
+func (c *Client) Subscribe(ctx context.Context, projectID string, recordingID int64) (*Subscription, error) {
+	var subscription Subscription
+	if err := c.Post(subscriptionPath(projectID, recordingID), nil, &subscription); err != nil {
+		return nil, fmt.Errorf("failed to subscribe: %w", err)
+	}
+	return &subscription, nil
+}
+
+// Unsubscribe removes the current user's subscription to a recording. A
+// missing subscription is treated as already unsubscribed.
+func (c *Client) Unsubscribe(ctx context.Context, projectID string, recordingID int64) error {
+	if err := c.Delete(subscriptionPath(projectID, recordingID)); err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to unsubscribe: %w", err)
+	}
+	return nil
+}
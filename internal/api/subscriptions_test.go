@@ -0,0 +1,76 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSubscribers(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/123456/buckets/1/recordings/42/subscription.json", r.URL.Path)
+		_, _ = w.Write([]byte(`{
+			"subscribed": true,
+			"count": 2,
+			"url": "https://3.basecampapi.com/123456/buckets/1/recordings/42/subscription.json",
+			"subscribers": [
+				{"id": 7, "name": "Ann", "email_address": "ann@example.com"},
+				{"id": 8, "name": "Bo"}
+			]
+		}`))
+	}))
+	defer srv.Close()
+
+	client := &Client{
+		accountID:  "123456",
+		baseURL:    srv.URL,
+		httpClient: &http.Client{},
+	}
+
+	people, err := client.GetSubscribers(context.Background(), "1", 42)
+	require.NoError(t, err)
+	require.Len(t, people, 2)
+	assert.Equal(t, "Ann", people[0].Name)
+	assert.Equal(t, "ann@example.com", people[0].EmailAddress)
+	assert.Equal(t, int64(8), people[1].ID)
+}
+
+func TestSubscribeAndUnsubscribe(t *testing.T) {
+	subscribed := false
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/123456/buckets/1/recordings/42/subscription.json", r.URL.Path)
+		switch r.Method {
+		case "POST":
+			subscribed = true
+			_, _ = w.Write([]byte(`{"subscribed": true, "count": 1, "subscribers": [{"id": 7, "name": "Ann"}]}`))
+		case "DELETE":
+			if !subscribed {
+				http.NotFound(w, r)
+				return
+			}
+			subscribed = false
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer srv.Close()
+
+	client := &Client{
+		accountID:  "123456",
+		baseURL:    srv.URL,
+		httpClient: &http.Client{},
+	}
+
+	subscription, err := client.Subscribe(context.Background(), "1", 42)
+	require.NoError(t, err)
+	assert.True(t, subscription.Subscribed)
+	assert.Equal(t, 1, subscription.Count)
+
+	require.NoError(t, client.Unsubscribe(context.Background(), "1", 42))
+	// A second unsubscribe finds no subscription, which isn't an error
+	require.NoError(t, client.Unsubscribe(context.Background(), "1", 42))
+}
@@ -0,0 +1,140 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAllTodos_PartialErrorWhenCompletedFetchFails(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/123456/buckets/1/todolists/42/todos.json", r.URL.Path)
+		if r.URL.Query().Get("completed") == "true" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, _ = w.Write([]byte(`[{"id": 1, "title": "Open one"}, {"id": 2, "title": "Open two"}]`))
+	}))
+	defer srv.Close()
+
+	client := &Client{
+		accountID:  "123456",
+		baseURL:    srv.URL,
+		httpClient: &http.Client{},
+	}
+
+	todos, err := client.GetAllTodos(context.Background(), "1", 42)
+	require.Error(t, err)
+
+	var partialErr *PartialError
+	require.True(t, errors.As(err, &partialErr), "expected a *PartialError, got %T", err)
+	assert.Equal(t, "completed todos", partialErr.Missing)
+	assert.Contains(t, err.Error(), "completed todos unavailable")
+
+	require.Len(t, todos, 2)
+	assert.Equal(t, int64(1), todos[0].ID)
+	assert.Equal(t, int64(2), todos[1].ID)
+}
+
+func TestGetTodosWithStatus_SendsStatusParam(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     string
+		wantStatus string
+	}{
+		{name: "active adds no param", status: StatusActive, wantStatus: ""},
+		{name: "empty adds no param", status: "", wantStatus: ""},
+		{name: "archived", status: StatusArchived, wantStatus: "archived"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/123456/buckets/1/todolists/42/todos.json", r.URL.Path)
+				assert.Equal(t, tt.wantStatus, r.URL.Query().Get("status"))
+				_, _ = w.Write([]byte(`[{"id": 1, "title": "One"}]`))
+			}))
+			defer srv.Close()
+
+			client := &Client{accountID: "123456", baseURL: srv.URL, httpClient: &http.Client{}}
+
+			todos, err := client.GetTodosWithStatus(context.Background(), "1", 42, tt.status)
+			require.NoError(t, err)
+			assert.Len(t, todos, 1)
+		})
+	}
+}
+
+func TestGetTodoListsWithStatus_SendsStatusParam(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/123456/buckets/1/todosets/7/todolists.json", r.URL.Path)
+		assert.Equal(t, "archived", r.URL.Query().Get("status"))
+		_, _ = w.Write([]byte(`[{"id": 3, "title": "Old list"}]`))
+	}))
+	defer srv.Close()
+
+	client := &Client{accountID: "123456", baseURL: srv.URL, httpClient: &http.Client{}}
+
+	lists, err := client.GetTodoListsWithStatus(context.Background(), "1", 7, StatusArchived)
+	require.NoError(t, err)
+	require.Len(t, lists, 1)
+	assert.Equal(t, "Old list", lists[0].Title)
+}
+
+func TestGetTodosWithStatus_UnsupportedStatus(t *testing.T) {
+	client := &Client{accountID: "123456", baseURL: "http://unused", httpClient: &http.Client{}}
+
+	_, err := client.GetTodosWithStatus(context.Background(), "1", 42, "trashed")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `status "trashed" is not supported for todos`)
+}
+
+func TestTodo_DecodesCompletion(t *testing.T) {
+	var todo Todo
+	err := json.Unmarshal([]byte(`{
+		"id": 9,
+		"title": "Ship release",
+		"completed": true,
+		"completion": {
+			"created_at": "2024-06-13T16:30:00.000Z",
+			"creator": {"id": 7, "name": "Jane Doe"}
+		}
+	}`), &todo)
+	require.NoError(t, err)
+
+	require.NotNil(t, todo.CompletedAt)
+	assert.Equal(t, "2024-06-13T16:30:00.000Z", *todo.CompletedAt)
+	require.NotNil(t, todo.Completer)
+	assert.Equal(t, "Jane Doe", todo.Completer.Name)
+
+	var open Todo
+	require.NoError(t, json.Unmarshal([]byte(`{"id": 10, "title": "Open", "completed": false}`), &open))
+	assert.Nil(t, open.CompletedAt)
+	assert.Nil(t, open.Completer)
+}
+
+func TestMoveTodo(t *testing.T) {
+	var body map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+		assert.Equal(t, "/123456/buckets/1/todos/5/position.json", r.URL.Path)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	client := &Client{accountID: "123456", baseURL: srv.URL, httpClient: &http.Client{}}
+
+	require.NoError(t, client.MoveTodo(context.Background(), "1", 5, "1", 42, 3))
+	assert.Equal(t, float64(42), body["parent_id"])
+	assert.Equal(t, float64(3), body["position"])
+
+	err := client.MoveTodo(context.Background(), "1", 5, "2", 42, 1)
+	assert.ErrorIs(t, err, ErrCrossProjectMoveUnsupported)
+}
@@ -27,10 +27,12 @@ func GetAuthPath() string {
 }
 
 const (
-	authURL      = "https://launchpad.37signals.com/authorization/new"
-	tokenURL     = "https://launchpad.37signals.com/authorization/token"
-	callbackPort = "8888"
-	redirectURL  = "http://localhost:" + callbackPort + "/callback"
+	authURL  = "https://launchpad.37signals.com/authorization/new"
+	tokenURL = "https://launchpad.37signals.com/authorization/token"
+	// authorizationURL describes the identity and accounts behind a token
+	authorizationURL = "https://launchpad.37signals.com/authorization.json"
+	callbackPort     = "8888"
+	redirectURL      = "http://localhost:" + callbackPort + "/callback"
 
 	// authTimeout is the maximum time to wait for authentication to complete
 	authTimeout = 5 * time.Minute
@@ -77,6 +79,9 @@ type Client struct {
 	config       *oauth2.Config
 	authStore    *AuthStore
 	storePath    string
+	// loaded is the store as last read from or written to disk, used to work
+	// out which changes this process made when saving
+	loaded *AuthStore
 }
 
 // NewClient creates a new auth client
@@ -107,6 +112,67 @@ func NewClient(clientID, clientSecret string) *Client {
 
 // Login performs the OAuth2 authentication flow
 func (c *Client) Login(ctx context.Context) (*AccountToken, error) {
+	accountToken, err := c.authorize(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get account info and save token
+	if err := c.fetchAndSaveAccountInfo(ctx, accountToken); err != nil {
+		return nil, err
+	}
+
+	return accountToken, nil
+}
+
+// AddAccounts performs the OAuth2 authentication flow and stores any
+// Basecamp accounts that aren't already authenticated. Existing accounts and
+// the default account are left untouched. It returns the accounts that were
+// added, which is empty if the login only had access to known accounts.
+func (c *Client) AddAccounts(ctx context.Context) ([]AccountToken, error) {
+	accountToken, err := c.authorize(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err := c.fetchBasecampAccounts(ctx, accountToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.authStore == nil {
+		c.authStore = &AuthStore{
+			Accounts: make(map[string]AccountToken),
+		}
+	}
+
+	var added []AccountToken
+	for _, account := range accounts {
+		if _, exists := c.authStore.Accounts[account.AccountID]; exists {
+			continue
+		}
+		c.authStore.Accounts[account.AccountID] = account
+		added = append(added, account)
+	}
+
+	if len(added) == 0 {
+		return nil, nil
+	}
+
+	// Only pick a default if there wasn't one before
+	if c.authStore.DefaultAccount == "" {
+		c.authStore.DefaultAccount = added[0].AccountID
+	}
+
+	if err := c.saveAuthStore(); err != nil {
+		return nil, err
+	}
+	return added, nil
+}
+
+// authorize runs the browser-based OAuth2 flow and returns the resulting
+// token, without any account information
+func (c *Client) authorize(ctx context.Context) (*AccountToken, error) {
 	// Generate state for CSRF protection
 	state := c.generateState()
 
@@ -123,10 +189,7 @@ func (c *Client) Login(ctx context.Context) (*AccountToken, error) {
 	}()
 
 	// Open browser to authorization URL
-	// Basecamp requires a 'type' parameter
-	authURL := c.config.AuthCodeURL(state, oauth2.AccessTypeOffline)
-	// Add the required 'type' parameter for Basecamp
-	authURL = authURL + "&type=web_server"
+	authURL := c.authCodeURL(state)
 
 	// Try to open browser and provide fallback instructions
 	browserErr := browser.OpenURL(authURL)
@@ -149,29 +212,7 @@ func (c *Client) Login(ctx context.Context) (*AccountToken, error) {
 	// Wait for callback
 	select {
 	case code := <-codeChan:
-		// Exchange code for token
-		// Basecamp requires 'type' parameter for token exchange
-		token, err := c.config.Exchange(ctx, code,
-			oauth2.SetAuthURLParam("type", "web_server"))
-		if err != nil {
-			return nil, fmt.Errorf("failed to exchange code: %w", err)
-		}
-
-		// Create account token
-		accountToken := &AccountToken{
-			AccessToken:  token.AccessToken,
-			RefreshToken: token.RefreshToken,
-			TokenType:    token.TokenType,
-			ExpiresIn:    int(time.Until(token.Expiry).Seconds()),
-			ObtainedAt:   time.Now(),
-		}
-
-		// Get account info and save token
-		if err := c.fetchAndSaveAccountInfo(ctx, accountToken); err != nil {
-			return nil, err
-		}
-
-		return accountToken, nil
+		return c.exchangeCode(ctx, code)
 
 	case err := <-errorChan:
 		return nil, fmt.Errorf("callback error: %w", err)
@@ -184,6 +225,31 @@ func (c *Client) Login(ctx context.Context) (*AccountToken, error) {
 	}
 }
 
+// authCodeURL returns the Launchpad authorization URL for state
+func (c *Client) authCodeURL(state string) string {
+	// Basecamp requires a 'type' parameter
+	return c.config.AuthCodeURL(state, oauth2.AccessTypeOffline) + "&type=web_server"
+}
+
+// exchangeCode exchanges an authorization code for a token, without any
+// account information
+func (c *Client) exchangeCode(ctx context.Context, code string) (*AccountToken, error) {
+	// Basecamp requires 'type' parameter for token exchange
+	token, err := c.config.Exchange(ctx, code,
+		oauth2.SetAuthURLParam("type", "web_server"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to exchange code: %w", err)
+	}
+
+	return &AccountToken{
+		AccessToken:  token.AccessToken,
+		RefreshToken: token.RefreshToken,
+		TokenType:    token.TokenType,
+		ExpiresIn:    int(time.Until(token.Expiry).Seconds()),
+		ObtainedAt:   time.Now(),
+	}, nil
+}
+
 // Logout removes stored credentials
 func (c *Client) Logout(accountID string) error {
 	if c.authStore == nil {
@@ -328,8 +394,7 @@ func (c *Client) startCallbackServer(state string, codeChan chan<- string, error
 }
 
 func (c *Client) isTokenExpired(token *AccountToken) bool {
-	expiresAt := token.ObtainedAt.Add(time.Duration(token.ExpiresIn) * time.Second)
-	return time.Now().After(expiresAt.Add(-5 * time.Minute)) // 5 minute buffer
+	return time.Now().After(token.ExpiresAt().Add(-5 * time.Minute)) // 5 minute buffer
 }
 
 func (c *Client) refreshToken(token *AccountToken) (*AccountToken, error) {
@@ -378,17 +443,47 @@ func (c *Client) refreshToken(token *AccountToken) (*AccountToken, error) {
 }
 
 func (c *Client) fetchAndSaveAccountInfo(ctx context.Context, token *AccountToken) error {
-	// Get authorization info to find account ID
-	req, err := http.NewRequestWithContext(ctx, "GET", "https://launchpad.37signals.com/authorization.json", nil)
+	accounts, err := c.fetchBasecampAccounts(ctx, token)
 	if err != nil {
 		return err
 	}
+
+	// Save token(s) for all Basecamp accounts found
+	if c.authStore == nil {
+		c.authStore = &AuthStore{
+			Accounts: make(map[string]AccountToken),
+		}
+	}
+	for _, account := range accounts {
+		c.authStore.Accounts[account.AccountID] = account
+	}
+
+	// Set default account if not set
+	if c.authStore.DefaultAccount == "" {
+		c.authStore.DefaultAccount = accounts[0].AccountID
+	}
+
+	// Update the token to return with the first account info
+	token.AccountID = accounts[0].AccountID
+	token.AccountName = accounts[0].AccountName
+
+	return c.saveAuthStore()
+}
+
+// fetchBasecampAccounts looks up the Basecamp accounts the token has access
+// to and returns a copy of the token for each. It errors if there are none.
+func (c *Client) fetchBasecampAccounts(ctx context.Context, token *AccountToken) ([]AccountToken, error) {
+	// Get authorization info to find account ID
+	req, err := http.NewRequestWithContext(ctx, "GET", authorizationURL, nil)
+	if err != nil {
+		return nil, err
+	}
 	req.Header.Set("Authorization", "Bearer "+token.AccessToken)
 	req.Header.Set("User-Agent", version.UserAgent())
 
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := launchpadClient.Do(req)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer func() { _ = resp.Body.Close() }()
 
@@ -401,25 +496,14 @@ func (c *Client) fetchAndSaveAccountInfo(ctx context.Context, token *AccountToke
 	}
 
 	if err := json.NewDecoder(resp.Body).Decode(&authInfo); err != nil {
-		return err
-	}
-
-	// Silent - no debug output during auth
-
-	// Save token(s) for all Basecamp accounts found
-	if c.authStore == nil {
-		c.authStore = &AuthStore{
-			Accounts: make(map[string]AccountToken),
-		}
+		return nil, err
 	}
 
-	basecampAccounts := 0
-	firstAccountID := ""
-
-	// Save all BC3/BC4 accounts
+	// Keep all BC3/BC4 accounts
+	var accounts []AccountToken
 	for _, account := range authInfo.Accounts {
 		if account.Product == "bc3" || account.Product == "bc4" || account.Product == "basecamp3" || account.Product == "basecamp4" || account.Product == "basecamp" {
-			accountToken := AccountToken{
+			accounts = append(accounts, AccountToken{
 				AccountID:    fmt.Sprintf("%d", account.ID),
 				AccountName:  account.Name,
 				AccessToken:  token.AccessToken,
@@ -427,57 +511,104 @@ func (c *Client) fetchAndSaveAccountInfo(ctx context.Context, token *AccountToke
 				TokenType:    token.TokenType,
 				ExpiresIn:    token.ExpiresIn,
 				ObtainedAt:   token.ObtainedAt,
-			}
-
-			c.authStore.Accounts[accountToken.AccountID] = accountToken
-			basecampAccounts++
-
-			if firstAccountID == "" {
-				firstAccountID = accountToken.AccountID
-			}
-
-			// Silent - added account
+			})
 		}
 	}
 
-	if basecampAccounts == 0 {
-		return fmt.Errorf("no Basecamp accounts found")
-	}
-
-	// Set default account if not set
-	if c.authStore.DefaultAccount == "" {
-		c.authStore.DefaultAccount = firstAccountID
+	if len(accounts) == 0 {
+		return nil, fmt.Errorf("no Basecamp accounts found")
 	}
+	return accounts, nil
+}
 
-	// Update the token to return with the first account info
-	if basecampAccounts > 0 {
-		firstToken := c.authStore.Accounts[firstAccountID]
-		token.AccountID = firstToken.AccountID
-		token.AccountName = firstToken.AccountName
+func (c *Client) loadAuthStore() {
+	if store := readAuthStore(c.storePath); store != nil {
+		c.authStore = store
+		c.loaded = store.clone()
 	}
-
-	return c.saveAuthStore()
 }
 
-func (c *Client) loadAuthStore() {
-	file, err := os.Open(c.storePath)
+// readAuthStore reads the auth store at path, returning nil if it is
+// missing or unreadable
+func readAuthStore(path string) *AuthStore {
+	file, err := os.Open(path)
 	if err != nil {
-		return
+		return nil
 	}
 	defer func() { _ = file.Close() }()
 
 	store := &AuthStore{}
 	if err := json.NewDecoder(file).Decode(store); err != nil {
 		// File is corrupted or empty; keep default empty store
-		return
+		return nil
 	}
 	// Initialize the Accounts map if nil to prevent panics
 	if store.Accounts == nil {
 		store.Accounts = make(map[string]AccountToken)
 	}
-	c.authStore = store
+	return store
+}
+
+// clone returns a copy of the store that shares no maps with the original
+func (s *AuthStore) clone() *AuthStore {
+	if s == nil {
+		return nil
+	}
+	copied := &AuthStore{
+		DefaultAccount: s.DefaultAccount,
+		Accounts:       make(map[string]AccountToken, len(s.Accounts)),
+	}
+	for id, token := range s.Accounts {
+		copied.Accounts[id] = token
+	}
+	return copied
+}
+
+// mergeAuthStore applies the changes between base and local on top of disk:
+// accounts added or updated locally overwrite disk, accounts removed locally
+// are removed, and a changed default account wins. Everything else on disk,
+// such as a token another process just refreshed, is kept.
+func mergeAuthStore(base, local, disk *AuthStore) *AuthStore {
+	if base == nil {
+		base = &AuthStore{}
+	}
+	if local == nil {
+		local = &AuthStore{}
+	}
+	merged := disk.clone()
+	if merged == nil {
+		merged = &AuthStore{Accounts: make(map[string]AccountToken)}
+	}
+
+	for id, token := range local.Accounts {
+		if baseToken, ok := base.Accounts[id]; !ok || !tokensEqual(baseToken, token) {
+			merged.Accounts[id] = token
+		}
+	}
+	for id := range base.Accounts {
+		if _, ok := local.Accounts[id]; !ok {
+			delete(merged.Accounts, id)
+		}
+	}
+	if local.DefaultAccount != base.DefaultAccount {
+		merged.DefaultAccount = local.DefaultAccount
+	}
+	return merged
+}
+
+func tokensEqual(a, b AccountToken) bool {
+	return a.AccountID == b.AccountID &&
+		a.AccountName == b.AccountName &&
+		a.AccessToken == b.AccessToken &&
+		a.RefreshToken == b.RefreshToken &&
+		a.TokenType == b.TokenType &&
+		a.ExpiresIn == b.ExpiresIn &&
+		a.ObtainedAt.Equal(b.ObtainedAt)
 }
 
+// saveAuthStore writes this process's changes to the auth store. It holds a
+// lock across re-reading and writing the file so that concurrent bc4
+// processes, e.g. one refreshing a token, don't overwrite each other.
 func (c *Client) saveAuthStore() error {
 	// Create directory if it doesn't exist
 	dir := filepath.Dir(c.storePath)
@@ -485,6 +616,14 @@ func (c *Client) saveAuthStore() error {
 		return err
 	}
 
+	unlock, err := utils.LockFile(c.storePath + ".lock")
+	if err != nil {
+		return fmt.Errorf("failed to lock auth store: %w", err)
+	}
+	defer unlock()
+
+	merged := mergeAuthStore(c.loaded, c.authStore, readAuthStore(c.storePath))
+
 	// Atomic write: write to temp file, then rename
 	tmpFile, err := os.CreateTemp(dir, ".auth-*.json.tmp")
 	if err != nil {
@@ -494,7 +633,7 @@ func (c *Client) saveAuthStore() error {
 
 	encoder := json.NewEncoder(tmpFile)
 	encoder.SetIndent("", "  ")
-	if err := encoder.Encode(c.authStore); err != nil {
+	if err := encoder.Encode(merged); err != nil {
 		_ = tmpFile.Close()
 		_ = os.Remove(tmpPath)
 		return err
@@ -510,5 +649,11 @@ func (c *Client) saveAuthStore() error {
 		return err
 	}
 
-	return utils.AtomicRename(tmpPath, c.storePath)
+	if err := utils.AtomicRename(tmpPath, c.storePath); err != nil {
+		return err
+	}
+
+	c.authStore = merged
+	c.loaded = merged.clone()
+	return nil
 }
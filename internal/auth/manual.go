@@ -0,0 +1,84 @@
+package auth
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+)
+
+// LoginManual performs the OAuth2 flow without the local callback server,
+// for headless machines. The user opens the authorization URL on any device
+// and pastes back the redirect URL (or just its code), which is exchanged for
+// a token here.
+func (c *Client) LoginManual(ctx context.Context, in io.Reader, out io.Writer) (*AccountToken, error) {
+	state := c.generateState()
+
+	_, _ = fmt.Fprintln(out, "Open this URL in a browser on any machine and approve access:")
+	_, _ = fmt.Fprintln(out)
+	_, _ = fmt.Fprintln(out, c.authCodeURL(state))
+	_, _ = fmt.Fprintln(out)
+	_, _ = fmt.Fprintln(out, "You'll then be sent to a localhost page that won't load. Copy its full URL")
+	_, _ = fmt.Fprintln(out, "from the address bar (or just the code parameter) and paste it below.")
+	_, _ = fmt.Fprint(out, "\nRedirect URL or code: ")
+
+	line, err := bufio.NewReader(in).ReadString('\n')
+	if err != nil && (err != io.EOF || strings.TrimSpace(line) == "") {
+		return nil, ErrAuthCancelled
+	}
+
+	code, err := parsePastedCode(line, state)
+	if err != nil {
+		return nil, err
+	}
+
+	accountToken, err := c.exchangeCode(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.fetchAndSaveAccountInfo(ctx, accountToken); err != nil {
+		return nil, err
+	}
+	return accountToken, nil
+}
+
+// parsePastedCode extracts the authorization code from what the user pasted:
+// either the full redirect URL, its query string, or the bare code. When the
+// paste includes a state parameter it must match.
+func parsePastedCode(input, state string) (string, error) {
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return "", fmt.Errorf("no authorization code entered")
+	}
+
+	if !strings.Contains(input, "code=") && !strings.Contains(input, "error=") {
+		if strings.ContainsAny(input, " \t/?&") {
+			return "", fmt.Errorf("couldn't find an authorization code in %q", input)
+		}
+		return input, nil
+	}
+
+	rawQuery := input
+	if i := strings.Index(input, "?"); i >= 0 {
+		rawQuery = input[i+1:]
+	}
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return "", fmt.Errorf("couldn't parse the pasted URL: %w", err)
+	}
+
+	if denied := query.Get("error"); denied != "" {
+		return "", fmt.Errorf("authorization was denied: %s", denied)
+	}
+	if got := query.Get("state"); got != "" && got != state {
+		return "", fmt.Errorf("invalid state parameter; the URL is from a different login attempt")
+	}
+	code := query.Get("code")
+	if code == "" {
+		return "", fmt.Errorf("no authorization code in the pasted URL")
+	}
+	return code, nil
+}
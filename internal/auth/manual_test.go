@@ -0,0 +1,41 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePastedCode(t *testing.T) {
+	const state = "abc123"
+
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr string
+	}{
+		{"full redirect URL", "http://localhost:8888/callback?code=xyz789&state=abc123\n", "xyz789", ""},
+		{"query string only", "code=xyz789&state=abc123", "xyz789", ""},
+		{"bare code", "  xyz789  ", "xyz789", ""},
+		{"URL without state", "http://localhost:8888/callback?code=xyz789", "xyz789", ""},
+		{"state mismatch", "http://localhost:8888/callback?code=xyz789&state=other", "", "different login attempt"},
+		{"denied", "http://localhost:8888/callback?error=access_denied&state=abc123", "", "denied: access_denied"},
+		{"empty", "   ", "", "no authorization code"},
+		{"URL without code", "http://localhost:8888/callback?state=abc123", "", "couldn't find"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parsePastedCode(tt.input, state)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package auth
+
+import (
+	"fmt"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newStoreTestClient(path string) *Client {
+	c := &Client{storePath: path}
+	c.loadAuthStore()
+	if c.authStore == nil {
+		c.authStore = &AuthStore{Accounts: make(map[string]AccountToken)}
+	}
+	return c
+}
+
+func TestSaveAuthStore_ConcurrentSavesKeepEachUpdate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "auth.json")
+
+	// Seed the store with the accounts every client will update
+	const workers = 8
+	seed := newStoreTestClient(path)
+	for i := 0; i < workers; i++ {
+		id := fmt.Sprintf("%d", i)
+		seed.authStore.Accounts[id] = AccountToken{AccountID: id, AccessToken: "old"}
+	}
+	seed.authStore.DefaultAccount = "0"
+	require.NoError(t, seed.saveAuthStore())
+
+	// Each client loads the same snapshot, then refreshes a different account
+	clients := make([]*Client, workers)
+	for i := range clients {
+		clients[i] = newStoreTestClient(path)
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, workers)
+	for i, c := range clients {
+		wg.Add(1)
+		go func(i int, c *Client) {
+			defer wg.Done()
+			id := fmt.Sprintf("%d", i)
+			c.authStore.Accounts[id] = AccountToken{
+				AccountID:   id,
+				AccessToken: fmt.Sprintf("new-%d", i),
+				ObtainedAt:  time.Now(),
+			}
+			errs <- c.saveAuthStore()
+		}(i, c)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+
+	store := readAuthStore(path)
+	require.NotNil(t, store)
+	assert.Equal(t, "0", store.DefaultAccount)
+	require.Len(t, store.Accounts, workers)
+	for i := 0; i < workers; i++ {
+		id := fmt.Sprintf("%d", i)
+		assert.Equal(t, fmt.Sprintf("new-%d", i), store.Accounts[id].AccessToken, "account %s", id)
+	}
+}
+
+func TestMergeAuthStore(t *testing.T) {
+	base := &AuthStore{
+		DefaultAccount: "1",
+		Accounts: map[string]AccountToken{
+			"1": {AccountID: "1", AccessToken: "a"},
+			"2": {AccountID: "2", AccessToken: "b"},
+		},
+	}
+	local := base.clone()
+	delete(local.Accounts, "2")
+	local.Accounts["3"] = AccountToken{AccountID: "3", AccessToken: "c"}
+
+	disk := base.clone()
+	disk.Accounts["1"] = AccountToken{AccountID: "1", AccessToken: "refreshed"}
+	disk.DefaultAccount = "2"
+
+	merged := mergeAuthStore(base, local, disk)
+
+	assert.Equal(t, "refreshed", merged.Accounts["1"].AccessToken, "unchanged local account should keep disk token")
+	assert.NotContains(t, merged.Accounts, "2", "locally removed account should be removed")
+	assert.Equal(t, "c", merged.Accounts["3"].AccessToken, "locally added account should be written")
+	assert.Equal(t, "2", merged.DefaultAccount, "default unchanged locally should keep disk value")
+}
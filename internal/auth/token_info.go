@@ -0,0 +1,81 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/needmore/bc4/internal/version"
+)
+
+// Authorization is the token's view of itself from the Launchpad
+// authorization endpoint
+type Authorization struct {
+	ExpiresAt time.Time `json:"expires_at"`
+	Identity  struct {
+		ID           int64  `json:"id"`
+		FirstName    string `json:"first_name"`
+		LastName     string `json:"last_name"`
+		EmailAddress string `json:"email_address"`
+	} `json:"identity"`
+	// Scopes is only populated if Launchpad reports granted scopes
+	Scopes []string `json:"scopes,omitempty"`
+}
+
+// ExpiresAt returns when the stored access token expires
+func (t AccountToken) ExpiresAt() time.Time {
+	return t.ObtainedAt.Add(time.Duration(t.ExpiresIn) * time.Second)
+}
+
+// launchpadClient makes the Launchpad authorization requests, with a timeout
+// so an unresponsive server can't hang the command
+var launchpadClient = &http.Client{Timeout: 30 * time.Second}
+
+// GetAuthorization fetches the authorization details for an account's token
+func (c *Client) GetAuthorization(ctx context.Context, accountID string) (*Authorization, error) {
+	token, err := c.GetToken(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", authorizationURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token.AccessToken)
+	req.Header.Set("User-Agent", version.UserAgent())
+
+	resp, err := launchpadClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch authorization: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch authorization: %s", resp.Status)
+	}
+
+	var authorization Authorization
+	if err := json.NewDecoder(resp.Body).Decode(&authorization); err != nil {
+		return nil, fmt.Errorf("failed to decode authorization: %w", err)
+	}
+	return &authorization, nil
+}
+
+// RedactToken masks all but a short prefix and the last four characters of
+// a token, e.g. "bc3_****...****abcd". Short tokens are masked entirely.
+func RedactToken(token string) string {
+	if len(token) < 16 {
+		return "****"
+	}
+
+	prefix := ""
+	// Keep a type prefix such as "bc3_" when the token has one
+	if i := strings.IndexAny(token, "_-"); i > 0 && i <= 4 {
+		prefix = token[:i+1]
+	}
+	return prefix + "****...****" + token[len(token)-4:]
+}
@@ -0,0 +1,21 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedactToken(t *testing.T) {
+	assert.Equal(t, "bc3_****...****abcd", RedactToken("bc3_0123456789xyzabcd"))
+	assert.Equal(t, "****...****wxyz", RedactToken("BAhbB0kiAbB7ImNsaWVudHwxyz"))
+	assert.Equal(t, "****", RedactToken("short"))
+	assert.Equal(t, "****", RedactToken(""))
+}
+
+func TestAccountToken_ExpiresAt(t *testing.T) {
+	obtained := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	token := AccountToken{ObtainedAt: obtained, ExpiresIn: 1209600}
+	assert.Equal(t, obtained.Add(14*24*time.Hour), token.ExpiresAt())
+}
@@ -0,0 +1,63 @@
+package cmdutil
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// CheckFailedError reports that a --fail-if-any or --fail-if-empty check
+// failed. The command's output is unaffected; it exits with ExitCheckFailed.
+type CheckFailedError struct {
+	Message string
+}
+
+func (e *CheckFailedError) Error() string {
+	return e.Message
+}
+
+// IsCheckFailedError checks if an error is a failed result check
+func IsCheckFailedError(err error) bool {
+	var checkErr *CheckFailedError
+	return errors.As(err, &checkErr)
+}
+
+// ResultCheck holds the --fail-if-any and --fail-if-empty flags, which turn a
+// listing command into a monitoring check
+type ResultCheck struct {
+	FailIfAny   bool
+	FailIfEmpty bool
+}
+
+// AddFlags registers --fail-if-any and --fail-if-empty on cmd. noun names
+// what is listed, e.g. "todos".
+func (c *ResultCheck) AddFlags(cmd *cobra.Command, noun string) {
+	cmd.Flags().BoolVar(&c.FailIfAny, "fail-if-any", false, fmt.Sprintf("Exit with status %d if any %s are listed", ExitCheckFailed, noun))
+	cmd.Flags().BoolVar(&c.FailIfEmpty, "fail-if-empty", false, fmt.Sprintf("Exit with status %d if no %s are listed", ExitCheckFailed, noun))
+}
+
+// Validate rejects using both checks at once
+func (c *ResultCheck) Validate() error {
+	if c.FailIfAny && c.FailIfEmpty {
+		return fmt.Errorf("--fail-if-any and --fail-if-empty cannot be used together")
+	}
+	return nil
+}
+
+// Enabled reports whether either check was asked for
+func (c *ResultCheck) Enabled() bool {
+	return c.FailIfAny || c.FailIfEmpty
+}
+
+// Evaluate returns a CheckFailedError when count fails the check, and nil
+// otherwise
+func (c *ResultCheck) Evaluate(count int, noun string) error {
+	switch {
+	case c.FailIfAny && count > 0:
+		return &CheckFailedError{Message: fmt.Sprintf("check failed: %d %s found", count, noun)}
+	case c.FailIfEmpty && count == 0:
+		return &CheckFailedError{Message: fmt.Sprintf("check failed: no %s found", noun)}
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package cmdutil
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResultCheck(t *testing.T) {
+	t.Run("no check", func(t *testing.T) {
+		check := ResultCheck{}
+		assert.False(t, check.Enabled())
+		assert.NoError(t, check.Evaluate(0, "todos"))
+		assert.NoError(t, check.Evaluate(3, "todos"))
+	})
+
+	t.Run("fail if any", func(t *testing.T) {
+		check := ResultCheck{FailIfAny: true}
+		assert.NoError(t, check.Evaluate(0, "todos"))
+		err := check.Evaluate(3, "todos")
+		assert.EqualError(t, err, "check failed: 3 todos found")
+		assert.True(t, IsCheckFailedError(err))
+	})
+
+	t.Run("fail if empty", func(t *testing.T) {
+		check := ResultCheck{FailIfEmpty: true}
+		assert.NoError(t, check.Evaluate(2, "todos"))
+		err := check.Evaluate(0, "todos")
+		assert.EqualError(t, err, "check failed: no todos found")
+		assert.True(t, IsCheckFailedError(fmt.Errorf("wrapped: %w", err)))
+	})
+
+	t.Run("both", func(t *testing.T) {
+		check := ResultCheck{FailIfAny: true, FailIfEmpty: true}
+		assert.Error(t, check.Validate())
+	})
+}
@@ -368,3 +368,29 @@ func TestUnwrapSilent(t *testing.T) {
 		})
 	}
 }
+
+func TestChangedFlagsRoundTrip(t *testing.T) {
+	newCmd := func() (*cobra.Command, *bool, *[]string) {
+		var all bool
+		var assignees []string
+		cmd := &cobra.Command{Use: "list"}
+		cmd.Flags().BoolVar(&all, "all", false, "")
+		cmd.Flags().StringSliceVar(&assignees, "assignee", nil, "")
+		cmd.Flags().String("save-view", "", "")
+		return cmd, &all, &assignees
+	}
+
+	src, _, _ := newCmd()
+	assert.NoError(t, src.ParseFlags([]string{"--all", "--assignee", "ann,bob", "--save-view", "triage"}))
+	values := ChangedFlags(src, "save-view")
+	assert.Equal(t, map[string]string{"all": "true", "assignee": "ann,bob"}, values)
+
+	dst, all, assignees := newCmd()
+	assert.NoError(t, dst.ParseFlags([]string{"--assignee", "carol"}))
+	values["_arg"] = "Inbox"
+	assert.NoError(t, ApplyFlagValues(dst, values))
+	assert.True(t, *all)
+	assert.Equal(t, []string{"carol"}, *assignees, "explicit flags win over saved values")
+
+	assert.ErrorContains(t, ApplyFlagValues(dst, map[string]string{"sort": "due"}), "unknown flag --sort")
+}
@@ -0,0 +1,47 @@
+package cmdutil
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/needmore/bc4/internal/parser"
+	"github.com/spf13/cobra"
+)
+
+// CreatedOutput holds the --print-url and --quiet flags shared by commands
+// that create a resource
+type CreatedOutput struct {
+	PrintURL bool
+	Quiet    bool
+}
+
+// AddFlags registers --print-url and --quiet on cmd
+func (o *CreatedOutput) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&o.PrintURL, "print-url", false, "Print the web URL of the created item")
+	cmd.Flags().BoolVarP(&o.Quiet, "quiet", "q", false, "With --print-url, print only the URL")
+}
+
+// Validate checks that --quiet is only used together with --print-url
+func (o *CreatedOutput) Validate() error {
+	if o.Quiet && !o.PrintURL {
+		return fmt.Errorf("--quiet requires --print-url")
+	}
+	return nil
+}
+
+// Write prints summary for a newly created resource, followed by its web URL
+// when --print-url is set. With --quiet only the URL is printed.
+func (o *CreatedOutput) Write(w io.Writer, summary, accountID, projectID string, resourceType parser.ResourceType, id int64) error {
+	if !o.Quiet {
+		fmt.Fprintln(w, summary)
+	}
+	if !o.PrintURL {
+		return nil
+	}
+	url, err := parser.BuildWebURL(accountID, projectID, resourceType, id)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(w, url)
+	return nil
+}
@@ -0,0 +1,42 @@
+package cmdutil
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/needmore/bc4/internal/parser"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreatedOutputValidate(t *testing.T) {
+	assert.NoError(t, (&CreatedOutput{}).Validate())
+	assert.NoError(t, (&CreatedOutput{PrintURL: true, Quiet: true}).Validate())
+	assert.EqualError(t, (&CreatedOutput{Quiet: true}).Validate(), "--quiet requires --print-url")
+}
+
+func TestCreatedOutputWrite(t *testing.T) {
+	tests := []struct {
+		name   string
+		output CreatedOutput
+		want   string
+	}{
+		{"summary only", CreatedOutput{}, "#42\n"},
+		{"summary and URL", CreatedOutput{PrintURL: true}, "#42\nhttps://3.basecamp.com/1/buckets/2/todos/42\n"},
+		{"URL only", CreatedOutput{PrintURL: true, Quiet: true}, "https://3.basecamp.com/1/buckets/2/todos/42\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, tt.output.Write(&buf, "#42", "1", "2", parser.ResourceTypeTodo, 42))
+			assert.Equal(t, tt.want, buf.String())
+		})
+	}
+}
+
+func TestCreatedOutputWriteUnsupportedType(t *testing.T) {
+	output := CreatedOutput{PrintURL: true}
+	var buf bytes.Buffer
+	assert.Error(t, output.Write(&buf, "#1", "1", "2", parser.ResourceTypeStep, 1))
+}
@@ -0,0 +1,41 @@
+package cmdutil
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+// intervalValue is a duration flag value that also takes a bare number of
+// seconds, so "--interval 10" and "--interval 10s" mean the same
+type intervalValue time.Duration
+
+func (d *intervalValue) String() string {
+	return time.Duration(*d).String()
+}
+
+func (d *intervalValue) Set(s string) error {
+	if seconds, err := strconv.Atoi(s); err == nil {
+		*d = intervalValue(time.Duration(seconds) * time.Second)
+		return nil
+	}
+	parsed, err := time.ParseDuration(s)
+	if err != nil {
+		return fmt.Errorf("use a duration like 30s or 1m, or a number of seconds")
+	}
+	*d = intervalValue(parsed)
+	return nil
+}
+
+func (d *intervalValue) Type() string {
+	return "duration"
+}
+
+// IntervalVarP defines a polling interval flag: a duration such as 15s or 1m,
+// or a bare number of seconds
+func IntervalVarP(flags *pflag.FlagSet, p *time.Duration, name, shorthand string, value time.Duration, usage string) {
+	*p = value
+	flags.VarP((*intervalValue)(p), name, shorthand, usage)
+}
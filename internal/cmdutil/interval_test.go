@@ -0,0 +1,38 @@
+package cmdutil
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIntervalVarP(t *testing.T) {
+	tests := []struct {
+		arg  string
+		want time.Duration
+	}{
+		{arg: "10", want: 10 * time.Second},
+		{arg: "15s", want: 15 * time.Second},
+		{arg: "1m30s", want: 90 * time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.arg, func(t *testing.T) {
+			var interval time.Duration
+			flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+			IntervalVarP(flags, &interval, "interval", "i", 30*time.Second, "")
+			assert.Equal(t, 30*time.Second, interval)
+
+			require.NoError(t, flags.Parse([]string{"--interval", tt.arg}))
+			assert.Equal(t, tt.want, interval)
+		})
+	}
+
+	var interval time.Duration
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	IntervalVarP(flags, &interval, "interval", "", time.Minute, "")
+	assert.Error(t, flags.Parse([]string{"--interval", "soon"}))
+}
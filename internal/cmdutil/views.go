@@ -0,0 +1,66 @@
+package cmdutil
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// ChangedFlags returns the flags the user set explicitly on cmd, as the
+// strings they would be set from, omitting the named flags. Slice flags are
+// joined with commas.
+func ChangedFlags(cmd *cobra.Command, omit ...string) map[string]string {
+	skip := make(map[string]bool, len(omit))
+	for _, name := range omit {
+		skip[name] = true
+	}
+
+	values := make(map[string]string)
+	cmd.Flags().Visit(func(flag *pflag.Flag) {
+		if skip[flag.Name] {
+			return
+		}
+		if sv, ok := flag.Value.(pflag.SliceValue); ok {
+			values[flag.Name] = strings.Join(sv.GetSlice(), ",")
+			return
+		}
+		values[flag.Name] = flag.Value.String()
+	})
+	return values
+}
+
+// ApplyFlagValues sets each named flag on cmd from values, skipping flags
+// the user already set explicitly so the command line always wins. Keys
+// starting with "_" are reserved for callers and ignored.
+func ApplyFlagValues(cmd *cobra.Command, values map[string]string) error {
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if strings.HasPrefix(name, "_") {
+			continue
+		}
+		flag := cmd.Flags().Lookup(name)
+		if flag == nil {
+			return fmt.Errorf("unknown flag --%s", name)
+		}
+		if flag.Changed {
+			continue
+		}
+		if err := cmd.Flags().Set(name, values[name]); err != nil {
+			return fmt.Errorf("invalid value for --%s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// CommandName returns cmd's path without the root command, e.g. "todo list".
+func CommandName(cmd *cobra.Command) string {
+	return strings.TrimPrefix(cmd.CommandPath(), cmd.Root().Name()+" ")
+}
@@ -0,0 +1,47 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// CardTemplate describes a card to create with 'card create --from-template'
+type CardTemplate struct {
+	// Column is the name or ID of the column the card goes in
+	Column string `json:"column,omitempty"`
+	// Title may contain {date}, expanded to the creation date (YYYY-MM-DD)
+	Title string `json:"title,omitempty"`
+	// Content is the card description, in Markdown
+	Content   string   `json:"content,omitempty"`
+	Assignees []string `json:"assignees,omitempty"`
+	Steps     []string `json:"steps,omitempty"`
+}
+
+// ExpandTitle returns the template title with its placeholders filled in
+func (t CardTemplate) ExpandTitle(now time.Time) string {
+	return strings.ReplaceAll(t.Title, "{date}", now.Format("2006-01-02"))
+}
+
+// CardTemplate returns the named card template
+func (c *Config) CardTemplate(name string) (CardTemplate, error) {
+	tmpl, ok := c.CardTemplates[name]
+	if !ok {
+		if names := c.CardTemplateNames(); len(names) > 0 {
+			return CardTemplate{}, fmt.Errorf("card template %q not found (available: %s)", name, strings.Join(names, ", "))
+		}
+		return CardTemplate{}, fmt.Errorf("card template %q not found; define it under card_templates in the config file ('bc4 config edit')", name)
+	}
+	return tmpl, nil
+}
+
+// CardTemplateNames returns the names of the card templates, sorted
+func (c *Config) CardTemplateNames() []string {
+	names := make([]string, 0, len(c.CardTemplates))
+	for name := range c.CardTemplates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -11,14 +11,28 @@ import (
 	"github.com/spf13/viper"
 )
 
+// CurrentVersion is the config file layout version written by Save.
+// Bump it and add a step to migrate when the layout changes.
+const CurrentVersion = 1
+
 // Config represents the application configuration
 type Config struct {
+	Version        int                      `json:"version,omitempty"`
 	ClientID       string                   `json:"client_id,omitempty"`
 	ClientSecret   string                   `json:"client_secret,omitempty"`
 	DefaultAccount string                   `json:"default_account,omitempty"`
 	DefaultProject string                   `json:"default_project,omitempty"`
 	Accounts       map[string]AccountConfig `json:"accounts,omitempty"`
 	Preferences    PreferencesConfig        `json:"preferences,omitempty"`
+	// Views holds named flag sets saved with --save-view, keyed by view name
+	Views map[string]map[string]string `json:"views,omitempty"`
+	// CardTemplates holds reusable card definitions for 'card create
+	// --from-template', keyed by template name
+	CardTemplates map[string]CardTemplate `json:"card_templates,omitempty"`
+	// Aliases maps user-defined command shortcuts to their expansions
+	Aliases map[string]string `json:"aliases,omitempty"`
+	// APIBaseURL replaces the Basecamp API URL, e.g. to go through a proxy
+	APIBaseURL string `json:"api_base_url,omitempty"`
 }
 
 // AccountConfig represents per-account configuration
@@ -40,6 +54,12 @@ type PreferencesConfig struct {
 	Editor string `json:"editor,omitempty"`
 	Pager  string `json:"pager,omitempty"`
 	Color  string `json:"color,omitempty"`
+	// DateFormat is the default --date-format for todo listings
+	DateFormat string `json:"date_format,omitempty"`
+	// ListSizeWarn is how many todos a list can have before
+	// 'todo add --check-list-capacity' warns; 0 means never warn and unset
+	// means the built-in default
+	ListSizeWarn *int `json:"list_size_warn,omitempty"`
 }
 
 var configDir string
@@ -106,70 +126,188 @@ func Load() (*Config, error) {
 	viper.SetEnvPrefix("BC4")
 	viper.AutomaticEnv()
 
-	var config Config
+	config, migrated, err := readConfigFile()
+	if err != nil {
+		return nil, err
+	}
+	if migrated {
+		// Best effort: persist the upgraded layout so migration only runs once
+		_ = Save(config)
+	}
+
+	// Override with environment variables (applies to both file and no-file cases)
+	if clientID := viper.GetString("CLIENT_ID"); clientID != "" {
+		config.ClientID = clientID
+	}
+	if clientSecret := viper.GetString("CLIENT_SECRET"); clientSecret != "" {
+		config.ClientSecret = clientSecret
+	}
+	if accountID := viper.GetString("ACCOUNT_ID"); accountID != "" {
+		config.DefaultAccount = accountID
+	}
+	if projectID := viper.GetString("PROJECT_ID"); projectID != "" {
+		config.DefaultProject = projectID
+	}
 
+	return config, nil
+}
+
+// EffectiveAPIBaseURL returns the API base URL to use, or "" for the
+// default. BC4_API_BASE_URL takes precedence over the config file. It is
+// resolved here rather than in Load so a temporary override never ends up
+// in the file when the config is saved.
+func (c *Config) EffectiveAPIBaseURL() string {
+	if baseURL := viper.GetString("API_BASE_URL"); baseURL != "" {
+		return baseURL
+	}
+	return c.APIBaseURL
+}
+
+// readConfigFile reads the config file, migrating older layouts. It reports
+// whether a migration was applied so the caller can persist it.
+func readConfigFile() (*Config, bool, error) {
 	// Check if config file exists
 	if _, err := os.Stat(configPath); os.IsNotExist(err) {
 		// Return empty config for first run
-		config = Config{
+		return &Config{
 			Accounts: make(map[string]AccountConfig),
 			Preferences: PreferencesConfig{
 				Editor: os.Getenv("EDITOR"),
 				Pager:  "less",
 				Color:  "auto",
 			},
-		}
-	} else {
-		// Read config file
-		file, err := os.Open(configPath)
-		if err != nil {
-			return nil, fmt.Errorf("failed to open config file: %w", err)
-		}
-		defer func() { _ = file.Close() }()
+		}, false, nil
+	}
 
-		if err := json.NewDecoder(file).Decode(&config); err != nil {
-			return nil, fmt.Errorf("failed to decode config: %w", err)
-		}
+	// Read config file
+	file, err := os.Open(configPath)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to open config file: %w", err)
 	}
+	defer func() { _ = file.Close() }()
 
-	// Override with environment variables (applies to both file and no-file cases)
-	if clientID := viper.GetString("CLIENT_ID"); clientID != "" {
-		config.ClientID = clientID
+	var config Config
+	if err := json.NewDecoder(file).Decode(&config); err != nil {
+		return nil, false, fmt.Errorf("failed to decode config: %w", err)
 	}
-	if clientSecret := viper.GetString("CLIENT_SECRET"); clientSecret != "" {
-		config.ClientSecret = clientSecret
+
+	if config.Version > CurrentVersion {
+		fmt.Fprintf(os.Stderr, "Warning: config file version %d is newer than this version of bc4 supports (%d); some settings may be ignored\n",
+			config.Version, CurrentVersion)
+		return &config, false, nil
 	}
-	if accountID := viper.GetString("ACCOUNT_ID"); accountID != "" {
-		config.DefaultAccount = accountID
+	if config.Version < CurrentVersion {
+		migrate(&config, config.Version)
+		return &config, true, nil
 	}
-	if projectID := viper.GetString("PROJECT_ID"); projectID != "" {
-		config.DefaultProject = projectID
+	return &config, false, nil
+}
+
+// migrate upgrades a config loaded from an older file layout, one version
+// at a time, and sets its version to CurrentVersion.
+func migrate(config *Config, fromVersion int) {
+	if fromVersion < 1 {
+		// v0 -> v1: the default project used to be stored only at the top
+		// level. Copy it into the default account so it survives switching
+		// accounts and back.
+		if config.Accounts == nil {
+			config.Accounts = make(map[string]AccountConfig)
+		}
+		if config.DefaultAccount != "" && config.DefaultProject != "" {
+			acc := config.Accounts[config.DefaultAccount]
+			if acc.DefaultProject == "" {
+				acc.DefaultProject = config.DefaultProject
+				config.Accounts[config.DefaultAccount] = acc
+			}
+		}
 	}
 
-	return &config, nil
+	config.Version = CurrentVersion
 }
 
-// Save saves the configuration to file
+// Save saves the configuration to file. The write happens under a lock so
+// concurrent bc4 processes don't interleave their writes.
 func Save(config *Config) error {
-	// Create directory if it doesn't exist
+	unlock, err := lockConfig()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	return writeConfigFile(config)
+}
+
+// Update applies fn to the configuration currently on disk and saves the
+// result, holding the config lock for the whole read-modify-write cycle so
+// changes made by other bc4 processes in the meantime are not lost.
+func Update(fn func(*Config) error) error {
+	unlock, err := lockConfig()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	config, _, err := readConfigFile()
+	if err != nil {
+		return err
+	}
+	if err := fn(config); err != nil {
+		return err
+	}
+	return writeConfigFile(config)
+}
+
+// lockConfig takes the advisory lock guarding config file writes
+func lockConfig() (func(), error) {
 	dir := filepath.Dir(configPath)
 	if err := os.MkdirAll(dir, 0755); err != nil {
-		return fmt.Errorf("failed to create config directory: %w", err)
+		return nil, fmt.Errorf("failed to create config directory: %w", err)
+	}
+
+	unlock, err := utils.LockFile(configPath + ".lock")
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock config file: %w", err)
+	}
+	return unlock, nil
+}
+
+// writeConfigFile atomically writes config to the config path.
+// Callers must hold the config lock.
+func writeConfigFile(config *Config) error {
+	data, err := encodeConfig(config)
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(configPath, data)
+}
+
+// encodeConfig returns the config file content for config
+func encodeConfig(config *Config) ([]byte, error) {
+	// Always write the current layout version (but never downgrade a newer one)
+	if config.Version < CurrentVersion {
+		config.Version = CurrentVersion
 	}
 
-	// Atomic write: write to temp file, then rename
-	tmpFile, err := os.CreateTemp(dir, ".config-*.json.tmp")
+	data, err := json.MarshalIndent(config, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode config: %w", err)
+	}
+	return append(data, '\n'), nil
+}
+
+// writeFileAtomic writes data to a temp file next to path, then renames it
+// into place so readers never see a partial file
+func writeFileAtomic(path string, data []byte) error {
+	tmpFile, err := os.CreateTemp(filepath.Dir(path), ".config-*.json.tmp")
 	if err != nil {
 		return fmt.Errorf("failed to create temp config file: %w", err)
 	}
 	tmpPath := tmpFile.Name()
 
-	encoder := json.NewEncoder(tmpFile)
-	encoder.SetIndent("", "  ")
-	if err := encoder.Encode(config); err != nil {
+	if _, err := tmpFile.Write(data); err != nil {
 		_ = tmpFile.Close()
 		_ = os.Remove(tmpPath)
-		return fmt.Errorf("failed to encode config: %w", err)
+		return fmt.Errorf("failed to write config file: %w", err)
 	}
 
 	if err := tmpFile.Close(); err != nil {
@@ -177,7 +315,7 @@ func Save(config *Config) error {
 		return fmt.Errorf("failed to write config file: %w", err)
 	}
 
-	if err := utils.AtomicRename(tmpPath, configPath); err != nil {
+	if err := utils.AtomicRename(tmpPath, path); err != nil {
 		_ = os.Remove(tmpPath)
 		return fmt.Errorf("failed to save config file: %w", err)
 	}
@@ -185,6 +323,12 @@ func Save(config *Config) error {
 	return nil
 }
 
+// SetConfigPath makes path the config file for the rest of the process, for
+// a file given with --config or BC4_CONFIG
+func SetConfigPath(path string) {
+	configPath = path
+}
+
 // GetConfigPath returns the path to the config file
 func GetConfigPath() string {
 	return configPath
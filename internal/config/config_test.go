@@ -2,9 +2,12 @@ package config
 
 import (
 	"encoding/json"
+	"fmt"
 	"os"
 	"path/filepath"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
@@ -546,3 +549,186 @@ func TestConfig_EnvironmentVariablesNoConfigFile(t *testing.T) {
 	assert.Equal(t, "env-test-secret", cfg.ClientSecret)
 	assert.Equal(t, "env-account-123", cfg.DefaultAccount)
 }
+
+func TestEffectiveAPIBaseURL_EnvIsNotSaved(t *testing.T) {
+	originalPath := configPath
+	defer func() { configPath = originalPath }()
+
+	configPath = filepath.Join(t.TempDir(), "config.json")
+	require.NoError(t, os.WriteFile(configPath, []byte(`{"version": 1, "api_base_url": "https://file.example.com"}`), 0600))
+
+	viper.Reset()
+	t.Setenv("BC4_API_BASE_URL", "https://env.example.com")
+
+	cfg, err := Load()
+	require.NoError(t, err)
+	assert.Equal(t, "https://env.example.com", cfg.EffectiveAPIBaseURL())
+
+	require.NoError(t, Save(cfg))
+	saved, _, err := readConfigFile()
+	require.NoError(t, err)
+	assert.Equal(t, "https://file.example.com", saved.APIBaseURL)
+}
+
+func TestLoad_MigratesVersionlessConfig(t *testing.T) {
+	originalPath := configPath
+	defer func() { configPath = originalPath }()
+
+	tempDir := t.TempDir()
+	configPath = filepath.Join(tempDir, "config.json")
+
+	// A v0 file has no version and keeps the default project only at the top level
+	v0 := `{
+  "default_account": "123",
+  "default_project": "456",
+  "accounts": {
+    "123": {"name": "Test Account"}
+  }
+}`
+	require.NoError(t, os.WriteFile(configPath, []byte(v0), 0600))
+
+	cfg, err := Load()
+	require.NoError(t, err)
+
+	assert.Equal(t, CurrentVersion, cfg.Version)
+	assert.Equal(t, "456", cfg.DefaultProject)
+	assert.Equal(t, "456", cfg.Accounts["123"].DefaultProject)
+	assert.Equal(t, "Test Account", cfg.Accounts["123"].Name)
+
+	// The migrated layout is written back with the version set
+	data, err := os.ReadFile(configPath)
+	require.NoError(t, err)
+
+	var saved Config
+	require.NoError(t, json.Unmarshal(data, &saved))
+	assert.Equal(t, CurrentVersion, saved.Version)
+	assert.Equal(t, "456", saved.Accounts["123"].DefaultProject)
+}
+
+func TestLoad_MigrationKeepsExistingAccountDefault(t *testing.T) {
+	originalPath := configPath
+	defer func() { configPath = originalPath }()
+
+	tempDir := t.TempDir()
+	configPath = filepath.Join(tempDir, "config.json")
+
+	v0 := `{
+  "default_account": "123",
+  "default_project": "456",
+  "accounts": {
+    "123": {"name": "Test Account", "default_project": "789"}
+  }
+}`
+	require.NoError(t, os.WriteFile(configPath, []byte(v0), 0600))
+
+	cfg, err := Load()
+	require.NoError(t, err)
+	assert.Equal(t, "789", cfg.Accounts["123"].DefaultProject)
+}
+
+func TestLoad_FutureVersionLoadsBestEffort(t *testing.T) {
+	originalPath := configPath
+	defer func() { configPath = originalPath }()
+
+	tempDir := t.TempDir()
+	configPath = filepath.Join(tempDir, "config.json")
+
+	future := `{"version": 99, "default_account": "123", "some_new_setting": true}`
+	require.NoError(t, os.WriteFile(configPath, []byte(future), 0600))
+
+	cfg, err := Load()
+	require.NoError(t, err)
+	assert.Equal(t, 99, cfg.Version)
+	assert.Equal(t, "123", cfg.DefaultAccount)
+
+	// Loading must not rewrite a file from a newer version
+	data, err := os.ReadFile(configPath)
+	require.NoError(t, err)
+	assert.Equal(t, future, string(data))
+}
+
+func TestSave_WritesCurrentVersion(t *testing.T) {
+	originalPath := configPath
+	defer func() { configPath = originalPath }()
+
+	tempDir := t.TempDir()
+	configPath = filepath.Join(tempDir, "config.json")
+
+	require.NoError(t, Save(&Config{DefaultAccount: "123"}))
+
+	data, err := os.ReadFile(configPath)
+	require.NoError(t, err)
+
+	var saved Config
+	require.NoError(t, json.Unmarshal(data, &saved))
+	assert.Equal(t, CurrentVersion, saved.Version)
+}
+
+func TestUpdate_ConcurrentUpdatesAreNotLost(t *testing.T) {
+	originalPath := configPath
+	defer func() { configPath = originalPath }()
+	configPath = filepath.Join(t.TempDir(), "config.json")
+
+	const workers = 10
+	var wg sync.WaitGroup
+	errs := make(chan error, workers)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs <- Update(func(c *Config) error {
+				if c.Accounts == nil {
+					c.Accounts = make(map[string]AccountConfig)
+				}
+				c.Accounts[fmt.Sprintf("%d", i)] = AccountConfig{Name: fmt.Sprintf("Account %d", i)}
+				return nil
+			})
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+
+	cfg, err := Load()
+	require.NoError(t, err)
+	assert.Len(t, cfg.Accounts, workers)
+	assert.Equal(t, CurrentVersion, cfg.Version)
+}
+
+func TestViews(t *testing.T) {
+	cfg := &Config{}
+	cfg.SaveView("triage", "todo list", map[string]string{"all": "true", ViewArgKey: "Inbox"})
+
+	view, err := cfg.LookupView("triage", "todo list")
+	require.NoError(t, err)
+	assert.Equal(t, "true", view["all"])
+	assert.Equal(t, "Inbox", view[ViewArgKey])
+	assert.Equal(t, "todo list", view[ViewCommandKey])
+
+	_, err = cfg.LookupView("triage", "card list")
+	assert.ErrorContains(t, err, "saved for 'todo list'")
+
+	_, err = cfg.LookupView("missing", "todo list")
+	assert.ErrorContains(t, err, "saved views: [triage]")
+
+	assert.Empty(t, cfg.ViewNames("card list"))
+}
+
+func TestCardTemplates(t *testing.T) {
+	cfg := &Config{CardTemplates: map[string]CardTemplate{
+		"bug":     {Column: "Triage", Title: "Bug: {date}"},
+		"feature": {Title: "Feature"},
+	}}
+
+	tmpl, err := cfg.CardTemplate("bug")
+	require.NoError(t, err)
+	assert.Equal(t, "Bug: 2025-04-01", tmpl.ExpandTitle(time.Date(2025, 4, 1, 12, 0, 0, 0, time.UTC)))
+
+	_, err = cfg.CardTemplate("chore")
+	assert.ErrorContains(t, err, "available: bug, feature")
+
+	_, err = (&Config{}).CardTemplate("bug")
+	assert.ErrorContains(t, err, "card_templates")
+}
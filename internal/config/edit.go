@@ -0,0 +1,118 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/needmore/bc4/internal/ui"
+)
+
+// Edit passes the config file content to edit and saves what it returns,
+// once it passes Validate. The live file is never edited in place: edit
+// works on a copy, and the result is written atomically under the config
+// lock, with the previous file kept next to it with a .bak suffix. If edit
+// fails, the result is invalid, or another bc4 process changed the file in
+// the meantime, nothing is saved and the error is returned.
+func Edit(edit func(content string) (string, error)) error {
+	original, err := readForEdit()
+	if err != nil {
+		return err
+	}
+
+	edited, err := edit(string(original))
+	if err != nil {
+		return err
+	}
+	if err := Validate([]byte(edited)); err != nil {
+		return fmt.Errorf("changes discarded, config is invalid: %w", err)
+	}
+
+	unlock, err := lockConfig()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	current, err := os.ReadFile(configPath)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to read config file: %w", err)
+	}
+	if err == nil {
+		if !bytes.Equal(current, original) {
+			return fmt.Errorf("changes discarded, the config file was changed by another bc4 command while you were editing it")
+		}
+		if err := writeFileAtomic(GetBackupPath(), current); err != nil {
+			return fmt.Errorf("failed to back up config file: %w", err)
+		}
+	}
+	return writeFileAtomic(configPath, []byte(edited))
+}
+
+// GetBackupPath returns the path of the config backup written by Edit
+func GetBackupPath() string {
+	return configPath + ".bak"
+}
+
+// readForEdit returns the config file content to start editing from: the
+// file as it is, or the defaults on first run
+func readForEdit() ([]byte, error) {
+	unlock, err := lockConfig()
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	data, err := os.ReadFile(configPath)
+	if err == nil {
+		return data, nil
+	}
+	if !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	// Start from the defaults on first run
+	config, _, err := readConfigFile()
+	if err != nil {
+		return nil, err
+	}
+	return encodeConfig(config)
+}
+
+// Validate checks config file content: it must be a single JSON object
+// with only known fields and valid preference values
+func Validate(data []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	var config Config
+	if err := decoder.Decode(&config); err != nil {
+		return fmt.Errorf("failed to decode config: %w", err)
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return fmt.Errorf("failed to decode config: unexpected content after the config object")
+	}
+
+	if config.Version < 0 {
+		return fmt.Errorf("invalid version %d", config.Version)
+	}
+	switch config.Preferences.Color {
+	case "", "auto", "always", "never":
+	default:
+		return fmt.Errorf("invalid preferences.color %q: must be auto, always, or never", config.Preferences.Color)
+	}
+	if _, err := ui.ParseDateFormat(config.Preferences.DateFormat); err != nil {
+		return fmt.Errorf("invalid preferences.date_format: %w", err)
+	}
+	if warn := config.Preferences.ListSizeWarn; warn != nil && *warn < 0 {
+		return fmt.Errorf("invalid preferences.list_size_warn %d: must be 0 or more", *warn)
+	}
+	for id := range config.Accounts {
+		if id == "" {
+			return fmt.Errorf("account IDs cannot be empty")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEdit(t *testing.T) {
+	originalPath := configPath
+	defer func() { configPath = originalPath }()
+
+	const original = `{"version": 1, "default_account": "123"}`
+
+	setup := func(t *testing.T) {
+		configPath = filepath.Join(t.TempDir(), "config.json")
+		require.NoError(t, os.WriteFile(configPath, []byte(original), 0600))
+	}
+	writeContent := func(content string) func(string) (string, error) {
+		return func(string) (string, error) {
+			return content, nil
+		}
+	}
+	assertUnchanged := func(t *testing.T) {
+		data, err := os.ReadFile(configPath)
+		require.NoError(t, err)
+		assert.Equal(t, original, string(data))
+	}
+
+	t.Run("valid edit is kept", func(t *testing.T) {
+		setup(t)
+		err := Edit(writeContent(`{"version": 1, "default_account": "456"}`))
+		require.NoError(t, err)
+
+		cfg, err := Load()
+		require.NoError(t, err)
+		assert.Equal(t, "456", cfg.DefaultAccount)
+
+		backup, err := os.ReadFile(GetBackupPath())
+		require.NoError(t, err)
+		assert.Equal(t, original, string(backup))
+	})
+
+	t.Run("live file is not edited in place", func(t *testing.T) {
+		setup(t)
+		err := Edit(func(content string) (string, error) {
+			assert.Equal(t, original, content)
+			assertUnchanged(t)
+			return `{"version": 1, "default_account": "456"}`, nil
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("malformed JSON is discarded", func(t *testing.T) {
+		setup(t)
+		err := Edit(writeContent(`{"version": 1,`))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "changes discarded")
+		assertUnchanged(t)
+	})
+
+	t.Run("unknown field is discarded", func(t *testing.T) {
+		setup(t)
+		err := Edit(writeContent(`{"version": 1, "default_acount": "456"}`))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "default_acount")
+		assertUnchanged(t)
+	})
+
+	t.Run("editor failure is discarded", func(t *testing.T) {
+		setup(t)
+		err := Edit(func(string) (string, error) {
+			return "partial", errors.New("editor crashed")
+		})
+		require.EqualError(t, err, "editor crashed")
+		assertUnchanged(t)
+	})
+
+	t.Run("concurrent change is not clobbered", func(t *testing.T) {
+		setup(t)
+		err := Edit(func(string) (string, error) {
+			require.NoError(t, Update(func(cfg *Config) error {
+				cfg.DefaultProject = "789"
+				return nil
+			}))
+			return `{"version": 1, "default_account": "456"}`, nil
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "changed by another bc4 command")
+
+		cfg, err := Load()
+		require.NoError(t, err)
+		assert.Equal(t, "123", cfg.DefaultAccount)
+		assert.Equal(t, "789", cfg.DefaultProject)
+	})
+
+	t.Run("missing config starts from the defaults", func(t *testing.T) {
+		configPath = filepath.Join(t.TempDir(), "config.json")
+		var seen string
+		err := Edit(func(content string) (string, error) {
+			seen = content
+			return content, nil
+		})
+		require.NoError(t, err)
+		assert.NoError(t, Validate([]byte(seen)))
+
+		data, err := os.ReadFile(configPath)
+		require.NoError(t, err)
+		assert.Equal(t, seen, string(data))
+	})
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{name: "valid", content: `{"version": 1, "preferences": {"color": "never"}}`},
+		{name: "malformed", content: `{`, wantErr: "failed to decode config"},
+		{name: "unknown field", content: `{"colour": "never"}`, wantErr: "unknown field"},
+		{name: "trailing content", content: `{} {}`, wantErr: "unexpected content"},
+		{name: "bad color", content: `{"preferences": {"color": "purple"}}`, wantErr: "preferences.color"},
+		{name: "date format", content: `{"preferences": {"date_format": "relative"}}`},
+		{name: "bad date format", content: `{"preferences": {"date_format": "relatve"}}`, wantErr: "preferences.date_format"},
+		{name: "list size warn off", content: `{"preferences": {"list_size_warn": 0}}`},
+		{name: "negative list size warn", content: `{"preferences": {"list_size_warn": -1}}`, wantErr: "preferences.list_size_warn"},
+		{name: "wrong type", content: `{"version": "1"}`, wantErr: "failed to decode config"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate([]byte(tt.content))
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
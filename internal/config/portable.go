@@ -0,0 +1,133 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Export returns the config file's settings as indented JSON, for moving
+// them to another machine. Tokens live in the auth file and are never
+// included; the OAuth client credentials are left out unless includeSecrets
+// is set. Environment variable overrides are not applied.
+func Export(includeSecrets bool) ([]byte, error) {
+	config, _, err := readConfigFile()
+	if err != nil {
+		return nil, err
+	}
+	if config.Version < CurrentVersion {
+		config.Version = CurrentVersion
+	}
+	if !includeSecrets {
+		config.ClientID = ""
+		config.ClientSecret = ""
+	}
+
+	data, err := json.MarshalIndent(config, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode config: %w", err)
+	}
+	return append(data, '\n'), nil
+}
+
+// Import validates exported settings, migrates them from older layouts, and
+// merges them into the config file. Existing values win; imported ones only
+// fill in what's missing. With replace, the imported settings take the place
+// of the current ones, except that existing client credentials are kept when
+// the import has none.
+func Import(data []byte, replace bool) error {
+	if err := Validate(data); err != nil {
+		return fmt.Errorf("invalid config to import: %w", err)
+	}
+
+	var imported Config
+	if err := json.Unmarshal(data, &imported); err != nil {
+		return fmt.Errorf("failed to decode config: %w", err)
+	}
+	if imported.Version > CurrentVersion {
+		return fmt.Errorf("config version %d is newer than this version of bc4 supports (%d)", imported.Version, CurrentVersion)
+	}
+	if imported.Version < CurrentVersion {
+		migrate(&imported, imported.Version)
+	}
+
+	return Update(func(config *Config) error {
+		if replace {
+			if imported.ClientID == "" && imported.ClientSecret == "" {
+				imported.ClientID = config.ClientID
+				imported.ClientSecret = config.ClientSecret
+			}
+			*config = imported
+			return nil
+		}
+		mergeConfig(config, &imported)
+		return nil
+	})
+}
+
+// mergeConfig copies the settings from src that dst doesn't have yet
+func mergeConfig(dst, src *Config) {
+	fillString(&dst.ClientID, src.ClientID)
+	fillString(&dst.ClientSecret, src.ClientSecret)
+	fillString(&dst.DefaultAccount, src.DefaultAccount)
+	fillString(&dst.DefaultProject, src.DefaultProject)
+	fillString(&dst.APIBaseURL, src.APIBaseURL)
+
+	fillString(&dst.Preferences.Editor, src.Preferences.Editor)
+	fillString(&dst.Preferences.Pager, src.Preferences.Pager)
+	fillString(&dst.Preferences.Color, src.Preferences.Color)
+	fillString(&dst.Preferences.DateFormat, src.Preferences.DateFormat)
+	if dst.Preferences.ListSizeWarn == nil {
+		dst.Preferences.ListSizeWarn = src.Preferences.ListSizeWarn
+	}
+
+	if len(src.Accounts) > 0 && dst.Accounts == nil {
+		dst.Accounts = make(map[string]AccountConfig)
+	}
+	for id, srcAcc := range src.Accounts {
+		acc, ok := dst.Accounts[id]
+		if !ok {
+			dst.Accounts[id] = srcAcc
+			continue
+		}
+		fillString(&acc.Name, srcAcc.Name)
+		fillString(&acc.DefaultProject, srcAcc.DefaultProject)
+		if len(srcAcc.ProjectDefaults) > 0 && acc.ProjectDefaults == nil {
+			acc.ProjectDefaults = make(map[string]ProjectDefaults)
+		}
+		for projectID, srcDefaults := range srcAcc.ProjectDefaults {
+			defaults := acc.ProjectDefaults[projectID]
+			fillString(&defaults.DefaultTodoList, srcDefaults.DefaultTodoList)
+			fillString(&defaults.DefaultCampfire, srcDefaults.DefaultCampfire)
+			fillString(&defaults.DefaultCardTable, srcDefaults.DefaultCardTable)
+			acc.ProjectDefaults[projectID] = defaults
+		}
+		dst.Accounts[id] = acc
+	}
+
+	dst.Views = mergeMissing(dst.Views, src.Views)
+	dst.CardTemplates = mergeMissing(dst.CardTemplates, src.CardTemplates)
+	dst.Aliases = mergeMissing(dst.Aliases, src.Aliases)
+}
+
+// fillString sets *dst to src when *dst is empty
+func fillString(dst *string, src string) {
+	if *dst == "" {
+		*dst = src
+	}
+}
+
+// mergeMissing adds the entries of src whose keys dst doesn't have
+func mergeMissing[V any](dst, src map[string]V) map[string]V {
+	if len(src) == 0 {
+		return dst
+	}
+	if dst == nil {
+		dst = make(map[string]V, len(src))
+	}
+	for key, value := range src {
+		if _, ok := dst[key]; !ok {
+			dst[key] = value
+		}
+	}
+	return dst
+}
@@ -0,0 +1,115 @@
+package config
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExport(t *testing.T) {
+	originalPath := configPath
+	defer func() { configPath = originalPath }()
+
+	configPath = filepath.Join(t.TempDir(), "config.json")
+	require.NoError(t, os.WriteFile(configPath, []byte(`{"version": 1, "client_id": "id", "client_secret": "secret", "default_account": "123"}`), 0600))
+
+	data, err := Export(false)
+	require.NoError(t, err)
+	var exported Config
+	require.NoError(t, json.Unmarshal(data, &exported))
+	assert.Equal(t, "123", exported.DefaultAccount)
+	assert.Empty(t, exported.ClientID)
+	assert.Empty(t, exported.ClientSecret)
+
+	data, err = Export(true)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, &exported))
+	assert.Equal(t, "id", exported.ClientID)
+	assert.Equal(t, "secret", exported.ClientSecret)
+}
+
+func TestImport(t *testing.T) {
+	originalPath := configPath
+	defer func() { configPath = originalPath }()
+
+	const current = `{
+  "version": 1,
+  "client_id": "id",
+  "client_secret": "secret",
+  "default_account": "123",
+  "accounts": {
+    "123": {"name": "Acme", "project_defaults": {"1": {"default_todo_list": "10"}}}
+  },
+  "preferences": {"pager": "less"},
+  "aliases": {"mine": "todo list --assignee me"}
+}`
+	const imported = `{
+  "version": 1,
+  "default_account": "456",
+  "api_base_url": "https://proxy.example.com",
+  "accounts": {
+    "123": {"name": "Renamed", "default_project": "1", "project_defaults": {"1": {"default_todo_list": "99", "default_campfire": "20"}}},
+    "456": {"name": "Other"}
+  },
+  "preferences": {"pager": "more", "editor": "vim"},
+  "aliases": {"mine": "todo list", "standup": "activity list --since 1d"}
+}`
+
+	setup := func(t *testing.T) {
+		configPath = filepath.Join(t.TempDir(), "config.json")
+		require.NoError(t, os.WriteFile(configPath, []byte(current), 0600))
+	}
+
+	t.Run("merge keeps existing settings", func(t *testing.T) {
+		setup(t)
+		require.NoError(t, Import([]byte(imported), false))
+
+		cfg, _, err := readConfigFile()
+		require.NoError(t, err)
+		assert.Equal(t, "123", cfg.DefaultAccount)
+		assert.Equal(t, "id", cfg.ClientID)
+		assert.Equal(t, "https://proxy.example.com", cfg.APIBaseURL)
+		assert.Equal(t, "Acme", cfg.Accounts["123"].Name)
+		assert.Equal(t, "1", cfg.Accounts["123"].DefaultProject)
+		assert.Equal(t, ProjectDefaults{DefaultTodoList: "10", DefaultCampfire: "20"}, cfg.Accounts["123"].ProjectDefaults["1"])
+		assert.Equal(t, "Other", cfg.Accounts["456"].Name)
+		assert.Equal(t, PreferencesConfig{Pager: "less", Editor: "vim"}, cfg.Preferences)
+		assert.Equal(t, map[string]string{"mine": "todo list --assignee me", "standup": "activity list --since 1d"}, cfg.Aliases)
+	})
+
+	t.Run("replace keeps client credentials", func(t *testing.T) {
+		setup(t)
+		require.NoError(t, Import([]byte(imported), true))
+
+		cfg, _, err := readConfigFile()
+		require.NoError(t, err)
+		assert.Equal(t, "456", cfg.DefaultAccount)
+		assert.Equal(t, "Renamed", cfg.Accounts["123"].Name)
+		assert.Equal(t, "id", cfg.ClientID)
+		assert.Equal(t, "secret", cfg.ClientSecret)
+	})
+
+	t.Run("old layouts are migrated", func(t *testing.T) {
+		setup(t)
+		require.NoError(t, Import([]byte(`{"default_account": "789", "default_project": "5"}`), true))
+
+		cfg, _, err := readConfigFile()
+		require.NoError(t, err)
+		assert.Equal(t, CurrentVersion, cfg.Version)
+		assert.Equal(t, "5", cfg.Accounts["789"].DefaultProject)
+	})
+
+	t.Run("invalid files are rejected", func(t *testing.T) {
+		setup(t)
+		assert.ErrorContains(t, Import([]byte(`{"unknown": true}`), false), "invalid config to import")
+		assert.ErrorContains(t, Import([]byte(`{"version": 99}`), false), "newer than this version")
+
+		data, err := os.ReadFile(configPath)
+		require.NoError(t, err)
+		assert.Equal(t, current, string(data))
+	})
+}
@@ -0,0 +1,56 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Reserved keys in a saved view. Everything else is a flag name and value.
+const (
+	// ViewCommandKey records the command a view was saved from, so a view
+	// saved for one command can't be replayed against another
+	ViewCommandKey = "_command"
+	// ViewArgKey holds the positional argument saved with the view, if any
+	ViewArgKey = "_arg"
+)
+
+// SaveView stores values as the named view for command, replacing any view
+// of the same name.
+func (c *Config) SaveView(name, command string, values map[string]string) {
+	if c.Views == nil {
+		c.Views = make(map[string]map[string]string)
+	}
+	view := make(map[string]string, len(values)+1)
+	for k, v := range values {
+		view[k] = v
+	}
+	view[ViewCommandKey] = command
+	c.Views[name] = view
+}
+
+// LookupView returns the named view saved for command.
+func (c *Config) LookupView(name, command string) (map[string]string, error) {
+	view, ok := c.Views[name]
+	if !ok {
+		if names := c.ViewNames(command); len(names) > 0 {
+			return nil, fmt.Errorf("view %q not found (saved views: %v)", name, names)
+		}
+		return nil, fmt.Errorf("view %q not found", name)
+	}
+	if saved := view[ViewCommandKey]; saved != "" && saved != command {
+		return nil, fmt.Errorf("view %q was saved for '%s', not '%s'", name, saved, command)
+	}
+	return view, nil
+}
+
+// ViewNames returns the names of the views saved for command, sorted.
+func (c *Config) ViewNames(command string) []string {
+	var names []string
+	for name, view := range c.Views {
+		if view[ViewCommandKey] == command {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
@@ -3,6 +3,7 @@ package download
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -22,6 +23,14 @@ type Options struct {
 	OutputDir       string
 	Overwrite       bool
 	AttachmentIndex int // 1-based; 0 means "all"
+	// Filter, when set, keeps only the attachments it returns true for;
+	// AttachmentIndex then applies to the filtered list
+	Filter func(attachments.Attachment) bool
+	// NoSummary suppresses the closing summary, for callers that aggregate
+	// several runs and print one summary with PrintSummary
+	NoSummary bool
+	// Output receives progress and the summary; nil means stdout
+	Output io.Writer
 }
 
 // Result tracks the outcome of a download run.
@@ -30,6 +39,19 @@ type Result struct {
 	Failed     int
 	Skipped    int
 	Total      int
+	Paths      []string // files written by successful downloads, in order
+}
+
+// Add accumulates the counts and paths of another run into r.
+func (r *Result) Add(other *Result) {
+	if other == nil {
+		return
+	}
+	r.Successful += other.Successful
+	r.Failed += other.Failed
+	r.Skipped += other.Skipped
+	r.Total += other.Total
+	r.Paths = append(r.Paths, other.Paths...)
 }
 
 // DownloadFromSources parses attachments from one or more HTML content sources
@@ -47,16 +69,26 @@ func DownloadFromSources(
 		source string
 	}
 
+	out := opts.Output
+	if out == nil {
+		out = os.Stdout
+	}
+
 	var allAtts []taggedAttachment
 	for _, src := range sources {
 		parsed := attachments.ParseAttachments(src.Content)
 		for _, att := range parsed {
+			if opts.Filter != nil && !opts.Filter(att) {
+				continue
+			}
 			allAtts = append(allAtts, taggedAttachment{att: att, source: src.Label})
 		}
 	}
 
 	if len(allAtts) == 0 {
-		fmt.Println("No attachments found")
+		if !opts.NoSummary {
+			fmt.Fprintln(out, "No attachments found")
+		}
 		return &Result{}, nil
 	}
 
@@ -92,21 +124,21 @@ func DownloadFromSources(
 		}
 
 		if opts.AttachmentIndex > 0 {
-			fmt.Printf("%sDownloading attachment %d: %s\n", sourcePrefix, displayIndex, ta.att.GetDisplayName())
+			fmt.Fprintf(out, "%sDownloading attachment %d: %s\n", sourcePrefix, displayIndex, ta.att.GetDisplayName())
 		} else {
-			fmt.Printf("%sDownloading attachment %d/%d: %s\n", sourcePrefix, displayIndex, originalCount, ta.att.GetDisplayName())
+			fmt.Fprintf(out, "%sDownloading attachment %d/%d: %s\n", sourcePrefix, displayIndex, originalCount, ta.att.GetDisplayName())
 		}
 
 		// Try to extract upload ID from URL or Href
 		extractResult, err := attachments.TryExtractUploadID(&ta.att)
 		if err != nil {
 			if extractResult != nil && extractResult.IsBlobURL {
-				fmt.Println("  ⚠ Skipped (browser-only URL, cannot download via API)")
-				fmt.Printf("    URL: %s\n", extractResult.BlobURL)
-				fmt.Println("    Tip: Open this URL in your browser while logged into Basecamp to download")
+				fmt.Fprintln(out, "  ⚠ Skipped (browser-only URL, cannot download via API)")
+				fmt.Fprintf(out, "    URL: %s\n", extractResult.BlobURL)
+				fmt.Fprintln(out, "    Tip: Open this URL in your browser while logged into Basecamp to download")
 				result.Skipped++
 			} else {
-				fmt.Printf("  ✗ Failed: %v\n", err)
+				fmt.Fprintf(out, "  ✗ Failed: %v\n", err)
 				result.Failed++
 			}
 			continue
@@ -115,7 +147,7 @@ func DownloadFromSources(
 		// Get full upload details including download URL
 		upload, err := uploadOps.GetUpload(ctx, bucketID, extractResult.UploadID)
 		if err != nil {
-			fmt.Printf("  ✗ Failed to get upload details: %v\n", err)
+			fmt.Fprintf(out, "  ✗ Failed to get upload details: %v\n", err)
 			result.Failed++
 			continue
 		}
@@ -133,8 +165,8 @@ func DownloadFromSources(
 		// Check if file exists
 		if !opts.Overwrite {
 			if _, err := os.Stat(destPath); err == nil {
-				fmt.Printf("  ⚠ File already exists: %s (use --overwrite to replace)\n", destPath)
-				fmt.Println("  Skipping...")
+				fmt.Fprintf(out, "  ⚠ File already exists: %s (use --overwrite to replace)\n", destPath)
+				fmt.Fprintln(out, "  Skipping...")
 				result.Skipped++
 				continue
 			}
@@ -143,32 +175,45 @@ func DownloadFromSources(
 		// Download the attachment
 		err = uploadOps.DownloadAttachment(ctx, upload.DownloadURL, destPath)
 		if err != nil {
-			fmt.Printf("  ✗ Failed to download: %v\n", err)
+			fmt.Fprintf(out, "  ✗ Failed to download: %v\n", err)
 			result.Failed++
 			continue
 		}
 
 		sizeStr := FormatByteSize(upload.ByteSize)
-		fmt.Printf("  ✓ Downloaded: %s (%s)\n", destPath, sizeStr)
+		fmt.Fprintf(out, "  ✓ Downloaded: %s (%s)\n", destPath, sizeStr)
 		result.Successful++
+		result.Paths = append(result.Paths, destPath)
 	}
 
-	// Print summary
-	fmt.Println()
-	if result.Successful > 0 {
-		fmt.Printf("Successfully downloaded: %d/%d attachments\n", result.Successful, result.Total)
-	}
-	if result.Skipped > 0 {
-		fmt.Printf("Skipped: %d attachments\n", result.Skipped)
+	if !opts.NoSummary {
+		printSummary(out, result)
 	}
 	if result.Failed > 0 {
-		fmt.Printf("Failed: %d attachments\n", result.Failed)
 		return result, fmt.Errorf("some attachments failed to download")
 	}
 
 	return result, nil
 }
 
+// PrintSummary prints the closing summary of a download run.
+func PrintSummary(result *Result) {
+	printSummary(os.Stdout, result)
+}
+
+func printSummary(w io.Writer, result *Result) {
+	fmt.Fprintln(w)
+	if result.Successful > 0 {
+		fmt.Fprintf(w, "Successfully downloaded: %d/%d attachments\n", result.Successful, result.Total)
+	}
+	if result.Skipped > 0 {
+		fmt.Fprintf(w, "Skipped: %d attachments\n", result.Skipped)
+	}
+	if result.Failed > 0 {
+		fmt.Fprintf(w, "Failed: %d attachments\n", result.Failed)
+	}
+}
+
 // SanitizeFilename removes or replaces characters that are unsafe for filenames
 // to prevent path traversal attacks and filesystem errors.
 func SanitizeFilename(filename string) string {
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/attachments"
 )
 
 // mockUploadOps implements api.UploadOperations for testing.
@@ -561,3 +562,32 @@ func TestFormatByteSize(t *testing.T) {
 		})
 	}
 }
+
+func TestDownloadFromSources_FilterImages(t *testing.T) {
+	tmpDir := t.TempDir()
+	mock := &mockUploadOps{
+		uploads: map[int64]*api.Upload{
+			100: {ID: 100, Filename: "report.pdf", DownloadURL: "https://example.com/dl/100"},
+			200: {ID: 200, Filename: "screenshot.png", DownloadURL: "https://example.com/dl/200"},
+		},
+	}
+	pdf := `<bc-attachment sgid="pdf" content-type="application/pdf" filename="report.pdf" url="https://3.basecamp.com/123/uploads/100/download/report.pdf"></bc-attachment>`
+	sources := []AttachmentSource{
+		{Label: "card", Content: pdf + htmlWithUploadAttachment(200, "screenshot.png")},
+	}
+
+	result, err := DownloadFromSources(context.Background(), mock, "bucket1", sources, Options{
+		OutputDir: tmpDir,
+		Filter:    func(att attachments.Attachment) bool { return att.IsImage() },
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Total != 1 || result.Successful != 1 {
+		t.Errorf("expected one successful download, got total=%d successful=%d", result.Total, result.Successful)
+	}
+	want := filepath.Join(tmpDir, "screenshot.png")
+	if len(result.Paths) != 1 || result.Paths[0] != want {
+		t.Errorf("expected Paths=[%s], got %v", want, result.Paths)
+	}
+}
@@ -3,6 +3,7 @@ package factory
 import (
 	"context"
 	"fmt"
+	"os"
 	"sync"
 
 	"github.com/needmore/bc4/internal/api"
@@ -148,12 +149,40 @@ func (f *Factory) ApiClient() (*api.ModularClient, error) {
 			return
 		}
 
-		f.apiClient = api.NewModularClient(accountID, token.AccessToken)
+		baseURL, err := f.apiBaseURL()
+		if err != nil {
+			f.apiClientErr = err
+			return
+		}
+
+		f.apiClient = api.NewModularClientWithBaseURL(accountID, token.AccessToken, baseURL)
 	})
 
 	return f.apiClient, f.apiClientErr
 }
 
+// apiBaseURL returns the API base URL set with BC4_API_BASE_URL or the
+// config, or "" for the default. Plain http URLs are allowed with a warning.
+func (f *Factory) apiBaseURL() (string, error) {
+	cfg, err := f.Config()
+	if err != nil {
+		return "", fmt.Errorf("failed to load config: %w", err)
+	}
+	raw := cfg.EffectiveAPIBaseURL()
+	if raw == "" {
+		return "", nil
+	}
+
+	baseURL, insecure, err := api.ParseBaseURL(raw)
+	if err != nil {
+		return "", err
+	}
+	if insecure {
+		fmt.Fprintf(os.Stderr, "Warning: API base URL %s is not https; your access token will be sent unencrypted\n", baseURL)
+	}
+	return baseURL, nil
+}
+
 // Context returns a context for API operations
 // This can be extended in the future to include timeouts, tracing, etc.
 func (f *Factory) Context() context.Context {
@@ -0,0 +1,197 @@
+// Package identity caches who the logged-in user is in each account, so
+// commands that need to know "me" don't fetch the profile on every run.
+// Entries expire after TTL and are cleared on login and logout.
+package identity
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/config"
+	"github.com/needmore/bc4/internal/utils"
+)
+
+// TTL is how long a cached identity is used before it is fetched again
+const TTL = 24 * time.Hour
+
+// Identity is the logged-in user in one account
+type Identity struct {
+	PersonID int64  `json:"person_id"`
+	Name     string `json:"name"`
+	Email    string `json:"email"`
+	// ProjectPersonIDs maps a project ID to the user's person ID on that
+	// project, as found by ProjectPersonID
+	ProjectPersonIDs map[string]int64 `json:"project_person_ids,omitempty"`
+	FetchedAt        time.Time        `json:"fetched_at"`
+}
+
+// ProfileFetcher fetches the logged-in user's profile
+type ProfileFetcher interface {
+	GetMyProfile(ctx context.Context) (*api.Person, error)
+}
+
+// ProjectPeopleFetcher fetches the logged-in user's profile and a project's
+// people
+type ProjectPeopleFetcher interface {
+	ProfileFetcher
+	GetProjectPeople(ctx context.Context, projectID string) ([]api.Person, error)
+}
+
+// cacheFile is the layout of the cache file, keyed by account ID
+type cacheFile struct {
+	Accounts map[string]Identity `json:"accounts"`
+}
+
+// storePath returns where identities are cached
+var storePath = func() string {
+	return filepath.Join(config.GetConfigDir(), "identity.json")
+}
+
+// now is the current time, replaced in tests
+var now = time.Now
+
+// Me returns the logged-in user in accountID, from the cache when it is
+// younger than TTL and otherwise fetched and cached. refresh always fetches.
+func Me(ctx context.Context, client ProfileFetcher, accountID string, refresh bool) (*Identity, error) {
+	cache := load()
+	if cached, ok := cache.Accounts[accountID]; ok && !refresh && fresh(cached) {
+		return &cached, nil
+	}
+
+	profile, err := client.GetMyProfile(ctx)
+	if err != nil {
+		return nil, err
+	}
+	me := Identity{
+		PersonID:  profile.ID,
+		Name:      profile.Name,
+		Email:     profile.EmailAddress,
+		FetchedAt: now(),
+	}
+	cache.Accounts[accountID] = me
+	save(cache)
+	return &me, nil
+}
+
+// ProjectPersonID returns the logged-in user's person ID on a project,
+// matched by email among the project's people. The result is remembered
+// with the cached identity.
+func ProjectPersonID(ctx context.Context, client ProjectPeopleFetcher, accountID, projectID string) (int64, error) {
+	me, err := Me(ctx, client, accountID, false)
+	if err != nil {
+		return 0, err
+	}
+	if id, ok := me.ProjectPersonIDs[projectID]; ok {
+		return id, nil
+	}
+
+	people, err := client.GetProjectPeople(ctx, projectID)
+	if err != nil {
+		return 0, err
+	}
+	var personID int64
+	for _, person := range people {
+		if me.Email != "" && strings.EqualFold(person.EmailAddress, me.Email) {
+			personID = person.ID
+			break
+		}
+	}
+	if personID == 0 {
+		return 0, fmt.Errorf("you are not a member of project %s", projectID)
+	}
+
+	cache := load()
+	entry, ok := cache.Accounts[accountID]
+	if !ok {
+		entry = *me
+	}
+	if entry.ProjectPersonIDs == nil {
+		entry.ProjectPersonIDs = make(map[string]int64)
+	}
+	entry.ProjectPersonIDs[projectID] = personID
+	cache.Accounts[accountID] = entry
+	save(cache)
+	return personID, nil
+}
+
+// Clear forgets the cached identity for accountID, or for every account
+// when accountID is empty
+func Clear(accountID string) error {
+	if accountID == "" {
+		if err := os.Remove(storePath()); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to clear identity cache: %w", err)
+		}
+		return nil
+	}
+
+	cache := load()
+	if _, ok := cache.Accounts[accountID]; !ok {
+		return nil
+	}
+	delete(cache.Accounts, accountID)
+	if err := write(cache); err != nil {
+		return fmt.Errorf("failed to clear identity cache: %w", err)
+	}
+	return nil
+}
+
+// fresh reports whether a cached identity can still be used
+func fresh(cached Identity) bool {
+	age := now().Sub(cached.FetchedAt)
+	return age >= 0 && age < TTL
+}
+
+// load reads the cache. A missing or unreadable cache is empty, since
+// everything in it can be fetched again.
+func load() cacheFile {
+	cache := cacheFile{}
+	if data, err := os.ReadFile(storePath()); err == nil {
+		_ = json.Unmarshal(data, &cache)
+	}
+	if cache.Accounts == nil {
+		cache.Accounts = make(map[string]Identity)
+	}
+	return cache
+}
+
+// save writes the cache, best effort: failing to cache only means fetching
+// again next time
+func save(cache cacheFile) {
+	_ = write(cache)
+}
+
+// write replaces the cache file atomically
+func write(cache cacheFile) error {
+	data, err := json.MarshalIndent(cache, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	path := storePath()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".identity-*.json.tmp")
+	if err != nil {
+		return err
+	}
+	tmpPath := tmp.Name()
+	_, writeErr := tmp.Write(append(data, '\n'))
+	closeErr := tmp.Close()
+	if err := errors.Join(writeErr, closeErr); err != nil {
+		_ = os.Remove(tmpPath)
+		return err
+	}
+	if err := utils.AtomicRename(tmpPath, path); err != nil {
+		_ = os.Remove(tmpPath)
+		return err
+	}
+	return nil
+}
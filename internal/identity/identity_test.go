@@ -0,0 +1,140 @@
+package identity
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/needmore/bc4/internal/api"
+)
+
+func useTempStore(t *testing.T) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "bc4", "identity.json")
+	originalPath, originalNow := storePath, now
+	storePath = func() string { return path }
+	t.Cleanup(func() { storePath, now = originalPath, originalNow })
+}
+
+// setNow pins the current time
+func setNow(t time.Time) {
+	now = func() time.Time { return t }
+}
+
+// countingClient counts how often the profile and project people are fetched
+type countingClient struct {
+	profile      api.Person
+	people       []api.Person
+	profileCalls int
+	peopleCalls  int
+	profileErr   error
+}
+
+func (c *countingClient) GetMyProfile(ctx context.Context) (*api.Person, error) {
+	c.profileCalls++
+	if c.profileErr != nil {
+		return nil, c.profileErr
+	}
+	profile := c.profile
+	return &profile, nil
+}
+
+func (c *countingClient) GetProjectPeople(ctx context.Context, projectID string) ([]api.Person, error) {
+	c.peopleCalls++
+	return c.people, nil
+}
+
+func TestMe_CacheHitAndMiss(t *testing.T) {
+	useTempStore(t)
+	start := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+	setNow(start)
+	client := &countingClient{profile: api.Person{ID: 7, Name: "Jane Doe", EmailAddress: "jane@example.com"}}
+
+	me, err := Me(context.Background(), client, "1", false)
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), me.PersonID)
+	assert.Equal(t, "jane@example.com", me.Email)
+	assert.Equal(t, 1, client.profileCalls, "a cold cache fetches the profile")
+
+	setNow(start.Add(TTL - time.Minute))
+	me, err = Me(context.Background(), client, "1", false)
+	require.NoError(t, err)
+	assert.Equal(t, "Jane Doe", me.Name)
+	assert.Equal(t, 1, client.profileCalls, "a fresh entry is served from the cache")
+
+	_, err = Me(context.Background(), client, "2", false)
+	require.NoError(t, err)
+	assert.Equal(t, 2, client.profileCalls, "each account is cached separately")
+
+	_, err = Me(context.Background(), client, "1", true)
+	require.NoError(t, err)
+	assert.Equal(t, 3, client.profileCalls, "refresh always fetches")
+
+	setNow(start.Add(TTL - time.Minute).Add(TTL))
+	_, err = Me(context.Background(), client, "1", false)
+	require.NoError(t, err)
+	assert.Equal(t, 4, client.profileCalls, "an entry older than the TTL is fetched again")
+}
+
+func TestMe_FetchError(t *testing.T) {
+	useTempStore(t)
+	client := &countingClient{profileErr: errors.New("boom")}
+
+	_, err := Me(context.Background(), client, "1", false)
+	assert.EqualError(t, err, "boom")
+}
+
+func TestProjectPersonID_Memoized(t *testing.T) {
+	useTempStore(t)
+	setNow(time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC))
+	client := &countingClient{
+		profile: api.Person{ID: 7, EmailAddress: "Jane@Example.com"},
+		people: []api.Person{
+			{ID: 5, EmailAddress: "bob@example.com"},
+			{ID: 7, EmailAddress: "jane@example.com"},
+		},
+	}
+
+	id, err := ProjectPersonID(context.Background(), client, "1", "100")
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), id, "matched by email, ignoring case")
+
+	id, err = ProjectPersonID(context.Background(), client, "1", "100")
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), id)
+	assert.Equal(t, 1, client.peopleCalls, "the project lookup is remembered")
+	assert.Equal(t, 1, client.profileCalls)
+
+	client.people = []api.Person{{ID: 5, EmailAddress: "bob@example.com"}}
+	_, err = ProjectPersonID(context.Background(), client, "1", "200")
+	assert.ErrorContains(t, err, "not a member of project 200")
+}
+
+func TestClear(t *testing.T) {
+	useTempStore(t)
+	client := &countingClient{profile: api.Person{ID: 7}}
+
+	_, err := Me(context.Background(), client, "1", false)
+	require.NoError(t, err)
+	_, err = Me(context.Background(), client, "2", false)
+	require.NoError(t, err)
+
+	require.NoError(t, Clear("1"))
+	_, err = Me(context.Background(), client, "1", false)
+	require.NoError(t, err)
+	_, err = Me(context.Background(), client, "2", false)
+	require.NoError(t, err)
+	assert.Equal(t, 3, client.profileCalls, "only the cleared account is fetched again")
+
+	require.NoError(t, Clear(""))
+	_, err = Me(context.Background(), client, "2", false)
+	require.NoError(t, err)
+	assert.Equal(t, 4, client.profileCalls, "clearing every account empties the cache")
+
+	assert.NoError(t, Clear(""), "clearing an empty cache is fine")
+}
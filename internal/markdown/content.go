@@ -0,0 +1,96 @@
+package markdown
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+)
+
+// ContentFormat selects how rich text bodies are rendered in machine-readable
+// output
+type ContentFormat string
+
+const (
+	// ContentHTML leaves Basecamp's rich text HTML as-is
+	ContentHTML ContentFormat = "html"
+	// ContentMarkdown converts rich text to Markdown
+	ContentMarkdown ContentFormat = "markdown"
+	// ContentText strips rich text down to plain text
+	ContentText ContentFormat = "text"
+)
+
+// ParseContentFormat parses a --content-as value
+func ParseContentFormat(s string) (ContentFormat, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "", "html":
+		return ContentHTML, nil
+	case "markdown", "md":
+		return ContentMarkdown, nil
+	case "text", "plain":
+		return ContentText, nil
+	default:
+		return "", fmt.Errorf("invalid content format %q: must be html, markdown, or text", s)
+	}
+}
+
+// ConvertContent renders rich text in the given format
+func ConvertContent(c Converter, richtext string, format ContentFormat) (string, error) {
+	switch format {
+	case ContentMarkdown:
+		return c.RichTextToMarkdown(richtext)
+	case ContentText:
+		return PlainText(richtext), nil
+	default:
+		return richtext, nil
+	}
+}
+
+var (
+	blockBreakRe = regexp.MustCompile(`(?i)<br\s*/?>|</(p|div|li|h[1-6]|blockquote|figure)>`)
+	listItemRe   = regexp.MustCompile(`(?i)<li[^>]*>`)
+	linkRe       = regexp.MustCompile(`(?is)<a\s[^>]*href="([^"]+)"[^>]*>(.*?)</a>`)
+	preRe        = regexp.MustCompile(`(?i)</?pre[^>]*>`)
+	tagRe        = regexp.MustCompile(`<[^>]*>`)
+	blankLinesRe = regexp.MustCompile(`\n{3,}`)
+)
+
+// codeFence marks the start and end of a pre block in plain text
+const codeFence = "```"
+
+// PlainText strips rich text HTML to plain text, keeping line breaks between
+// blocks. List items start with "• ", links are written as "text (url)", and
+// pre blocks are fenced with ``` and keep their indentation.
+func PlainText(richtext string) string {
+	text := listItemRe.ReplaceAllString(richtext, "• ")
+	text = linkRe.ReplaceAllStringFunc(text, func(link string) string {
+		m := linkRe.FindStringSubmatch(link)
+		label := tagRe.ReplaceAllString(m[2], "")
+		if label == "" || label == m[1] {
+			return m[1]
+		}
+		return label + " (" + m[1] + ")"
+	})
+	text = preRe.ReplaceAllString(text, "\n"+codeFence+"\n")
+	text = blockBreakRe.ReplaceAllString(text, "\n")
+	text = tagRe.ReplaceAllString(text, "")
+	text = html.UnescapeString(text)
+
+	lines := strings.Split(text, "\n")
+	inCode := false
+	for i, line := range lines {
+		if strings.TrimSpace(line) == codeFence {
+			inCode = !inCode
+			lines[i] = codeFence
+			continue
+		}
+		if inCode {
+			lines[i] = strings.TrimRight(line, " \t")
+		} else {
+			lines[i] = strings.TrimSpace(line)
+		}
+	}
+	text = strings.Join(lines, "\n")
+
+	return strings.TrimSpace(blankLinesRe.ReplaceAllString(text, "\n\n"))
+}
@@ -0,0 +1,51 @@
+package markdown
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseContentFormat(t *testing.T) {
+	for input, want := range map[string]ContentFormat{
+		"":         ContentHTML,
+		"HTML":     ContentHTML,
+		"markdown": ContentMarkdown,
+		"md":       ContentMarkdown,
+		"text":     ContentText,
+	} {
+		got, err := ParseContentFormat(input)
+		require.NoError(t, err, input)
+		assert.Equal(t, want, got, input)
+	}
+
+	_, err := ParseContentFormat("pdf")
+	assert.Error(t, err)
+}
+
+func TestConvertContent(t *testing.T) {
+	c := NewConverter()
+	richtext := "<div>Ship <strong>v2</strong> &amp; tag it<br>Then announce</div><ul><li>Docs</li></ul>"
+
+	text, err := ConvertContent(c, richtext, ContentText)
+	require.NoError(t, err)
+	assert.Equal(t, "Ship v2 & tag it\nThen announce\n• Docs", text)
+
+	md, err := ConvertContent(c, richtext, ContentMarkdown)
+	require.NoError(t, err)
+	assert.Contains(t, md, "**v2**")
+
+	raw, err := ConvertContent(c, richtext, ContentHTML)
+	require.NoError(t, err)
+	assert.Equal(t, richtext, raw)
+}
+
+func TestPlainText_KeepsListsLinksAndCode(t *testing.T) {
+	richtext := `<div>Steps:</div><ul><li>Read the <a href="https://example.com/spec">spec</a></li><li>Run it</li></ul>` +
+		`<pre>go test ./...
+  -run TestX</pre><div>See https://example.com</div>`
+
+	assert.Equal(t, "Steps:\n• Read the spec (https://example.com/spec)\n• Run it\n\n"+
+		"```\ngo test ./...\n  -run TestX\n```\nSee https://example.com", PlainText(richtext))
+}
@@ -342,6 +342,29 @@ func ParseBasecampURL(inputURL string) (*ParsedURL, error) {
 	return nil, fmt.Errorf("unrecognized Basecamp URL pattern: %s", path)
 }
 
+// webPaths maps resource types to their path within a project on the web app
+var webPaths = map[ResourceType]string{
+	ResourceTypeTodo:      "todos",
+	ResourceTypeTodoList:  "todolists",
+	ResourceTypeCard:      "card_tables/cards",
+	ResourceTypeCardTable: "card_tables",
+	ResourceTypeCampfire:  "chats",
+	ResourceTypeMessage:   "messages",
+	ResourceTypeDocument:  "documents",
+	ResourceTypeSchedule:  "schedules",
+	ResourceTypeQuestion:  "questions",
+}
+
+// BuildWebURL returns the Basecamp web URL for a resource in a project, the
+// inverse of ParseBasecampURL
+func BuildWebURL(accountID, projectID string, resourceType ResourceType, id int64) (string, error) {
+	path, ok := webPaths[resourceType]
+	if !ok {
+		return "", fmt.Errorf("cannot build a web URL for %s resources", resourceType)
+	}
+	return fmt.Sprintf("https://3.basecamp.com/%s/buckets/%s/%s/%d", accountID, projectID, path, id), nil
+}
+
 // IsBasecampURL checks if a string looks like a Basecamp URL
 func IsBasecampURL(s string) bool {
 	return strings.Contains(s, "basecamp.com") || strings.Contains(s, "basecampapi.com")
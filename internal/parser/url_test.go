@@ -286,3 +286,23 @@ func TestParseArgument(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildWebURL(t *testing.T) {
+	for _, resourceType := range []ResourceType{ResourceTypeTodo, ResourceTypeCard, ResourceTypeCampfire, ResourceTypeMessage, ResourceTypeDocument} {
+		url, err := BuildWebURL("1234567", "89012345", resourceType, 42)
+		if err != nil {
+			t.Fatalf("BuildWebURL(%s) error = %v", resourceType, err)
+		}
+		parsed, err := ParseBasecampURL(url)
+		if err != nil {
+			t.Fatalf("ParseBasecampURL(%q) error = %v", url, err)
+		}
+		if parsed.ResourceType != resourceType || parsed.ResourceID != 42 || parsed.ProjectID != 89012345 || parsed.AccountID != 1234567 {
+			t.Errorf("round trip of %s gave %+v", url, parsed)
+		}
+	}
+
+	if _, err := BuildWebURL("1", "2", ResourceTypeStep, 3); err == nil {
+		t.Error("expected an error for a resource type without a web path")
+	}
+}
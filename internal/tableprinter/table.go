@@ -115,6 +115,12 @@ func measureWidth(s string) int {
 	return runewidth.StringWidth(stripAnsi(s))
 }
 
+// Truncate shortens s to at most maxWidth display columns, the same way
+// table fields are truncated. It accounts for wide characters and ANSI codes.
+func Truncate(maxWidth int, s string) string {
+	return defaultTruncate(maxWidth, s)
+}
+
 // defaultTruncate provides the default truncation behavior
 func defaultTruncate(maxWidth int, s string) string {
 	if measureWidth(s) <= maxWidth {
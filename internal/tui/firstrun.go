@@ -151,21 +151,7 @@ func (m FirstRunModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			// Allow ESC to skip project selection
 			if m.currentStep == stepSelectProject {
 				// Save config without project
-				cfg, _ := config.Load()
-				if cfg == nil {
-					cfg = &config.Config{
-						Accounts: make(map[string]config.AccountConfig),
-					}
-				}
-				// Ensure OAuth credentials are set from user input
-				if cfg.ClientID == "" {
-					cfg.ClientID = m.clientID.Value()
-				}
-				if cfg.ClientSecret == "" {
-					cfg.ClientSecret = m.clientSecret.Value()
-				}
-				cfg.DefaultAccount = m.selectedAccount
-				if err := config.Save(cfg); err != nil {
+				if err := m.saveSelection(""); err != nil {
 					m.err = fmt.Errorf("failed to save config: %w", err)
 					return m, nil
 				}
@@ -594,36 +580,11 @@ func (m FirstRunModel) handleEnter() (tea.Model, tea.Cmd) {
 		return m, nil
 
 	case stepSelectProject:
-		cfg, _ := config.Load()
-		if cfg == nil {
-			cfg = &config.Config{
-				Accounts: make(map[string]config.AccountConfig),
-			}
-		}
-		// Ensure OAuth credentials are set from user input
-		if cfg.ClientID == "" {
-			cfg.ClientID = m.clientID.Value()
-		}
-		if cfg.ClientSecret == "" {
-			cfg.ClientSecret = m.clientSecret.Value()
-		}
-
-		// Save default account
-		cfg.DefaultAccount = m.selectedAccount
-
-		// Save selected project if any
+		var projectID string
 		if selected, ok := m.projectList.SelectedItem().(projectItem); ok {
-			cfg.DefaultProject = selected.id
-			if cfg.Accounts == nil {
-				cfg.Accounts = make(map[string]config.AccountConfig)
-			}
-			cfg.Accounts[m.selectedAccount] = config.AccountConfig{
-				Name:           m.accounts[m.selectedAccount].AccountName,
-				DefaultProject: selected.id,
-			}
+			projectID = selected.id
 		}
-
-		if err := config.Save(cfg); err != nil {
+		if err := m.saveSelection(projectID); err != nil {
 			m.err = fmt.Errorf("failed to save config: %w", err)
 			return m, nil
 		}
@@ -661,8 +622,16 @@ func (m *FirstRunModel) loadProjects(accountID string) tea.Cmd {
 			return projectsLoadedMsg{err: err}
 		}
 
-		// Create modular API client
-		apiClient := api.NewModularClient(accountID, token.AccessToken)
+		// Create modular API client, honoring a custom API base URL
+		var baseURL string
+		if cfg, _ := config.Load(); cfg != nil {
+			if raw := cfg.EffectiveAPIBaseURL(); raw != "" {
+				if baseURL, _, err = api.ParseBaseURL(raw); err != nil {
+					return projectsLoadedMsg{err: err}
+				}
+			}
+		}
+		apiClient := api.NewModularClientWithBaseURL(accountID, token.AccessToken, baseURL)
 		projectOps := apiClient.Projects()
 
 		// Fetch projects
@@ -733,3 +702,33 @@ func (d itemDelegate) Render(w io.Writer, m list.Model, index int, listItem list
 		_, _ = fmt.Fprint(w, normalItemStyle.Render(str))
 	}
 }
+
+// saveSelection saves the OAuth credentials entered and the chosen default
+// account, along with the default project when projectID is set
+func (m FirstRunModel) saveSelection(projectID string) error {
+	return config.Update(func(cfg *config.Config) error {
+		// Ensure OAuth credentials are set from user input
+		if cfg.ClientID == "" {
+			cfg.ClientID = m.clientID.Value()
+		}
+		if cfg.ClientSecret == "" {
+			cfg.ClientSecret = m.clientSecret.Value()
+		}
+
+		// Save default account
+		cfg.DefaultAccount = m.selectedAccount
+
+		// Save selected project if any
+		if projectID != "" {
+			cfg.DefaultProject = projectID
+			if cfg.Accounts == nil {
+				cfg.Accounts = make(map[string]config.AccountConfig)
+			}
+			cfg.Accounts[m.selectedAccount] = config.AccountConfig{
+				Name:           m.accounts[m.selectedAccount].AccountName,
+				DefaultProject: projectID,
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,82 @@
+package ui
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// ErrClipboardUnavailable is returned by CopyToClipboard when no clipboard
+// tool is installed
+var ErrClipboardUnavailable = errors.New("clipboard unavailable: install pbcopy, wl-copy, xclip, or xsel")
+
+// clipboardWaitDelay is how long to wait for a clipboard tool's stderr to
+// close after it exits
+const clipboardWaitDelay = 500 * time.Millisecond
+
+// clipboardTool is a command that copies its stdin to the clipboard
+type clipboardTool struct {
+	name string
+	args []string
+}
+
+// CopyToClipboard copies text to the system clipboard using the platform's
+// clipboard tool: pbcopy on macOS, clip.exe on Windows and WSL, and wl-copy,
+// xclip, or xsel elsewhere. It returns ErrClipboardUnavailable when none is
+// found.
+func CopyToClipboard(text string) error {
+	tool, ok := findClipboardTool(runtime.GOOS, exec.LookPath, os.Getenv)
+	if !ok {
+		return ErrClipboardUnavailable
+	}
+
+	// xclip, xsel, and wl-copy leave a child running to serve the selection,
+	// and it inherits any output pipes. Stdout goes to /dev/null and stderr
+	// is only waited on for a moment after the tool exits, so that child
+	// can't hold up Wait.
+	var stderr bytes.Buffer
+	cmd := exec.Command(tool.name, tool.args...)
+	cmd.Stdin = strings.NewReader(text)
+	cmd.Stderr = &stderr
+	cmd.WaitDelay = clipboardWaitDelay
+	if err := cmd.Run(); err != nil && !errors.Is(err, exec.ErrWaitDelay) {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return fmt.Errorf("failed to copy to clipboard: %s: %w", msg, err)
+		}
+		return fmt.Errorf("failed to copy to clipboard: %w", err)
+	}
+	return nil
+}
+
+// findClipboardTool picks the first clipboard tool available on goos
+func findClipboardTool(goos string, lookPath func(string) (string, error), getenv func(string) string) (clipboardTool, bool) {
+	var candidates []clipboardTool
+	switch goos {
+	case "darwin":
+		candidates = []clipboardTool{{name: "pbcopy"}}
+	case "windows":
+		candidates = []clipboardTool{{name: "clip.exe"}}
+	default:
+		if getenv("WAYLAND_DISPLAY") != "" {
+			candidates = append(candidates, clipboardTool{name: "wl-copy"})
+		}
+		candidates = append(candidates,
+			clipboardTool{name: "xclip", args: []string{"-selection", "clipboard"}},
+			clipboardTool{name: "xsel", args: []string{"--clipboard", "--input"}},
+			// WSL can reach the Windows clipboard
+			clipboardTool{name: "clip.exe"},
+		)
+	}
+
+	for _, tool := range candidates {
+		if _, err := lookPath(tool.name); err == nil {
+			return tool, true
+		}
+	}
+	return clipboardTool{}, false
+}
@@ -0,0 +1,73 @@
+package ui
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindClipboardTool(t *testing.T) {
+	installed := func(names ...string) func(string) (string, error) {
+		return func(name string) (string, error) {
+			for _, n := range names {
+				if n == name {
+					return "/usr/bin/" + name, nil
+				}
+			}
+			return "", errors.New("not found")
+		}
+	}
+	env := func(vars map[string]string) func(string) string {
+		return func(key string) string { return vars[key] }
+	}
+	noEnv := env(nil)
+
+	tests := []struct {
+		name     string
+		goos     string
+		lookPath func(string) (string, error)
+		getenv   func(string) string
+		want     string
+		wantArgs []string
+	}{
+		{name: "macOS", goos: "darwin", lookPath: installed("pbcopy"), getenv: noEnv, want: "pbcopy"},
+		{name: "windows", goos: "windows", lookPath: installed("clip.exe"), getenv: noEnv, want: "clip.exe"},
+		{name: "wayland", goos: "linux", lookPath: installed("wl-copy", "xclip"), getenv: env(map[string]string{"WAYLAND_DISPLAY": "wayland-0"}), want: "wl-copy"},
+		{name: "x11 ignores wl-copy", goos: "linux", lookPath: installed("wl-copy", "xclip"), getenv: noEnv, want: "xclip", wantArgs: []string{"-selection", "clipboard"}},
+		{name: "xsel fallback", goos: "linux", lookPath: installed("xsel"), getenv: noEnv, want: "xsel", wantArgs: []string{"--clipboard", "--input"}},
+		{name: "wsl", goos: "linux", lookPath: installed("clip.exe"), getenv: noEnv, want: "clip.exe"},
+		{name: "none", goos: "linux", lookPath: installed(), getenv: noEnv},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tool, ok := findClipboardTool(tt.goos, tt.lookPath, tt.getenv)
+			assert.Equal(t, tt.want != "", ok)
+			assert.Equal(t, tt.want, tool.name)
+			assert.Equal(t, tt.wantArgs, tool.args)
+		})
+	}
+}
+
+func TestCopyToClipboard_DoesNotWaitForSelectionOwner(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("uses a shell script in place of xclip")
+	}
+
+	// Like xclip, leave a child behind holding stdout and stderr
+	dir := t.TempDir()
+	script := "#!/bin/sh\nexec 0<&-; /bin/sleep 10 &\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "xclip"), []byte(script), 0o755))
+	t.Setenv("PATH", dir)
+	t.Setenv("WAYLAND_DISPLAY", "")
+
+	start := time.Now()
+	require.NoError(t, CopyToClipboard("hello"))
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
@@ -0,0 +1,111 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// JSONFieldNames returns the JSON field names of a struct type, in declaration
+// order. Fields tagged with "-" are skipped.
+func JSONFieldNames(v interface{}) []string {
+	t := reflect.TypeOf(v)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+
+	names := make([]string, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+// ParseJSONFields parses a comma-separated field list and validates each name
+// against the allowed set. An empty spec returns nil, meaning all fields.
+func ParseJSONFields(spec string, valid []string) ([]string, error) {
+	if strings.TrimSpace(spec) == "" {
+		return nil, nil
+	}
+
+	allowed := make(map[string]bool, len(valid))
+	for _, name := range valid {
+		allowed[name] = true
+	}
+
+	var fields []string
+	var unknown []string
+	for _, name := range strings.Split(spec, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if !allowed[name] {
+			unknown = append(unknown, name)
+			continue
+		}
+		fields = append(fields, name)
+	}
+
+	if len(unknown) > 0 {
+		return nil, fmt.Errorf("unknown field(s): %s\n\nAvailable fields:\n  %s",
+			strings.Join(unknown, ", "), strings.Join(valid, "\n  "))
+	}
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("no fields specified")
+	}
+	return fields, nil
+}
+
+// FilterJSONFields converts v to a JSON object containing only the given
+// fields. A nil field list returns the full object.
+func FilterJSONFields(v interface{}, fields []string) (map[string]interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode JSON: %w", err)
+	}
+
+	var full map[string]interface{}
+	if err := json.Unmarshal(data, &full); err != nil {
+		return nil, fmt.Errorf("failed to decode JSON: %w", err)
+	}
+
+	if fields == nil {
+		return full, nil
+	}
+
+	filtered := make(map[string]interface{}, len(fields))
+	for _, name := range fields {
+		// Fields omitted via omitempty are reported as null
+		filtered[name] = full[name]
+	}
+	return filtered, nil
+}
+
+// FilterJSONFieldsSlice applies FilterJSONFields to each element of items
+func FilterJSONFieldsSlice[T any](items []T, fields []string) ([]map[string]interface{}, error) {
+	result := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		filtered, err := FilterJSONFields(item, fields)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, filtered)
+	}
+	return result, nil
+}
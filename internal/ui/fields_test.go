@@ -0,0 +1,85 @@
+package ui
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fieldsTestRecord struct {
+	ID      int64  `json:"id"`
+	Title   string `json:"title"`
+	Email   string `json:"email,omitempty"`
+	Secret  string `json:"-"`
+	private string
+}
+
+func TestJSONFieldNames(t *testing.T) {
+	assert.Equal(t, []string{"id", "title", "email"}, JSONFieldNames(fieldsTestRecord{}))
+	assert.Equal(t, []string{"id", "title", "email"}, JSONFieldNames(&fieldsTestRecord{}))
+	assert.Nil(t, JSONFieldNames("not a struct"))
+}
+
+func TestParseJSONFields(t *testing.T) {
+	valid := JSONFieldNames(fieldsTestRecord{})
+
+	t.Run("empty means all fields", func(t *testing.T) {
+		fields, err := ParseJSONFields("", valid)
+		require.NoError(t, err)
+		assert.Nil(t, fields)
+	})
+
+	t.Run("keeps requested order", func(t *testing.T) {
+		fields, err := ParseJSONFields("title, id", valid)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"title", "id"}, fields)
+	})
+
+	t.Run("unknown field lists valid set", func(t *testing.T) {
+		_, err := ParseJSONFields("id,secret", valid)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "secret")
+		assert.Contains(t, err.Error(), "email")
+	})
+
+	t.Run("only separators", func(t *testing.T) {
+		_, err := ParseJSONFields(" , ", valid)
+		assert.Error(t, err)
+	})
+}
+
+func TestFilterJSONFields(t *testing.T) {
+	record := fieldsTestRecord{ID: 7, Title: "Hello"}
+
+	full, err := FilterJSONFields(record, nil)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"id": float64(7), "title": "Hello"}, full)
+
+	filtered, err := FilterJSONFields(record, []string{"title", "email"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"title": "Hello", "email": nil}, filtered)
+}
+
+func TestWriteJSONLines(t *testing.T) {
+	records := []fieldsTestRecord{{ID: 1, Title: "One"}, {ID: 2, Title: "Two", Email: "a@b.c"}}
+
+	t.Run("one object per line", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, WriteJSONLines(&buf, records, nil))
+		assert.Equal(t, "{\"id\":1,\"title\":\"One\"}\n{\"id\":2,\"title\":\"Two\",\"email\":\"a@b.c\"}\n", buf.String())
+	})
+
+	t.Run("with field filtering", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, WriteJSONLines(&buf, records, []string{"id"}))
+		assert.Equal(t, "{\"id\":1}\n{\"id\":2}\n", buf.String())
+	})
+
+	t.Run("empty input writes nothing", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, WriteJSONLines(&buf, []fieldsTestRecord{}, nil))
+		assert.Empty(t, buf.String())
+	})
+}
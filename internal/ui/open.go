@@ -0,0 +1,9 @@
+package ui
+
+import "github.com/pkg/browser"
+
+// OpenURL opens a URL, or a local file path, with the system's default
+// application
+func OpenURL(target string) error {
+	return browser.OpenURL(target)
+}
@@ -1,6 +1,8 @@
 package ui
 
 import (
+	"encoding/csv"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
@@ -19,6 +21,8 @@ const (
 	OutputFormatJSON OutputFormat = "json"
 	// OutputFormatCSV renders as comma-separated values
 	OutputFormatCSV OutputFormat = "csv"
+	// OutputFormatJSONL renders as newline-delimited JSON, one record per line
+	OutputFormatJSONL OutputFormat = "jsonl"
 )
 
 // ParseOutputFormat parses a string into an OutputFormat
@@ -30,11 +34,95 @@ func ParseOutputFormat(s string) (OutputFormat, error) {
 		return OutputFormatJSON, nil
 	case "csv":
 		return OutputFormatCSV, nil
+	case "jsonl", "ndjson", "json-lines":
+		return OutputFormatJSONL, nil
 	default:
 		return "", fmt.Errorf("unknown output format: %s", s)
 	}
 }
 
+// ResolveFormat parses s for a command that only renders the supported
+// formats, rejecting every other known format with an error naming them
+func ResolveFormat(s string, supported ...OutputFormat) (OutputFormat, error) {
+	format, err := ParseOutputFormat(s)
+	if err != nil {
+		return "", err
+	}
+	for _, f := range supported {
+		if format == f {
+			return format, nil
+		}
+	}
+
+	names := make([]string, len(supported))
+	for i, f := range supported {
+		names[i] = string(f)
+	}
+	use := strings.Join(names, " or ")
+	if len(names) > 2 {
+		use = strings.Join(names[:len(names)-1], ", ") + ", or " + names[len(names)-1]
+	}
+	return "", fmt.Errorf("unsupported output format: %s (use %s)", s, use)
+}
+
+// ResolveTableOrJSON parses s for commands that only render a table or JSON,
+// rejecting every other known format
+func ResolveTableOrJSON(s string) (OutputFormat, error) {
+	return ResolveFormat(s, OutputFormatTable, OutputFormatJSON)
+}
+
+// WriteJSONLines writes each item as a standalone JSON object followed by a
+// newline. When fields is non-nil, each object only contains those fields
+// (see ParseJSONFields).
+func WriteJSONLines[T any](w io.Writer, items []T, fields []string) error {
+	encoder := json.NewEncoder(w)
+	for _, item := range items {
+		var record interface{} = item
+		if fields != nil {
+			filtered, err := FilterJSONFields(item, fields)
+			if err != nil {
+				return err
+			}
+			record = filtered
+		}
+		if err := encoder.Encode(record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteJSON writes v as indented JSON
+func WriteJSON(w io.Writer, v interface{}) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
+
+// WriteCSV writes a header row followed by rows as CSV
+func WriteCSV(w io.Writer, header []string, rows [][]string) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+	if err := writer.WriteAll(rows); err != nil {
+		return err
+	}
+	return writer.Error()
+}
+
+// WriteIDs writes each ID on its own line with no other decoration, for
+// --print-ids-only output that is piped into other commands. Nothing is
+// written when there are no IDs.
+func WriteIDs(w io.Writer, ids []int64) error {
+	for _, id := range ids {
+		if _, err := fmt.Fprintln(w, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // IsTerminal returns true if the given writer is a terminal
 func IsTerminal(w io.Writer) bool {
 	if f, ok := w.(*os.File); ok {
@@ -0,0 +1,47 @@
+package ui
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteIDs(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteIDs(&buf, []int64{12, 3456}))
+	assert.Equal(t, "12\n3456\n", buf.String())
+
+	buf.Reset()
+	require.NoError(t, WriteIDs(&buf, nil))
+	assert.Empty(t, buf.String())
+}
+
+func TestResolveTableOrJSON(t *testing.T) {
+	format, err := ResolveTableOrJSON("")
+	require.NoError(t, err)
+	assert.Equal(t, OutputFormatTable, format)
+
+	format, err = ResolveTableOrJSON("JSON")
+	require.NoError(t, err)
+	assert.Equal(t, OutputFormatJSON, format)
+
+	_, err = ResolveTableOrJSON("csv")
+	assert.EqualError(t, err, "unsupported output format: csv (use table or json)")
+
+	_, err = ResolveTableOrJSON("xml")
+	assert.EqualError(t, err, "unknown output format: xml")
+}
+
+func TestResolveFormat(t *testing.T) {
+	format, err := ResolveFormat("csv", OutputFormatTable, OutputFormatJSON, OutputFormatCSV)
+	require.NoError(t, err)
+	assert.Equal(t, OutputFormatCSV, format)
+
+	_, err = ResolveFormat("jsonl", OutputFormatTable, OutputFormatJSON, OutputFormatCSV)
+	assert.EqualError(t, err, "unsupported output format: jsonl (use table, json, or csv)")
+
+	_, err = ResolveFormat("json", OutputFormatTable)
+	assert.EqualError(t, err, "unsupported output format: json (use table)")
+}
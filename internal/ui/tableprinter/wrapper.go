@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/needmore/bc4/internal/tableprinter"
+	"github.com/needmore/bc4/internal/ui"
 )
 
 // TablePrinter provides bc4-specific table functionality wrapping the core tableprinter
@@ -64,7 +65,7 @@ func (t *TablePrinter) AddTimeField(now, timestamp time.Time) {
 
 	if t.isTTY {
 		// Human-readable relative time for TTY
-		timeStr = formatRelativeTime(now, timestamp)
+		timeStr = ui.HumanTime(now, timestamp)
 	} else {
 		// RFC3339 format for non-TTY (machine readable)
 		timeStr = timestamp.Format(time.RFC3339)
@@ -114,20 +115,23 @@ func (t *TablePrinter) AddTodoField(title string, completed bool) {
 	t.core.AddField(title, tableprinter.WithColor(colorFunc))
 }
 
+// StatusSymbol returns the check mark used for a completed item, or the
+// open circle used for an incomplete one
+func StatusSymbol(completed bool) string {
+	if completed {
+		return "✓"
+	}
+	return "○"
+}
+
 // AddStatusField adds a status symbol field (like GitHub CLI's check marks)
 func (t *TablePrinter) AddStatusField(completed bool) {
-	var symbol string
-	var colorFunc func(string) string
-
+	colorFunc := t.cs.Gray
 	if completed {
-		symbol = "✓"
 		colorFunc = t.cs.Green
-	} else {
-		symbol = "○"
-		colorFunc = t.cs.Gray
 	}
 
-	t.core.AddField(symbol, tableprinter.WithColor(colorFunc))
+	t.core.AddField(StatusSymbol(completed), tableprinter.WithColor(colorFunc))
 }
 
 // EndRow completes the current row
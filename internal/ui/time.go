@@ -0,0 +1,146 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Date format presets accepted by ParseDateFormat
+const (
+	// DateFormatShort is "Jan 2", with the year added when it isn't the
+	// current year
+	DateFormatShort = "short"
+	// DateFormatISO is "2006-01-02"
+	DateFormatISO = "iso"
+	// DateFormatRelative is "today", "in 3 days", "2 days ago" and so on
+	DateFormatRelative = "relative"
+)
+
+// DateFormat controls how calendar dates, such as due dates, are shown.
+// The zero value is the short preset.
+type DateFormat struct {
+	layout   string
+	relative bool
+}
+
+// ParseDateFormat parses a date format: one of the presets short, iso, or
+// relative, or a Go time layout such as "Mon Jan 2 2006". An empty value is
+// the short preset.
+func ParseDateFormat(s string) (DateFormat, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "", DateFormatShort:
+		return DateFormat{}, nil
+	case DateFormatISO:
+		return DateFormat{layout: "2006-01-02"}, nil
+	case DateFormatRelative:
+		return DateFormat{relative: true}, nil
+	}
+
+	// A layout with no layout elements would print the same text for every
+	// date, which is almost certainly a typo for a preset
+	sample := time.Date(2001, time.March, 4, 5, 6, 7, 0, time.UTC)
+	if sample.Format(s) == s {
+		return DateFormat{}, fmt.Errorf("invalid date format %q: must be short, iso, relative, or a Go time layout such as \"2006-01-02\"", s)
+	}
+	return DateFormat{layout: s}, nil
+}
+
+// Format formats date in this format. now is used for relative dates and to
+// decide whether the short format needs the year.
+func (f DateFormat) Format(now, date time.Time) string {
+	switch {
+	case f.relative:
+		return RelativeDate(now, date)
+	case f.layout != "":
+		return date.Format(f.layout)
+	case date.Year() != now.Year():
+		return date.Format("Jan 2, 2006")
+	default:
+		return date.Format("Jan 2")
+	}
+}
+
+// RelativeDate describes date in calendar days from now, e.g. "today",
+// "tomorrow", "in 3 days", or "2 weeks ago". Each is compared by its own
+// calendar date, so a time of day doesn't shift the count.
+func RelativeDate(now, date time.Time) string {
+	from := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	to := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	days := int(to.Sub(from).Hours() / 24)
+
+	switch {
+	case days == 0:
+		return "today"
+	case days == 1:
+		return "tomorrow"
+	case days == -1:
+		return "yesterday"
+	case days > 0:
+		return "in " + formatDuration(time.Duration(days)*24*time.Hour)
+	default:
+		return formatDuration(time.Duration(-days)*24*time.Hour) + " ago"
+	}
+}
+
+// HumanTime formats timestamp relative to now in a human-readable form,
+// e.g. "2 hours ago", following GitHub CLI's approach
+func HumanTime(now, timestamp time.Time) string {
+	duration := now.Sub(timestamp)
+
+	// Handle future timestamps
+	if duration < 0 {
+		duration = -duration
+		return "in " + formatDuration(duration)
+	}
+
+	return formatDuration(duration) + " ago"
+}
+
+// formatDuration formats a duration in human-readable form
+func formatDuration(d time.Duration) string {
+	seconds := int(d.Seconds())
+	minutes := int(d.Minutes())
+	hours := int(d.Hours())
+	days := int(d.Hours() / 24)
+	weeks := days / 7
+	months := days / 30
+	years := days / 365
+
+	switch {
+	case years > 0:
+		if years == 1 {
+			return "1 year"
+		}
+		return fmt.Sprintf("%d years", years)
+	case months > 0:
+		if months == 1 {
+			return "1 month"
+		}
+		return fmt.Sprintf("%d months", months)
+	case weeks > 0:
+		if weeks == 1 {
+			return "1 week"
+		}
+		return fmt.Sprintf("%d weeks", weeks)
+	case days > 0:
+		if days == 1 {
+			return "1 day"
+		}
+		return fmt.Sprintf("%d days", days)
+	case hours > 0:
+		if hours == 1 {
+			return "1 hour"
+		}
+		return fmt.Sprintf("%d hours", hours)
+	case minutes > 0:
+		if minutes == 1 {
+			return "1 minute"
+		}
+		return fmt.Sprintf("%d minutes", minutes)
+	case seconds > 5:
+		return fmt.Sprintf("%d seconds", seconds)
+	default:
+		return "just now"
+	}
+}
@@ -0,0 +1,84 @@
+package ui
+
+import (
+	"testing"
+	"time"
+)
+
+func TestHumanTime(t *testing.T) {
+	now := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		ago  time.Duration
+		want string
+	}{
+		{name: "seconds", ago: 30 * time.Second, want: "30 seconds ago"},
+		{name: "one minute", ago: time.Minute, want: "1 minute ago"},
+		{name: "minutes", ago: 59 * time.Minute, want: "59 minutes ago"},
+		{name: "one hour", ago: time.Hour, want: "1 hour ago"},
+		{name: "hours", ago: 23 * time.Hour, want: "23 hours ago"},
+		{name: "one day", ago: 24 * time.Hour, want: "1 day ago"},
+		{name: "days", ago: 6 * 24 * time.Hour, want: "6 days ago"},
+		{name: "one week", ago: 7 * 24 * time.Hour, want: "1 week ago"},
+		{name: "weeks", ago: 29 * 24 * time.Hour, want: "4 weeks ago"},
+		{name: "one month", ago: 30 * 24 * time.Hour, want: "1 month ago"},
+		{name: "one year", ago: 365 * 24 * time.Hour, want: "1 year ago"},
+		{name: "future", ago: -2 * time.Hour, want: "in 2 hours"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HumanTime(now, now.Add(-tt.ago)); got != tt.want {
+				t.Errorf("HumanTime() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDateFormat_AcrossYearBoundary(t *testing.T) {
+	lateDecember := time.Date(2026, 12, 30, 18, 0, 0, 0, time.UTC)
+	earlyJanuary := time.Date(2027, 1, 1, 9, 0, 0, 0, time.UTC)
+	nextYearDue := time.Date(2027, 1, 2, 0, 0, 0, 0, time.UTC)
+	lastYearDue := time.Date(2026, 12, 30, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		format string
+		now    time.Time
+		date   time.Time
+		want   string
+	}{
+		{name: "short this year", format: "short", now: lateDecember, date: lastYearDue, want: "Dec 30"},
+		{name: "short next year", format: "short", now: lateDecember, date: nextYearDue, want: "Jan 2, 2027"},
+		{name: "short last year", format: "", now: earlyJanuary, date: lastYearDue, want: "Dec 30, 2026"},
+		{name: "iso", format: "iso", now: lateDecember, date: nextYearDue, want: "2027-01-02"},
+		{name: "layout", format: "Mon 2 Jan 06", now: lateDecember, date: nextYearDue, want: "Sat 2 Jan 27"},
+		{name: "relative future", format: "relative", now: lateDecember, date: nextYearDue, want: "in 3 days"},
+		{name: "relative past", format: "RELATIVE", now: earlyJanuary, date: lastYearDue, want: "2 days ago"},
+		{name: "relative today", format: "relative", now: lateDecember, date: lastYearDue, want: "today"},
+		{name: "relative tomorrow", format: "relative", now: time.Date(2026, 12, 31, 23, 0, 0, 0, time.UTC), date: time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC), want: "tomorrow"},
+		{name: "relative yesterday", format: "relative", now: earlyJanuary, date: time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC), want: "yesterday"},
+		{name: "relative weeks", format: "relative", now: lateDecember, date: time.Date(2027, 1, 13, 0, 0, 0, 0, time.UTC), want: "in 2 weeks"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			format, err := ParseDateFormat(tt.format)
+			if err != nil {
+				t.Fatalf("ParseDateFormat(%q) error: %v", tt.format, err)
+			}
+			if got := format.Format(tt.now, tt.date); got != tt.want {
+				t.Errorf("Format() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseDateFormat_Invalid(t *testing.T) {
+	for _, s := range []string{"relatve", "dd/mm/yyyy"} {
+		if _, err := ParseDateFormat(s); err == nil {
+			t.Errorf("ParseDateFormat(%q) expected an error", s)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+// Package undo records the last reversible change bc4 made, so that
+// 'bc4 undo' can reverse it. Only the one most recent action is kept, with
+// just enough of the previous state to put things back.
+package undo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/needmore/bc4/internal/config"
+	"github.com/needmore/bc4/internal/utils"
+)
+
+// Hint is printed after a change that can be undone
+const Hint = "Undo with: bc4 undo"
+
+// Kind identifies a reversible action
+type Kind string
+
+const (
+	// KindCardMove is a card moved to another column or card table
+	KindCardMove Kind = "card_move"
+	// KindTodoMove is a todo moved to another list
+	KindTodoMove Kind = "todo_move"
+	// KindTodoComplete is a todo marked complete
+	KindTodoComplete Kind = "todo_complete"
+)
+
+// ErrNothingToUndo is returned by Last when no action has been recorded
+var ErrNothingToUndo = errors.New("nothing to undo")
+
+// Action is a recorded change and the state it replaced
+type Action struct {
+	Kind      Kind   `json:"kind"`
+	AccountID string `json:"account_id"`
+	ProjectID string `json:"project_id"`
+	// ID is the card or todo that changed
+	ID int64 `json:"id"`
+	// PreviousParentID is the column (or on-hold section) or todo list the
+	// item was in before a move
+	PreviousParentID int64 `json:"previous_parent_id,omitempty"`
+	// PreviousParentName is the name of PreviousParentID, for messages
+	PreviousParentName string `json:"previous_parent_name,omitempty"`
+	// PreviousTableID is the card table a card was on before moving to
+	// another board
+	PreviousTableID int64 `json:"previous_table_id,omitempty"`
+	// Description says what was done, e.g. "Moved card #1 to Done"
+	Description string    `json:"description"`
+	RecordedAt  time.Time `json:"recorded_at"`
+}
+
+// storePath returns where the last action is kept
+var storePath = func() string {
+	return filepath.Join(config.GetConfigDir(), "last_action.json")
+}
+
+// Record saves action as the last action, replacing any earlier one
+func Record(action Action) error {
+	if action.RecordedAt.IsZero() {
+		action.RecordedAt = time.Now()
+	}
+	data, err := json.MarshalIndent(action, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode last action: %w", err)
+	}
+
+	path := storePath()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".last_action-*.json.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to record last action: %w", err)
+	}
+	tmpPath := tmp.Name()
+	_, writeErr := tmp.Write(append(data, '\n'))
+	closeErr := tmp.Close()
+	if err := errors.Join(writeErr, closeErr); err != nil {
+		_ = os.Remove(tmpPath)
+		return fmt.Errorf("failed to record last action: %w", err)
+	}
+	if err := utils.AtomicRename(tmpPath, path); err != nil {
+		_ = os.Remove(tmpPath)
+		return fmt.Errorf("failed to record last action: %w", err)
+	}
+	return nil
+}
+
+// RecordWithHint records action and prints the undo hint to stderr. A failure
+// to record is only a warning, since the change itself has been made.
+func RecordWithHint(action Action) {
+	if err := Record(action); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+		return
+	}
+	fmt.Fprintln(os.Stderr, Hint)
+}
+
+// Last returns the last recorded action, or ErrNothingToUndo
+func Last() (*Action, error) {
+	data, err := os.ReadFile(storePath())
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNothingToUndo
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read last action: %w", err)
+	}
+
+	var action Action
+	if err := json.Unmarshal(data, &action); err != nil {
+		return nil, fmt.Errorf("failed to decode last action: %w", err)
+	}
+	return &action, nil
+}
+
+// Clear forgets the last action once it has been undone
+func Clear() error {
+	if err := os.Remove(storePath()); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to clear last action: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package undo
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func useTempStore(t *testing.T) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "bc4", "last_action.json")
+	original := storePath
+	storePath = func() string { return path }
+	t.Cleanup(func() { storePath = original })
+}
+
+func TestRecordAndLast(t *testing.T) {
+	useTempStore(t)
+
+	_, err := Last()
+	assert.ErrorIs(t, err, ErrNothingToUndo)
+
+	first := Action{Kind: KindTodoComplete, AccountID: "1", ProjectID: "2", ID: 3, Description: "Completed todo #3"}
+	require.NoError(t, Record(first))
+
+	second := Action{
+		Kind:               KindCardMove,
+		AccountID:          "1",
+		ProjectID:          "2",
+		ID:                 4,
+		PreviousParentID:   50,
+		PreviousParentName: "Doing",
+		RecordedAt:         time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC),
+	}
+	require.NoError(t, Record(second))
+
+	last, err := Last()
+	require.NoError(t, err)
+	assert.Equal(t, second, *last, "only the most recent action is kept")
+
+	require.NoError(t, Clear())
+	_, err = Last()
+	assert.ErrorIs(t, err, ErrNothingToUndo)
+
+	assert.NoError(t, Clear(), "clearing twice is fine")
+}
+
+func TestRecordSetsTime(t *testing.T) {
+	useTempStore(t)
+
+	require.NoError(t, Record(Action{Kind: KindTodoComplete, ID: 1}))
+	last, err := Last()
+	require.NoError(t, err)
+	assert.False(t, last.RecordedAt.IsZero())
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/attachments"
 	"github.com/needmore/bc4/internal/markdown"
 )
 
@@ -104,6 +105,8 @@ func FormatCardAsMarkdown(card *api.Card, comments []api.Comment) (string, error
 		}
 	}
 
+	writeAttachmentLinks(&buf, card.Content)
+
 	// Comments
 	if len(comments) > 0 {
 		fmt.Fprintf(&buf, "\n## Comments (%d)\n", len(comments))
@@ -124,6 +127,27 @@ func FormatCardAsMarkdown(card *api.Card, comments []api.Comment) (string, error
 	return buf.String(), nil
 }
 
+// writeAttachmentLinks lists the attachments in rich text content as
+// Markdown links, under an Attachments heading
+func writeAttachmentLinks(buf *strings.Builder, content string) {
+	atts := attachments.ParseAttachments(content)
+	if len(atts) == 0 {
+		return
+	}
+	fmt.Fprintf(buf, "\n## Attachments (%d)\n\n", len(atts))
+	for _, att := range atts {
+		link := att.Href
+		if link == "" {
+			link = att.URL
+		}
+		if link == "" {
+			fmt.Fprintf(buf, "- %s\n", att.GetDisplayName())
+			continue
+		}
+		fmt.Fprintf(buf, "- [%s](%s)\n", att.GetDisplayName(), link)
+	}
+}
+
 // FormatTodoAsMarkdown formats a todo with all its comments as AI-optimized markdown
 func FormatTodoAsMarkdown(todo *api.Todo, comments []api.Comment) (string, error) {
 	var buf strings.Builder
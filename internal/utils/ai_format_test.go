@@ -0,0 +1,45 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatCardAsMarkdown(t *testing.T) {
+	due := "2026-03-20"
+	card := &api.Card{
+		ID:        7,
+		Title:     "Redesign login",
+		Content:   `<div>See the spec.</div><bc-attachment sgid="a1" content-type="application/pdf" filename="spec.pdf" href="https://example.com/spec.pdf"></bc-attachment>`,
+		Parent:    &api.Column{Title: "In Progress"},
+		Assignees: []api.Person{{Name: "Jane Doe"}},
+		DueOn:     &due,
+		Creator:   &api.Person{Name: "Sam Lee"},
+		Steps: []api.Step{
+			{Title: "Wireframes", Completed: true},
+			{Title: "Build"},
+		},
+	}
+
+	doc, err := FormatCardAsMarkdown(card, nil)
+	require.NoError(t, err)
+
+	assert.Contains(t, doc, "# Redesign login\n")
+	assert.Contains(t, doc, "- **Column:** In Progress\n")
+	assert.Contains(t, doc, "- **Assignees:** Jane Doe\n")
+	assert.Contains(t, doc, "- **Due:** 2026-03-20\n")
+	assert.Contains(t, doc, "- **Created by:** Sam Lee\n")
+	assert.Contains(t, doc, "See the spec.")
+	assert.Contains(t, doc, "- [x] Wireframes\n- [ ] Build\n")
+	assert.Contains(t, doc, "## Attachments (1)\n\n- [spec.pdf](https://example.com/spec.pdf)\n")
+	assert.NotContains(t, doc, "## Comments")
+}
+
+func TestFormatCardAsMarkdownWithoutAttachments(t *testing.T) {
+	doc, err := FormatCardAsMarkdown(&api.Card{ID: 1, Title: "Plain", Content: "<div>Hi</div>"}, nil)
+	require.NoError(t, err)
+	assert.NotContains(t, doc, "## Attachments")
+}
@@ -0,0 +1,142 @@
+package utils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/needmore/bc4/internal/api"
+)
+
+// CheckinSchedules are the frequencies a check-in question can be asked at
+var CheckinSchedules = []string{"every_day", "every_week", "every_other_week", "every_four_weeks"}
+
+// ValidateCheckinSchedule checks that schedule is one of CheckinSchedules
+func ValidateCheckinSchedule(schedule string) error {
+	for _, s := range CheckinSchedules {
+		if schedule == s {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid schedule: %s; must be one of: %s", schedule, strings.Join(CheckinSchedules, ", "))
+}
+
+// checkinDays are the short names of the check-in days, indexed by the day
+// numbers Basecamp uses
+var checkinDays = [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"}
+
+// checkinDayNames maps lowercase day names and their abbreviations to
+// check-in day numbers
+var checkinDayNames = func() map[string]int {
+	names := map[string]int{
+		"sunday": 0, "monday": 1, "tues": 2, "tuesday": 2, "wednesday": 3,
+		"thur": 4, "thurs": 4, "thursday": 4, "friday": 5, "saturday": 6,
+	}
+	for day, name := range checkinDays {
+		names[strings.ToLower(name)] = day
+	}
+	return names
+}()
+
+// CheckinDayName returns the short name of a check-in day ("Mon"), or the
+// number itself when it is out of range
+func CheckinDayName(day int) string {
+	if day < 0 || day >= len(checkinDays) {
+		return strconv.Itoa(day)
+	}
+	return checkinDays[day]
+}
+
+// ParseCheckinDays parses a comma-separated list of check-in days, given
+// either as names ("mon", "Friday") or as numbers 0 (Sunday) through 6
+// (Saturday). Repeated days are only included once.
+func ParseCheckinDays(daysStr string) ([]int, error) {
+	if daysStr == "" {
+		return nil, nil
+	}
+
+	parts := strings.Split(daysStr, ",")
+	days := make([]int, 0, len(parts))
+	seen := make(map[int]bool, len(parts))
+
+	for _, p := range parts {
+		p = strings.ToLower(strings.TrimSpace(p))
+		if p == "" {
+			continue
+		}
+
+		day, ok := checkinDayNames[p]
+		if !ok {
+			n, err := strconv.Atoi(p)
+			if err != nil {
+				return nil, fmt.Errorf("invalid day: %s; use a day name like mon or a number 0-6", p)
+			}
+			if n < 0 || n > 6 {
+				return nil, fmt.Errorf("day must be between 0 (Sunday) and 6 (Saturday): got %d", n)
+			}
+			day = n
+		}
+		if !seen[day] {
+			seen[day] = true
+			days = append(days, day)
+		}
+	}
+
+	return days, nil
+}
+
+// ParseTimeOfDay parses a 24-hour HH:MM time into its hour and minute
+func ParseTimeOfDay(s string) (hour, minute int, err error) {
+	h, m, ok := strings.Cut(strings.TrimSpace(s), ":")
+	if !ok {
+		return 0, 0, fmt.Errorf("invalid time %q: use HH:MM, e.g. 09:00", s)
+	}
+	hour, err = strconv.Atoi(h)
+	if err != nil || hour < 0 || hour > 23 {
+		return 0, 0, fmt.Errorf("invalid time %q: hour must be between 0 and 23", s)
+	}
+	minute, err = strconv.Atoi(m)
+	if err != nil || len(m) != 2 || minute < 0 || minute > 59 {
+		return 0, 0, fmt.Errorf("invalid time %q: minute must be between 00 and 59", s)
+	}
+	return hour, minute, nil
+}
+
+// CheckinQuestion builds the request for a check-in question asked on
+// schedule at hour:minute. days only applies to the weekly schedules, where it
+// defaults to Monday; giving days for every_day is an error rather than being
+// silently dropped.
+func CheckinQuestion(title, schedule, days string, hour, minute int) (api.QuestionCreateRequest, error) {
+	var req api.QuestionCreateRequest
+
+	if err := ValidateCheckinSchedule(schedule); err != nil {
+		return req, err
+	}
+
+	dayList, err := ParseCheckinDays(days)
+	if err != nil {
+		return req, err
+	}
+	if schedule == "every_day" {
+		if len(dayList) > 0 {
+			return req, fmt.Errorf("days can only be given for weekly schedules, not every_day")
+		}
+	} else if len(dayList) == 0 {
+		dayList = []int{1}
+	}
+
+	if hour < 0 || hour > 23 {
+		return req, fmt.Errorf("hour must be between 0 and 23")
+	}
+	if minute < 0 || minute > 59 {
+		return req, fmt.Errorf("minute must be between 0 and 59")
+	}
+
+	return api.QuestionCreateRequest{
+		Title:    title,
+		Schedule: schedule,
+		Days:     dayList,
+		Hour:     hour,
+		Minute:   minute,
+	}, nil
+}
@@ -0,0 +1,114 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseCheckinDays(t *testing.T) {
+	days, err := ParseCheckinDays("1, 3,5")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(days, []int{1, 3, 5}) {
+		t.Errorf("ParseCheckinDays = %v, want [1 3 5]", days)
+	}
+
+	days, err = ParseCheckinDays("mon,Wed, FRIDAY,sun,mon")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(days, []int{1, 3, 5, 0}) {
+		t.Errorf("ParseCheckinDays = %v, want [1 3 5 0]", days)
+	}
+
+	for _, input := range []string{"7", "-1", "x", "mo"} {
+		if _, err := ParseCheckinDays(input); err == nil {
+			t.Errorf("ParseCheckinDays(%q) expected an error", input)
+		}
+	}
+}
+
+func TestParseTimeOfDay(t *testing.T) {
+	tests := []struct {
+		input        string
+		hour, minute int
+		wantErr      bool
+	}{
+		{"09:00", 9, 0, false},
+		{"9:05", 9, 5, false},
+		{"23:59", 23, 59, false},
+		{"24:00", 0, 0, true},
+		{"12:60", 0, 0, true},
+		{"12:5", 0, 0, true},
+		{"noon", 0, 0, true},
+	}
+
+	for _, tt := range tests {
+		hour, minute, err := ParseTimeOfDay(tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseTimeOfDay(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			continue
+		}
+		if hour != tt.hour || minute != tt.minute {
+			t.Errorf("ParseTimeOfDay(%q) = %d:%d, want %d:%d", tt.input, hour, minute, tt.hour, tt.minute)
+		}
+	}
+}
+
+func TestValidateCheckinSchedule(t *testing.T) {
+	for _, s := range CheckinSchedules {
+		if err := ValidateCheckinSchedule(s); err != nil {
+			t.Errorf("ValidateCheckinSchedule(%q) = %v", s, err)
+		}
+	}
+	if err := ValidateCheckinSchedule("monthly"); err == nil {
+		t.Error("expected an error for an unknown schedule")
+	}
+}
+
+func TestCheckinDayNameRoundTrip(t *testing.T) {
+	for day := 0; day <= 6; day++ {
+		days, err := ParseCheckinDays(CheckinDayName(day))
+		if err != nil || len(days) != 1 || days[0] != day {
+			t.Errorf("ParseCheckinDays(CheckinDayName(%d)) = %v, %v", day, days, err)
+		}
+	}
+	if got := CheckinDayName(9); got != "9" {
+		t.Errorf("CheckinDayName(9) = %q, want \"9\"", got)
+	}
+}
+
+func TestCheckinQuestion(t *testing.T) {
+	req, err := CheckinQuestion("Retro", "every_other_week", "", 15, 30)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(req.Days, []int{1}) || req.Hour != 15 || req.Minute != 30 {
+		t.Errorf("CheckinQuestion = %+v, want Monday at 15:30", req)
+	}
+
+	req, err = CheckinQuestion("Standup", "every_day", "", 9, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if req.Days != nil {
+		t.Errorf("every_day Days = %v, want nil", req.Days)
+	}
+
+	bad := []struct {
+		schedule, days string
+		hour, minute   int
+	}{
+		{"every_day", "mon", 9, 0},
+		{"monthly", "", 9, 0},
+		{"every_week", "funday", 9, 0},
+		{"every_week", "", 24, 0},
+		{"every_week", "", 9, 60},
+	}
+	for _, tt := range bad {
+		if _, err := CheckinQuestion("Q", tt.schedule, tt.days, tt.hour, tt.minute); err == nil {
+			t.Errorf("CheckinQuestion(%q, %q, %d, %d) succeeded, want an error", tt.schedule, tt.days, tt.hour, tt.minute)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package utils
 import (
 	"bytes"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/charmbracelet/glamour"
@@ -10,6 +11,32 @@ import (
 	"github.com/needmore/bc4/internal/api"
 )
 
+// Comment sort orders accepted by the --sort flags of comment listings
+const (
+	CommentSortAsc  = "asc"
+	CommentSortDesc = "desc"
+)
+
+// ValidateCommentSort checks a comment --sort value
+func ValidateCommentSort(order string) error {
+	switch order {
+	case CommentSortAsc, CommentSortDesc:
+		return nil
+	}
+	return fmt.Errorf("invalid --sort %q: must be %s or %s", order, CommentSortAsc, CommentSortDesc)
+}
+
+// SortComments orders comments by creation time, oldest first for asc and
+// newest first for desc. Comments created at the same time keep their order.
+func SortComments(comments []api.Comment, order string) {
+	sort.SliceStable(comments, func(i, j int) bool {
+		if order == CommentSortDesc {
+			return comments[i].CreatedAt.After(comments[j].CreatedAt)
+		}
+		return comments[i].CreatedAt.Before(comments[j].CreatedAt)
+	})
+}
+
 // FormatCommentsForDisplay formats a list of comments for display in a pager
 func FormatCommentsForDisplay(comments []api.Comment) (string, error) {
 	if len(comments) == 0 {
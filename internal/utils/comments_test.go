@@ -94,3 +94,46 @@ func TestFormatCommentsForDisplay(t *testing.T) {
 		}
 	})
 }
+
+func TestSortComments(t *testing.T) {
+	at := func(hour int) time.Time { return time.Date(2024, 1, 15, hour, 0, 0, 0, time.UTC) }
+	newComments := func() []api.Comment {
+		return []api.Comment{
+			{ID: 2, CreatedAt: at(11)},
+			{ID: 3, CreatedAt: at(12)},
+			{ID: 1, CreatedAt: at(9)},
+		}
+	}
+	ids := func(comments []api.Comment) []int64 {
+		var out []int64
+		for _, c := range comments {
+			out = append(out, c.ID)
+		}
+		return out
+	}
+
+	t.Run("asc", func(t *testing.T) {
+		comments := newComments()
+		SortComments(comments, CommentSortAsc)
+		if got := ids(comments); got[0] != 1 || got[1] != 2 || got[2] != 3 {
+			t.Errorf("Expected oldest first, got %v", got)
+		}
+	})
+
+	t.Run("desc", func(t *testing.T) {
+		comments := newComments()
+		SortComments(comments, CommentSortDesc)
+		if got := ids(comments); got[0] != 3 || got[1] != 2 || got[2] != 1 {
+			t.Errorf("Expected newest first, got %v", got)
+		}
+	})
+
+	t.Run("invalid order", func(t *testing.T) {
+		if err := ValidateCommentSort("newest"); err == nil {
+			t.Error("Expected error for invalid sort order")
+		}
+		if err := ValidateCommentSort(CommentSortDesc); err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,152 @@
+package utils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DateLayout is the date format used by the Basecamp API for due/start dates
+const DateLayout = "2006-01-02"
+
+// ParseDate parses a user-supplied date and returns it in YYYY-MM-DD format.
+// Supports:
+// - ISO dates: 2025-01-15
+// - Relative keywords: today, tomorrow, yesterday
+// - Weekdays: monday, next-friday (the next occurrence after today)
+// - Offsets: +3d, +2w (days or weeks from today)
+func ParseDate(input string) (string, error) {
+	return parseDateFrom(input, time.Now())
+}
+
+// ParseDateRelativeTo parses input like ParseDate, but resolves relative
+// forms (today, weekdays, +Nd/+Nw) against base instead of the current date
+func ParseDateRelativeTo(input string, base time.Time) (string, error) {
+	return parseDateFrom(input, base)
+}
+
+// parseDateFrom parses input relative to the given reference time
+func parseDateFrom(input string, now time.Time) (string, error) {
+	value := strings.ToLower(strings.TrimSpace(input))
+	if value == "" {
+		return "", fmt.Errorf("date cannot be empty")
+	}
+
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	switch value {
+	case "today":
+		return today.Format(DateLayout), nil
+	case "tomorrow":
+		return today.AddDate(0, 0, 1).Format(DateLayout), nil
+	case "yesterday":
+		return today.AddDate(0, 0, -1).Format(DateLayout), nil
+	}
+
+	// Weekday names, optionally prefixed with "next-"
+	if weekday, ok := parseWeekday(strings.TrimPrefix(value, "next-")); ok {
+		days := (int(weekday) - int(today.Weekday()) + 7) % 7
+		if days == 0 {
+			days = 7
+		}
+		return today.AddDate(0, 0, days).Format(DateLayout), nil
+	}
+
+	// Offsets such as +3d or +2w
+	if offset, ok := strings.CutPrefix(value, "+"); ok {
+		if span, ok := ParseSpan(offset); ok && span.Unit != 'h' {
+			return span.After(today).Format(DateLayout), nil
+		}
+	}
+
+	if t, err := time.Parse(DateLayout, value); err == nil {
+		return t.Format(DateLayout), nil
+	}
+
+	return "", fmt.Errorf("unrecognized date format: %s (use YYYY-MM-DD, today, tomorrow, a weekday, or +Nd/+Nw)", input)
+}
+
+// parseWeekday maps a weekday name (full or three-letter) to time.Weekday
+func parseWeekday(name string) (time.Weekday, bool) {
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		full := strings.ToLower(d.String())
+		if name == full || name == full[:3] {
+			return d, true
+		}
+	}
+	return 0, false
+}
+
+// Span is a count of hours, days, or weeks, written like 12h, 3d, or 2w
+type Span struct {
+	Count int
+	// Unit is 'h', 'd', or 'w'
+	Unit byte
+}
+
+// ParseSpan parses a span: a non-negative count followed by h, d, or w,
+// ignoring case and surrounding space. ok is false for anything else.
+func ParseSpan(value string) (span Span, ok bool) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if len(value) < 2 {
+		return Span{}, false
+	}
+	n, err := strconv.Atoi(value[:len(value)-1])
+	if err != nil || n < 0 || strings.ContainsAny(value[:1], "+-") {
+		return Span{}, false
+	}
+	switch unit := value[len(value)-1]; unit {
+	case 'h', 'd', 'w':
+		return Span{Count: n, Unit: unit}, true
+	}
+	return Span{}, false
+}
+
+// Days returns the span in whole days, rounding hours down
+func (s Span) Days() int {
+	switch s.Unit {
+	case 'd':
+		return s.Count
+	case 'w':
+		return 7 * s.Count
+	}
+	return s.Count / 24
+}
+
+// Duration returns the span as a fixed duration, taking days as 24 hours
+func (s Span) Duration() time.Duration {
+	if s.Unit == 'h' {
+		return time.Duration(s.Count) * time.Hour
+	}
+	return time.Duration(s.Days()) * 24 * time.Hour
+}
+
+// After returns t moved forward by the span. Days and weeks are calendar
+// days, so the wall clock time is kept across DST changes.
+func (s Span) After(t time.Time) time.Time {
+	if s.Unit == 'h' {
+		return t.Add(s.Duration())
+	}
+	return t.AddDate(0, 0, s.Days())
+}
+
+// Before returns t moved back by the span, like After
+func (s Span) Before(t time.Time) time.Time {
+	if s.Unit == 'h' {
+		return t.Add(-s.Duration())
+	}
+	return t.AddDate(0, 0, -s.Days())
+}
+
+// ValidateDateRange returns an error if start is after due.
+// Both values must be in YYYY-MM-DD format; empty values are ignored.
+func ValidateDateRange(start, due string) error {
+	if start == "" || due == "" {
+		return nil
+	}
+	if start > due {
+		return fmt.Errorf("start date %s is after due date %s", start, due)
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package utils
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseDateFrom(t *testing.T) {
+	// Wednesday, 2025-01-15
+	now := time.Date(2025, 1, 15, 14, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{name: "iso date", input: "2025-02-01", want: "2025-02-01"},
+		{name: "today", input: "today", want: "2025-01-15"},
+		{name: "tomorrow mixed case", input: "Tomorrow", want: "2025-01-16"},
+		{name: "yesterday", input: "yesterday", want: "2025-01-14"},
+		{name: "weekday", input: "friday", want: "2025-01-17"},
+		{name: "short weekday", input: "mon", want: "2025-01-20"},
+		{name: "same weekday is next week", input: "wednesday", want: "2025-01-22"},
+		{name: "next weekday", input: "next-friday", want: "2025-01-17"},
+		{name: "day offset", input: "+3d", want: "2025-01-18"},
+		{name: "week offset", input: "+2w", want: "2025-01-29"},
+		{name: "empty", input: "", wantErr: true},
+		{name: "invalid", input: "someday", wantErr: true},
+		{name: "invalid offset unit", input: "+3m", wantErr: true},
+		{name: "invalid calendar date", input: "2025-02-30", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseDateFrom(tt.input, now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseDateFrom(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("parseDateFrom(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateDateRange(t *testing.T) {
+	if err := ValidateDateRange("2025-01-10", "2025-01-15"); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	if err := ValidateDateRange("2025-01-15", "2025-01-15"); err != nil {
+		t.Errorf("expected no error for equal dates, got %v", err)
+	}
+	if err := ValidateDateRange("2025-01-16", "2025-01-15"); err == nil {
+		t.Error("expected error when start is after due")
+	}
+	if err := ValidateDateRange("", "2025-01-15"); err != nil {
+		t.Errorf("expected no error when start is empty, got %v", err)
+	}
+}
+
+func TestParseSpan(t *testing.T) {
+	tests := []struct {
+		input  string
+		want   Span
+		wantOK bool
+	}{
+		{input: "12h", want: Span{Count: 12, Unit: 'h'}, wantOK: true},
+		{input: " 3D ", want: Span{Count: 3, Unit: 'd'}, wantOK: true},
+		{input: "2w", want: Span{Count: 2, Unit: 'w'}, wantOK: true},
+		{input: "0d", want: Span{Count: 0, Unit: 'd'}, wantOK: true},
+		{input: "d", wantOK: false},
+		{input: "7", wantOK: false},
+		{input: "-1d", wantOK: false},
+		{input: "+1d", wantOK: false},
+		{input: "5m", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, ok := ParseSpan(tt.input)
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("ParseSpan(%q) = %+v, %v, want %+v, %v", tt.input, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestSpanArithmetic(t *testing.T) {
+	now := time.Date(2025, 1, 15, 14, 30, 0, 0, time.UTC)
+
+	if got := (Span{Count: 2, Unit: 'w'}).Days(); got != 14 {
+		t.Errorf("Days() = %d, want 14", got)
+	}
+	if got := (Span{Count: 3, Unit: 'd'}).Duration(); got != 72*time.Hour {
+		t.Errorf("Duration() = %v, want 72h", got)
+	}
+	if got := (Span{Count: 6, Unit: 'h'}).Before(now); !got.Equal(now.Add(-6 * time.Hour)) {
+		t.Errorf("Before() = %v", got)
+	}
+	if got := (Span{Count: 1, Unit: 'w'}).After(now); !got.Equal(now.AddDate(0, 0, 7)) {
+		t.Errorf("After() = %v", got)
+	}
+}
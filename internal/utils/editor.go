@@ -0,0 +1,76 @@
+package utils
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// ResolveEditor returns the editor command to use: the configured
+// preference, then $VISUAL, then $EDITOR, then a platform default
+func ResolveEditor(preferred string) string {
+	for _, editor := range []string{preferred, os.Getenv("VISUAL"), os.Getenv("EDITOR")} {
+		if strings.TrimSpace(editor) != "" {
+			return editor
+		}
+	}
+	if runtime.GOOS == "windows" {
+		return "notepad"
+	}
+	return "vi"
+}
+
+// RunEditor opens path in the editor and waits for it to exit. The editor
+// may include arguments (e.g. "code --wait").
+func RunEditor(editor, path string) error {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		args := strings.Fields(editor)
+		if len(args) == 0 {
+			return fmt.Errorf("no editor configured")
+		}
+		cmd = exec.Command(args[0], append(args[1:], path)...)
+	} else {
+		// Run through the shell like the pager, passing the path as $1
+		cmd = exec.Command("sh", "-c", editor+` "$1"`, "sh", path)
+	}
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("editor %q failed: %w", editor, err)
+	}
+	return nil
+}
+
+// EditText opens initial in the editor in a temporary file named after
+// pattern (see os.CreateTemp) and returns the saved text
+func EditText(editor, initial, pattern string) (string, error) {
+	tmp, err := os.CreateTemp("", pattern)
+	if err != nil {
+		return "", fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	path := tmp.Name()
+	defer func() { _ = os.Remove(path) }()
+
+	if _, err := tmp.WriteString(initial); err != nil {
+		_ = tmp.Close()
+		return "", fmt.Errorf("failed to write temporary file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return "", fmt.Errorf("failed to write temporary file: %w", err)
+	}
+
+	if err := RunEditor(editor, path); err != nil {
+		return "", err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read edited file: %w", err)
+	}
+	return string(data), nil
+}
@@ -0,0 +1,86 @@
+package utils
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/ui/tableprinter"
+)
+
+// WriteEventTable writes events as a WHEN/WHO/ACTION/DETAILS table, with
+// when formatting each event's time
+func WriteEventTable(w io.Writer, events []api.Event, when func(time.Time) string) error {
+	table := tableprinter.New(w)
+	table.AddHeader("WHEN", "WHO", "ACTION", "DETAILS")
+	for _, event := range events {
+		table.AddField(when(event.CreatedAt))
+		who := event.Creator.Name
+		if who == "" {
+			who = "-"
+		}
+		table.AddField(who)
+		table.AddField(FormatEventAction(event.Action))
+		table.AddField(FormatEventDetails(event.Details))
+		table.EndRow()
+	}
+	return table.Render()
+}
+
+// FormatEventAction turns an API action such as "assignment_changed" into
+// "assignment changed"
+func FormatEventAction(action string) string {
+	if action == "" {
+		return "-"
+	}
+	return strings.ReplaceAll(action, "_", " ")
+}
+
+// FormatEventDetails flattens an event's details into "key: value" pairs in
+// key order, so the output is stable
+func FormatEventDetails(details map[string]any) string {
+	if len(details) == 0 {
+		return "-"
+	}
+
+	keys := make([]string, 0, len(details))
+	for k := range details {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		parts = append(parts, fmt.Sprintf("%s: %s", strings.ReplaceAll(k, "_", " "), formatDetailValue(details[k])))
+	}
+	return strings.Join(parts, "; ")
+}
+
+func formatDetailValue(v any) string {
+	switch val := v.(type) {
+	case nil:
+		return "-"
+	case float64:
+		// JSON numbers decode as float64; IDs read better without exponents
+		return fmt.Sprintf("%.0f", val)
+	case []any:
+		items := make([]string, 0, len(val))
+		for _, item := range val {
+			items = append(items, formatDetailValue(item))
+		}
+		return strings.Join(items, ", ")
+	case map[string]any:
+		if title, ok := val["title"].(string); ok {
+			return title
+		}
+		if name, ok := val["name"].(string); ok {
+			return name
+		}
+		return fmt.Sprintf("%v", val)
+	default:
+		return fmt.Sprintf("%v", val)
+	}
+}
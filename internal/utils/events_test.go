@@ -0,0 +1,41 @@
+package utils
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/needmore/bc4/internal/api"
+)
+
+func TestFormatEventDetails(t *testing.T) {
+	assert.Equal(t, "-", FormatEventDetails(nil))
+	assert.Equal(t, "added assignee ids: 7, 8; column: Doing", FormatEventDetails(map[string]any{
+		"column":             map[string]any{"title": "Doing"},
+		"added_assignee_ids": []any{float64(7), float64(8)},
+	}))
+	assert.Equal(t, "assignment changed", FormatEventAction("assignment_changed"))
+}
+
+func TestWriteEventTable(t *testing.T) {
+	events := []api.Event{
+		{Action: "assignment_changed", Creator: api.Person{Name: "Jane"}, CreatedAt: time.Date(2025, 1, 15, 9, 0, 0, 0, time.UTC)},
+		{Action: "completed"},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteEventTable(&buf, events, func(t time.Time) string {
+		if t.IsZero() {
+			return "never"
+		}
+		return t.Format("2006-01-02")
+	}))
+	out := buf.String()
+	assert.Contains(t, out, "2025-01-15")
+	assert.Contains(t, out, "assignment changed")
+	assert.Contains(t, out, "Jane")
+	assert.Contains(t, out, "never")
+}
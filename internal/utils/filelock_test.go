@@ -0,0 +1,63 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestLockFile_SerializesReadModifyWrite(t *testing.T) {
+	dir := t.TempDir()
+	counterPath := filepath.Join(dir, "counter")
+	lockPath := filepath.Join(dir, "counter.lock")
+	if err := os.WriteFile(counterPath, []byte("0"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	const workers = 20
+	var wg sync.WaitGroup
+	errs := make(chan error, workers)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			unlock, err := LockFile(lockPath)
+			if err != nil {
+				errs <- err
+				return
+			}
+			defer unlock()
+
+			data, err := os.ReadFile(counterPath)
+			if err != nil {
+				errs <- err
+				return
+			}
+			n, err := strconv.Atoi(strings.TrimSpace(string(data)))
+			if err != nil {
+				errs <- err
+				return
+			}
+			errs <- os.WriteFile(counterPath, []byte(strconv.Itoa(n+1)), 0600)
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	data, err := os.ReadFile(counterPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.TrimSpace(string(data)); got != strconv.Itoa(workers) {
+		t.Errorf("counter = %s, want %d", got, workers)
+	}
+}
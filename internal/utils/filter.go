@@ -0,0 +1,190 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/needmore/bc4/internal/api"
+)
+
+// ItemFilter holds the assignee and due date filters shared by the todo and
+// card listing commands. The zero value matches everything.
+type ItemFilter struct {
+	// AssigneeIDs keeps items assigned to any of these people
+	AssigneeIDs []int64
+	// DueBefore keeps items due on or before this date (YYYY-MM-DD)
+	DueBefore string
+	// DueAfter keeps items due on or after this date (YYYY-MM-DD)
+	DueAfter string
+	// Overdue keeps items due before Today
+	Overdue bool
+	// Today is the reference date for Overdue (YYYY-MM-DD); defaults to today
+	Today string
+
+	// Assigned keeps items with at least one assignee
+	Assigned bool
+	// Unassigned keeps items with no assignees
+	Unassigned bool
+	// HasDue keeps items with a due date
+	HasDue bool
+	// NoDue keeps items without a due date
+	NoDue bool
+}
+
+// IsZero reports whether the filter has no conditions
+func (f ItemFilter) IsZero() bool {
+	return len(f.AssigneeIDs) == 0 && f.DueBefore == "" && f.DueAfter == "" && !f.Overdue &&
+		!f.Assigned && !f.Unassigned && !f.HasDue && !f.NoDue
+}
+
+// Validate rejects combinations of conditions that can never match, naming
+// the command-line flags that set them
+func (f ItemFilter) Validate() error {
+	switch {
+	case f.Assigned && f.Unassigned:
+		return fmt.Errorf("--assigned and --unassigned cannot be used together")
+	case f.Unassigned && len(f.AssigneeIDs) > 0:
+		return fmt.Errorf("--unassigned and --assignee cannot be used together")
+	case f.HasDue && f.NoDue:
+		return fmt.Errorf("--has-due and --no-due cannot be used together")
+	case f.NoDue && f.DueBefore != "":
+		return fmt.Errorf("--no-due and --due-before cannot be used together")
+	case f.NoDue && f.DueAfter != "":
+		return fmt.Errorf("--no-due and --due-after cannot be used together")
+	case f.DueBefore != "" && f.DueAfter != "" && f.DueAfter > f.DueBefore:
+		return fmt.Errorf("--due-after (%s) is later than --due-before (%s)", f.DueAfter, f.DueBefore)
+	case f.NoDue && f.Overdue:
+		return fmt.Errorf("--no-due and --overdue cannot be used together")
+	}
+	return nil
+}
+
+// Matches reports whether an item with the given assignees and due date
+// passes every condition of the filter
+func (f ItemFilter) Matches(assignees []api.Person, dueOn *string) bool {
+	if len(f.AssigneeIDs) > 0 && !HasAnyAssignee(assignees, f.AssigneeIDs) {
+		return false
+	}
+	if f.Assigned && len(assignees) == 0 {
+		return false
+	}
+	if f.Unassigned && len(assignees) > 0 {
+		return false
+	}
+
+	due := ""
+	if dueOn != nil {
+		due = *dueOn
+	}
+	if f.HasDue && due == "" {
+		return false
+	}
+	if f.NoDue && due != "" {
+		return false
+	}
+	if f.DueBefore != "" && (due == "" || due > f.DueBefore) {
+		return false
+	}
+	if f.DueAfter != "" && (due == "" || due < f.DueAfter) {
+		return false
+	}
+	if f.Overdue {
+		today := f.Today
+		if today == "" {
+			today = time.Now().Format(DateLayout)
+		}
+		if due == "" || due >= today {
+			return false
+		}
+	}
+	return true
+}
+
+// HasAnyAssignee reports whether any of the assignees has one of the given IDs
+func HasAnyAssignee(assignees []api.Person, ids []int64) bool {
+	for _, a := range assignees {
+		for _, id := range ids {
+			if a.ID == id {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// FilterItems returns the items for which keep returns true
+func FilterItems[T any](items []T, keep func(T) bool) []T {
+	var kept []T
+	for _, item := range items {
+		if keep(item) {
+			kept = append(kept, item)
+		}
+	}
+	return kept
+}
+
+// CompareDue orders due dates earliest first, with undated items last
+func CompareDue(a, b *string) int {
+	aDue, bDue := "", ""
+	if a != nil {
+		aDue = *a
+	}
+	if b != nil {
+		bDue = *b
+	}
+	switch {
+	case aDue == bDue:
+		return 0
+	case aDue == "":
+		return 1
+	case bDue == "":
+		return -1
+	case aDue < bDue:
+		return -1
+	default:
+		return 1
+	}
+}
+
+// SortItemsByTitle sorts items by title (case-insensitive), keeping the
+// existing order for equal titles
+func SortItemsByTitle[T any](items []T, title func(T) string) {
+	sort.SliceStable(items, func(i, j int) bool {
+		return strings.ToLower(title(items[i])) < strings.ToLower(title(items[j]))
+	})
+}
+
+// SortItemsByDue sorts items by due date, earliest first and undated last
+func SortItemsByDue[T any](items []T, due func(T) *string) {
+	sort.SliceStable(items, func(i, j int) bool {
+		return CompareDue(due(items[i]), due(items[j])) < 0
+	})
+}
+
+// ResolvePersonIDs resolves people given by numeric ID, name, @mention, or
+// email, as accepted by the --assignee filters. Numeric IDs are used as-is.
+func ResolvePersonIDs(ctx context.Context, resolver *UserResolver, identifiers []string) ([]int64, error) {
+	var ids []int64
+	var names []string
+	for _, identifier := range identifiers {
+		identifier = strings.TrimSpace(identifier)
+		if id, err := strconv.ParseInt(identifier, 10, 64); err == nil {
+			ids = append(ids, id)
+		} else if identifier != "" {
+			names = append(names, identifier)
+		}
+	}
+	if len(names) == 0 {
+		return ids, nil
+	}
+
+	resolved, err := resolver.ResolveUsers(ctx, names)
+	if err != nil {
+		return nil, err
+	}
+	return append(ids, resolved...), nil
+}
@@ -0,0 +1,126 @@
+package utils
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/needmore/bc4/internal/api"
+)
+
+func strPtr(s string) *string { return &s }
+
+func TestItemFilter_Matches(t *testing.T) {
+	jane := api.Person{ID: 1, Name: "Jane"}
+	bob := api.Person{ID: 2, Name: "Bob"}
+
+	tests := []struct {
+		name      string
+		filter    ItemFilter
+		assignees []api.Person
+		due       *string
+		want      bool
+	}{
+		{name: "zero filter matches all", filter: ItemFilter{}, want: true},
+		{name: "assignee match", filter: ItemFilter{AssigneeIDs: []int64{2}}, assignees: []api.Person{jane, bob}, want: true},
+		{name: "assignee mismatch", filter: ItemFilter{AssigneeIDs: []int64{3}}, assignees: []api.Person{jane}, want: false},
+		{name: "due before inclusive", filter: ItemFilter{DueBefore: "2024-06-10"}, due: strPtr("2024-06-10"), want: true},
+		{name: "due after cutoff", filter: ItemFilter{DueBefore: "2024-06-10"}, due: strPtr("2024-06-11"), want: false},
+		{name: "due before excludes undated", filter: ItemFilter{DueBefore: "2024-06-10"}, want: false},
+		{name: "due after inclusive", filter: ItemFilter{DueAfter: "2024-06-10"}, due: strPtr("2024-06-10"), want: true},
+		{name: "due before start", filter: ItemFilter{DueAfter: "2024-06-10"}, due: strPtr("2024-06-09"), want: false},
+		{name: "due after excludes undated", filter: ItemFilter{DueAfter: "2024-06-10"}, want: false},
+		{name: "due window", filter: ItemFilter{DueAfter: "2024-06-01", DueBefore: "2024-06-10"}, due: strPtr("2024-06-05"), want: true},
+		{name: "overdue", filter: ItemFilter{Overdue: true, Today: "2024-06-10"}, due: strPtr("2024-06-09"), want: true},
+		{name: "due today is not overdue", filter: ItemFilter{Overdue: true, Today: "2024-06-10"}, due: strPtr("2024-06-10"), want: false},
+		{name: "overdue excludes undated", filter: ItemFilter{Overdue: true, Today: "2024-06-10"}, want: false},
+		{name: "assigned", filter: ItemFilter{Assigned: true}, assignees: []api.Person{jane}, want: true},
+		{name: "assigned excludes unassigned", filter: ItemFilter{Assigned: true}, want: false},
+		{name: "unassigned", filter: ItemFilter{Unassigned: true}, want: true},
+		{name: "unassigned excludes assigned", filter: ItemFilter{Unassigned: true}, assignees: []api.Person{bob}, want: false},
+		{name: "has due", filter: ItemFilter{HasDue: true}, due: strPtr("2024-06-10"), want: true},
+		{name: "has due excludes empty date", filter: ItemFilter{HasDue: true}, due: strPtr(""), want: false},
+		{name: "no due", filter: ItemFilter{NoDue: true}, want: true},
+		{name: "no due excludes dated", filter: ItemFilter{NoDue: true}, due: strPtr("2024-06-10"), want: false},
+		{name: "combined", filter: ItemFilter{Assigned: true, HasDue: true, AssigneeIDs: []int64{1}}, assignees: []api.Person{jane}, due: strPtr("2024-06-10"), want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.Matches(tt.assignees, tt.due); got != tt.want {
+				t.Errorf("Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestItemFilter_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		filter  ItemFilter
+		wantErr string
+	}{
+		{name: "compatible", filter: ItemFilter{Assigned: true, HasDue: true, AssigneeIDs: []int64{1}}},
+		{name: "assigned and unassigned", filter: ItemFilter{Assigned: true, Unassigned: true}, wantErr: "--assigned and --unassigned"},
+		{name: "unassigned with assignee", filter: ItemFilter{Unassigned: true, AssigneeIDs: []int64{1}}, wantErr: "--unassigned and --assignee"},
+		{name: "has and no due", filter: ItemFilter{HasDue: true, NoDue: true}, wantErr: "--has-due and --no-due"},
+		{name: "no due and due after", filter: ItemFilter{NoDue: true, DueAfter: "2024-06-10"}, wantErr: "--no-due and --due-after"},
+		{name: "empty due window", filter: ItemFilter{DueAfter: "2024-06-10", DueBefore: "2024-06-01"}, wantErr: "later than --due-before"},
+		{name: "no due and overdue", filter: ItemFilter{NoDue: true, Overdue: true}, wantErr: "--no-due and --overdue"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.filter.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Validate() = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSortItemsByDue_UndatedLast(t *testing.T) {
+	items := []*string{nil, strPtr("2024-06-12"), strPtr("2024-06-01"), nil}
+	SortItemsByDue(items, func(s *string) *string { return s })
+
+	var got []string
+	for _, s := range items {
+		if s == nil {
+			got = append(got, "")
+		} else {
+			got = append(got, *s)
+		}
+	}
+	want := []string{"2024-06-01", "2024-06-12", "", ""}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestSortItemsByTitle_CaseInsensitive(t *testing.T) {
+	items := []string{"beta", "Alpha", "gamma"}
+	SortItemsByTitle(items, func(s string) string { return s })
+	want := []string{"Alpha", "beta", "gamma"}
+	if !reflect.DeepEqual(items, want) {
+		t.Errorf("got %v, want %v", items, want)
+	}
+}
+
+func TestResolvePersonIDs_NumericIDsSkipLookup(t *testing.T) {
+	// A nil client would panic if a lookup were attempted
+	resolver := NewUserResolver(nil, "1")
+	ids, err := ResolvePersonIDs(context.Background(), resolver, []string{"42", " 7 "})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(ids, []int64{42, 7}) {
+		t.Errorf("got %v", ids)
+	}
+}
@@ -0,0 +1,51 @@
+package utils
+
+import (
+	"strconv"
+	"strings"
+)
+
+// MatchIDOrName returns the indexes of the items ref refers to. When id is
+// non-nil and ref is a number, only the item with that ID can match and
+// byID is true. Otherwise the items with a name equal to ref, ignoring case,
+// match; if there are none and partial is set, the items with a name
+// containing ref match instead.
+func MatchIDOrName[T any](items []T, ref string, partial bool, id func(T) int64, names ...func(T) string) (matches []int, byID bool) {
+	ref = strings.TrimSpace(ref)
+	if id != nil {
+		if want, err := strconv.ParseInt(ref, 10, 64); err == nil {
+			for i, item := range items {
+				if id(item) == want {
+					return []int{i}, true
+				}
+			}
+			return nil, true
+		}
+	}
+
+	search := strings.ToLower(ref)
+	var exact, contains []int
+	for i, item := range items {
+		isExact, isPartial := false, false
+		for _, name := range names {
+			value := name(item)
+			if strings.EqualFold(value, ref) {
+				isExact = true
+				break
+			}
+			if partial && strings.Contains(strings.ToLower(value), search) {
+				isPartial = true
+			}
+		}
+		switch {
+		case isExact:
+			exact = append(exact, i)
+		case isPartial:
+			contains = append(contains, i)
+		}
+	}
+	if len(exact) > 0 {
+		return exact, false
+	}
+	return contains, false
+}
@@ -0,0 +1,49 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchIDOrName(t *testing.T) {
+	type item struct {
+		id    int64
+		title string
+		name  string
+	}
+	items := []item{
+		{id: 1, title: "Sprint 1"},
+		{id: 2, title: "Sprint 12"},
+		{id: 3, title: "Backlog", name: "backlog-list"},
+	}
+	id := func(i item) int64 { return i.id }
+	title := func(i item) string { return i.title }
+	name := func(i item) string { return i.name }
+
+	tests := []struct {
+		name     string
+		ref      string
+		partial  bool
+		id       func(item) int64
+		wantIdx  []int
+		wantByID bool
+	}{
+		{name: "exact match beats partial", ref: " sprint 1 ", partial: true, wantIdx: []int{0}},
+		{name: "partial matches", ref: "sprint", partial: true, wantIdx: []int{0, 1}},
+		{name: "partial needs opt in", ref: "sprint", wantIdx: nil},
+		{name: "matches any name", ref: "BACKLOG-LIST", wantIdx: []int{2}},
+		{name: "no match", ref: "missing", partial: true, wantIdx: nil},
+		{name: "numeric ref matches ID", ref: "2", id: id, wantIdx: []int{1}, wantByID: true},
+		{name: "unknown ID", ref: "12", id: id, partial: true, wantIdx: nil, wantByID: true},
+		{name: "numeric ref without IDs is a name", ref: "12", partial: true, wantIdx: []int{1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches, byID := MatchIDOrName(items, tt.ref, tt.partial, tt.id, title, name)
+			assert.Equal(t, tt.wantIdx, matches)
+			assert.Equal(t, tt.wantByID, byID)
+		})
+	}
+}
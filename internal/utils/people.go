@@ -0,0 +1,57 @@
+package utils
+
+import (
+	"strings"
+
+	"github.com/needmore/bc4/internal/api"
+)
+
+// PeopleIndex looks up a fixed set of people by ID or email. Build it
+// once per command from a single people fetch and share it between name
+// resolution, display, and validation.
+type PeopleIndex struct {
+	byID    map[int64]*api.Person
+	byEmail map[string]*api.Person
+}
+
+// NewPeopleIndex indexes people. When several people share an email, the
+// first one wins.
+func NewPeopleIndex(people []api.Person) *PeopleIndex {
+	idx := &PeopleIndex{
+		byID:    make(map[int64]*api.Person, len(people)),
+		byEmail: make(map[string]*api.Person, len(people)),
+	}
+	for i := range people {
+		p := &people[i]
+		if _, ok := idx.byID[p.ID]; !ok {
+			idx.byID[p.ID] = p
+		}
+		if email := strings.ToLower(strings.TrimSpace(p.EmailAddress)); email != "" {
+			if _, ok := idx.byEmail[email]; !ok {
+				idx.byEmail[email] = p
+			}
+		}
+	}
+	return idx
+}
+
+// ByID returns the person with the given ID
+func (idx *PeopleIndex) ByID(id int64) (*api.Person, bool) {
+	p, ok := idx.byID[id]
+	return p, ok
+}
+
+// ByEmail returns the person with the given email address, ignoring case
+func (idx *PeopleIndex) ByEmail(email string) (*api.Person, bool) {
+	p, ok := idx.byEmail[strings.ToLower(strings.TrimSpace(email))]
+	return p, ok
+}
+
+// IDs returns the set of indexed person IDs
+func (idx *PeopleIndex) IDs() map[int64]bool {
+	ids := make(map[int64]bool, len(idx.byID))
+	for id := range idx.byID {
+		ids[id] = true
+	}
+	return ids
+}
@@ -0,0 +1,61 @@
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/api/mock"
+)
+
+func TestPeopleIndex(t *testing.T) {
+	idx := NewPeopleIndex([]api.Person{
+		{ID: 1, Name: "John Doe", EmailAddress: "John@Example.com"},
+		{ID: 2, Name: "Jane Smith", EmailAddress: "jane@example.com"},
+		{ID: 3, Name: "John Doe", EmailAddress: "john@example.com"},
+	})
+
+	if p, ok := idx.ByID(2); !ok || p.Name != "Jane Smith" {
+		t.Errorf("ByID(2) = %v, %v", p, ok)
+	}
+	if p, ok := idx.ByEmail(" JOHN@example.com"); !ok || p.ID != 1 {
+		t.Errorf("ByEmail should match case-insensitively, got %v, %v", p, ok)
+	}
+	if _, ok := idx.ByEmail("nobody@example.com"); ok {
+		t.Error("ByEmail found an unknown address")
+	}
+	if _, ok := idx.ByID(4); ok {
+		t.Error("ByID found an unknown person")
+	}
+	if ids := idx.IDs(); len(ids) != 3 || !ids[1] || !ids[2] || !ids[3] {
+		t.Errorf("IDs = %v", ids)
+	}
+}
+
+func TestUserResolver_FetchesPeopleOnce(t *testing.T) {
+	client := mock.NewMockClient()
+	client.People = []api.Person{
+		{ID: 1, Name: "John Doe", EmailAddress: "john@example.com"},
+		{ID: 2, Name: "Jane Smith", EmailAddress: "jane@example.com"},
+	}
+	ctx := context.Background()
+	resolver := NewUserResolver(client, "10")
+
+	if _, err := ResolvePersonIDs(ctx, resolver, []string{"@jane", "john@example.com"}); err != nil {
+		t.Fatalf("ResolvePersonIDs: %v", err)
+	}
+	idx, err := resolver.Index(ctx)
+	if err != nil {
+		t.Fatalf("Index: %v", err)
+	}
+	if again, _ := resolver.Index(ctx); again != idx {
+		t.Error("Index should return the same index on later calls")
+	}
+	if _, err := resolver.ResolvePeople(ctx, []string{"Jane Smith"}); err != nil {
+		t.Fatalf("ResolvePeople: %v", err)
+	}
+
+	if len(client.Calls) != 1 || client.Calls[0] != "GetProjectPeople(10)" {
+		t.Errorf("expected a single GetProjectPeople call, got %v", client.Calls)
+	}
+}
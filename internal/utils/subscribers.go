@@ -0,0 +1,31 @@
+package utils
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/needmore/bc4/internal/api"
+)
+
+// WriteSubscribers writes the people subscribed to a recording, one per
+// line, with their email when known
+func WriteSubscribers(w io.Writer, people []api.Person) error {
+	if len(people) == 0 {
+		_, err := fmt.Fprintln(w, "No subscribers")
+		return err
+	}
+
+	if _, err := fmt.Fprintf(w, "Subscribers (%d):\n", len(people)); err != nil {
+		return err
+	}
+	for _, person := range people {
+		line := "  " + person.Name
+		if person.EmailAddress != "" {
+			line += fmt.Sprintf(" <%s>", person.EmailAddress)
+		}
+		if _, err := fmt.Fprintln(w, line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,23 @@
+package utils
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteSubscribers(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteSubscribers(&buf, nil))
+	assert.Equal(t, "No subscribers\n", buf.String())
+
+	buf.Reset()
+	require.NoError(t, WriteSubscribers(&buf, []api.Person{
+		{Name: "Ann", EmailAddress: "ann@example.com"},
+		{Name: "Bo"},
+	}))
+	assert.Equal(t, "Subscribers (2):\n  Ann <ann@example.com>\n  Bo\n", buf.String())
+}
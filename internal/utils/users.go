@@ -10,10 +10,12 @@ import (
 
 // UserResolver helps resolve user identifiers to Person objects
 type UserResolver struct {
-	client    api.APIClient
-	projectID string
-	people    []api.Person
-	cached    bool
+	client      api.APIClient
+	projectID   string
+	accountWide bool
+	people      []api.Person
+	index       *PeopleIndex
+	cached      bool
 }
 
 // NewUserResolver creates a new user resolver for a project
@@ -24,6 +26,16 @@ func NewUserResolver(client api.APIClient, projectID string) *UserResolver {
 	}
 }
 
+// NewAccountUserResolver creates a resolver that matches against everyone in
+// the account rather than one project, for finding people who aren't on a
+// project yet
+func NewAccountUserResolver(client api.APIClient) *UserResolver {
+	return &UserResolver{
+		client:      client,
+		accountWide: true,
+	}
+}
+
 // ResolveUsers resolves a list of user identifiers to person IDs
 // Supports:
 // - Email addresses: john@example.com
@@ -77,6 +89,23 @@ func (ur *UserResolver) GetPeople(ctx context.Context) ([]api.Person, error) {
 	return ur.people, nil
 }
 
+// Index returns an index of the cached people, fetching them the first time
+// it is needed. The same index is returned on later calls.
+func (ur *UserResolver) Index(ctx context.Context) (*PeopleIndex, error) {
+	if err := ur.ensurePeopleCached(ctx); err != nil {
+		return nil, err
+	}
+	return ur.peopleIndex(), nil
+}
+
+// peopleIndex returns the index of the cached people, building it once
+func (ur *UserResolver) peopleIndex() *PeopleIndex {
+	if ur.index == nil {
+		ur.index = NewPeopleIndex(ur.people)
+	}
+	return ur.index
+}
+
 // ResolvePeople resolves a list of user identifiers to full Person objects
 func (ur *UserResolver) ResolvePeople(ctx context.Context, identifiers []string) ([]api.Person, error) {
 	if err := ur.ensurePeopleCached(ctx); err != nil {
@@ -99,11 +128,8 @@ func (ur *UserResolver) ResolvePeople(ctx context.Context, identifiers []string)
 			continue
 		}
 
-		for _, p := range ur.people {
-			if p.ID == personID {
-				people = append(people, p)
-				break
-			}
+		if person, ok := ur.peopleIndex().ByID(personID); ok {
+			people = append(people, *person)
 		}
 	}
 
@@ -120,6 +146,16 @@ func (ur *UserResolver) ensurePeopleCached(ctx context.Context) error {
 		return nil
 	}
 
+	if ur.accountWide {
+		people, err := ur.client.GetAllPeople(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to fetch people: %w", err)
+		}
+		ur.people = people
+		ur.cached = true
+		return nil
+	}
+
 	people, err := ur.client.GetProjectPeople(ctx, ur.projectID)
 	if err != nil {
 		return fmt.Errorf("failed to fetch project people: %w", err)
@@ -157,11 +193,8 @@ func (ur *UserResolver) resolveIdentifier(identifier string) (int64, bool) {
 
 // findByEmail finds a person by email address (case-insensitive)
 func (ur *UserResolver) findByEmail(email string) (int64, bool) {
-	email = strings.ToLower(strings.TrimSpace(email))
-	for _, person := range ur.people {
-		if strings.ToLower(person.EmailAddress) == email {
-			return person.ID, true
-		}
+	if person, ok := ur.peopleIndex().ByEmail(email); ok {
+		return person.ID, true
 	}
 	return 0, false
 }
@@ -198,3 +231,58 @@ func (ur *UserResolver) findByName(name string) (int64, bool) {
 
 	return 0, false
 }
+
+// Assignee update modes for --assignee-mode
+const (
+	AssigneeModeReplace = "replace"
+	AssigneeModeAdd     = "add"
+	AssigneeModeRemove  = "remove"
+)
+
+// ValidateAssigneeMode checks that mode is one of replace, add, or remove
+func ValidateAssigneeMode(mode string) error {
+	switch mode {
+	case AssigneeModeReplace, AssigneeModeAdd, AssigneeModeRemove:
+		return nil
+	default:
+		return fmt.Errorf("invalid assignee mode %q (use replace, add, or remove)", mode)
+	}
+}
+
+// ApplyAssigneeMode combines the current assignee IDs with ids according to mode:
+// replace returns exactly ids, add appends ids not already assigned, and remove
+// drops ids from the current set. The order of current assignees is preserved.
+func ApplyAssigneeMode(current, ids []int64, mode string) []int64 {
+	result := make([]int64, 0, len(current)+len(ids))
+	seen := make(map[int64]bool)
+
+	switch mode {
+	case AssigneeModeAdd:
+		for _, id := range append(append([]int64{}, current...), ids...) {
+			if !seen[id] {
+				seen[id] = true
+				result = append(result, id)
+			}
+		}
+	case AssigneeModeRemove:
+		remove := make(map[int64]bool, len(ids))
+		for _, id := range ids {
+			remove[id] = true
+		}
+		for _, id := range current {
+			if !remove[id] && !seen[id] {
+				seen[id] = true
+				result = append(result, id)
+			}
+		}
+	default:
+		for _, id := range ids {
+			if !seen[id] {
+				seen[id] = true
+				result = append(result, id)
+			}
+		}
+	}
+
+	return result
+}
@@ -32,6 +32,27 @@ func TestNewUserResolver(t *testing.T) {
 	}
 }
 
+func TestNewAccountUserResolver_UsesAllPeople(t *testing.T) {
+	mockClient := mock.NewMockClient()
+	mockClient.People = []api.Person{
+		{ID: 1, Name: "John Doe", EmailAddress: "john@example.com"},
+		{ID: 2, Name: "Jane Smith", EmailAddress: "jane@example.com"},
+	}
+
+	resolver := NewAccountUserResolver(mockClient)
+	ids, err := resolver.ResolveUsers(context.Background(), []string{"jane@example.com"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(ids) != 1 || ids[0] != 2 {
+		t.Errorf("Expected [2], got %v", ids)
+	}
+	if len(mockClient.Calls) != 1 || mockClient.Calls[0] != "GetAllPeople()" {
+		t.Errorf("Expected a single GetAllPeople call, got %v", mockClient.Calls)
+	}
+}
+
 func TestUserResolver_GetPeople(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -400,3 +421,47 @@ func TestWriteToPager(t *testing.T) {
 		})
 	}
 }
+
+func TestApplyAssigneeMode(t *testing.T) {
+	current := []int64{1, 2, 3}
+
+	tests := []struct {
+		name string
+		ids  []int64
+		mode string
+		want []int64
+	}{
+		{name: "replace", ids: []int64{4, 2}, mode: AssigneeModeReplace, want: []int64{4, 2}},
+		{name: "add keeps existing", ids: []int64{4, 2}, mode: AssigneeModeAdd, want: []int64{1, 2, 3, 4}},
+		{name: "remove", ids: []int64{2, 9}, mode: AssigneeModeRemove, want: []int64{1, 3}},
+		{name: "remove all", ids: []int64{1, 2, 3}, mode: AssigneeModeRemove, want: []int64{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ApplyAssigneeMode(current, tt.ids, tt.mode)
+			if got == nil {
+				t.Fatal("ApplyAssigneeMode() = nil, want a non-nil slice so it is sent as []")
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("ApplyAssigneeMode() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("ApplyAssigneeMode() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateAssigneeMode(t *testing.T) {
+	for _, mode := range []string{AssigneeModeReplace, AssigneeModeAdd, AssigneeModeRemove} {
+		if err := ValidateAssigneeMode(mode); err != nil {
+			t.Errorf("ValidateAssigneeMode(%q) unexpected error: %v", mode, err)
+		}
+	}
+	if err := ValidateAssigneeMode("merge"); err == nil {
+		t.Error("expected error for unknown mode")
+	}
+}
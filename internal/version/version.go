@@ -3,6 +3,7 @@ package version
 import (
 	"fmt"
 	"runtime"
+	"runtime/debug"
 )
 
 // These variables are set at build time using ldflags
@@ -20,24 +21,50 @@ var (
 	GoVersion = runtime.Version()
 )
 
+// readBuildInfo is swapped out in tests
+var readBuildInfo = debug.ReadBuildInfo
+
 // Info represents version information
 type Info struct {
 	Version   string `json:"version"`
-	GitCommit string `json:"gitCommit"`
-	BuildDate string `json:"buildDate"`
-	GoVersion string `json:"goVersion"`
-	Platform  string `json:"platform"`
+	GitCommit string `json:"commit"`
+	BuildDate string `json:"date"`
+	GoVersion string `json:"go_version"`
+	OS        string `json:"os"`
+	Arch      string `json:"arch"`
+	Platform  string `json:"-"`
 }
 
-// Get returns the version information
+// Get returns the version information. Values not set through ldflags are
+// filled in from the build info embedded by the Go toolchain where possible,
+// e.g. for binaries built with go install.
 func Get() Info {
-	return Info{
+	info := Info{
 		Version:   Version,
 		GitCommit: GitCommit,
 		BuildDate: BuildDate,
 		GoVersion: GoVersion,
+		OS:        runtime.GOOS,
+		Arch:      runtime.GOARCH,
 		Platform:  fmt.Sprintf("%s/%s", runtime.GOOS, runtime.GOARCH),
 	}
+
+	bi, ok := readBuildInfo()
+	if !ok {
+		return info
+	}
+	if info.Version == "dev" && bi.Main.Version != "" && bi.Main.Version != "(devel)" {
+		info.Version = bi.Main.Version
+	}
+	for _, setting := range bi.Settings {
+		switch {
+		case setting.Key == "vcs.revision" && info.GitCommit == "unknown":
+			info.GitCommit = setting.Value
+		case setting.Key == "vcs.time" && info.BuildDate == "unknown":
+			info.BuildDate = setting.Value
+		}
+	}
+	return info
 }
 
 // String returns a formatted version string
@@ -45,6 +72,19 @@ func (i Info) String() string {
 	return fmt.Sprintf("bc4 version %s (%s, %s)", i.Version, i.GitCommit, i.BuildDate)
 }
 
+// VerboseString returns the version string followed by the Go version and
+// platform, for bug reports
+func (i Info) VerboseString() string {
+	return fmt.Sprintf(`%s
+Go version: %s
+OS/Arch:    %s/%s`,
+		i.String(),
+		i.GoVersion,
+		i.OS,
+		i.Arch,
+	)
+}
+
 // DetailedString returns a detailed version string
 func (i Info) DetailedString() string {
 	return fmt.Sprintf(`bc4 version %s
@@ -62,5 +102,5 @@ func (i Info) DetailedString() string {
 
 // UserAgent returns a properly formatted User-Agent string for HTTP requests
 func UserAgent() string {
-	return fmt.Sprintf("bc4-cli/%s (github.com/needmore/bc4)", Version)
+	return fmt.Sprintf("bc4-cli/%s (%s; %s) (github.com/needmore/bc4)", Version, runtime.GOOS, runtime.GOARCH)
 }
@@ -1,6 +1,9 @@
 package version
 
 import (
+	"encoding/json"
+	"runtime"
+	"runtime/debug"
 	"strings"
 	"testing"
 )
@@ -46,3 +49,60 @@ func TestGet(t *testing.T) {
 		t.Errorf("GoVersion should start with 'go', got: %s", info.GoVersion)
 	}
 }
+
+func TestUserAgent_IncludesPlatform(t *testing.T) {
+	userAgent := UserAgent()
+	if !strings.Contains(userAgent, "("+runtime.GOOS+"; "+runtime.GOARCH+")") {
+		t.Errorf("UserAgent should contain OS and arch, got: %s", userAgent)
+	}
+}
+
+func TestGet_FallsBackToBuildInfo(t *testing.T) {
+	originalRead := readBuildInfo
+	defer func() { readBuildInfo = originalRead }()
+
+	readBuildInfo = func() (*debug.BuildInfo, bool) {
+		return &debug.BuildInfo{
+			Main: debug.Module{Version: "v1.2.3"},
+			Settings: []debug.BuildSetting{
+				{Key: "vcs.revision", Value: "abc123"},
+				{Key: "vcs.time", Value: "2025-01-02T03:04:05Z"},
+			},
+		}, true
+	}
+
+	info := Get()
+	if Version == "dev" && info.Version != "v1.2.3" {
+		t.Errorf("Version should come from build info, got: %s", info.Version)
+	}
+	if GitCommit == "unknown" && info.GitCommit != "abc123" {
+		t.Errorf("GitCommit should come from build info, got: %s", info.GitCommit)
+	}
+	if BuildDate == "unknown" && info.BuildDate != "2025-01-02T03:04:05Z" {
+		t.Errorf("BuildDate should come from build info, got: %s", info.BuildDate)
+	}
+	if info.OS != runtime.GOOS || info.Arch != runtime.GOARCH {
+		t.Errorf("OS/Arch should match the runtime, got: %s/%s", info.OS, info.Arch)
+	}
+}
+
+func TestInfo_JSON(t *testing.T) {
+	data, err := json.Marshal(Info{Version: "1.0.0", GitCommit: "abc", BuildDate: "today", GoVersion: "go1.23", OS: "linux", Arch: "amd64", Platform: "linux/amd64"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"version":"1.0.0","commit":"abc","date":"today","go_version":"go1.23","os":"linux","arch":"amd64"}`
+	if string(data) != want {
+		t.Errorf("got %s, want %s", data, want)
+	}
+}
+
+func TestInfo_VerboseString(t *testing.T) {
+	s := Info{Version: "1.0.0", GitCommit: "abc", BuildDate: "today", GoVersion: "go1.23", OS: "linux", Arch: "amd64"}.VerboseString()
+	if !strings.HasPrefix(s, "bc4 version 1.0.0 (abc, today)\n") {
+		t.Errorf("VerboseString should start with the version line, got: %s", s)
+	}
+	if !strings.Contains(s, "go1.23") || !strings.Contains(s, "linux/amd64") {
+		t.Errorf("VerboseString should include Go version and platform, got: %s", s)
+	}
+}